@@ -0,0 +1,317 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const codegenTableProto = `edition = "2023";
+
+package codegentable;
+
+option go_package = "codegentable/helper";
+
+enum Color {
+  COLOR_UNSPECIFIED = 0;
+  COLOR_BLUE = -2;
+}
+
+message Wide {
+  int32 required_id = 1 [features.field_presence = LEGACY_REQUIRED];
+  string name = 2;
+  bytes blob = 3;
+  int64 implicit_int64 = 4 [features.field_presence = IMPLICIT];
+  repeated sint64 packed = 5;
+  repeated uint32 expanded = 6 [features.repeated_field_encoding = EXPANDED];
+  Color color = 7;
+  Wide self = 8;
+  repeated Wide kids = 9;
+  double d = 10;
+  float f = 11;
+  fixed64 fx = 12;
+  sfixed32 sfx = 13;
+  bool b = 14;
+  map<int64, string> labels = 15;
+  map<string, Wide> by_name = 16;
+  map<uint32, Color> colors = 17;
+  oneof choice {
+    int64 choice_int = 18;
+    string choice_name = 19;
+    Wide choice_wide = 20;
+    bytes choice_blob = 21;
+  }
+  repeated string names = 22;
+  repeated bytes blobs = 23;
+  repeated double ds = 24;
+  int64 last = 536870911;
+}
+
+message Delimited {
+  Wide wide = 1 [features.message_encoding = DELIMITED];
+}
+`
+
+const codegenTableBehaviorTest = `package codegentable
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	helper "codegentable/helper"
+	table "codegentable/table"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func newHelperWide() *helper.Wide {
+	kid := &helper.Wide{RequiredId: ptr(int32(-7)), Name: ptr("kid"), Blob: []byte{}, Color: helper.Color_COLOR_BLUE.Enum()}
+	return &helper.Wide{
+		RequiredId:    ptr(int32(3)),
+		Name:          ptr("héllo"),
+		Blob:          []byte("blob"),
+		ImplicitInt64: -1,
+		Packed:        []int64{-1, 0, 1 << 40},
+		Expanded:      []uint32{0, 300},
+		Color:         helper.Color_COLOR_BLUE.Enum(),
+		Self:          kid,
+		Kids:          []*helper.Wide{kid, {RequiredId: ptr(int32(0))}},
+		D:             ptr(2.5),
+		F:             ptr(float32(-1)),
+		Fx:            ptr(uint64(1 << 63)),
+		Sfx:           ptr(int32(-9)),
+		B:             ptr(false),
+		Labels:        map[int64]string{-5: "neg"},
+		ByName:        map[string]*helper.Wide{"kid": kid},
+		Colors:        map[uint32]helper.Color{9: helper.Color_COLOR_BLUE},
+		Choice:        &helper.Wide_ChoiceWide{ChoiceWide: kid},
+		Names:         []string{"", "a"},
+		Blobs:         [][]byte{nil, {1}},
+		Ds:            []float64{0, -0.5},
+		Last:          ptr(int64(-1)),
+	}
+}
+
+func TestTableMatchesHelper(t *testing.T) {
+	want, err := newHelperWide().MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStrict, err := newHelperWide().MarshalVTStrict()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown := []byte{0xf8, 0x07, 0x01, 0x82, 0x08, 0x02, 'h', 'i'}
+	want = append(want, unknown...)
+	wantStrict = append(wantStrict, unknown...)
+
+	var msg table.Wide
+	if err := msg.UnmarshalVT(want); err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.SizeVT(); got != len(want) {
+		t.Fatalf("SizeVT() = %d, want %d", got, len(want))
+	}
+	got, err := msg.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", got, want)
+	}
+	got, err = msg.MarshalVTStrict()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, wantStrict) {
+		t.Fatalf("MarshalVTStrict() = %x, want %x", got, wantStrict)
+	}
+
+	buf := append([]byte(nil), want...)
+	var aliased table.Wide
+	if err := aliased.UnmarshalVTUnsafe(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !aliased.EqualVT(&msg) {
+		t.Fatal("UnmarshalVTUnsafe result differs from UnmarshalVT")
+	}
+	buf[bytes.Index(buf, []byte("kid"))] = 'K'
+	if _, ok := aliased.GetByName()["Kid"]; ok {
+		t.Fatal("map keys must not alias the input buffer")
+	}
+	if aliased.GetSelf().GetName() != "Kid" {
+		t.Fatalf("unsafe string = %q, want aliased input", aliased.GetSelf().GetName())
+	}
+}
+
+func TestTableUnmarshalFieldsMatchesHelper(t *testing.T) {
+	data, err := newHelperWide().MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := []int32{2, 9, 16, 536870911}
+	var want helper.Wide
+	if err := want.UnmarshalVTFields(data, fields...); err != nil {
+		t.Fatal(err)
+	}
+	var got table.Wide
+	if err := got.UnmarshalVTFields(data, fields...); err != nil {
+		t.Fatal(err)
+	}
+	// The required field was not selected, set it so both sides marshal.
+	got.RequiredId, want.RequiredId = ptr(int32(0)), ptr(int32(0))
+	wantBytes, err := want.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotBytes, err := got.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gotBytes, wantBytes) {
+		t.Fatalf("table UnmarshalVTFields() = %x, want %x", gotBytes, wantBytes)
+	}
+	if got.GetName() != "héllo" || got.Blob != nil || got.GetSelf() != nil {
+		t.Fatalf("table UnmarshalVTFields() decoded %v", &got)
+	}
+
+	if err := new(table.Wide).UnmarshalVTFieldsUnsafe(data, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := new(table.Wide).UnmarshalVTFields([]byte{0x12, 0x00}, 1); err == nil || !strings.Contains(err.Error(), "required field required_id not set") {
+		t.Fatalf("unmarshal missing selected required field: %v", err)
+	}
+	if err := new(table.Wide).UnmarshalVTFields([]byte{0x08, 0x01, 0x2a, 0x05, 0x01}, 1); err == nil {
+		t.Fatal("unmarshal truncated unselected field should fail")
+	}
+}
+
+func TestTableErrors(t *testing.T) {
+	_, err := (&table.Wide{}).MarshalVT()
+	if err == nil || !strings.Contains(err.Error(), "required field required_id not set") {
+		t.Fatalf("marshal missing required field: %v", err)
+	}
+	if err := new(table.Wide).UnmarshalVT([]byte{0x12, 0x00}); err == nil || !strings.Contains(err.Error(), "required field required_id not set") {
+		t.Fatalf("unmarshal missing required field: %v", err)
+	}
+	if err := new(table.Wide).UnmarshalVT([]byte{0x08, 0x01, 0x12, 0x01, 0xff}); err == nil || !strings.Contains(err.Error(), "invalid UTF-8") {
+		t.Fatalf("unmarshal invalid UTF-8: %v", err)
+	}
+	if err := new(table.Wide).UnmarshalVT([]byte{0x08, 0x01, 0x2a, 0x05, 0x01}); err == nil {
+		t.Fatal("unmarshal truncated packed field should fail")
+	}
+	if err := new(table.Wide).UnmarshalVT([]byte{0x08, 0x01, 0x11, 0x00}); err == nil || !strings.Contains(err.Error(), "wrong wireType") {
+		t.Fatalf("unmarshal wrong wire type: %v", err)
+	}
+}
+`
+
+func TestCodegenModeTableUsesFieldTables(t *testing.T) {
+	fixture := generateCodegenModeFixture(t, "codegen=table")
+	out := fixture.content
+	assertGeneratedCodegenModeFixtureCompiles(t, fixture.outDir, "table output")
+
+	assertContainsAll(t, out, "table output", []string{
+		"var tableMsg = protobuf_go_lite.Table{",
+		"Unknown: unsafe.Offsetof(Msg{}.unknownFields),",
+		"Offset: unsafe.Offsetof(Msg{}.Signed), Number: 2, Kind: protobuf_go_lite.TableKindSint32}",
+		"Flags: protobuf_go_lite.TableFlagRepeated | protobuf_go_lite.TableFlagPacked",
+		"Type: protobuf_go_lite.TableMessage[Child, *Child]{}",
+		"Case: protobuf_go_lite.TableCase[isMsg_Choice, Msg_ChoiceChild]{}",
+		"Access: protobuf_go_lite.TableMapOf[string, *Child]{}",
+		"return protobuf_go_lite.TableSize(&tableMsg, unsafe.Pointer(m))",
+		"return protobuf_go_lite.TableMarshal(&tableMsg, unsafe.Pointer(m), dAtA)",
+		"return protobuf_go_lite.TableUnmarshalFields(&tableMsg, unsafe.Pointer(m), dAtA, fields)",
+	})
+	assertContainsNone(t, out, "table output", []string{
+		"protobuf_go_lite.EncodeZigzag32",
+		"protobuf_go_lite.EncodeVarintPacked",
+		"protobuf_go_lite.DecodeSint32",
+		"protobuf_go_lite.SkipWithin",
+		"func (m *Msg_ChoicePayload) SizeVT()",
+	})
+	if strings.Count(out, "var tableMsg = ") != 1 {
+		t.Fatalf("table output should declare tableMsg once:\n%s", out)
+	}
+}
+
+func TestCodegenModeTableRequiresFeatures(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	protoPath := writeTempProto(t, codegenModeProto)
+
+	cmd := exec.Command(
+		"protoc",
+		"-I", filepath.Dir(protoPath),
+		"--plugin=protoc-gen-go-lite="+plugin,
+		"--go-lite_out="+t.TempDir(),
+		"--go-lite_opt=features=size+marshal,paths=source_relative,codegen=table",
+		protoPath,
+	)
+	cmd.Dir = root
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatal("expected codegen=table without unmarshal to fail")
+	}
+	if !strings.Contains(string(out), "codegen=table requires size, marshal, and unmarshal features") {
+		t.Fatalf("expected table feature error, got:\n%s", out)
+	}
+}
+
+func TestCodegenModeTableMatchesHelper(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	protoPath := writeTempProto(t, codegenTableProto)
+	outDir := t.TempDir()
+
+	for _, mode := range []string{"helper", "table"} {
+		if err := os.MkdirAll(filepath.Join(outDir, mode), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		cmd := exec.Command(
+			"protoc",
+			"-I", filepath.Dir(protoPath),
+			"--plugin=protoc-gen-go-lite="+plugin,
+			"--go-lite_out="+filepath.Join(outDir, mode),
+			"--go-lite_opt=features=size+equal+marshal+marshal_strict+unmarshal+unmarshal_unsafe+unmarshal_fields+unmarshal_fields_unsafe,paths=source_relative,codegen="+mode+",M"+filepath.Base(protoPath)+"=codegentable/"+mode,
+			protoPath,
+		)
+		cmd.Dir = root
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("generate %s fixture:\n%s", mode, out)
+		}
+	}
+
+	out, err := os.ReadFile(filepath.Join(outDir, "table", "fixture.pb.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertContainsAll(t, string(out), "table output", []string{
+		"var tableWideStrict = protobuf_go_lite.TableMarshalFuncs{",
+		"protobuf_go_lite.TableStrict[Wide]",
+		"var tableWideUnsafe = protobuf_go_lite.TableUnmarshalFuncs{",
+		"protobuf_go_lite.TableUnsafe[Wide]",
+		"Flags: protobuf_go_lite.TableFlagPointer | protobuf_go_lite.TableFlagRequired}",
+		"Flags: protobuf_go_lite.TableFlagPointer | protobuf_go_lite.TableFlagUTF8}",
+		"Kind: protobuf_go_lite.TableKindBytes, Flags: protobuf_go_lite.TableFlagPresence}",
+		"var tableWide = protobuf_go_lite.Table{",
+	})
+	// Messages with delimited fields keep the helper method bodies.
+	if strings.Contains(string(out), "var tableDelimited = ") {
+		t.Fatalf("messages with delimited fields should not use a table:\n%s", out)
+	}
+
+	writeFile(t, filepath.Join(outDir, "go.mod"), "module codegentable\n\ngo 1.25\n\nrequire github.com/aperturerobotics/protobuf-go-lite v0.0.0\n\nreplace github.com/aperturerobotics/protobuf-go-lite => "+root+"\n")
+	writeFile(t, filepath.Join(outDir, "table_test.go"), codegenTableBehaviorTest)
+
+	cmd := exec.Command("go", "test", "-mod=mod", "./...")
+	cmd.Dir = outDir
+	testOut, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("table output should match helper output:\n%s", testOut)
+	}
+}
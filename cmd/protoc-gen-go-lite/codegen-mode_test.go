@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
 )
 
 const codegenModeProto = `syntax = "proto3";
@@ -83,6 +85,64 @@ func TestCodegenModeUnrolledUsesPreviousMethodShape(t *testing.T) {
 	})
 }
 
+func TestCodegenModeUnmarshalVTSkipsFieldFilter(t *testing.T) {
+	for _, opts := range [][]string{nil, {"codegen=unrolled"}} {
+		out := generateCodegenModeFixture(t, opts...).content
+		unmarshal := generatedFunc(t, out, "func (m *Msg) UnmarshalVT(")
+		if strings.Contains(unmarshal, "slices.Contains") {
+			t.Fatalf("UnmarshalVT with %q should not check the field list:\n%s", opts, unmarshal)
+		}
+		fields := generatedFunc(t, out, "func (m *Msg) UnmarshalVTFields(")
+		if !strings.Contains(fields, "if !slices.Contains(fields, fieldNum) {") {
+			t.Fatalf("UnmarshalVTFields with %q should check the field list:\n%s", opts, fields)
+		}
+	}
+}
+
+func TestUnmarshalFieldsIsOptional(t *testing.T) {
+	for _, tc := range []struct {
+		features []string
+		want     []string
+	}{
+		{[]string{"all"}, nil},
+		{[]string{"all", "unmarshal_fields"}, []string{"UnmarshalVTFields("}},
+		{[]string{"all", "unmarshal_fields", "unmarshal_fields_unsafe"}, []string{"UnmarshalVTFields(", "UnmarshalVTFieldsUnsafe("}},
+	} {
+		files, err := generatortest.Generate(map[string]string{"msg.proto": codegenModeProto}, &generatortest.Options{
+			Features: tc.features,
+			Params:   []string{"paths=source_relative"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := files["msg.pb.go"]
+		if got := strings.Count(out, ") UnmarshalVTFields"); got != 2*len(tc.want) {
+			t.Errorf("features %q generated %d UnmarshalVTFields methods, want %d", tc.features, got, 2*len(tc.want))
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(out, "func (m *Msg) "+want) {
+				t.Errorf("features %q did not generate Msg.%s", tc.features, want)
+			}
+		}
+	}
+	if _, err := generatortest.Generate(map[string]string{"msg.proto": codegenModeProto}, &generatortest.Options{
+		Features: []string{"size", "unmarshal_fields"},
+	}); err == nil || !strings.Contains(err.Error(), `feature "unmarshal_fields" requires the unmarshal features`) {
+		t.Errorf("unmarshal_fields without unmarshal: err = %v", err)
+	}
+}
+
+// generatedFunc returns the declaration of out starting with prefix.
+func generatedFunc(t *testing.T, out, prefix string) string {
+	t.Helper()
+	i := strings.Index(out, prefix)
+	if i < 0 {
+		t.Fatalf("generated output missing %s", prefix)
+	}
+	body, _, _ := strings.Cut(out[i:], "\n}\n")
+	return body
+}
+
 func TestCodegenModeRejectsUnknown(t *testing.T) {
 	root := repoRoot(t)
 	plugin := buildCurrentPlugin(t, root)
@@ -151,7 +211,7 @@ func generateCodegenModeFixture(t *testing.T, opts ...string) codegenModeFixture
 	protoPath := writeTempProto(t, codegenModeProto)
 	outDir := t.TempDir()
 
-	opt := "features=size+equal+clone+marshal+unmarshal+text,paths=source_relative"
+	opt := "features=size+equal+clone+marshal+unmarshal+unmarshal_fields+text,paths=source_relative"
 	if len(opts) != 0 {
 		opt += "," + strings.Join(opts, ",")
 	}
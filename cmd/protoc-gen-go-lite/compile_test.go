@@ -0,0 +1,117 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompileMatchesPlugin(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	files := []string{
+		"testproto/basic/basic.proto",
+		"testproto/editions2024/editions2024.proto",
+		"testproto/maps/maps.proto",
+		"testproto/proto2/scalars.proto",
+		"testproto/proto3opt/opt.proto",
+		"testproto/redact/redact.proto",
+		"testproto/wkt/wkt.proto",
+	}
+	pluginOut := t.TempDir()
+	compileOut := t.TempDir()
+
+	cmd := exec.Command("protoc", append([]string{
+		"-I", ".",
+		"-I", protobufSourceDir(t, root),
+		"--plugin=protoc-gen-go-lite=" + plugin,
+		"--go-lite_out=" + pluginOut,
+		"--go-lite_opt=features=all,paths=source_relative",
+	}, files...)...)
+	cmd.Dir = root
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("protoc:\n%s", out)
+	}
+
+	cmd = exec.Command(plugin, append([]string{"compile", "-I", ".", "--out=" + compileOut, "--opt=features=all", "--opt=paths=source_relative"}, files...)...)
+	cmd.Dir = root
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compile:\n%s", out)
+	}
+
+	var generated int
+	err := filepath.WalkDir(pluginOut, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(pluginOut, path)
+		if err != nil {
+			return err
+		}
+		want := string(readFile(t, path))
+		got := string(readFile(t, filepath.Join(compileOut, rel)))
+		if got != want {
+			t.Errorf("compile output of %s differs from the plugin output:\n%s", rel, got)
+		}
+		generated++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated != len(files) {
+		t.Fatalf("protoc generated %d files, want %d", generated, len(files))
+	}
+}
+
+func TestCompileDiskPaths(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "proto", "gendemo"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "proto", "gendemo", "event.proto"), genProto)
+	descriptorSet := filepath.Join(dir, "event.binpb")
+
+	cmd := exec.Command(plugin, "compile", "-I", "proto", "--out=out", "--descriptor_set_out="+descriptorSet, "--opt=paths=source_relative", "proto/gendemo/event.proto")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compile:\n%s", out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "out", "gendemo", "event.pb.go")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(descriptorSet); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd = exec.Command(plugin, "compile", "-I", "proto", "--out=out", "event.binpb")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), "event.binpb: file is not in any import path") {
+		t.Fatalf("compile of a file outside the import paths: %v\n%s", err, out)
+	}
+}
+
+func TestCompileReportsErrors(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "bad.proto"), `syntax = "proto3";
+
+message Bad {
+  Missing value = 1;
+}
+`)
+
+	cmd := exec.Command(plugin, "compile", "--out="+dir, "bad.proto")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), `bad.proto:4:3: "Missing" is not defined.`) {
+		t.Fatalf("compile of an invalid file: %v\n%s", err, out)
+	}
+}
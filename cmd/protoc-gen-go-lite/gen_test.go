@@ -0,0 +1,151 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const genProto = `syntax = "proto3";
+
+package gendemo;
+
+import "google/protobuf/timestamp.proto";
+
+option go_package = "example.com/gendemo;gendemo";
+
+message Event {
+  string name = 1;
+  google.protobuf.Timestamp at = 2;
+}
+`
+
+func TestGenMatchesPlugin(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "event.proto"), genProto)
+	descriptorSet := filepath.Join(dir, "event.binpb")
+	pluginOut := filepath.Join(dir, "plugin")
+	genOut := filepath.Join(dir, "gen")
+	if err := os.MkdirAll(pluginOut, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"-o", descriptorSet, "--include_imports"},
+		{"--plugin=protoc-gen-go-lite=" + plugin, "--go-lite_out=" + pluginOut, "--go-lite_opt=features=all,paths=source_relative"},
+	} {
+		cmd := exec.Command("protoc", append([]string{"-I", dir, "-I", protobufSourceDir(t, root)}, append(args, "event.proto")...)...)
+		cmd.Dir = root
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("protoc %v:\n%s", args, out)
+		}
+	}
+
+	cmd := exec.Command(plugin, "gen", "--descriptor_set="+descriptorSet, "--out="+genOut, "--opt=features=all", "--opt=paths=source_relative")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gen:\n%s", out)
+	}
+
+	want := string(readFile(t, filepath.Join(pluginOut, "event.pb.go")))
+	got := string(readFile(t, filepath.Join(genOut, "event.pb.go")))
+	if got != want {
+		t.Fatalf("gen output differs from the plugin output:\n%s", got)
+	}
+	if _, err := os.Stat(filepath.Join(genOut, "google")); !os.IsNotExist(err) {
+		t.Fatalf("gen generated the well-known types: %v", err)
+	}
+
+	cmd = exec.Command(plugin, "gen", "--descriptor_set="+descriptorSet, "--out="+genOut, "missing.proto")
+	out, err := cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), `file "missing.proto" is not in the descriptor set`) {
+		t.Fatalf("gen of a missing file: %v\n%s", err, out)
+	}
+}
+
+const genDepProto = `syntax = "proto3";
+
+package gendep;
+
+option go_package = "example.com/gendep;gendep";
+
+message Dep {
+  string id = 1;
+}
+`
+
+const genUserProto = `syntax = "proto3";
+
+package genuser;
+
+import "dep.proto";
+
+option go_package = "example.com/genuser;genuser";
+
+message User {
+  gendep.Dep dep = 1;
+}
+`
+
+func TestGenDefaultFiles(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "dep.proto"), genDepProto)
+	writeFile(t, filepath.Join(dir, "user.proto"), genUserProto)
+	descriptorSet := filepath.Join(dir, "user.binpb")
+	cmd := exec.Command(plugin, "compile", "--out=compiled", "--descriptor_set_out="+descriptorSet, "user.proto")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compile:\n%s", out)
+	}
+
+	// Only the file no other file imports is generated by default.
+	genOut := filepath.Join(dir, "gen")
+	cmd = exec.Command(plugin, "gen", "--descriptor_set="+descriptorSet, "--out="+genOut, "--opt=paths=source_relative")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gen:\n%s", out)
+	}
+	if _, err := os.Stat(filepath.Join(genOut, "user.pb.go")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(genOut, "dep.pb.go")); !os.IsNotExist(err) {
+		t.Fatalf("gen generated the imported file: %v", err)
+	}
+
+	// Named imports are generated.
+	cmd = exec.Command(plugin, "gen", "--descriptor_set="+descriptorSet, "--out="+genOut, "--opt=paths=source_relative", "dep.proto")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("gen of dep.proto:\n%s", out)
+	}
+	if _, err := os.Stat(filepath.Join(genOut, "dep.pb.go")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGenRejectsPathsOutsideOut(t *testing.T) {
+	root := repoRoot(t)
+	plugin := buildCurrentPlugin(t, root)
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "escape.proto"), `syntax = "proto3";
+
+package escape;
+
+option go_package = "../escape;escape";
+
+message Escape {}
+`)
+
+	cmd := exec.Command(plugin, "compile", "--out=out", "escape.proto")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), "../escape/escape.pb.go: generated file name is not a relative path inside the output directory") {
+		t.Fatalf("compile of a file outside the output directory: %v\n%s", err, out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escape")); !os.IsNotExist(err) {
+		t.Fatalf("compile wrote outside the output directory: %v", err)
+	}
+}
@@ -1,11 +1,10 @@
 package main
 
 import (
-	"os"
-	"os/exec"
-	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
 )
 
 const jsonOptionalFieldMaskProto = `syntax = "proto3";
@@ -63,41 +62,27 @@ func TestOptionalFieldMask(t *testing.T) {
 `
 
 func TestJSONOptionalFieldMask(t *testing.T) {
-	root := repoRoot(t)
-	plugin := buildCurrentPlugin(t, root)
-	protoPath := writeTempProto(t, jsonOptionalFieldMaskProto)
-	outDir := t.TempDir()
-
-	cmd := exec.Command(
-		"protoc",
-		"-I", filepath.Dir(protoPath),
-		"--plugin=protoc-gen-go-lite="+plugin,
-		"--go-lite_out="+outDir,
-		"--go-lite_opt=features=json,paths=source_relative",
-		protoPath,
-	)
-	cmd.Dir = root
-	out, err := cmd.CombinedOutput()
+	files, err := generatortest.Generate(map[string]string{"fixture.proto": jsonOptionalFieldMaskProto}, &generatortest.Options{
+		Features: []string{"json"},
+		Params:   []string{"paths=source_relative"},
+	})
 	if err != nil {
-		t.Fatalf("generate JSON optional fixture:\n%s", out)
+		t.Fatalf("generate JSON optional fixture: %v", err)
 	}
+	generatedText := files["fixture.pb.go"]
+	files["fixture_test.go"] = jsonOptionalFieldMaskTest
 
-	writeFile(t, filepath.Join(outDir, "go.mod"), "module jsonfixture\n\ngo 1.25\n\nrequire github.com/aperturerobotics/protobuf-go-lite v0.0.0\n\nreplace github.com/aperturerobotics/protobuf-go-lite => "+root+"\n")
-	writeFile(t, filepath.Join(outDir, "fixture_test.go"), jsonOptionalFieldMaskTest)
-
-	cmd = exec.Command("go", "test", "-mod=mod", "./...")
-	cmd.Dir = outDir
-	out, err = cmd.CombinedOutput()
+	sandbox, err := generatortest.NewSandbox(t.TempDir(), "jsonfixture")
 	if err != nil {
-		t.Fatalf("generated JSON optional fixture:\n%s", out)
+		t.Fatal(err)
 	}
-
-	generatedPath := filepath.Join(outDir, filepath.Base(strings.TrimSuffix(protoPath, ".proto")+".pb.go"))
-	generated, err := os.ReadFile(generatedPath)
-	if err != nil {
+	if err := sandbox.WriteFiles(files); err != nil {
 		t.Fatal(err)
 	}
-	generatedText := string(generated)
+	if out, err := sandbox.GoTest(); err != nil {
+		t.Fatalf("generated JSON optional fixture:\n%s", out)
+	}
+
 	if strings.Contains(generatedText, `OptionalValue != nil || s.HasField("optionalValue")`) {
 		t.Fatalf("optional scalar still forced by field mask:\n%s", generatedText)
 	}
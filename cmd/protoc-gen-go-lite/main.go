@@ -10,55 +10,11 @@
 package main
 
 import (
-	"flag"
-	"fmt"
-	"os"
-	"path/filepath"
-	"strings"
-
-	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"github.com/aperturerobotics/protobuf-go-lite/generator"
-	"github.com/aperturerobotics/protobuf-go-lite/internal/version"
 
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/clone"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/equal"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/json"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/marshal"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/size"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/text"
-	_ "github.com/aperturerobotics/protobuf-go-lite/features/unmarshal"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/all"
 )
 
 func main() {
-	if len(os.Args) == 2 && os.Args[1] == "--version" {
-		fmt.Fprintf(os.Stdout, "%v %v\n", filepath.Base(os.Args[0]), version.String())
-		os.Exit(0)
-	}
-
-	var cfg generator.Config
-	var codegenMode string
-	var features string
-	var f flag.FlagSet
-
-	f.BoolVar(&cfg.AllowEmpty, "allow-empty", false, "allow generation of empty files")
-	f.StringVar(&codegenMode, "codegen", string(generator.CodegenModeHelper), "code generation mode: helper or unrolled")
-	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
-	f.StringVar(&cfg.BuildTag, "buildTag", "", "the go:build tag to set on generated files")
-	f.BoolVar(&cfg.Registry, "registry", false, "generate init-time message registry with flattened custom options")
-
-	protogen.Options{
-		ParamFunc: f.Set,
-	}.Run(func(plugin *protogen.Plugin) error {
-		if err := cfg.SetCodegenMode(codegenMode); err != nil {
-			return err
-		}
-		featureNames := strings.Split(features, "+")
-		gen, err := generator.NewGenerator(plugin, featureNames, &cfg)
-		if err != nil {
-			return err
-		}
-		gen.Generate()
-
-		return nil
-	})
+	generator.Main(generator.MainOptions{})
 }
@@ -0,0 +1,151 @@
+package protobuf_go_lite
+
+import (
+	"bytes"
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+)
+
+// CompareVT is a message with a CompareVT function.
+type CompareVT[T any] interface {
+	// CompareVT returns -1, 0 or +1 as the message orders before, equal to or
+	// after other.
+	CompareVT(other T) int
+}
+
+// Compare orders two messages with CompareVT. It can be passed to
+// slices.SortFunc and similar functions.
+func Compare[T CompareVT[T]](a, b T) int {
+	return a.CompareVT(b)
+}
+
+// CompareBool orders false before true.
+func CompareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+// ComparePtr orders two explicit scalars, where unset orders before set.
+func ComparePtr[T cmp.Ordered](a, b *T) int {
+	if a == nil || b == nil {
+		return CompareBool(a != nil, b != nil)
+	}
+	return cmp.Compare(*a, *b)
+}
+
+// CompareBoolPtr orders two explicit bools, where unset orders before set.
+func CompareBoolPtr(a, b *bool) int {
+	if a == nil || b == nil {
+		return CompareBool(a != nil, b != nil)
+	}
+	return CompareBool(*a, *b)
+}
+
+// CompareBytesPresent orders two explicit bytes values, where nil orders
+// before set.
+func CompareBytesPresent(a, b []byte) int {
+	if a == nil || b == nil {
+		return CompareBool(a != nil, b != nil)
+	}
+	return cmp.Compare(string(a), string(b))
+}
+
+// CompareVTImplicit orders two messages with compare, where nil is equivalent
+// to an empty message.
+func CompareVTImplicit[T any](a, b *T, compare func(a, b *T) int) int {
+	if a == b {
+		return 0
+	}
+	if a == nil {
+		a = new(T)
+	}
+	if b == nil {
+		b = new(T)
+	}
+	return compare(a, b)
+}
+
+// CompareVTSlice orders two repeated message fields element by element, where
+// nil elements are empty messages and a prefix orders first.
+func CompareVTSlice[S ~[]*T, T any](a, b S, compare func(a, b *T) int) int {
+	return slices.CompareFunc(a, b, func(a, b *T) int {
+		return CompareVTImplicit(a, b, compare)
+	})
+}
+
+// CompareMap orders two maps as their entries sorted by key, comparing entry by
+// entry and then by length.
+func CompareMap[M ~map[K]V, K comparable, V any](a, b M, compareKey func(a, b K) int, compareValue func(a, b V) int) int {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	akeys := slices.SortedFunc(maps.Keys(a), compareKey)
+	bkeys := slices.SortedFunc(maps.Keys(b), compareKey)
+	for i := range min(len(akeys), len(bkeys)) {
+		if c := compareKey(akeys[i], bkeys[i]); c != 0 {
+			return c
+		}
+		if c := compareValue(a[akeys[i]], b[bkeys[i]]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(akeys), len(bkeys))
+}
+
+// CompareVTMap orders two maps with message values like CompareMap, where nil
+// values are empty messages.
+func CompareVTMap[M ~map[K]*T, K comparable, T any](a, b M, compareKey func(a, b K) int, compare func(a, b *T) int) int {
+	return CompareMap(a, b, compareKey, func(a, b *T) int {
+		return CompareVTImplicit(a, b, compare)
+	})
+}
+
+// CompareStd orders two native well-known type values. Times order by instant.
+func CompareStd[T Std](a, b T) int {
+	switch a := any(a).(type) {
+	case time.Time:
+		return a.Compare(any(b).(time.Time))
+	case time.Duration:
+		return compareOrdered(a, b)
+	case float64:
+		return compareOrdered(a, b)
+	case float32:
+		return compareOrdered(a, b)
+	case int64:
+		return compareOrdered(a, b)
+	case uint64:
+		return compareOrdered(a, b)
+	case int32:
+		return compareOrdered(a, b)
+	case uint32:
+		return compareOrdered(a, b)
+	case bool:
+		return CompareBool(a, any(b).(bool))
+	case string:
+		return compareOrdered(a, b)
+	case []byte:
+		return bytes.Compare(a, any(b).([]byte))
+	}
+	return 0
+}
+
+// compareOrdered orders a before b, which holds a value of the type of a.
+func compareOrdered[E cmp.Ordered](a E, b any) int {
+	return cmp.Compare(a, b.(E))
+}
+
+// CompareStdPtr orders two explicit native well-known type values, where unset
+// orders before set.
+func CompareStdPtr[T Std](a, b *T) int {
+	if a == nil || b == nil {
+		return CompareBool(a != nil, b != nil)
+	}
+	return CompareStd(*a, *b)
+}
@@ -0,0 +1,310 @@
+// Package protoparse parses and links .proto source files into descriptors
+// without protoc.
+//
+// It supports proto2, proto3 and editions 2023 and 2024, and records the
+// source code info protoc records for the declarations, so the comments of the
+// files are available to the generator. Imports are searched for in the
+// import paths, and the well-known types are built in.
+package protoparse
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// Register the descriptors of the built-in files.
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/apipb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/sourcecontextpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+	_ "google.golang.org/protobuf/types/pluginpb"
+)
+
+// builtinPrefix is the path prefix of the built-in files, the well-known types
+// and the descriptor, plugin and Go features protos. They are used when they
+// are not found in the import paths.
+const builtinPrefix = "google/protobuf/"
+
+// Error is an error in a source file.
+type Error struct {
+	// Filename is the name of the file, relative to its import path.
+	Filename string
+	// Line and Column are the one-based position of the error, or zero if
+	// it is not known.
+	Line, Column int
+	// Msg describes the error.
+	Msg string
+}
+
+func newError(filename string, pos position, format string, args ...any) *Error {
+	return &Error{
+		Filename: filename,
+		Line:     pos.line + 1,
+		Column:   pos.col + 1,
+		Msg:      fmt.Sprintf(format, args...),
+	}
+}
+
+// Error formats the error as protoc does.
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return e.Filename + ": " + e.Msg
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Msg)
+}
+
+// Compiler compiles .proto files.
+type Compiler struct {
+	// ImportPaths are the directories searched for the files to compile and
+	// their imports, in order. If empty, the current directory is searched.
+	ImportPaths []string
+	// FS, if set, is the file system the import paths are in, with
+	// slash-separated paths. Otherwise they are in the operating system's.
+	FS fs.FS
+}
+
+// compiledFile is a compiled file.
+type compiledFile struct {
+	desc *descriptorpb.FileDescriptorProto
+	file protoreflect.FileDescriptor
+}
+
+// compilation is the state of a Compile call.
+type compilation struct {
+	c       *Compiler
+	files   map[string]*compiledFile
+	order   []*descriptorpb.FileDescriptorProto
+	symbols *symbols
+}
+
+// Compile parses and links the named files, which are relative to the import
+// paths. It returns them with their imports, each file after the files it
+// imports, like protoc -o --include_imports --include_source_info.
+func (c *Compiler) Compile(names ...string) (*descriptorpb.FileDescriptorSet, error) {
+	cc := &compilation{
+		c:       c,
+		files:   make(map[string]*compiledFile),
+		symbols: newSymbols(),
+	}
+	for _, name := range names {
+		if _, err := cc.load(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return &descriptorpb.FileDescriptorSet{File: cc.order}, nil
+}
+
+// load compiles the file name after its imports. importing is the chain of
+// files importing it.
+func (cc *compilation) load(name string, importing []string) (*compiledFile, error) {
+	if f, ok := cc.files[name]; ok {
+		if f == nil {
+			return nil, &Error{Filename: importing[0], Msg: "File recursively imports itself: " + strings.Join(append(importing, name), " -> ")}
+		}
+		return f, nil
+	}
+	cc.files[name] = nil
+
+	src, err := cc.read(name)
+	if err != nil {
+		return nil, err
+	}
+	if src == nil {
+		return cc.loadBuiltin(name, importing)
+	}
+	parsed, err := parse(name, string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	desc := parsed.desc
+	imports := append(append([]string(nil), desc.Dependency...), desc.OptionDependency...)
+	deps := make(map[string]*compiledFile, len(imports))
+	for _, dep := range imports {
+		f, err := cc.load(dep, append(importing, name))
+		if err != nil {
+			var notFound *importNotFoundError
+			if errors.As(err, &notFound) && notFound.name == dep {
+				return nil, newError(name, parsed.imports[dep], "Import %q was not found or had errors.", dep)
+			}
+			return nil, err
+		}
+		deps[dep] = f
+	}
+
+	f, err := cc.link(parsed, deps)
+	if err != nil {
+		return nil, err
+	}
+	cc.files[name] = f
+	cc.order = append(cc.order, f.desc)
+	return f, nil
+}
+
+// importNotFoundError reports a file missing from the import paths.
+type importNotFoundError struct {
+	name string
+}
+
+func (e *importNotFoundError) Error() string {
+	return e.name + ": File not found."
+}
+
+// read returns the source of the file name from the first import path
+// containing it, or nil if it is a built-in file not in any import path.
+func (cc *compilation) read(name string) ([]byte, error) {
+	importPaths := cc.c.ImportPaths
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+	for _, dir := range importPaths {
+		var src []byte
+		var err error
+		if cc.c.FS != nil {
+			src, err = fs.ReadFile(cc.c.FS, path.Join(dir, name))
+		} else {
+			src, err = os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		}
+		if err == nil {
+			return src, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	if strings.HasPrefix(name, builtinPrefix) {
+		if _, err := protoregistry.GlobalFiles.FindFileByPath(name); err == nil {
+			return nil, nil
+		}
+	}
+	return nil, &importNotFoundError{name: name}
+}
+
+// loadBuiltin loads a built-in file, after its imports.
+func (cc *compilation) loadBuiltin(name string, importing []string) (*compiledFile, error) {
+	file, err := protoregistry.GlobalFiles.FindFileByPath(name)
+	if err != nil {
+		return nil, err
+	}
+	imports := file.Imports()
+	for i := range imports.Len() {
+		if _, err := cc.load(imports.Get(i).Path(), append(importing, name)); err != nil {
+			return nil, err
+		}
+	}
+	desc := protodesc.ToFileDescriptorProto(file)
+	if err := cc.symbols.addFile(desc); err != nil {
+		return nil, err
+	}
+	f := &compiledFile{desc: desc, file: file}
+	cc.files[name] = f
+	cc.order = append(cc.order, desc)
+	return f, nil
+}
+
+// link resolves the names and options of a parsed file and validates it.
+func (cc *compilation) link(parsed *parsedFile, deps map[string]*compiledFile) (*compiledFile, error) {
+	desc := parsed.desc
+	name := desc.GetName()
+	if err := cc.symbols.addFile(desc); err != nil {
+		return nil, err
+	}
+
+	l := &linker{
+		symbols: cc.symbols,
+		file:    parsed,
+		visible: map[string]bool{name: true},
+	}
+	var addVisible func(f *descriptorpb.FileDescriptorProto)
+	addVisible = func(f *descriptorpb.FileDescriptorProto) {
+		l.visible[f.GetName()] = true
+		for _, i := range f.GetPublicDependency() {
+			if dep := cc.files[f.GetDependency()[i]]; dep != nil {
+				addVisible(dep.desc)
+			}
+		}
+	}
+	for _, dep := range deps {
+		addVisible(dep.desc)
+	}
+	if err := l.link(); err != nil {
+		return nil, err
+	}
+
+	// Interpret the standard options first, as the features change how the
+	// descriptors are built, and then the custom options, which need the
+	// extensions the file declares.
+	files := new(protoregistry.Files)
+	if err := cc.register(files, deps, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	oi := &optionInterpreter{linker: l}
+	if err := oi.interpret(desc, false); err != nil {
+		return nil, err
+	}
+	if err := l.checkFieldPresence(); err != nil {
+		return nil, err
+	}
+	file, err := protodesc.NewFile(desc, files)
+	if err != nil {
+		return nil, &Error{Filename: name, Msg: strings.TrimPrefix(err.Error(), "proto: ")}
+	}
+	scope := new(protoregistry.Files)
+	if err := cc.register(scope, deps, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	if err := scope.RegisterFile(file); err != nil {
+		return nil, &Error{Filename: name, Msg: err.Error()}
+	}
+	oi.files, oi.types = scope, dynamicpb.NewTypes(scope)
+	if err := oi.interpret(desc, true); err != nil {
+		return nil, err
+	}
+	if err := normalizeOptions(desc); err != nil {
+		return nil, err
+	}
+
+	file, err = protodesc.NewFile(desc, files)
+	if err != nil {
+		return nil, &Error{Filename: name, Msg: strings.TrimPrefix(err.Error(), "proto: ")}
+	}
+	return &compiledFile{desc: desc, file: file}, nil
+}
+
+// register registers the files of deps and their imports in files.
+func (cc *compilation) register(files *protoregistry.Files, deps map[string]*compiledFile, seen map[string]bool) error {
+	for name, dep := range deps {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		imports := make(map[string]*compiledFile)
+		for _, i := range append(dep.desc.GetDependency(), dep.desc.GetOptionDependency()...) {
+			imports[i] = cc.files[i]
+		}
+		if err := cc.register(files, imports, seen); err != nil {
+			return err
+		}
+		if err := files.RegisterFile(dep.file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package protoparse
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// compile writes files to a temporary import path and compiles the first
+// file named.
+func compile(t *testing.T, files map[string]string, name string) (*descriptorpb.FileDescriptorSet, error) {
+	t.Helper()
+
+	dir := t.TempDir()
+	for path, src := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c := &Compiler{ImportPaths: []string{dir}}
+	return c.Compile(name)
+}
+
+// mustCompile compiles a single file and returns its descriptor.
+func mustCompile(t *testing.T, src string) protoreflect.FileDescriptor {
+	t.Helper()
+
+	set, err := compile(t, map[string]string{"test.proto": src}, "test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd, err := files.FindFileByPath("test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func TestCompileProto2(t *testing.T) {
+	fd := mustCompile(t, `syntax = "proto2";
+package test;
+
+message Msg {
+  required int32 id = 1 [default = -0x10];
+  optional string name = 2 [default = "a\"b", json_name = "title"];
+  optional bytes data = 3 [default = "\001\xff"];
+  optional Kind kind = 4 [default = SECOND];
+  repeated group Item = 5 {
+    optional float weight = 1 [default = -inf];
+  }
+  extensions 100 to max;
+  reserved 10 to 20, 30;
+  reserved "old";
+
+  enum Kind {
+    FIRST = 1;
+    SECOND = 2;
+  }
+}
+
+extend Msg {
+  optional Msg.Kind ext_kind = 100;
+}
+`)
+	msg := fd.Messages().ByName("Msg")
+	fields := msg.Fields()
+	if got := fields.ByName("id").Default().Int(); got != -16 {
+		t.Errorf("id default = %d, want -16", got)
+	}
+	name := fields.ByName("name")
+	if got := name.Default().String(); got != `a"b` {
+		t.Errorf("name default = %q, want %q", got, `a"b`)
+	}
+	if got := name.JSONName(); got != "title" {
+		t.Errorf("name json_name = %q, want title", got)
+	}
+	if got := string(fields.ByName("data").Default().Bytes()); got != "\x01\xff" {
+		t.Errorf("data default = %q", got)
+	}
+	kind := fields.ByName("kind")
+	if kind.Kind() != protoreflect.EnumKind || kind.Enum().FullName() != "test.Msg.Kind" || kind.Default().Enum() != 2 {
+		t.Errorf("kind = %v %v default %v", kind.Kind(), kind.Enum().FullName(), kind.Default().Enum())
+	}
+	item := fields.ByName("item")
+	if item.Kind() != protoreflect.GroupKind || item.Message().FullName() != "test.Msg.Item" {
+		t.Errorf("item = %v %v", item.Kind(), item.Message().FullName())
+	}
+	if r := msg.ExtensionRanges().Get(0); r[0] != 100 || r[1] != 536870912 {
+		t.Errorf("extension range = %v", r)
+	}
+	if n := msg.ReservedRanges().Len(); n != 2 || msg.ReservedNames().Get(0) != "old" {
+		t.Errorf("reserved = %d ranges, names %v", n, msg.ReservedNames())
+	}
+	ext := fd.Extensions().ByName("ext_kind")
+	if ext.ContainingMessage().FullName() != "test.Msg" || ext.Enum().FullName() != "test.Msg.Kind" {
+		t.Errorf("ext_kind extends %v with %v", ext.ContainingMessage().FullName(), ext.Enum().FullName())
+	}
+}
+
+func TestCompileProto3(t *testing.T) {
+	fd := mustCompile(t, `syntax = "proto3";
+package test.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Msg {
+  optional int32 count = 1;
+  map<string, Nested> by_name = 2;
+  google.protobuf.Timestamp at = 3;
+  oneof choice {
+    string text = 4;
+    bytes raw = 5;
+  }
+  message Nested {}
+}
+
+service Svc {
+  rpc Watch(Msg) returns (stream .test.v1.Msg.Nested);
+}
+`)
+	msg := fd.Messages().ByName("Msg")
+	count := msg.Fields().ByName("count")
+	if !count.HasPresence() || count.ContainingOneof() == nil || !count.ContainingOneof().IsSynthetic() || count.ContainingOneof().Name() != "_count" {
+		t.Errorf("count is not proto3 optional")
+	}
+	byName := msg.Fields().ByName("by_name")
+	if !byName.IsMap() || byName.Message().Name() != "ByNameEntry" || byName.MapValue().Message().FullName() != "test.v1.Msg.Nested" {
+		t.Errorf("by_name is not a map of Nested")
+	}
+	if got := msg.Fields().ByName("at").Message().FullName(); got != "google.protobuf.Timestamp" {
+		t.Errorf("at type = %v", got)
+	}
+	if got := msg.Oneofs().ByName("choice").Fields().Len(); got != 2 {
+		t.Errorf("choice has %d fields, want 2", got)
+	}
+	method := fd.Services().ByName("Svc").Methods().ByName("Watch")
+	if !method.IsStreamingServer() || method.IsStreamingClient() || method.Output().FullName() != "test.v1.Msg.Nested" {
+		t.Errorf("Watch = %v -> %v", method.Input().FullName(), method.Output().FullName())
+	}
+}
+
+func TestCompileEditions(t *testing.T) {
+	fd := mustCompile(t, `edition = "2024";
+package test;
+
+option features.field_presence = IMPLICIT;
+
+export message Msg {
+  int32 plain = 1;
+  int32 explicit = 2 [features.field_presence = EXPLICIT];
+  repeated int32 expanded = 3 [features.repeated_field_encoding = EXPANDED];
+  local enum Closed {
+    option features.enum_type = CLOSED;
+    ZERO = 0;
+  }
+}
+`)
+	if fd.Syntax() != protoreflect.Editions {
+		t.Fatalf("syntax = %v, want editions", fd.Syntax())
+	}
+	fields := fd.Messages().ByName("Msg").Fields()
+	if fields.ByName("plain").HasPresence() {
+		t.Error("plain has presence")
+	}
+	if !fields.ByName("explicit").HasPresence() {
+		t.Error("explicit has no presence")
+	}
+	if fields.ByName("expanded").IsPacked() {
+		t.Error("expanded is packed")
+	}
+	if !fd.Messages().ByName("Msg").Enums().ByName("Closed").IsClosed() {
+		t.Error("Closed is open")
+	}
+}
+
+func TestCompileComments(t *testing.T) {
+	set, err := compile(t, map[string]string{"test.proto": `// Detached.
+
+// Syntax.
+syntax = "proto3";
+
+/* Message
+ * comment. */
+message Msg {
+  int32 id = 1; // Trailing.
+}
+`}, "test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd, err := protodesc.NewFile(set.File[0], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locs := fd.SourceLocations()
+	syntax := locs.ByPath(protoreflect.SourcePath{12})
+	if syntax.LeadingComments != " Syntax.\n" || len(syntax.LeadingDetachedComments) != 1 || syntax.LeadingDetachedComments[0] != " Detached.\n" {
+		t.Errorf("syntax comments = %q, %q", syntax.LeadingComments, syntax.LeadingDetachedComments)
+	}
+	msg := fd.Messages().ByName("Msg")
+	if got := locs.ByDescriptor(msg).LeadingComments; got != " Message\n comment. " {
+		t.Errorf("message comment = %q", got)
+	}
+	id := locs.ByDescriptor(msg.Fields().ByName("id"))
+	if id.TrailingComments != " Trailing.\n" || id.StartLine != 8 || id.StartColumn != 2 || id.EndColumn != 15 {
+		t.Errorf("id location = %+v", id)
+	}
+}
+
+func TestCompileCustomOptions(t *testing.T) {
+	set, err := compile(t, map[string]string{
+		"opts.proto": `syntax = "proto3";
+package opts;
+import "google/protobuf/descriptor.proto";
+message Rule {
+  string name = 1;
+  repeated int32 values = 2;
+}
+extend google.protobuf.MessageOptions {
+  Rule rule = 50000;
+  repeated string tags = 50001;
+}
+`,
+		"test.proto": `syntax = "proto3";
+import "opts.proto";
+message Msg {
+  option (opts.rule) = { name: "r" values: [1, 2] };
+  option (opts.rule).values = 3;
+  option (opts.tags) = "a";
+  option (opts.tags) = "b";
+}
+`,
+	}, "test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(set.File); n != 3 {
+		t.Fatalf("compiled %d files, want descriptor.proto, opts.proto and test.proto", n)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := dynamicpb.NewTypes(files)
+	raw, err := proto.Marshal(set.File[2].MessageType[0].Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := &descriptorpb.MessageOptions{}
+	if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(raw, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := &descriptorpb.MessageOptions{}
+	if err := (prototext.UnmarshalOptions{Resolver: types}).Unmarshal([]byte(`[opts.rule] { name: "r" values: [1, 2, 3] } [opts.tags]: ["a", "b"]`), want); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(opts, want) {
+		t.Errorf("options = %v, want %v", opts, want)
+	}
+}
+
+func TestCompileProto2DefaultJSONNameConflict(t *testing.T) {
+	// protoc only warns about a conflict with a default JSON name in proto2.
+	_, err := compile(t, map[string]string{
+		"test.proto": "syntax = \"proto2\";\nmessage Msg {\n  optional int32 foo_bar = 1;\n  optional int32 fooBar = 2 [json_name = \"fooBar\"];\n  optional int32 foobar = 3;\n}\n",
+	}, "test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		files map[string]string
+		want  string
+	}{{
+		files: map[string]string{"test.proto": `syntax = "proto4";`},
+		want:  `test.proto:1:10: Unrecognized syntax identifier "proto4".  This parser only recognizes "proto2" and "proto3".`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 id = 1\n}\n"},
+		want:  "test.proto:4:1: Expected \";\".",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  Missing m = 1;\n}\n"},
+		want:  `test.proto:3:3: "Missing" is not defined.`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nimport \"missing.proto\";\n"},
+		want:  `test.proto:2:1: Import "missing.proto" was not found or had errors.`,
+	}, {
+		files: map[string]string{
+			"test.proto": "syntax = \"proto3\";\nimport \"a.proto\";\nmessage Msg {\n  B b = 1;\n}\n",
+			"a.proto":    "syntax = \"proto3\";\nimport \"b.proto\";\n",
+			"b.proto":    "syntax = \"proto3\";\nmessage B {}\n",
+		},
+		want: `test.proto:4:3: "B" seems to be defined in "b.proto", which is not imported by "test.proto".  To use it here, please add the necessary import.`,
+	}, {
+		files: map[string]string{
+			"test.proto": "syntax = \"proto3\";\nimport \"a.proto\";\n",
+			"a.proto":    "syntax = \"proto3\";\nimport \"test.proto\";\n",
+		},
+		want: "test.proto: File recursively imports itself: test.proto -> a.proto -> test.proto",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 1;\n  int32 a = 2;\n}\n"},
+		want:  `test.proto: "Msg.a" is already defined.`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto2\";\nmessage Msg {\n  optional int32 a = 1 [default = \"x\"];\n}\n"},
+		want:  "test.proto:3:35: Expected integer for field default value.",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 0;\n}\n"},
+		want:  "test.proto:3:13: Field numbers must be positive integers.",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 536870912;\n}\n"},
+		want:  "test.proto:3:13: Field numbers cannot be greater than 536870911.",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 19000;\n}\n"},
+		want:  "test.proto:3:13: Field numbers 19000 through 19999 are reserved for the protocol buffer library implementation.",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto2\";\nmessage Msg {\n  optional group G = 19999 {}\n}\n"},
+		want:  "test.proto:3:22: Field numbers 19000 through 19999 are reserved for the protocol buffer library implementation.",
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 foo_bar = 1;\n  int32 fooBar = 2;\n}\n"},
+		want:  `test.proto:2:9: The default JSON name of field "fooBar" ("fooBar") conflicts with the default JSON name of field "foo_bar".`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 1 [json_name = \"x\"];\n  int32 b = 2 [json_name = \"x\"];\n}\n"},
+		want:  `test.proto:2:9: The custom JSON name of field "b" ("x") conflicts with the custom JSON name of field "a".`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto3\";\nmessage Msg {\n  int32 a = 1 [json_name = \"b\"];\n  int32 b = 2;\n}\n"},
+		want:  `test.proto:2:9: The default JSON name of field "b" ("b") conflicts with the custom JSON name of field "a".`,
+	}, {
+		files: map[string]string{"test.proto": "syntax = \"proto2\";\nmessage Msg {\n  optional int32 a = 1 [json_name = \"x\"];\n  optional int32 b = 2 [json_name = \"x\"];\n}\n"},
+		want:  `test.proto:2:9: The custom JSON name of field "b" ("x") conflicts with the custom JSON name of field "a".`,
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  int32 foo_bar = 1;\n  int32 fooBar = 2;\n}\n"},
+		want:  `test.proto:2:9: The default JSON name of field "fooBar" ("fooBar") conflicts with the default JSON name of field "foo_bar".`,
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  repeated int32 b = 2 [features.field_presence = EXPLICIT];\n}\n"},
+		want:  "test.proto:3:18: Repeated fields can't specify field presence.",
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  map<string, int32> b = 2 [features.field_presence = IMPLICIT];\n}\n"},
+		want:  "test.proto:3:22: Repeated fields can't specify field presence.",
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  oneof o {\n    int32 b = 2 [features.field_presence = EXPLICIT];\n  }\n}\n"},
+		want:  "test.proto:4:11: Oneof fields can't specify field presence.",
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  Msg b = 2 [features.field_presence = IMPLICIT];\n}\n"},
+		want:  "test.proto:3:7: Message fields can't specify implicit presence.",
+	}, {
+		files: map[string]string{"test.proto": "edition = \"2023\";\nmessage Msg {\n  extensions 10 to 20;\n}\nextend Msg {\n  int32 b = 10 [features.field_presence = EXPLICIT];\n}\n"},
+		want:  "test.proto:6:9: Extensions can't specify field presence.",
+	}} {
+		_, err := compile(t, tc.files, "test.proto")
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Compile(%q) = %v, want %s", tc.files["test.proto"], err, tc.want)
+		}
+	}
+}
+
+func TestCompileFS(t *testing.T) {
+	c := &Compiler{
+		ImportPaths: []string{"proto"},
+		FS: fstest.MapFS{
+			"proto/a/a.proto": {Data: []byte("syntax = \"proto3\";\npackage a;\nimport \"b.proto\";\nmessage A {\n  b.B b = 1;\n}\n")},
+			"proto/b.proto":   {Data: []byte("syntax = \"proto3\";\npackage b;\nmessage B {}\n")},
+		},
+	}
+	set, err := c.Compile("a/a.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range set.File {
+		names = append(names, file.GetName())
+	}
+	if got := strings.Join(names, " "); got != "b.proto a/a.proto" {
+		t.Errorf("compiled files = %s, want b.proto a/a.proto", got)
+	}
+	if got := set.File[1].MessageType[0].Field[0].GetTypeName(); got != ".b.B" {
+		t.Errorf("b type = %s, want .b.B", got)
+	}
+}
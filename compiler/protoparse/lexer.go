@@ -0,0 +1,617 @@
+package protoparse
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tokenKind is the kind of a token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenSymbol
+)
+
+// position is a zero-based line and column in a source file. Columns count
+// bytes, with tabs advancing to the next multiple of eight, as protoc does.
+type position struct {
+	line, col int
+}
+
+// token is a lexical token with the comments attached to it.
+type token struct {
+	kind tokenKind
+	// text is the source text of the token.
+	text string
+	// start and end are the positions of the first byte of the token and of
+	// the byte after it.
+	start, end position
+	// offset is the byte offset of the token in the source.
+	offset int
+
+	// leading and detached are the comments before the token, and trailing
+	// is the comment after it, as protoc attributes them.
+	leading  string
+	trailing string
+	detached []string
+}
+
+// lexer splits a source file into tokens.
+type lexer struct {
+	filename string
+	src      string
+	off      int
+	pos      position
+	err      error
+}
+
+func (l *lexer) errorf(pos position, format string, args ...any) {
+	if l.err == nil {
+		l.err = newError(l.filename, pos, format, args...)
+	}
+}
+
+// peek returns the current byte, or 0 at the end of the source.
+func (l *lexer) peek() byte {
+	if l.off < len(l.src) {
+		return l.src[l.off]
+	}
+	return 0
+}
+
+// advance consumes the current byte.
+func (l *lexer) advance() {
+	if l.off >= len(l.src) {
+		return
+	}
+	switch l.src[l.off] {
+	case '\n':
+		l.pos.line++
+		l.pos.col = 0
+	case '\t':
+		l.pos.col += 8 - l.pos.col%8
+	default:
+		l.pos.col++
+	}
+	l.off++
+}
+
+func (l *lexer) consume(c byte) bool {
+	if l.peek() == c && l.off < len(l.src) {
+		l.advance()
+		return true
+	}
+	return false
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isWhitespace(c byte) bool {
+	switch c {
+	case ' ', '\n', '\t', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
+
+// skipLineWhitespace consumes whitespace other than newlines.
+func (l *lexer) skipLineWhitespace() {
+	for l.off < len(l.src) && l.peek() != '\n' && isWhitespace(l.peek()) {
+		l.advance()
+	}
+}
+
+// commentStart is what tryCommentStart found.
+type commentStart int
+
+const (
+	noComment commentStart = iota
+	lineComment
+	blockComment
+	slashNotComment
+)
+
+// tryCommentStart consumes the start of a comment. A lone slash is consumed
+// too and reported as slashNotComment.
+func (l *lexer) tryCommentStart() commentStart {
+	if l.peek() != '/' {
+		return noComment
+	}
+	if l.off+1 < len(l.src) {
+		switch l.src[l.off+1] {
+		case '/':
+			l.advance()
+			l.advance()
+			return lineComment
+		case '*':
+			l.advance()
+			l.advance()
+			return blockComment
+		}
+	}
+	l.advance()
+	return slashNotComment
+}
+
+// lineCommentText consumes the rest of a line comment, including the
+// newline, and returns it.
+func (l *lexer) lineCommentText() string {
+	start := l.off
+	for l.off < len(l.src) && l.peek() != '\n' {
+		l.advance()
+	}
+	l.consume('\n')
+	return l.src[start:l.off]
+}
+
+// blockCommentText consumes the rest of a block comment and returns its
+// content, with the leading whitespace and asterisk of each line removed.
+func (l *lexer) blockCommentText(start position) string {
+	var b strings.Builder
+	for {
+		for l.off < len(l.src) && l.peek() != '*' && l.peek() != '/' && l.peek() != '\n' {
+			b.WriteByte(l.peek())
+			l.advance()
+		}
+		switch {
+		case l.off >= len(l.src):
+			l.errorf(start, "End-of-file inside block comment.")
+			return b.String()
+		case l.consume('\n'):
+			b.WriteByte('\n')
+			l.skipLineWhitespace()
+			if l.peek() == '*' && !strings.HasPrefix(l.src[l.off:], "*/") {
+				l.advance()
+			}
+		case l.consume('*'):
+			if l.consume('/') {
+				return b.String()
+			}
+			b.WriteByte('*')
+		case l.consume('/'):
+			b.WriteByte('/')
+			if l.peek() == '*' {
+				l.errorf(l.pos, "\"/*\" inside block comment.  Block comments cannot be nested.")
+			}
+		}
+	}
+}
+
+// scan consumes whitespace and comments, discarding them, and then returns
+// the next token.
+func (l *lexer) scan() token {
+	for {
+		for isWhitespace(l.peek()) && l.off < len(l.src) {
+			l.advance()
+		}
+		start, offset := l.pos, l.off
+		switch l.tryCommentStart() {
+		case lineComment:
+			l.lineCommentText()
+			continue
+		case blockComment:
+			l.blockCommentText(start)
+			continue
+		case slashNotComment:
+			return l.token(tokenSymbol, start, offset)
+		}
+		return l.scanToken()
+	}
+}
+
+func (l *lexer) token(kind tokenKind, start position, offset int) token {
+	return token{kind: kind, text: l.src[offset:l.off], start: start, end: l.pos, offset: offset}
+}
+
+// scanToken returns the token at the current position.
+func (l *lexer) scanToken() token {
+	start, offset := l.pos, l.off
+	if l.off >= len(l.src) {
+		return token{kind: tokenEOF, start: start, end: start, offset: offset}
+	}
+	c := l.peek()
+	switch {
+	case isLetter(c):
+		for isLetter(l.peek()) || isDigit(l.peek()) {
+			l.advance()
+		}
+		return l.token(tokenIdent, start, offset)
+	case c == '.' && l.off+1 < len(l.src) && isDigit(l.src[l.off+1]):
+		l.advance()
+		return l.token(l.scanNumber(false, true), start, offset)
+	case isDigit(c):
+		l.advance()
+		return l.token(l.scanNumber(c == '0', false), start, offset)
+	case c == '"' || c == '\'':
+		l.advance()
+		l.scanString(c, start)
+		return l.token(tokenString, start, offset)
+	case c < ' ' || c == 0x7f:
+		l.errorf(start, "Invalid control characters encountered in text.")
+		l.advance()
+		return l.scan()
+	case c >= utf8.RuneSelf:
+		l.errorf(start, "Interpreting non ascii codepoint %d.", c)
+		l.advance()
+		return l.token(tokenSymbol, start, offset)
+	}
+	l.advance()
+	return l.token(tokenSymbol, start, offset)
+}
+
+// scanNumber consumes the rest of a number and returns whether it is an
+// integer or a float.
+func (l *lexer) scanNumber(startedWithZero, startedWithDot bool) tokenKind {
+	kind := tokenInt
+	switch {
+	case startedWithZero && (l.consume('x') || l.consume('X')):
+		if !isHexDigit(l.peek()) {
+			l.errorf(l.pos, "\"0x\" must be followed by hex digits.")
+		}
+		for isHexDigit(l.peek()) {
+			l.advance()
+		}
+	case startedWithZero && isDigit(l.peek()):
+		for '0' <= l.peek() && l.peek() <= '7' {
+			l.advance()
+		}
+		if isDigit(l.peek()) {
+			l.errorf(l.pos, "Numbers starting with leading zero must be in octal.")
+			for isDigit(l.peek()) {
+				l.advance()
+			}
+		}
+	default:
+		if startedWithDot {
+			kind = tokenFloat
+		}
+		for isDigit(l.peek()) {
+			l.advance()
+		}
+		if !startedWithDot && l.consume('.') {
+			kind = tokenFloat
+			for isDigit(l.peek()) {
+				l.advance()
+			}
+		}
+		if l.consume('e') || l.consume('E') {
+			kind = tokenFloat
+			_ = l.consume('-') || l.consume('+')
+			if !isDigit(l.peek()) {
+				l.errorf(l.pos, "\"e\" must be followed by exponent.")
+			}
+			for isDigit(l.peek()) {
+				l.advance()
+			}
+		}
+	}
+	switch {
+	case isLetter(l.peek()):
+		l.errorf(l.pos, "Need space between number and identifier.")
+	case l.peek() == '.' && kind == tokenFloat:
+		l.errorf(l.pos, "Already saw decimal point or exponent; can't have another one.")
+	case l.peek() == '.':
+		l.errorf(l.pos, "Hex and octal numbers must be integers.")
+	}
+	return kind
+}
+
+// scanString consumes the rest of a string literal delimited by quote. The
+// escapes are checked, and decoded later by unquote.
+func (l *lexer) scanString(quote byte, start position) {
+	for {
+		c := l.peek()
+		switch {
+		case l.off >= len(l.src):
+			l.errorf(start, "Unexpected end of string.")
+			return
+		case c == '\n':
+			l.errorf(l.pos, "String literals cannot cross line boundaries.")
+			return
+		case c == quote:
+			l.advance()
+			return
+		case c == '\\':
+			l.advance()
+			if _, err := unescape(l.src[l.off:]); err != "" {
+				l.errorf(l.pos, "%s", err)
+			}
+			l.advance()
+		default:
+			l.advance()
+		}
+	}
+}
+
+// unescape decodes the escape sequence at the start of s, which follows a
+// backslash, and returns the number of bytes it spans, or an error message.
+func unescape(s string) (int, string) {
+	if s == "" {
+		return 0, "Invalid escape sequence in string literal."
+	}
+	switch c := s[0]; {
+	case strings.IndexByte(`abfnrtv\?'"`, c) >= 0:
+		return 1, ""
+	case '0' <= c && c <= '7':
+		n := 1
+		for n < 3 && n < len(s) && '0' <= s[n] && s[n] <= '7' {
+			n++
+		}
+		return n, ""
+	case c == 'x' || c == 'X':
+		n := 1
+		for n < 3 && n < len(s) && isHexDigit(s[n]) {
+			n++
+		}
+		if n == 1 {
+			return 0, "Expected hex digits for escape sequence."
+		}
+		return n, ""
+	case c == 'u' || c == 'U':
+		digits := 4
+		if c == 'U' {
+			digits = 8
+		}
+		if len(s) < 1+digits {
+			return 0, "Expected four hex digits for \\u escape sequence."
+		}
+		for i := 1; i <= digits; i++ {
+			if !isHexDigit(s[i]) {
+				return 0, "Expected four hex digits for \\u escape sequence."
+			}
+		}
+		return 1 + digits, ""
+	}
+	return 0, "Invalid escape sequence in string literal."
+}
+
+// unquote decodes a string literal token.
+func unquote(text string) string {
+	var b strings.Builder
+	s := text[1 : len(text)-1]
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		i++
+		n, _ := unescape(s[i:])
+		if n == 0 {
+			continue
+		}
+		esc := s[i : i+n]
+		i += n
+		switch c := esc[0]; {
+		case '0' <= c && c <= '7':
+			v, _ := strconv.ParseUint(esc, 8, 16)
+			b.WriteByte(byte(v))
+		case c == 'x' || c == 'X':
+			v, _ := strconv.ParseUint(esc[1:], 16, 8)
+			b.WriteByte(byte(v))
+		case c == 'u' || c == 'U':
+			v, _ := strconv.ParseUint(esc[1:], 16, 32)
+			b.WriteRune(rune(v))
+		default:
+			b.WriteByte(simpleEscape(c))
+		}
+	}
+	return b.String()
+}
+
+// simpleEscape returns the byte the single character escape \c stands for.
+func simpleEscape(c byte) byte {
+	switch c {
+	case 'a':
+		return '\a'
+	case 'b':
+		return '\b'
+	case 'f':
+		return '\f'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'v':
+		return '\v'
+	}
+	return c
+}
+
+// commentCollector attributes the comments between two tokens, as protoc's
+// tokenizer does: a comment on the line of the previous token trails it, the
+// comment block directly before the next token leads it, and the others are
+// detached.
+type commentCollector struct {
+	prevTrailing *string
+	detached     *[]string
+	nextLeading  *string
+
+	buf           strings.Builder
+	hasComment    bool
+	isLine        bool
+	canAttachPrev bool
+	hasTrailing   bool
+	numComments   int
+}
+
+// add appends a comment to the buffer. Consecutive line comments form one
+// block.
+func (c *commentCollector) add(text string, isLine bool) {
+	if c.hasComment && !(isLine && c.isLine) {
+		c.flush()
+	}
+	c.buf.WriteString(text)
+	c.hasComment = true
+	c.isLine = isLine
+}
+
+func (c *commentCollector) clear() {
+	c.buf.Reset()
+	c.hasComment = false
+}
+
+// flush records the buffered comment, which is not connected to the next
+// token, as the trailing comment of the previous token or as detached.
+func (c *commentCollector) flush() {
+	if !c.hasComment {
+		return
+	}
+	if c.canAttachPrev {
+		*c.prevTrailing += c.buf.String()
+		c.hasTrailing = true
+		c.canAttachPrev = false
+	} else {
+		*c.detached = append(*c.detached, c.buf.String())
+	}
+	c.clear()
+	c.numComments++
+}
+
+// maybeDetach detaches a lone comment between two tokens, as it is unclear
+// which one it belongs to.
+func (c *commentCollector) maybeDetach() {
+	count := c.numComments
+	if c.hasComment {
+		count++
+	}
+	if count != 1 {
+		return
+	}
+	if c.hasTrailing {
+		*c.detached = append([]string{*c.prevTrailing}, *c.detached...)
+		*c.prevTrailing = ""
+	}
+	c.canAttachPrev = false
+	c.flush()
+}
+
+// finish records the remaining comment as the leading comment of the next
+// token.
+func (c *commentCollector) finish() {
+	if c.hasComment {
+		*c.nextLeading = c.buf.String()
+		c.clear()
+	}
+}
+
+// nextWithComments returns the next token, collecting the comments before it
+// into c. It follows Tokenizer::NextWithComments of protoc.
+func (l *lexer) nextWithComments(c *commentCollector, first bool) token {
+	defer c.finish()
+
+	prevLine := l.pos.line
+	trailingEndLine := -1
+	if !first {
+		// A comment on the same line as the previous token trails it.
+		l.skipLineWhitespace()
+		start, offset := l.pos, l.off
+		switch l.tryCommentStart() {
+		case lineComment:
+			trailingEndLine = l.pos.line
+			c.add(l.lineCommentText(), true)
+			c.flush()
+		case blockComment:
+			c.add(l.blockCommentText(start), false)
+			trailingEndLine = l.pos.line
+			l.skipLineWhitespace()
+			if !l.consume('\n') {
+				// The next token is on the same line, so it is unclear which
+				// token the comment belongs to.
+				c.clear()
+				return l.scan()
+			}
+			c.flush()
+		case slashNotComment:
+			return l.token(tokenSymbol, start, offset)
+		case noComment:
+			if !l.consume('\n') {
+				return l.scan()
+			}
+		}
+	}
+
+	for {
+		l.skipLineWhitespace()
+		start, offset := l.pos, l.off
+		switch l.tryCommentStart() {
+		case lineComment:
+			c.add(l.lineCommentText(), true)
+		case blockComment:
+			c.add(l.blockCommentText(start), false)
+			l.skipLineWhitespace()
+			l.consume('\n')
+		case slashNotComment:
+			return l.token(tokenSymbol, start, offset)
+		case noComment:
+			if l.consume('\n') {
+				// A blank line ends the comment block.
+				c.flush()
+				c.canAttachPrev = false
+				continue
+			}
+			tok := l.scan()
+			switch tok.text {
+			case "}", "]", ")":
+				// A comment at the end of a scope does not lead its closing
+				// token.
+				c.flush()
+			}
+			if tok.kind == tokenEOF {
+				c.flush()
+			} else if prevLine == l.pos.line || trailingEndLine == l.pos.line {
+				c.maybeDetach()
+			}
+			return tok
+		}
+	}
+}
+
+// tokenize splits src into tokens, ending with an EOF token.
+func tokenize(filename, src string) ([]token, error) {
+	l := &lexer{filename: filename, src: src}
+	if strings.HasPrefix(src, "\uFEFF") {
+		l.off = len("\uFEFF")
+	}
+
+	var tokens []token
+	for {
+		var trailing, leading string
+		var detached []string
+		tok := l.nextWithComments(&commentCollector{
+			prevTrailing:  &trailing,
+			detached:      &detached,
+			nextLeading:   &leading,
+			canAttachPrev: len(tokens) > 0,
+		}, len(tokens) == 0)
+		if l.err != nil {
+			return nil, l.err
+		}
+		if len(tokens) > 0 {
+			tokens[len(tokens)-1].trailing = trailing
+		}
+		tok.leading, tok.detached = leading, detached
+		tokens = append(tokens, tok)
+		if tok.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
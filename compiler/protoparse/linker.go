@@ -0,0 +1,494 @@
+package protoparse
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// symbolKind is the kind of a named element.
+type symbolKind int
+
+const (
+	symbolPackage symbolKind = iota
+	symbolMessage
+	symbolEnum
+	symbolEnumValue
+	symbolField
+	symbolOneof
+	symbolService
+	symbolMethod
+)
+
+// isType reports whether the symbol can be the type of a field.
+func (k symbolKind) isType() bool {
+	return k == symbolMessage || k == symbolEnum
+}
+
+// isAggregate reports whether the symbol can contain other symbols.
+func (k symbolKind) isAggregate() bool {
+	return k == symbolPackage || k == symbolMessage || k == symbolEnum || k == symbolService
+}
+
+// symbol is a named element of a file.
+type symbol struct {
+	kind symbolKind
+	file string
+	// enum is the enum descriptor of an enum symbol.
+	enum *descriptorpb.EnumDescriptorProto
+}
+
+// symbols is the symbol table of the files compiled so far.
+type symbols struct {
+	byName map[string]symbol
+	// packages maps each package, and each prefix of one, to the files
+	// declaring it.
+	packages map[string]map[string]bool
+}
+
+func newSymbols() *symbols {
+	return &symbols{
+		byName:   make(map[string]symbol),
+		packages: make(map[string]map[string]bool),
+	}
+}
+
+// join returns the full name of name in scope.
+func join(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// addFile adds the symbols of file.
+func (s *symbols) addFile(file *descriptorpb.FileDescriptorProto) error {
+	name := file.GetName()
+	pkg := file.GetPackage()
+	for p := pkg; p != ""; {
+		if sym, ok := s.byName[p]; ok {
+			return &Error{Filename: name, Msg: "\"" + p + "\" is already defined (as something other than a package) in file \"" + sym.file + "\"."}
+		}
+		if s.packages[p] == nil {
+			s.packages[p] = make(map[string]bool)
+		}
+		s.packages[p][name] = true
+		i := strings.LastIndexByte(p, '.')
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+
+	add := func(full string, kind symbolKind) error {
+		if prev, ok := s.byName[full]; ok {
+			if prev.file == name {
+				return &Error{Filename: name, Msg: "\"" + full + "\" is already defined."}
+			}
+			return &Error{Filename: name, Msg: "\"" + full + "\" is already defined in file \"" + prev.file + "\"."}
+		}
+		if _, ok := s.packages[full]; ok {
+			return &Error{Filename: name, Msg: "\"" + full + "\" is already defined (as a package)."}
+		}
+		s.byName[full] = symbol{kind: kind, file: name}
+		return nil
+	}
+	addEnum := func(scope string, enum *descriptorpb.EnumDescriptorProto) error {
+		full := join(scope, enum.GetName())
+		if err := add(full, symbolEnum); err != nil {
+			return err
+		}
+		sym := s.byName[full]
+		sym.enum = enum
+		s.byName[full] = sym
+		// Enum values are siblings of their enum.
+		for _, value := range enum.Value {
+			if err := add(join(scope, value.GetName()), symbolEnumValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var addMessage func(scope string, msg *descriptorpb.DescriptorProto) error
+	addMessage = func(scope string, msg *descriptorpb.DescriptorProto) error {
+		full := join(scope, msg.GetName())
+		if err := add(full, symbolMessage); err != nil {
+			return err
+		}
+		for _, field := range msg.Field {
+			if err := add(join(full, field.GetName()), symbolField); err != nil {
+				return err
+			}
+		}
+		for _, ext := range msg.Extension {
+			if err := add(join(full, ext.GetName()), symbolField); err != nil {
+				return err
+			}
+		}
+		for _, oneof := range msg.OneofDecl {
+			if err := add(join(full, oneof.GetName()), symbolOneof); err != nil {
+				return err
+			}
+		}
+		for _, nested := range msg.NestedType {
+			if err := addMessage(full, nested); err != nil {
+				return err
+			}
+		}
+		for _, enum := range msg.EnumType {
+			if err := addEnum(full, enum); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, msg := range file.MessageType {
+		if err := addMessage(pkg, msg); err != nil {
+			return err
+		}
+	}
+	for _, enum := range file.EnumType {
+		if err := addEnum(pkg, enum); err != nil {
+			return err
+		}
+	}
+	for _, ext := range file.Extension {
+		if err := add(join(pkg, ext.GetName()), symbolField); err != nil {
+			return err
+		}
+	}
+	for _, service := range file.Service {
+		full := join(pkg, service.GetName())
+		if err := add(full, symbolService); err != nil {
+			return err
+		}
+		for _, method := range service.Method {
+			if err := add(join(full, method.GetName()), symbolMethod); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// linker resolves the references of a parsed file.
+type linker struct {
+	symbols *symbols
+	file    *parsedFile
+	// visible holds the files whose symbols the file can use: itself, its
+	// imports and the files they publicly import.
+	visible map[string]bool
+	// hidden is a file declaring a symbol the file could not use because it
+	// is not imported, for the error message.
+	hidden string
+}
+
+func (l *linker) errorf(key elemKey, format string, args ...any) error {
+	return newError(l.file.desc.GetName(), l.file.pos[key], format, args...)
+}
+
+// find returns the symbol named full if it is visible.
+func (l *linker) find(full string) (symbol, bool) {
+	if sym, ok := l.symbols.byName[full]; ok {
+		if l.visible[sym.file] {
+			return sym, true
+		}
+		l.hidden = sym.file
+		return symbol{}, false
+	}
+	for file := range l.symbols.packages[full] {
+		if l.visible[file] {
+			return symbol{kind: symbolPackage, file: file}, true
+		}
+	}
+	return symbol{}, false
+}
+
+// resolve looks up name relative to the element named scope, following the
+// scoping rules of protoc: the first component of name is looked up in the
+// scope and each enclosing scope, and the rest of the name within the
+// aggregate found. With onlyTypes, symbols other than messages and enums are
+// skipped.
+func (l *linker) resolve(name, scope string, onlyTypes bool) (string, symbol, bool) {
+	if strings.HasPrefix(name, ".") {
+		sym, ok := l.find(name[1:])
+		return name[1:], sym, ok
+	}
+	first, rest, compound := strings.Cut(name, ".")
+	for {
+		i := strings.LastIndexByte(scope, '.')
+		if i < 0 {
+			sym, ok := l.find(name)
+			return name, sym, ok
+		}
+		scope = scope[:i]
+		candidate := scope + "." + first
+		sym, ok := l.find(candidate)
+		if !ok {
+			continue
+		}
+		if compound {
+			if sym.kind.isAggregate() {
+				full := candidate + "." + rest
+				sym, ok := l.find(full)
+				return full, sym, ok
+			}
+			continue
+		}
+		if !onlyTypes || sym.kind.isType() {
+			return candidate, sym, true
+		}
+	}
+}
+
+// resolveType resolves the type name at key, requiring a message if
+// onlyMessages is set, and returns its fully-qualified name.
+func (l *linker) resolveType(name, scope string, key elemKey, onlyMessages bool) (string, symbol, error) {
+	l.hidden = ""
+	full, sym, ok := l.resolve(name, scope, true)
+	switch {
+	case !ok && l.hidden != "":
+		return "", sym, l.errorf(key, "%q seems to be defined in %q, which is not imported by %q.  To use it here, please add the necessary import.", name, l.hidden, l.file.desc.GetName())
+	case !ok:
+		return "", sym, l.errorf(key, "%q is not defined.", name)
+	case onlyMessages && sym.kind != symbolMessage:
+		return "", sym, l.errorf(key, "%q is not a message type.", name)
+	case !sym.kind.isType():
+		return "", sym, l.errorf(key, "%q is not a type.", name)
+	}
+	return "." + full, sym, nil
+}
+
+// link resolves the type names of the file and fills in the JSON names.
+func (l *linker) link() error {
+	file := l.file.desc
+	pkg := file.GetPackage()
+	seen := make(map[string]bool)
+	for _, dep := range file.Dependency {
+		if seen[dep] {
+			return &Error{Filename: file.GetName(), Msg: "Import \"" + dep + "\" was listed twice."}
+		}
+		seen[dep] = true
+	}
+
+	for _, msg := range file.MessageType {
+		if err := l.linkMessage(pkg, msg); err != nil {
+			return err
+		}
+	}
+	for _, ext := range file.Extension {
+		if err := l.linkField(join(pkg, ext.GetName()), ext); err != nil {
+			return err
+		}
+	}
+	for _, service := range file.Service {
+		scope := join(pkg, service.GetName())
+		for _, method := range service.Method {
+			methodScope := join(scope, method.GetName())
+			input, _, err := l.resolveType(method.GetInputType(), methodScope, elemKey{method, methodInputTag}, true)
+			if err != nil {
+				return err
+			}
+			output, _, err := l.resolveType(method.GetOutputType(), methodScope, elemKey{method, methodOutputTag}, true)
+			if err != nil {
+				return err
+			}
+			method.InputType, method.OutputType = proto.String(input), proto.String(output)
+		}
+	}
+	return nil
+}
+
+func (l *linker) linkMessage(scope string, msg *descriptorpb.DescriptorProto) error {
+	full := join(scope, msg.GetName())
+	// Check the JSON names before linkField fills in the default ones, as
+	// protoc does, first among the default names and then with the custom
+	// ones.
+	if err := l.checkJSONNames(msg, false); err != nil {
+		return err
+	}
+	if err := l.checkJSONNames(msg, true); err != nil {
+		return err
+	}
+	for _, field := range msg.Field {
+		if err := l.linkField(join(full, field.GetName()), field); err != nil {
+			return err
+		}
+	}
+	for _, ext := range msg.Extension {
+		if err := l.linkField(join(full, ext.GetName()), ext); err != nil {
+			return err
+		}
+	}
+	for _, nested := range msg.NestedType {
+		if err := l.linkMessage(full, nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkJSONNames reports two fields of msg with the same JSON name, using the
+// custom JSON names if custom is set. As in protoc, a conflict with a default
+// JSON name is allowed in proto2, where it is only a warning.
+func (l *linker) checkJSONNames(msg *descriptorpb.DescriptorProto, custom bool) error {
+	type jsonField struct {
+		field  *descriptorpb.FieldDescriptorProto
+		custom bool
+	}
+	kind := map[bool]string{false: "default", true: "custom"}
+	seen := make(map[string]jsonField)
+	for _, field := range msg.Field {
+		name, isCustom := jsonName(field.GetName()), false
+		if custom && field.JsonName != nil {
+			name, isCustom = field.GetJsonName(), true
+		}
+		prev, ok := seen[name]
+		if !ok {
+			seen[name] = jsonField{field, isCustom}
+			continue
+		}
+		if custom && !isCustom && !prev.custom {
+			// Reported by the pass over the default names.
+			continue
+		}
+		if l.file.syntax == syntaxProto2 && (!isCustom || !prev.custom) {
+			continue
+		}
+		return l.errorf(elemKey{msg, messageNameTag}, "The %s JSON name of field %q (%q) conflicts with the %s JSON name of field %q.",
+			kind[isCustom], field.GetName(), name, kind[prev.custom], prev.field.GetName())
+	}
+	return nil
+}
+
+// checkFieldPresence reports the fields of the file setting the
+// field_presence feature where protoc does not allow it. It runs once the
+// standard options are interpreted.
+func (l *linker) checkFieldPresence() error {
+	file := l.file.desc
+	var checkMessage func(msg *descriptorpb.DescriptorProto) error
+	checkMessage = func(msg *descriptorpb.DescriptorProto) error {
+		for _, field := range msg.Field {
+			if err := l.checkFieldFeatures(field); err != nil {
+				return err
+			}
+		}
+		for _, ext := range msg.Extension {
+			if err := l.checkFieldFeatures(ext); err != nil {
+				return err
+			}
+		}
+		for _, nested := range msg.NestedType {
+			if err := checkMessage(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, msg := range file.MessageType {
+		if err := checkMessage(msg); err != nil {
+			return err
+		}
+	}
+	for _, ext := range file.Extension {
+		if err := l.checkFieldFeatures(ext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkFieldFeatures reports a field_presence feature set on field that
+// protoc rejects.
+func (l *linker) checkFieldFeatures(field *descriptorpb.FieldDescriptorProto) error {
+	features := field.GetOptions().GetFeatures()
+	if features == nil || features.FieldPresence == nil {
+		return nil
+	}
+	key := elemKey{field, fieldNameTag}
+	presence := features.GetFieldPresence()
+	switch {
+	case field.OneofIndex != nil && !field.GetProto3Optional():
+		return l.errorf(key, "Oneof fields can't specify field presence.")
+	case field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED:
+		return l.errorf(key, "Repeated fields can't specify field presence.")
+	case field.Extendee != nil && presence == descriptorpb.FeatureSet_LEGACY_REQUIRED:
+		return l.errorf(key, "Extensions can't be required.")
+	case field.Extendee != nil:
+		return l.errorf(key, "Extensions can't specify field presence.")
+	case presence == descriptorpb.FeatureSet_IMPLICIT &&
+		(field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP):
+		return l.errorf(key, "Message fields can't specify implicit presence.")
+	}
+	return nil
+}
+
+// linkField resolves the type and extendee of the field named full.
+func (l *linker) linkField(full string, field *descriptorpb.FieldDescriptorProto) error {
+	if field.JsonName == nil {
+		field.JsonName = proto.String(jsonName(field.GetName()))
+	}
+	if field.Extendee != nil {
+		extendee, _, err := l.resolveType(field.GetExtendee(), full, elemKey{field, fieldExtendeeTag}, true)
+		if err != nil {
+			return err
+		}
+		field.Extendee = proto.String(extendee)
+	}
+	if field.TypeName == nil {
+		return nil
+	}
+
+	key := elemKey{field, fieldTypeNameTag}
+	typeName, sym, err := l.resolveType(field.GetTypeName(), full, key, field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_GROUP)
+	if err != nil {
+		return err
+	}
+	field.TypeName = proto.String(typeName)
+	switch {
+	case sym.kind == symbolEnum:
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+		if field.DefaultValue != nil {
+			if !hasEnumValue(sym.enum, field.GetDefaultValue()) {
+				return l.errorf(key, "Enum type %q has no value named %q.", typeName[1:], field.GetDefaultValue())
+			}
+		}
+	case field.Type == nil:
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	}
+	if sym.kind == symbolMessage && field.DefaultValue != nil {
+		return l.errorf(key, "Messages can't have default values.")
+	}
+	return nil
+}
+
+func hasEnumValue(enum *descriptorpb.EnumDescriptorProto, name string) bool {
+	for _, value := range enum.GetValue() {
+		if value.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonName returns the default JSON name of a field, as protoc computes it.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b.WriteByte(c - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteByte(c)
+			upper = false
+		}
+	}
+	return b.String()
+}
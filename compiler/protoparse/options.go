@@ -0,0 +1,395 @@
+package protoparse
+
+import (
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// scopedOptions is an options message with the full name of the element it
+// belongs to, which custom option names are resolved relative to as protoc
+// resolves them.
+type scopedOptions struct {
+	opts  proto.Message
+	scope string
+}
+
+// fileOptions returns the options messages of file.
+func fileOptions(file *descriptorpb.FileDescriptorProto) []scopedOptions {
+	var all []scopedOptions
+	add := func(opts proto.Message, scope string) {
+		if opts.ProtoReflect().IsValid() {
+			all = append(all, scopedOptions{opts, scope})
+		}
+	}
+	addField := func(scope string, field *descriptorpb.FieldDescriptorProto) {
+		add(field.GetOptions(), join(scope, field.GetName()))
+	}
+	addEnum := func(scope string, enum *descriptorpb.EnumDescriptorProto) {
+		full := join(scope, enum.GetName())
+		add(enum.GetOptions(), full)
+		for _, value := range enum.GetValue() {
+			add(value.GetOptions(), join(scope, value.GetName()))
+		}
+	}
+	var addMessage func(scope string, msg *descriptorpb.DescriptorProto)
+	addMessage = func(scope string, msg *descriptorpb.DescriptorProto) {
+		full := join(scope, msg.GetName())
+		add(msg.GetOptions(), full)
+		for _, field := range msg.GetField() {
+			addField(full, field)
+		}
+		for _, ext := range msg.GetExtension() {
+			addField(full, ext)
+		}
+		for _, oneof := range msg.GetOneofDecl() {
+			add(oneof.GetOptions(), join(full, oneof.GetName()))
+		}
+		for _, r := range msg.GetExtensionRange() {
+			add(r.GetOptions(), full)
+		}
+		for _, nested := range msg.GetNestedType() {
+			addMessage(full, nested)
+		}
+		for _, enum := range msg.GetEnumType() {
+			addEnum(full, enum)
+		}
+	}
+
+	pkg := file.GetPackage()
+	// Names are looked up from the parent scope of the element, which for
+	// the file is its package.
+	add(file.GetOptions(), join(pkg, "dummy"))
+	for _, msg := range file.GetMessageType() {
+		addMessage(pkg, msg)
+	}
+	for _, enum := range file.GetEnumType() {
+		addEnum(pkg, enum)
+	}
+	for _, ext := range file.GetExtension() {
+		addField(pkg, ext)
+	}
+	for _, service := range file.GetService() {
+		full := join(pkg, service.GetName())
+		add(service.GetOptions(), full)
+		for _, method := range service.GetMethod() {
+			add(method.GetOptions(), join(full, method.GetName()))
+		}
+	}
+	return all
+}
+
+// uninterpretedField is the uninterpreted_option field of the options
+// messages.
+const uninterpretedField = "uninterpreted_option"
+
+// optionInterpreter sets the fields of options messages from their
+// uninterpreted options.
+type optionInterpreter struct {
+	linker *linker
+	// files resolves extensions, and types the extensions and messages
+	// named in aggregate values. They are nil while interpreting standard
+	// options, which name neither.
+	files *protoregistry.Files
+	types *dynamicpb.Types
+}
+
+// interpret interprets the uninterpreted options of file. With custom unset,
+// only the options naming no extension are interpreted and the others are
+// left for a later pass.
+func (oi *optionInterpreter) interpret(file *descriptorpb.FileDescriptorProto, custom bool) error {
+	for _, so := range fileOptions(file) {
+		m := so.opts.ProtoReflect()
+		fd := m.Descriptor().Fields().ByName(uninterpretedField)
+		list := m.Get(fd).List()
+		var remaining []*descriptorpb.UninterpretedOption
+		for i := range list.Len() {
+			uo := list.Get(i).Message().Interface().(*descriptorpb.UninterpretedOption)
+			if !custom && isCustomOption(uo) {
+				remaining = append(remaining, uo)
+				continue
+			}
+			if err := oi.interpretOption(m, uo, so.scope); err != nil {
+				return err
+			}
+		}
+		if len(remaining) == 0 {
+			m.Clear(fd)
+			continue
+		}
+		list.Truncate(0)
+		for _, uo := range remaining {
+			list.Append(protoreflect.ValueOfMessage(uo.ProtoReflect()))
+		}
+	}
+	return nil
+}
+
+// isCustomOption reports whether the name of uo refers to an extension.
+func isCustomOption(uo *descriptorpb.UninterpretedOption) bool {
+	for _, part := range uo.GetName() {
+		if part.GetIsExtension() {
+			return true
+		}
+	}
+	return false
+}
+
+// optionName formats the name of uo as it is written in the source.
+func optionName(uo *descriptorpb.UninterpretedOption) string {
+	var b strings.Builder
+	for i, part := range uo.GetName() {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		if part.GetIsExtension() {
+			b.WriteString("(" + part.GetNamePart() + ")")
+		} else {
+			b.WriteString(part.GetNamePart())
+		}
+	}
+	return b.String()
+}
+
+func (oi *optionInterpreter) errorf(uo *descriptorpb.UninterpretedOption, format string, args ...any) error {
+	return oi.linker.errorf(elemKey{uo, 0}, format, args...)
+}
+
+// interpretOption sets the field of the options message m named by uo.
+func (oi *optionInterpreter) interpretOption(m protoreflect.Message, uo *descriptorpb.UninterpretedOption, scope string) error {
+	name := optionName(uo)
+	if name == uninterpretedField {
+		return oi.errorf(uo, "Option must not use reserved name \"uninterpreted_option\".")
+	}
+	parts := uo.GetName()
+	path := make([]int32, 0, len(parts))
+	for i, part := range parts {
+		fd, err := oi.field(m, part, uo, scope)
+		if err != nil {
+			return err
+		}
+		path = append(path, int32(fd.Number()))
+		if i < len(parts)-1 {
+			if fd.Message() == nil || fd.IsList() {
+				return oi.errorf(uo, "Option %q is an atomic type, not a message.", name)
+			}
+			m = m.Mutable(fd).Message()
+			continue
+		}
+		if err := oi.setOption(m, fd, uo, name); err != nil {
+			return err
+		}
+		if fd.IsList() {
+			// Elements of repeated options are located by their index.
+			path = append(path, int32(m.Get(fd).List().Len()-1))
+		}
+	}
+	if loc := oi.linker.file.optionLocs[uo]; loc != nil {
+		loc.Path = append(loc.Path, path...)
+	}
+	return nil
+}
+
+// field returns the field of m named by part.
+func (oi *optionInterpreter) field(m protoreflect.Message, part *descriptorpb.UninterpretedOption_NamePart, uo *descriptorpb.UninterpretedOption, scope string) (protoreflect.FieldDescriptor, error) {
+	md := m.Descriptor()
+	if !part.GetIsExtension() {
+		fd := md.Fields().ByName(protoreflect.Name(part.GetNamePart()))
+		if fd == nil {
+			return nil, oi.errorf(uo, "Option %q unknown. Ensure that your proto definition file imports the proto which defines the option.", part.GetNamePart())
+		}
+		return fd, nil
+	}
+
+	l := oi.linker
+	l.hidden = ""
+	full, sym, ok := l.resolve(part.GetNamePart(), scope, false)
+	switch {
+	case !ok && l.hidden != "":
+		return nil, oi.errorf(uo, "%q seems to be defined in %q, which is not imported by %q.  To use it here, please add the necessary import.", part.GetNamePart(), l.hidden, l.file.desc.GetName())
+	case !ok:
+		return nil, oi.errorf(uo, "Option \"(%s)\" unknown. Ensure that your proto definition file imports the proto which defines the option.", part.GetNamePart())
+	case sym.kind != symbolField:
+		return nil, oi.errorf(uo, "Option \"(%s)\" is not a field or extension of message %q.", part.GetNamePart(), md.Name())
+	}
+	d, err := oi.files.FindDescriptorByName(protoreflect.FullName(full))
+	if err != nil {
+		return nil, oi.errorf(uo, "Option \"(%s)\" unknown.", part.GetNamePart())
+	}
+	xd, ok := d.(protoreflect.ExtensionDescriptor)
+	if !ok || !xd.IsExtension() {
+		return nil, oi.errorf(uo, "Option \"(%s)\" is not a field or extension of message %q.", part.GetNamePart(), md.Name())
+	}
+	if xd.ContainingMessage().FullName() != md.FullName() {
+		return nil, oi.errorf(uo, "%q is not a field or extension of message %q.", full, md.FullName())
+	}
+	return dynamicpb.NewExtensionType(xd).TypeDescriptor(), nil
+}
+
+// setOption sets the field fd of m to the value of uo.
+func (oi *optionInterpreter) setOption(m protoreflect.Message, fd protoreflect.FieldDescriptor, uo *descriptorpb.UninterpretedOption, name string) error {
+	if fd.IsMap() {
+		return oi.errorf(uo, "Map fields are not allowed to be set as options: %q.", name)
+	}
+	if !fd.IsList() && m.Has(fd) {
+		return oi.errorf(uo, "Option %q was already set.", name)
+	}
+
+	var v protoreflect.Value
+	if fd.Message() != nil {
+		if uo.AggregateValue == nil {
+			return oi.errorf(uo, "Option %q is a message. To set the entire message, use syntax like \"%s = { <proto text format> }\". To set fields within it, use syntax like \"%s.foo = value\".", name, name, name)
+		}
+		var msg protoreflect.Message
+		if fd.IsList() {
+			msg = m.Mutable(fd).List().NewElement().Message()
+		} else {
+			msg = m.NewField(fd).Message()
+		}
+		opts := prototext.UnmarshalOptions{Resolver: oi.types}
+		if oi.types == nil {
+			opts.Resolver = protoregistry.GlobalTypes
+		}
+		if err := opts.Unmarshal([]byte(uo.GetAggregateValue()), msg.Interface()); err != nil {
+			return oi.errorf(uo, "Error while parsing option value for %q: %v", name, err)
+		}
+		v = protoreflect.ValueOfMessage(msg)
+	} else {
+		var err error
+		v, err = oi.scalarValue(fd, uo, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if fd.IsList() {
+		m.Mutable(fd).List().Append(v)
+	} else {
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+// scalarValue converts the value of uo to the type of fd.
+func (oi *optionInterpreter) scalarValue(fd protoreflect.FieldDescriptor, uo *descriptorpb.UninterpretedOption, name string) (protoreflect.Value, error) {
+	mismatch := func(want string) (protoreflect.Value, error) {
+		return protoreflect.Value{}, oi.errorf(uo, "Value must be %s for %s option %q.", want, fd.Kind(), name)
+	}
+	integer := func(min int64, max uint64) (int64, uint64, bool) {
+		switch {
+		case uo.PositiveIntValue != nil && uo.GetPositiveIntValue() <= max:
+			return int64(uo.GetPositiveIntValue()), uo.GetPositiveIntValue(), true
+		case uo.NegativeIntValue != nil && min < 0 && uo.GetNegativeIntValue() >= min:
+			return uo.GetNegativeIntValue(), 0, true
+		}
+		return 0, 0, false
+	}
+	outOfRange := uo.PositiveIntValue != nil || uo.NegativeIntValue != nil
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		switch uo.GetIdentifierValue() {
+		case "true":
+			return protoreflect.ValueOfBool(true), nil
+		case "false":
+			return protoreflect.ValueOfBool(false), nil
+		}
+		return mismatch("\"true\" or \"false\"")
+	case protoreflect.EnumKind:
+		if uo.IdentifierValue == nil {
+			return mismatch("identifier")
+		}
+		value := fd.Enum().Values().ByName(protoreflect.Name(uo.GetIdentifierValue()))
+		if value == nil {
+			return protoreflect.Value{}, oi.errorf(uo, "Enum type %q has no value named %q for option %q.", fd.Enum().FullName(), uo.GetIdentifierValue(), name)
+		}
+		return protoreflect.ValueOfEnum(value.Number()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if v, _, ok := integer(math.MinInt32, math.MaxInt32); ok {
+			return protoreflect.ValueOfInt32(int32(v)), nil
+		}
+		if outOfRange {
+			return protoreflect.Value{}, oi.errorf(uo, "Value out of range for int32 option %q.", name)
+		}
+		return mismatch("integer")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if v, _, ok := integer(math.MinInt64, math.MaxInt64); ok {
+			return protoreflect.ValueOfInt64(v), nil
+		}
+		if outOfRange {
+			return protoreflect.Value{}, oi.errorf(uo, "Value out of range for int64 option %q.", name)
+		}
+		return mismatch("integer")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if _, v, ok := integer(0, math.MaxUint32); ok {
+			return protoreflect.ValueOfUint32(uint32(v)), nil
+		}
+		if outOfRange {
+			return protoreflect.Value{}, oi.errorf(uo, "Value out of range for uint32 option %q.", name)
+		}
+		return mismatch("non-negative integer")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if _, v, ok := integer(0, math.MaxUint64); ok {
+			return protoreflect.ValueOfUint64(v), nil
+		}
+		return mismatch("non-negative integer")
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		var v float64
+		switch {
+		case uo.DoubleValue != nil:
+			v = uo.GetDoubleValue()
+		case uo.PositiveIntValue != nil:
+			v = float64(uo.GetPositiveIntValue())
+		case uo.NegativeIntValue != nil:
+			v = float64(uo.GetNegativeIntValue())
+		default:
+			switch uo.GetIdentifierValue() {
+			case "inf":
+				v = math.Inf(1)
+			case "-inf":
+				v = math.Inf(-1)
+			case "nan", "-nan":
+				v = math.NaN()
+			default:
+				return mismatch("number")
+			}
+		}
+		if fd.Kind() == protoreflect.FloatKind {
+			return protoreflect.ValueOfFloat32(float32(v)), nil
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.StringKind:
+		if uo.StringValue == nil {
+			return mismatch("quoted string")
+		}
+		return protoreflect.ValueOfString(string(uo.GetStringValue())), nil
+	case protoreflect.BytesKind:
+		if uo.StringValue == nil {
+			return mismatch("quoted string")
+		}
+		return protoreflect.ValueOfBytes(uo.GetStringValue()), nil
+	}
+	return mismatch("a " + fd.Kind().String())
+}
+
+// normalizeOptions re-encodes the options of file, so custom options are held
+// as unknown fields as in a descriptor read from protoc's output.
+func normalizeOptions(file *descriptorpb.FileDescriptorProto) error {
+	for _, so := range fileOptions(file) {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(so.opts)
+		if err != nil {
+			return err
+		}
+		proto.Reset(so.opts)
+		if err := proto.Unmarshal(b, so.opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1473 @@
+package protoparse
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Field numbers of the descriptor messages, used in source code info paths.
+const (
+	fileNameTag         = 1
+	filePackageTag      = 2
+	fileDependencyTag   = 3
+	fileMessageTag      = 4
+	fileEnumTag         = 5
+	fileServiceTag      = 6
+	fileExtensionTag    = 7
+	fileOptionsTag      = 8
+	filePublicDepTag    = 10
+	fileWeakDepTag      = 11
+	fileSyntaxTag       = 12
+	fileEditionTag      = 14
+	messageNameTag      = 1
+	messageFieldTag     = 2
+	messageNestedTag    = 3
+	messageEnumTag      = 4
+	messageRangeTag     = 5
+	messageExtendTag    = 6
+	messageOptionsTag   = 7
+	messageOneofTag     = 8
+	messageReservedTag  = 9
+	messageResNameTag   = 10
+	fieldNameTag        = 1
+	fieldExtendeeTag    = 2
+	fieldNumberTag      = 3
+	fieldLabelTag       = 4
+	fieldTypeTag        = 5
+	fieldTypeNameTag    = 6
+	fieldDefaultTag     = 7
+	fieldOptionsTag     = 8
+	fieldJSONNameTag    = 10
+	oneofNameTag        = 1
+	oneofOptionsTag     = 2
+	enumNameTag         = 1
+	enumValueTag        = 2
+	enumOptionsTag      = 3
+	enumReservedTag     = 4
+	enumResNameTag      = 5
+	rangeStartTag       = 1
+	rangeEndTag         = 2
+	rangeOptionsTag     = 3
+	enumValueNameTag    = 1
+	enumValueNumberTag  = 2
+	enumValueOptionsTag = 3
+	serviceNameTag      = 1
+	serviceMethodTag    = 2
+	serviceOptionsTag   = 3
+	methodNameTag       = 1
+	methodInputTag      = 2
+	methodOutputTag     = 3
+	methodOptionsTag    = 4
+)
+
+// maxFieldNumber is the largest valid field number.
+const maxFieldNumber = 536870911
+
+// The field numbers from firstReservedNumber to lastReservedNumber are
+// reserved for the protocol buffer implementation, and rejected by protoc.
+const (
+	firstReservedNumber = 19000
+	lastReservedNumber  = 19999
+)
+
+// syntaxKind is the syntax of a file.
+type syntaxKind int
+
+const (
+	syntaxProto2 syntaxKind = iota
+	syntaxProto3
+	syntaxEditions
+)
+
+// scalarTypes maps the scalar type keywords to their field types.
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+// elemKey identifies a field of a descriptor message, for recording the
+// source position of references resolved by the linker.
+type elemKey struct {
+	elem proto.Message
+	tag  int32
+}
+
+// parsedFile is a parsed but unlinked file.
+type parsedFile struct {
+	desc   *descriptorpb.FileDescriptorProto
+	syntax syntaxKind
+	// pos holds the positions of type references and options, and imports
+	// those of the import statements.
+	pos     map[elemKey]position
+	imports map[string]position
+	// optionLocs holds the source locations of the options, whose paths
+	// end with the options field of their element until the option
+	// interpreter appends the numbers of the fields the option sets.
+	optionLocs map[*descriptorpb.UninterpretedOption]*descriptorpb.SourceCodeInfo_Location
+}
+
+// bailout is panicked with to abort parsing on the first error.
+type bailout struct {
+	err *Error
+}
+
+// parser builds a FileDescriptorProto from the tokens of a file.
+type parser struct {
+	filename   string
+	toks       []token
+	i          int
+	src        string
+	syntax     syntaxKind
+	file       *descriptorpb.FileDescriptorProto
+	locs       []*descriptorpb.SourceCodeInfo_Location
+	pos        map[elemKey]position
+	imports    map[string]position
+	optionLocs map[*descriptorpb.UninterpretedOption]*descriptorpb.SourceCodeInfo_Location
+}
+
+// parse parses the source of the file named filename.
+func parse(filename, src string) (f *parsedFile, err error) {
+	toks, err := tokenize(filename, src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{
+		filename:   filename,
+		toks:       toks,
+		src:        src,
+		file:       &descriptorpb.FileDescriptorProto{Name: proto.String(filename)},
+		pos:        make(map[elemKey]position),
+		imports:    make(map[string]position),
+		optionLocs: make(map[*descriptorpb.UninterpretedOption]*descriptorpb.SourceCodeInfo_Location),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			b, ok := r.(bailout)
+			if !ok {
+				panic(r)
+			}
+			f, err = nil, b.err
+		}
+	}()
+	p.parseFile()
+	return &parsedFile{desc: p.file, syntax: p.syntax, pos: p.pos, imports: p.imports, optionLocs: p.optionLocs}, nil
+}
+
+func (p *parser) failf(tok *token, format string, args ...any) {
+	panic(bailout{newError(p.filename, tok.start, format, args...)})
+}
+
+func (p *parser) peek() *token {
+	return &p.toks[p.i]
+}
+
+// peekAt returns the token n tokens after the current one.
+func (p *parser) peekAt(n int) *token {
+	if p.i+n < len(p.toks) {
+		return &p.toks[p.i+n]
+	}
+	return &p.toks[len(p.toks)-1]
+}
+
+func (p *parser) next() *token {
+	tok := &p.toks[p.i]
+	if tok.kind != tokenEOF {
+		p.i++
+	}
+	return tok
+}
+
+// lookingAt reports whether the current token is the keyword or symbol text.
+func (p *parser) lookingAt(text string) bool {
+	tok := p.peek()
+	return (tok.kind == tokenIdent || tok.kind == tokenSymbol) && tok.text == text
+}
+
+func (p *parser) tryConsume(text string) *token {
+	if p.lookingAt(text) {
+		return p.next()
+	}
+	return nil
+}
+
+func (p *parser) consume(text string) *token {
+	if tok := p.tryConsume(text); tok != nil {
+		return tok
+	}
+	p.failf(p.peek(), "Expected %q.", text)
+	return nil
+}
+
+func (p *parser) consumeIdent(what string) *token {
+	if p.peek().kind != tokenIdent {
+		p.failf(p.peek(), "Expected %s.", what)
+	}
+	return p.next()
+}
+
+// consumeString consumes one or more adjacent string literals and returns
+// their concatenated value.
+func (p *parser) consumeString(what string) string {
+	if p.peek().kind != tokenString {
+		p.failf(p.peek(), "Expected %s.", what)
+	}
+	var b strings.Builder
+	for p.peek().kind == tokenString {
+		b.WriteString(unquote(p.next().text))
+	}
+	return b.String()
+}
+
+// consumeUint consumes an integer no larger than max.
+func (p *parser) consumeUint(max uint64, what string) (uint64, *token) {
+	tok := p.peek()
+	if tok.kind != tokenInt {
+		p.failf(tok, "Expected %s.", what)
+	}
+	v, err := strconv.ParseUint(tok.text, 0, 64)
+	if err != nil || v > max {
+		p.failf(tok, "Integer out of range.")
+	}
+	return v, p.next()
+}
+
+// consumeInt32 consumes an optionally negative integer in the int32 range.
+func (p *parser) consumeInt32(what string) (int32, *token) {
+	start := p.peek()
+	if p.tryConsume("-") != nil {
+		v, _ := p.consumeUint(-math.MinInt32, what)
+		return int32(-int64(v)), start
+	}
+	v, _ := p.consumeUint(math.MaxInt32, what)
+	return int32(v), start
+}
+
+// consumeTypeName consumes a possibly qualified type name.
+func (p *parser) consumeTypeName() string {
+	var b strings.Builder
+	if p.tryConsume(".") != nil {
+		b.WriteByte('.')
+	}
+	b.WriteString(p.consumeIdent("type name").text)
+	for p.tryConsume(".") != nil {
+		b.WriteByte('.')
+		b.WriteString(p.consumeIdent("identifier").text)
+	}
+	return b.String()
+}
+
+// appendPath returns a new path of path followed by elems.
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append(make([]int32, 0, len(path)+len(elems)), path...), elems...)
+}
+
+// location starts recording a source location whose span begins at start.
+func (p *parser) location(path []int32, start *token) *descriptorpb.SourceCodeInfo_Location {
+	loc := &descriptorpb.SourceCodeInfo_Location{
+		Path: path,
+		Span: []int32{int32(start.start.line), int32(start.start.col)},
+	}
+	p.locs = append(p.locs, loc)
+	return loc
+}
+
+// end sets the end of the span of loc to the end of the token end.
+func (p *parser) end(loc *descriptorpb.SourceCodeInfo_Location, end *token) {
+	if int(loc.Span[0]) != end.end.line {
+		loc.Span = append(loc.Span, int32(end.end.line))
+	}
+	loc.Span = append(loc.Span, int32(end.end.col))
+}
+
+// tokenLocation records a location spanning a single token.
+func (p *parser) tokenLocation(path []int32, tok *token) {
+	p.end(p.location(path, tok), tok)
+}
+
+// declaration ends the location of a declaration beginning with the token
+// start and ending with end, a ";" or "{", and attaches their comments.
+func (p *parser) declaration(loc *descriptorpb.SourceCodeInfo_Location, start, end *token) {
+	if start.leading != "" {
+		loc.LeadingComments = proto.String(start.leading)
+	}
+	if end.trailing != "" {
+		loc.TrailingComments = proto.String(end.trailing)
+	}
+	loc.LeadingDetachedComments = start.detached
+}
+
+// endStatement consumes the ";" ending the statement beginning with start
+// and ends its location.
+func (p *parser) endStatement(loc *descriptorpb.SourceCodeInfo_Location, start *token) {
+	end := p.consume(";")
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+}
+
+func (p *parser) parseFile() {
+	first := p.peek()
+	root := p.location(nil, first)
+	if p.lookingAt("syntax") || p.lookingAt("edition") {
+		p.parseSyntax()
+	}
+	for p.peek().kind != tokenEOF {
+		p.parseTopLevel()
+	}
+	last := first
+	if p.i > 0 {
+		last = &p.toks[p.i-1]
+	}
+	p.end(root, last)
+	p.file.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: p.locs}
+}
+
+func (p *parser) parseSyntax() {
+	start := p.next()
+	tag := int32(fileSyntaxTag)
+	if start.text == "edition" {
+		tag = fileEditionTag
+	}
+	loc := p.location([]int32{tag}, start)
+	p.consume("=")
+	valueTok := p.peek()
+	value := p.consumeString("syntax identifier")
+	end := p.consume(";")
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+
+	if start.text == "edition" {
+		edition, ok := map[string]descriptorpb.Edition{
+			"2023": descriptorpb.Edition_EDITION_2023,
+			"2024": descriptorpb.Edition_EDITION_2024,
+		}[value]
+		if !ok {
+			p.failf(valueTok, "Unsupported edition %q: this compiler supports editions 2023 and 2024.", value)
+		}
+		p.syntax = syntaxEditions
+		p.file.Syntax = proto.String("editions")
+		p.file.Edition = edition.Enum()
+		return
+	}
+	switch value {
+	case "proto2":
+	case "proto3":
+		p.syntax = syntaxProto3
+		p.file.Syntax = proto.String(value)
+	default:
+		p.failf(valueTok, "Unrecognized syntax identifier %q.  This parser only recognizes \"proto2\" and \"proto3\".", value)
+	}
+}
+
+// visibility consumes an export or local keyword before a message or enum in
+// edition 2024.
+func (p *parser) visibility() (*descriptorpb.SymbolVisibility, *token) {
+	if p.file.GetEdition() < descriptorpb.Edition_EDITION_2024 || !(p.lookingAt("export") || p.lookingAt("local")) {
+		return nil, nil
+	}
+	if next := p.peekAt(1); next.kind != tokenIdent || next.text != "message" && next.text != "enum" {
+		return nil, nil
+	}
+	tok := p.next()
+	if tok.text == "export" {
+		return descriptorpb.SymbolVisibility_VISIBILITY_EXPORT.Enum(), tok
+	}
+	return descriptorpb.SymbolVisibility_VISIBILITY_LOCAL.Enum(), tok
+}
+
+func (p *parser) parseTopLevel() {
+	f := p.file
+	start := p.peek()
+	vis, visTok := p.visibility()
+	switch {
+	case vis == nil && p.tryConsume(";") != nil:
+	case vis == nil && p.lookingAt("import"):
+		p.parseImport()
+	case vis == nil && p.lookingAt("package"):
+		p.parsePackage()
+	case vis == nil && p.lookingAt("option"):
+		if f.Options == nil {
+			f.Options = &descriptorpb.FileOptions{}
+		}
+		p.parseOptionStatement(&f.Options.UninterpretedOption, []int32{fileOptionsTag})
+	case p.lookingAt("message"):
+		msg := p.parseMessage([]int32{fileMessageTag, int32(len(f.MessageType))}, start)
+		msg.Visibility = vis
+		f.MessageType = append(f.MessageType, msg)
+	case p.lookingAt("enum"):
+		enum := p.parseEnum([]int32{fileEnumTag, int32(len(f.EnumType))}, start)
+		enum.Visibility = vis
+		f.EnumType = append(f.EnumType, enum)
+	case vis == nil && p.lookingAt("service"):
+		p.parseService()
+	case vis == nil && p.lookingAt("extend"):
+		p.parseExtend(nil, &f.Extension, &f.MessageType, []int32{fileExtensionTag}, []int32{fileMessageTag})
+	default:
+		if visTok != nil {
+			start = visTok
+		}
+		p.failf(start, "Expected top-level statement (e.g. \"message\").")
+	}
+}
+
+func (p *parser) parseImport() {
+	f := p.file
+	start := p.next()
+	index := int32(len(f.Dependency))
+	loc := &descriptorpb.SourceCodeInfo_Location{}
+	if !p.lookingAt("option") || f.GetEdition() < descriptorpb.Edition_EDITION_2024 {
+		loc = p.location([]int32{fileDependencyTag, index}, start)
+	}
+	option := false
+	switch {
+	case p.lookingAt("public"):
+		p.tokenLocation([]int32{filePublicDepTag, int32(len(f.PublicDependency))}, p.next())
+		f.PublicDependency = append(f.PublicDependency, index)
+	case p.lookingAt("weak"):
+		p.tokenLocation([]int32{fileWeakDepTag, int32(len(f.WeakDependency))}, p.next())
+		f.WeakDependency = append(f.WeakDependency, index)
+	case p.lookingAt("option") && f.GetEdition() >= descriptorpb.Edition_EDITION_2024:
+		p.next()
+		option = true
+	}
+	name := p.consumeString("a string naming the file to import")
+	end := p.consume(";")
+	p.imports[name] = start.start
+	if option {
+		f.OptionDependency = append(f.OptionDependency, name)
+		return
+	}
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+	f.Dependency = append(f.Dependency, name)
+}
+
+func (p *parser) parsePackage() {
+	start := p.next()
+	if p.file.Package != nil {
+		p.failf(start, "Multiple package definitions.")
+	}
+	loc := p.location([]int32{filePackageTag}, start)
+	var b strings.Builder
+	b.WriteString(p.consumeIdent("identifier").text)
+	for p.tryConsume(".") != nil {
+		b.WriteByte('.')
+		b.WriteString(p.consumeIdent("identifier").text)
+	}
+	end := p.consume(";")
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+	p.file.Package = proto.String(b.String())
+}
+
+// parseMessage parses a message definition at path, whose declaration begins
+// with the token start.
+func (p *parser) parseMessage(path []int32, start *token) *descriptorpb.DescriptorProto {
+	loc := p.location(path, start)
+	p.consume("message")
+	nameTok := p.consumeIdent("message name")
+	p.tokenLocation(appendPath(path, messageNameTag), nameTok)
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(nameTok.text)}
+	p.pos[elemKey{msg, messageNameTag}] = nameTok.start
+	open := p.consume("{")
+	p.declaration(loc, start, open)
+	p.end(loc, p.parseMessageBody(msg, path))
+	return msg
+}
+
+// parseMessageBody parses the statements of a message up to and including
+// the closing brace, which it returns.
+func (p *parser) parseMessageBody(msg *descriptorpb.DescriptorProto, path []int32) *token {
+	for {
+		if end := p.tryConsume("}"); end != nil {
+			p.addSyntheticOneofs(msg)
+			p.adjustExtensionRanges(msg)
+			return end
+		}
+		p.parseMessageStatement(msg, path)
+	}
+}
+
+func (p *parser) parseMessageStatement(msg *descriptorpb.DescriptorProto, path []int32) {
+	start := p.peek()
+	vis, _ := p.visibility()
+	switch {
+	case start.kind == tokenEOF:
+		p.failf(start, "Reached end of input in message definition (missing '}').")
+	case vis == nil && p.tryConsume(";") != nil:
+	case p.lookingAt("message"):
+		nested := p.parseMessage(appendPath(path, messageNestedTag, int32(len(msg.NestedType))), start)
+		nested.Visibility = vis
+		msg.NestedType = append(msg.NestedType, nested)
+	case p.lookingAt("enum"):
+		enum := p.parseEnum(appendPath(path, messageEnumTag, int32(len(msg.EnumType))), start)
+		enum.Visibility = vis
+		msg.EnumType = append(msg.EnumType, enum)
+	case p.lookingAt("extensions"):
+		p.parseExtensions(msg, path)
+	case p.lookingAt("reserved"):
+		p.parseMessageReserved(msg, path)
+	case p.lookingAt("extend"):
+		p.parseExtend(msg, &msg.Extension, &msg.NestedType, appendPath(path, messageExtendTag), appendPath(path, messageNestedTag))
+	case p.lookingAt("option"):
+		if msg.Options == nil {
+			msg.Options = &descriptorpb.MessageOptions{}
+		}
+		p.parseOptionStatement(&msg.Options.UninterpretedOption, appendPath(path, messageOptionsTag))
+	case p.lookingAt("oneof"):
+		p.parseOneof(msg, path)
+	default:
+		p.parseField(fieldContext{
+			fields:     &msg.Field,
+			nested:     &msg.NestedType,
+			path:       appendPath(path, messageFieldTag, int32(len(msg.Field))),
+			nestedPath: appendPath(path, messageNestedTag),
+		})
+	}
+}
+
+// fieldContext is where a field is declared.
+type fieldContext struct {
+	// fields is the list the field is added to, and nested the list its
+	// group or map entry message is added to.
+	fields *[]*descriptorpb.FieldDescriptorProto
+	nested *[]*descriptorpb.DescriptorProto
+	// path is the source path of the field, and nestedPath that of nested.
+	path, nestedPath []int32
+	// oneof is the index of the containing oneof, or nil.
+	oneof *int32
+	// extendee is the extended type of an extension, or nil, and
+	// extendeeStart and extendeeEnd are its first and last tokens.
+	extendee                   *string
+	extendeeStart, extendeeEnd *token
+}
+
+func (p *parser) parseField(ctx fieldContext) {
+	start := p.peek()
+	loc := p.location(ctx.path, start)
+	field := &descriptorpb.FieldDescriptorProto{}
+
+	isMap := p.lookingAt("map") && p.peekAt(1).kind == tokenSymbol && p.peekAt(1).text == "<"
+	if labelTok := p.peek(); labelTok.kind == tokenIdent {
+		var label descriptorpb.FieldDescriptorProto_Label
+		switch labelTok.text {
+		case "optional":
+			label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		case "required":
+			label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED
+		case "repeated":
+			label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		}
+		if label != 0 {
+			switch {
+			case ctx.oneof != nil:
+				p.failf(labelTok, "Fields in oneofs must not have labels (required / optional / repeated).")
+			case p.syntax == syntaxProto3 && label == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED:
+				p.failf(labelTok, "Required fields are not allowed in proto3.")
+			case p.syntax == syntaxEditions && label == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED:
+				p.failf(labelTok, "Label \"required\" is not supported in editions, use features.field_presence = LEGACY_REQUIRED.")
+			case p.syntax == syntaxEditions && label == descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL:
+				p.failf(labelTok, "Label \"optional\" is not supported in editions. By default, all singular fields have presence unless features.field_presence is set.")
+			}
+			p.next()
+			p.tokenLocation(appendPath(ctx.path, fieldLabelTag), labelTok)
+			field.Label = label.Enum()
+			if p.syntax == syntaxProto3 && label == descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL {
+				field.Proto3Optional = proto.Bool(true)
+			}
+			isMap = p.lookingAt("map") && p.peekAt(1).kind == tokenSymbol && p.peekAt(1).text == "<"
+			if isMap {
+				p.failf(labelTok, "Field labels (required/optional/repeated) are not allowed on map fields.")
+			}
+		}
+	}
+	if field.Label == nil {
+		if p.syntax == syntaxProto2 && ctx.oneof == nil && !isMap {
+			p.failf(start, "Expected \"required\", \"optional\", or \"repeated\".")
+		}
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+	}
+
+	var keyType, valueType *descriptorpb.FieldDescriptorProto
+	typeTok := p.peek()
+	isGroup := false
+	switch {
+	case isMap:
+		if ctx.extendee != nil {
+			p.failf(typeTok, "Map fields are not allowed to be extensions.")
+		}
+		p.next()
+		p.consume("<")
+		keyType = p.parseFieldType()
+		p.consume(",")
+		valueType = p.parseFieldType()
+		p.end(p.location(appendPath(ctx.path, fieldTypeNameTag), typeTok), p.consume(">"))
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	case p.lookingAt("group") && p.peekAt(1).kind == tokenIdent:
+		if p.syntax != syntaxProto2 {
+			p.failf(typeTok, "Group syntax is no longer supported in %s. Use a message field instead.", map[syntaxKind]string{syntaxProto3: "proto3", syntaxEditions: "editions"}[p.syntax])
+		}
+		p.next()
+		p.tokenLocation(appendPath(ctx.path, fieldTypeTag), typeTok)
+		field.Type = descriptorpb.FieldDescriptorProto_TYPE_GROUP.Enum()
+		isGroup = true
+	default:
+		t := p.parseFieldType()
+		field.Type, field.TypeName = t.Type, t.TypeName
+		tag := int32(fieldTypeTag)
+		if t.TypeName != nil {
+			tag = fieldTypeNameTag
+			p.pos[elemKey{field, fieldTypeNameTag}] = typeTok.start
+		}
+		p.end(p.location(appendPath(ctx.path, tag), typeTok), &p.toks[p.i-1])
+	}
+
+	nameTok := p.consumeIdent("field name")
+	p.tokenLocation(appendPath(ctx.path, fieldNameTag), nameTok)
+	p.pos[elemKey{field, fieldNameTag}] = nameTok.start
+	field.Name = proto.String(nameTok.text)
+	if isGroup {
+		if c := nameTok.text[0]; c < 'A' || c > 'Z' {
+			p.failf(nameTok, "Group names must start with a capital letter.")
+		}
+		field.Name = proto.String(strings.ToLower(nameTok.text))
+		field.TypeName = proto.String(nameTok.text)
+		p.pos[elemKey{field, fieldTypeNameTag}] = nameTok.start
+		p.tokenLocation(appendPath(ctx.path, fieldTypeNameTag), nameTok)
+	}
+
+	p.consume("=")
+	number, numberTok := p.consumeUint(math.MaxInt32, "field number")
+	switch {
+	case number == 0:
+		p.failf(numberTok, "Field numbers must be positive integers.")
+	case number > maxFieldNumber:
+		p.failf(numberTok, "Field numbers cannot be greater than %d.", maxFieldNumber)
+	case number >= firstReservedNumber && number <= lastReservedNumber:
+		p.failf(numberTok, "Field numbers %d through %d are reserved for the protocol buffer library implementation.", firstReservedNumber, lastReservedNumber)
+	}
+	p.tokenLocation(appendPath(ctx.path, fieldNumberTag), numberTok)
+	field.Number = proto.Int32(int32(number))
+	field.OneofIndex = ctx.oneof
+	field.Extendee = ctx.extendee
+	if ctx.extendee != nil {
+		p.end(p.location(appendPath(ctx.path, fieldExtendeeTag), ctx.extendeeStart), ctx.extendeeEnd)
+	}
+
+	if p.lookingAt("[") {
+		p.parseFieldOptions(field, ctx)
+	}
+
+	*ctx.fields = append(*ctx.fields, field)
+	switch {
+	case isGroup:
+		groupPath := appendPath(ctx.nestedPath, int32(len(*ctx.nested)))
+		groupLoc := p.location(groupPath, start)
+		p.tokenLocation(appendPath(groupPath, messageNameTag), nameTok)
+		group := &descriptorpb.DescriptorProto{Name: proto.String(nameTok.text)}
+		p.pos[elemKey{group, messageNameTag}] = nameTok.start
+		*ctx.nested = append(*ctx.nested, group)
+		open := p.consume("{")
+		p.declaration(groupLoc, start, open)
+		end := p.parseMessageBody(group, groupPath)
+		p.end(groupLoc, end)
+		p.end(loc, end)
+	case isMap:
+		entry := mapEntry(nameTok.text, keyType, valueType)
+		field.TypeName = entry.Name
+		p.pos[elemKey{field, fieldTypeNameTag}] = typeTok.start
+		if valueType.TypeName != nil {
+			p.pos[elemKey{entry.Field[1], fieldTypeNameTag}] = typeTok.start
+		}
+		*ctx.nested = append(*ctx.nested, entry)
+		end := p.consume(";")
+		p.end(loc, end)
+		p.declaration(loc, start, end)
+	default:
+		end := p.consume(";")
+		p.end(loc, end)
+		p.declaration(loc, start, end)
+	}
+}
+
+// parseFieldType parses a scalar type keyword or a type name.
+func (p *parser) parseFieldType() *descriptorpb.FieldDescriptorProto {
+	tok := p.peek()
+	if t, ok := scalarTypes[tok.text]; ok && tok.kind == tokenIdent {
+		p.next()
+		return &descriptorpb.FieldDescriptorProto{Type: t.Enum()}
+	}
+	if tok.kind != tokenIdent && !p.lookingAt(".") {
+		p.failf(tok, "Expected type name.")
+	}
+	return &descriptorpb.FieldDescriptorProto{TypeName: proto.String(p.consumeTypeName())}
+}
+
+// mapEntry returns the entry message of a map field with the given key and
+// value types, named as protoc names it.
+func mapEntry(fieldName string, key, value *descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+	var b strings.Builder
+	upper := true
+	for i := 0; i < len(fieldName); i++ {
+		c := fieldName[i]
+		switch {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b.WriteByte(c - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteByte(c)
+			upper = false
+		}
+	}
+	b.WriteString("Entry")
+
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	key.Name, key.Number, key.Label = proto.String("key"), proto.Int32(1), optional.Enum()
+	value.Name, value.Number, value.Label = proto.String("value"), proto.Int32(2), optional.Enum()
+	return &descriptorpb.DescriptorProto{
+		Name:    proto.String(b.String()),
+		Field:   []*descriptorpb.FieldDescriptorProto{key, value},
+		Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+}
+
+// parseFieldOptions parses the bracketed options of a field, including the
+// default and json_name pseudo-options.
+func (p *parser) parseFieldOptions(field *descriptorpb.FieldDescriptorProto, ctx fieldContext) {
+	optionsPath := appendPath(ctx.path, fieldOptionsTag)
+	optionsLoc := p.location(optionsPath, p.consume("["))
+	for {
+		tok := p.peek()
+		switch {
+		case p.lookingAt("default") && p.peekAt(1).text == "=":
+			if field.DefaultValue != nil {
+				p.failf(tok, "Already set option \"default\".")
+			}
+			if p.syntax == syntaxProto3 {
+				p.failf(tok, "Explicit default values are not allowed in proto3.")
+			}
+			if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+				p.failf(tok, "Repeated fields can't have default values.")
+			}
+			loc := p.location(appendPath(ctx.path, fieldDefaultTag), tok)
+			p.next()
+			p.next()
+			field.DefaultValue = proto.String(p.parseDefault(field))
+			p.end(loc, &p.toks[p.i-1])
+		case p.lookingAt("json_name") && p.peekAt(1).text == "=":
+			if field.JsonName != nil {
+				p.failf(tok, "Already set option \"json_name\".")
+			}
+			if ctx.extendee != nil {
+				p.failf(tok, "option json_name is not allowed on extension fields.")
+			}
+			loc := p.location(appendPath(ctx.path, fieldJSONNameTag), tok)
+			p.next()
+			p.next()
+			field.JsonName = proto.String(p.consumeString("string"))
+			p.end(loc, &p.toks[p.i-1])
+		default:
+			if field.Options == nil {
+				field.Options = &descriptorpb.FieldOptions{}
+			}
+			p.parseOption(&field.Options.UninterpretedOption, optionsPath)
+		}
+		if end := p.tryConsume("]"); end != nil {
+			p.end(optionsLoc, end)
+			return
+		}
+		p.consume(",")
+	}
+}
+
+// parseDefault parses the default value of field, normalized as protoc
+// stores it.
+func (p *parser) parseDefault(field *descriptorpb.FieldDescriptorProto) string {
+	tok := p.peek()
+	if field.Type == nil {
+		// The type is a message or enum, which is not known until linking.
+		// Keep the token and let the linker check it names an enum value.
+		p.next()
+		return tok.text
+	}
+	switch t := field.GetType(); t {
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		max := uint64(math.MaxInt32)
+		if t == descriptorpb.FieldDescriptorProto_TYPE_INT64 || t == descriptorpb.FieldDescriptorProto_TYPE_SINT64 || t == descriptorpb.FieldDescriptorProto_TYPE_SFIXED64 {
+			max = math.MaxInt64
+		}
+		sign := ""
+		if p.tryConsume("-") != nil {
+			sign, max = "-", max+1
+		}
+		v, _ := p.consumeUint(max, "integer for field default value")
+		return sign + strconv.FormatUint(v, 10)
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		max := uint64(math.MaxUint32)
+		if t == descriptorpb.FieldDescriptorProto_TYPE_UINT64 || t == descriptorpb.FieldDescriptorProto_TYPE_FIXED64 {
+			max = math.MaxUint64
+		}
+		if p.lookingAt("-") {
+			p.failf(tok, "Unsigned field can't have negative default value.")
+		}
+		v, _ := p.consumeUint(max, "integer for field default value")
+		return strconv.FormatUint(v, 10)
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		sign := ""
+		if p.tryConsume("-") != nil {
+			sign = "-"
+		}
+		return sign + p.consumeNumber()
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		if p.lookingAt("true") || p.lookingAt("false") {
+			return p.next().text
+		}
+		p.failf(tok, "Expected \"true\" or \"false\".")
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING:
+		return p.consumeString("string")
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return cEscape(p.consumeString("string"))
+	}
+	p.failf(tok, "Messages can't have default values.")
+	return ""
+}
+
+// consumeNumber consumes a number, inf or nan and returns it formatted as a
+// float.
+func (p *parser) consumeNumber() string {
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenFloat:
+		p.next()
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil && !strings.Contains(err.Error(), "range") {
+			p.failf(tok, "Invalid float %q.", tok.text)
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case tok.kind == tokenInt:
+		p.next()
+		v, err := strconv.ParseUint(tok.text, 0, 64)
+		if err != nil {
+			p.failf(tok, "Integer out of range.")
+		}
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case p.lookingAt("inf") || p.lookingAt("nan"):
+		return p.next().text
+	}
+	p.failf(tok, "Expected number.")
+	return ""
+}
+
+// cEscape escapes s as protoc stores bytes default values.
+func cEscape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				b.WriteByte('\\')
+				b.WriteByte('0' + c>>6)
+				b.WriteByte('0' + c>>3&7)
+				b.WriteByte('0' + c&7)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// addSyntheticOneofs adds the oneofs of the proto3 optional fields of msg,
+// after its real oneofs, named as protoc names them.
+func (p *parser) addSyntheticOneofs(msg *descriptorpb.DescriptorProto) {
+	names := make(map[string]bool)
+	for _, field := range msg.Field {
+		names[field.GetName()] = true
+	}
+	for _, oneof := range msg.OneofDecl {
+		names[oneof.GetName()] = true
+	}
+	for _, field := range msg.Field {
+		if !field.GetProto3Optional() {
+			continue
+		}
+		name := field.GetName()
+		if !strings.HasPrefix(name, "_") {
+			name = "_" + name
+		}
+		for names[name] {
+			name = "X" + name
+		}
+		names[name] = true
+		field.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+		msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String(name)})
+	}
+}
+
+// maxExtensionEnd is the end of an extension range ending in max. It is
+// replaced once the message options are known, as message sets allow larger
+// numbers.
+const maxExtensionEnd = -1
+
+// adjustExtensionRanges replaces the ends of the extension ranges ending in
+// max.
+func (p *parser) adjustExtensionRanges(msg *descriptorpb.DescriptorProto) {
+	end := int32(maxFieldNumber + 1)
+	for _, opt := range msg.GetOptions().GetUninterpretedOption() {
+		if len(opt.Name) == 1 && opt.Name[0].GetNamePart() == "message_set_wire_format" && opt.GetIdentifierValue() == "true" {
+			end = math.MaxInt32
+		}
+	}
+	for _, r := range msg.ExtensionRange {
+		if r.GetEnd() == maxExtensionEnd {
+			r.End = proto.Int32(end)
+		}
+	}
+}
+
+// parseRange parses a field number range at path, returning its inclusive
+// bounds. max is the value of the max keyword.
+func (p *parser) parseRange(path []int32, max int32, allowNegative bool) (int32, int32) {
+	startTok := p.peek()
+	loc := p.location(path, startTok)
+	var start int32
+	if allowNegative {
+		start, _ = p.consumeInt32("field number range")
+	} else {
+		v, _ := p.consumeUint(math.MaxInt32, "field number range")
+		start = int32(v)
+	}
+	p.end(p.location(appendPath(path, rangeStartTag), startTok), &p.toks[p.i-1])
+
+	end := start
+	endTok := startTok
+	switch {
+	case p.tryConsume("to") == nil:
+	case p.lookingAt("max"):
+		endTok = p.next()
+		end = max
+	case allowNegative:
+		endTok = p.peek()
+		end, _ = p.consumeInt32("integer")
+	default:
+		endTok = p.peek()
+		v, _ := p.consumeUint(math.MaxInt32, "integer")
+		end = int32(v)
+	}
+	last := &p.toks[p.i-1]
+	p.end(p.location(appendPath(path, rangeEndTag), endTok), last)
+	p.end(loc, last)
+	return start, end
+}
+
+func (p *parser) parseExtensions(msg *descriptorpb.DescriptorProto, path []int32) {
+	start := p.consume("extensions")
+	loc := p.location(appendPath(path, messageRangeTag), start)
+	var ranges []*descriptorpb.DescriptorProto_ExtensionRange
+	for {
+		start, end := p.parseRange(appendPath(loc.Path, int32(len(msg.ExtensionRange)+len(ranges))), maxExtensionEnd, false)
+		if end != maxExtensionEnd {
+			end++
+		}
+		ranges = append(ranges, &descriptorpb.DescriptorProto_ExtensionRange{
+			Start: proto.Int32(start),
+			End:   proto.Int32(end),
+		})
+		if p.tryConsume(",") == nil {
+			break
+		}
+	}
+	if open := p.tryConsume("["); open != nil {
+		// Like protoc, parse the options as those of the first range, then
+		// copy them and their locations to each range, as the options are
+		// interpreted in place.
+		first := len(p.locs)
+		index := len(loc.Path)
+		optsPath := appendPath(loc.Path, int32(len(msg.ExtensionRange)), rangeOptionsTag)
+		optsLoc := p.location(optsPath, open)
+		opts := &descriptorpb.ExtensionRangeOptions{}
+		for {
+			p.parseOption(&opts.UninterpretedOption, optsPath)
+			if p.tryConsume("]") != nil {
+				break
+			}
+			p.consume(",")
+		}
+		p.end(optsLoc, &p.toks[p.i-1])
+		locs := append([]*descriptorpb.SourceCodeInfo_Location(nil), p.locs[first:]...)
+		p.locs = p.locs[:first]
+		for i, r := range ranges {
+			r.Options = proto.Clone(opts).(*descriptorpb.ExtensionRangeOptions)
+			for _, loc := range locs {
+				loc = proto.Clone(loc).(*descriptorpb.SourceCodeInfo_Location)
+				loc.Path[index] = int32(len(msg.ExtensionRange) + i)
+				p.locs = append(p.locs, loc)
+			}
+			for j, opt := range opts.UninterpretedOption {
+				clone := r.Options.UninterpretedOption[j]
+				p.pos[elemKey{clone, 0}] = p.pos[elemKey{opt, 0}]
+				for k, loc := range locs {
+					if p.optionLocs[opt] == loc {
+						p.optionLocs[clone] = p.locs[len(p.locs)-len(locs)+k]
+					}
+				}
+			}
+		}
+		for _, opt := range opts.UninterpretedOption {
+			delete(p.pos, elemKey{opt, 0})
+			delete(p.optionLocs, opt)
+		}
+	}
+	p.endStatement(loc, start)
+	msg.ExtensionRange = append(msg.ExtensionRange, ranges...)
+}
+
+func (p *parser) parseMessageReserved(msg *descriptorpb.DescriptorProto, path []int32) {
+	start := p.consume("reserved")
+	if p.peek().kind != tokenInt {
+		loc := p.location(appendPath(path, messageResNameTag), start)
+		msg.ReservedName = p.parseReservedNames(msg.ReservedName, loc.Path)
+		p.endStatement(loc, start)
+		return
+	}
+	loc := p.location(appendPath(path, messageReservedTag), start)
+	for {
+		first, last := p.parseRange(appendPath(loc.Path, int32(len(msg.ReservedRange))), maxFieldNumber, false)
+		msg.ReservedRange = append(msg.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{
+			Start: proto.Int32(first),
+			End:   proto.Int32(last + 1),
+		})
+		if p.tryConsume(",") == nil {
+			break
+		}
+	}
+	p.endStatement(loc, start)
+}
+
+// parseReservedNames parses the names of a reserved statement, which are
+// strings before editions and identifiers since, appending them to names.
+// path is the source path of names.
+func (p *parser) parseReservedNames(names []string, path []int32) []string {
+	for {
+		tok := p.peek()
+		loc := p.location(appendPath(path, int32(len(names))), tok)
+		switch {
+		case p.syntax == syntaxEditions && tok.kind == tokenString:
+			p.failf(tok, "Reserved names must be identifiers in editions, not string literals.")
+		case p.syntax != syntaxEditions && tok.kind == tokenIdent:
+			p.failf(tok, "Reserved names must be string literals. (Only editions supports identifiers.)")
+		case tok.kind == tokenString:
+			names = append(names, p.consumeString("string"))
+		case tok.kind == tokenIdent:
+			names = append(names, p.next().text)
+		default:
+			p.failf(tok, "Expected field name or number range.")
+		}
+		p.end(loc, &p.toks[p.i-1])
+		if p.tryConsume(",") == nil {
+			return names
+		}
+	}
+}
+
+func (p *parser) parseOneof(msg *descriptorpb.DescriptorProto, msgPath []int32) {
+	start := p.next()
+	index := int32(len(msg.OneofDecl))
+	path := appendPath(msgPath, messageOneofTag, index)
+	loc := p.location(path, start)
+	nameTok := p.consumeIdent("oneof name")
+	p.tokenLocation(appendPath(path, oneofNameTag), nameTok)
+	oneof := &descriptorpb.OneofDescriptorProto{Name: proto.String(nameTok.text)}
+	msg.OneofDecl = append(msg.OneofDecl, oneof)
+	open := p.consume("{")
+	p.declaration(loc, start, open)
+	for {
+		tok := p.peek()
+		switch {
+		case tok.kind == tokenEOF:
+			p.failf(tok, "Reached end of input in oneof definition (missing '}').")
+		case p.tryConsume("}") != nil:
+			p.end(loc, tok)
+			return
+		case p.tryConsume(";") != nil:
+		case p.lookingAt("option"):
+			if oneof.Options == nil {
+				oneof.Options = &descriptorpb.OneofOptions{}
+			}
+			p.parseOptionStatement(&oneof.Options.UninterpretedOption, appendPath(path, oneofOptionsTag))
+		default:
+			p.parseField(fieldContext{
+				fields:     &msg.Field,
+				nested:     &msg.NestedType,
+				path:       appendPath(msgPath, messageFieldTag, int32(len(msg.Field))),
+				nestedPath: appendPath(msgPath, messageNestedTag),
+				oneof:      proto.Int32(index),
+			})
+		}
+	}
+}
+
+// parseExtend parses an extend block adding its fields to fields, and its
+// groups to nested. path is the source path of fields, and nestedPath that of
+// nested.
+func (p *parser) parseExtend(msg *descriptorpb.DescriptorProto, fields *[]*descriptorpb.FieldDescriptorProto, nested *[]*descriptorpb.DescriptorProto, path, nestedPath []int32) {
+	start := p.next()
+	loc := p.location(path, start)
+	extendeeTok := p.peek()
+	extendee := p.consumeTypeName()
+	extendeeEnd := &p.toks[p.i-1]
+	open := p.consume("{")
+	p.declaration(loc, start, open)
+	for {
+		tok := p.peek()
+		switch {
+		case tok.kind == tokenEOF:
+			p.failf(tok, "Reached end of input in extend definition (missing '}').")
+		case p.tryConsume("}") != nil:
+			p.end(loc, tok)
+			return
+		case p.tryConsume(";") != nil:
+		default:
+			fieldsBefore := len(*fields)
+			p.parseField(fieldContext{
+				fields:        fields,
+				nested:        nested,
+				path:          appendPath(path, int32(len(*fields))),
+				nestedPath:    nestedPath,
+				extendee:      proto.String(extendee),
+				extendeeStart: extendeeTok,
+				extendeeEnd:   extendeeEnd,
+			})
+			for _, field := range (*fields)[fieldsBefore:] {
+				p.pos[elemKey{field, fieldExtendeeTag}] = extendeeTok.start
+			}
+		}
+	}
+}
+
+func (p *parser) parseEnum(path []int32, start *token) *descriptorpb.EnumDescriptorProto {
+	loc := p.location(path, start)
+	p.consume("enum")
+	nameTok := p.consumeIdent("enum name")
+	p.tokenLocation(appendPath(path, enumNameTag), nameTok)
+	enum := &descriptorpb.EnumDescriptorProto{Name: proto.String(nameTok.text)}
+	open := p.consume("{")
+	p.declaration(loc, start, open)
+	for {
+		tok := p.peek()
+		switch {
+		case tok.kind == tokenEOF:
+			p.failf(tok, "Reached end of input in enum definition (missing '}').")
+		case p.tryConsume("}") != nil:
+			p.end(loc, tok)
+			return enum
+		case p.tryConsume(";") != nil:
+		case p.lookingAt("option"):
+			if enum.Options == nil {
+				enum.Options = &descriptorpb.EnumOptions{}
+			}
+			p.parseOptionStatement(&enum.Options.UninterpretedOption, appendPath(path, enumOptionsTag))
+		case p.lookingAt("reserved"):
+			p.parseEnumReserved(enum, path)
+		default:
+			p.parseEnumValue(enum, appendPath(path, enumValueTag, int32(len(enum.Value))))
+		}
+	}
+}
+
+func (p *parser) parseEnumReserved(enum *descriptorpb.EnumDescriptorProto, path []int32) {
+	start := p.consume("reserved")
+	if p.peek().kind != tokenInt && !p.lookingAt("-") {
+		loc := p.location(appendPath(path, enumResNameTag), start)
+		enum.ReservedName = p.parseReservedNames(enum.ReservedName, loc.Path)
+		p.endStatement(loc, start)
+		return
+	}
+	loc := p.location(appendPath(path, enumReservedTag), start)
+	for {
+		first, last := p.parseRange(appendPath(loc.Path, int32(len(enum.ReservedRange))), math.MaxInt32, true)
+		enum.ReservedRange = append(enum.ReservedRange, &descriptorpb.EnumDescriptorProto_EnumReservedRange{
+			Start: proto.Int32(first),
+			End:   proto.Int32(last),
+		})
+		if p.tryConsume(",") == nil {
+			break
+		}
+	}
+	p.endStatement(loc, start)
+}
+
+func (p *parser) parseEnumValue(enum *descriptorpb.EnumDescriptorProto, path []int32) {
+	start := p.peek()
+	loc := p.location(path, start)
+	nameTok := p.consumeIdent("enum constant name")
+	p.tokenLocation(appendPath(path, enumValueNameTag), nameTok)
+	p.consume("=")
+	number, numberTok := p.consumeInt32("integer")
+	p.end(p.location(appendPath(path, enumValueNumberTag), numberTok), &p.toks[p.i-1])
+	value := &descriptorpb.EnumValueDescriptorProto{
+		Name:   proto.String(nameTok.text),
+		Number: proto.Int32(number),
+	}
+	if open := p.tryConsume("["); open != nil {
+		optionsPath := appendPath(path, enumValueOptionsTag)
+		optionsLoc := p.location(optionsPath, open)
+		value.Options = &descriptorpb.EnumValueOptions{}
+		for {
+			p.parseOption(&value.Options.UninterpretedOption, optionsPath)
+			if end := p.tryConsume("]"); end != nil {
+				p.end(optionsLoc, end)
+				break
+			}
+			p.consume(",")
+		}
+	}
+	end := p.consume(";")
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+	enum.Value = append(enum.Value, value)
+}
+
+func (p *parser) parseService() {
+	f := p.file
+	start := p.next()
+	path := []int32{fileServiceTag, int32(len(f.Service))}
+	loc := p.location(path, start)
+	nameTok := p.consumeIdent("service name")
+	p.tokenLocation(appendPath(path, serviceNameTag), nameTok)
+	service := &descriptorpb.ServiceDescriptorProto{Name: proto.String(nameTok.text)}
+	f.Service = append(f.Service, service)
+	open := p.consume("{")
+	p.declaration(loc, start, open)
+	for {
+		tok := p.peek()
+		switch {
+		case tok.kind == tokenEOF:
+			p.failf(tok, "Reached end of input in service definition (missing '}').")
+		case p.tryConsume("}") != nil:
+			p.end(loc, tok)
+			return
+		case p.tryConsume(";") != nil:
+		case p.lookingAt("option"):
+			if service.Options == nil {
+				service.Options = &descriptorpb.ServiceOptions{}
+			}
+			p.parseOptionStatement(&service.Options.UninterpretedOption, appendPath(path, serviceOptionsTag))
+		case p.lookingAt("rpc"):
+			p.parseMethod(service, appendPath(path, serviceMethodTag, int32(len(service.Method))))
+		default:
+			p.failf(tok, "Expected \"rpc\".")
+		}
+	}
+}
+
+func (p *parser) parseMethod(service *descriptorpb.ServiceDescriptorProto, path []int32) {
+	start := p.next()
+	loc := p.location(path, start)
+	nameTok := p.consumeIdent("method name")
+	p.tokenLocation(appendPath(path, methodNameTag), nameTok)
+	method := &descriptorpb.MethodDescriptorProto{Name: proto.String(nameTok.text)}
+	service.Method = append(service.Method, method)
+
+	parseType := func(tag int32) (*string, bool) {
+		p.consume("(")
+		streaming := false
+		if p.lookingAt("stream") && p.peekAt(1).text != ")" {
+			// The streaming fields follow the type fields.
+			p.tokenLocation(appendPath(path, tag+3), p.next())
+			streaming = true
+		}
+		tok := p.peek()
+		name := p.consumeTypeName()
+		p.end(p.location(appendPath(path, tag), tok), &p.toks[p.i-1])
+		p.pos[elemKey{method, tag}] = tok.start
+		p.consume(")")
+		return proto.String(name), streaming
+	}
+	var clientStreaming, serverStreaming bool
+	method.InputType, clientStreaming = parseType(methodInputTag)
+	p.consume("returns")
+	method.OutputType, serverStreaming = parseType(methodOutputTag)
+	if clientStreaming {
+		method.ClientStreaming = proto.Bool(true)
+	}
+	if serverStreaming {
+		method.ServerStreaming = proto.Bool(true)
+	}
+
+	if open := p.tryConsume("{"); open != nil {
+		p.declaration(loc, start, open)
+		for {
+			tok := p.peek()
+			switch {
+			case tok.kind == tokenEOF:
+				p.failf(tok, "Reached end of input in method options (missing '}').")
+			case p.tryConsume("}") != nil:
+				p.end(loc, tok)
+				return
+			case p.tryConsume(";") != nil:
+			case p.lookingAt("option"):
+				if method.Options == nil {
+					method.Options = &descriptorpb.MethodOptions{}
+				}
+				p.parseOptionStatement(&method.Options.UninterpretedOption, appendPath(path, methodOptionsTag))
+			default:
+				p.failf(tok, "Expected \"option\".")
+			}
+		}
+	}
+	end := p.consume(";")
+	p.end(loc, end)
+	p.declaration(loc, start, end)
+}
+
+// parseOptionStatement parses an option statement, adding the option to opts.
+// path is the source path of the options field of the element.
+func (p *parser) parseOptionStatement(opts *[]*descriptorpb.UninterpretedOption, path []int32) {
+	start := p.consume("option")
+	loc := p.location(path, start)
+	optionLoc := p.parseOption(opts, path)
+	end := p.consume(";")
+	p.end(loc, end)
+	optionLoc.Span = append([]int32(nil), loc.Span...)
+	p.declaration(optionLoc, start, end)
+}
+
+// parseOption parses an option assignment, adding the option to opts
+// uninterpreted, and returns its source location. path is the source path
+// of the options field of the element, or nil to record no location.
+func (p *parser) parseOption(opts *[]*descriptorpb.UninterpretedOption, path []int32) *descriptorpb.SourceCodeInfo_Location {
+	opt := &descriptorpb.UninterpretedOption{}
+	start := p.peek()
+	p.pos[elemKey{opt, 0}] = start.start
+	var loc *descriptorpb.SourceCodeInfo_Location
+	if path != nil {
+		loc = p.location(appendPath(path), start)
+		p.optionLocs[opt] = loc
+	}
+	for {
+		if p.tryConsume("(") != nil {
+			var b strings.Builder
+			if p.tryConsume(".") != nil {
+				b.WriteByte('.')
+			}
+			b.WriteString(p.consumeIdent("identifier").text)
+			for p.tryConsume(".") != nil {
+				b.WriteByte('.')
+				b.WriteString(p.consumeIdent("identifier").text)
+			}
+			p.consume(")")
+			opt.Name = append(opt.Name, &descriptorpb.UninterpretedOption_NamePart{
+				NamePart:    proto.String(b.String()),
+				IsExtension: proto.Bool(true),
+			})
+		} else {
+			opt.Name = append(opt.Name, &descriptorpb.UninterpretedOption_NamePart{
+				NamePart:    proto.String(p.consumeIdent("identifier").text),
+				IsExtension: proto.Bool(false),
+			})
+		}
+		if p.tryConsume(".") == nil {
+			break
+		}
+	}
+	p.consume("=")
+
+	tok := p.peek()
+	negative := p.tryConsume("-") != nil
+	if negative {
+		tok = p.peek()
+	}
+	switch {
+	case tok.kind == tokenIdent:
+		p.next()
+		if negative {
+			if tok.text != "inf" && tok.text != "nan" {
+				p.failf(tok, "Identifier after '-' symbol must be inf or nan.")
+			}
+			opt.IdentifierValue = proto.String("-" + tok.text)
+		} else {
+			opt.IdentifierValue = proto.String(tok.text)
+		}
+	case tok.kind == tokenInt:
+		max := uint64(math.MaxUint64)
+		if negative {
+			max = -math.MinInt64
+		}
+		v, _ := p.consumeUint(max, "integer")
+		if negative {
+			opt.NegativeIntValue = proto.Int64(int64(-v))
+		} else {
+			opt.PositiveIntValue = proto.Uint64(v)
+		}
+	case tok.kind == tokenFloat:
+		p.next()
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil && !strings.Contains(err.Error(), "range") {
+			p.failf(tok, "Invalid float %q.", tok.text)
+		}
+		if negative {
+			v = -v
+		}
+		opt.DoubleValue = proto.Float64(v)
+	case negative:
+		p.failf(tok, "Expected number.")
+	case tok.kind == tokenString:
+		opt.StringValue = []byte(p.consumeString("string"))
+	case p.lookingAt("{"):
+		p.next()
+		depth := 1
+		for depth > 0 {
+			t := p.next()
+			switch {
+			case t.kind == tokenEOF:
+				p.failf(t, "Unexpected end of stream while parsing aggregate value.")
+			case t.kind == tokenSymbol && (t.text == "{" || t.text == "<"):
+				depth++
+			case t.kind == tokenSymbol && (t.text == "}" || t.text == ">"):
+				depth--
+			}
+		}
+		opt.AggregateValue = proto.String(p.src[tok.offset+1 : p.toks[p.i-1].offset])
+	default:
+		p.failf(tok, "Expected option value.")
+	}
+	*opts = append(*opts, opt)
+	if loc != nil {
+		p.end(loc, &p.toks[p.i-1])
+	}
+	return loc
+}
@@ -0,0 +1,200 @@
+package protobuf_go_lite
+
+import "maps"
+
+// CopyBytes copies src into dst, reusing the capacity of dst. A nil src yields
+// nil and an empty src yields a non-nil empty slice.
+func CopyBytes[S ~[]byte](dst, src S) S {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = S{}
+	}
+	return append(dst[:0], src...)
+}
+
+// CopyPtr copies one explicit scalar into dst, allocating dst if it is nil. A
+// nil src yields nil.
+func CopyPtr[T any](dst, src *T) *T {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = new(T)
+	}
+	*dst = *src
+	return dst
+}
+
+// CopySlice copies a scalar slice into dst, reusing the capacity of dst.
+func CopySlice[S ~[]E, E any](dst, src S) S {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = S{}
+	}
+	return append(dst[:0], src...)
+}
+
+// CopyBytesSlice copies a repeated bytes field into dst, reusing the capacity
+// of dst and of its elements.
+func CopyBytesSlice[S ~[]E, E ~[]byte](dst, src S) S {
+	if src == nil {
+		return nil
+	}
+	dst = resizeSlice(dst, len(src))
+	for i := range src {
+		dst[i] = CopyBytes(dst[i], src[i])
+	}
+	return dst
+}
+
+// CopyVTSlice copies a repeated message field into dst with copyVT, reusing
+// the capacity of dst and the messages it holds.
+func CopyVTSlice[S ~[]*T, T any](dst, src S, copyVT func(m, dst *T)) S {
+	if src == nil {
+		return nil
+	}
+	dst = resizeSlice(dst, len(src))
+	for i, v := range src {
+		dst[i] = CopyVTValue(dst[i], v, copyVT)
+	}
+	return dst
+}
+
+// CopyMap copies a map whose values do not need deep copying into dst,
+// reusing dst.
+func CopyMap[M ~map[K]V, K comparable, V any](dst, src M) M {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		return maps.Clone(src)
+	}
+	clear(dst)
+	maps.Copy(dst, src)
+	return dst
+}
+
+// CopyBytesMap copies a map with bytes values into dst, reusing dst and the
+// values stored under keys present in both maps.
+func CopyBytesMap[M ~map[K]V, K comparable, V ~[]byte](dst, src M) M {
+	if src == nil {
+		return nil
+	}
+	dst = pruneMap(dst, src)
+	for k, v := range src {
+		dst[k] = CopyBytes(dst[k], v)
+	}
+	return dst
+}
+
+// CopyVTMap copies a map with message values into dst with copyVT, reusing dst
+// and the messages stored under keys present in both maps.
+func CopyVTMap[M ~map[K]*T, K comparable, T any](dst, src M, copyVT func(m, dst *T)) M {
+	if src == nil {
+		return nil
+	}
+	dst = pruneMap(dst, src)
+	for k, v := range src {
+		dst[k] = CopyVTValue(dst[k], v, copyVT)
+	}
+	return dst
+}
+
+// CopyVTValue copies the message src into dst with copyVT, allocating dst if
+// it is nil. A nil src yields nil.
+func CopyVTValue[T any](dst, src *T, copyVT func(m, dst *T)) *T {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = new(T)
+	}
+	copyVT(src, dst)
+	return dst
+}
+
+// resizeSlice returns s with length n, keeping its existing elements. Elements
+// between the old length and n are cleared rather than reused, since the
+// caller may have handed them out after truncating s. A nil s is allocated, so
+// that copying an empty slice yields an empty slice rather than nil.
+func resizeSlice[S ~[]E, E any](s S, n int) S {
+	if s == nil {
+		s = S{}
+	}
+	old := len(s)
+	if n <= cap(s) {
+		s = s[:n]
+	} else {
+		s = append(s[:cap(s)], make(S, n-cap(s))...)
+	}
+	if old < n {
+		clear(s[old:n])
+	}
+	return s
+}
+
+// pruneMap returns dst with the keys missing from src deleted, allocating it if
+// it is nil.
+func pruneMap[M ~map[K]V, K comparable, V any](dst, src M) M {
+	if dst == nil {
+		return make(M, len(src))
+	}
+	for k := range dst {
+		if _, ok := src[k]; !ok {
+			delete(dst, k)
+		}
+	}
+	return dst
+}
+
+// CopyStd copies one native well-known type value, reusing the capacity of dst
+// for bytes values.
+func CopyStd[T Std](dst, src T) T {
+	if b, ok := any(src).([]byte); ok {
+		return any(CopyBytes(any(dst).([]byte), b)).(T)
+	}
+	return src
+}
+
+// CopyStdPtr copies one explicit native well-known type value into dst,
+// allocating dst if it is nil. A nil src yields nil.
+func CopyStdPtr[T Std](dst, src *T) *T {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = new(T)
+	}
+	*dst = CopyStd(*dst, *src)
+	return dst
+}
+
+// CopyStdSlice copies repeated native well-known type values into dst,
+// reusing the capacity of dst and of its elements.
+func CopyStdSlice[S ~[]E, E Std](dst, src S) S {
+	if src == nil {
+		return nil
+	}
+	dst = resizeSlice(dst, len(src))
+	for i := range src {
+		dst[i] = CopyStd(dst[i], src[i])
+	}
+	return dst
+}
+
+// CopyStdMap copies a map with native well-known type values into dst, reusing
+// dst and the values stored under keys present in both maps.
+func CopyStdMap[M ~map[K]V, K comparable, V Std](dst, src M) M {
+	if src == nil {
+		return nil
+	}
+	dst = pruneMap(dst, src)
+	for k, v := range src {
+		dst[k] = CopyStd(dst[k], v)
+	}
+	return dst
+}
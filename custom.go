@@ -0,0 +1,180 @@
+package protobuf_go_lite
+
+import (
+	"bytes"
+	"encoding/base64"
+	"log/slog"
+	"slices"
+)
+
+// CustomType is the constraint satisfied by pointers to the Go types of bytes
+// and string fields generated with the customtype directive. A value is
+// encoded as the bytes of the field.
+type CustomType[T any] interface {
+	*T
+	// SizeVT returns the size of the encoded value.
+	SizeVT() int
+	// MarshalToSizedBufferVT writes the encoded value to the end of dAtA and
+	// returns the number of bytes written.
+	MarshalToSizedBufferVT(dAtA []byte) (int, error)
+	// UnmarshalVT replaces the value with the one encoded in dAtA. It must not
+	// retain dAtA.
+	UnmarshalVT(dAtA []byte) error
+	// EqualVT reports whether the value equals that of other.
+	EqualVT(other *T) bool
+	// CloneVT returns a deep copy of the value.
+	CloneVT() *T
+}
+
+// CustomBytes returns the encoded custom type value v, or nil if v is nil.
+func CustomBytes[T any, P CustomType[T]](v P) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	dAtA := make([]byte, v.SizeVT())
+	n, err := v.MarshalToSizedBufferVT(dAtA)
+	return dAtA[len(dAtA)-n:], err
+}
+
+// CloneCustom returns a deep copy of the custom type value v, keeping nil as
+// nil.
+func CloneCustom[T any, P CustomType[T]](v P) P {
+	if v == nil {
+		return nil
+	}
+	return v.CloneVT()
+}
+
+// CloneCustomSlice returns a deep copy of the repeated custom type values s,
+// keeping nil as nil.
+func CloneCustomSlice[T any, P CustomType[T]](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for i := range s {
+		out[i] = *P(&s[i]).CloneVT()
+	}
+	return out
+}
+
+// EqualCustom reports whether the custom type values a and b are both nil or
+// equal.
+func EqualCustom[T any, P CustomType[T]](a, b P) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.EqualVT(b)
+}
+
+// EqualCustomSlice reports whether the repeated custom type values a and b are
+// equal.
+func EqualCustomSlice[T any, P CustomType[T]](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !P(&a[i]).EqualVT(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TextWriteCustom writes the custom type value v as the proto text value of a
+// string field if str is set, and of a bytes field otherwise.
+func TextWriteCustom[T any, P CustomType[T]](sb *TextBuilder, v P, str bool) {
+	b, _ := CustomBytes(v)
+	if str {
+		TextWriteString(sb, string(b))
+	} else {
+		TextWriteBytes(sb, b)
+	}
+}
+
+// LogCustom returns the slog.Value of the custom type value v of a string
+// field if str is set, and of a bytes field otherwise.
+func LogCustom[T any, P CustomType[T]](v P, str bool) slog.Value {
+	b, _ := CustomBytes(v)
+	if str {
+		return slog.StringValue(string(b))
+	}
+	return slog.StringValue(base64.StdEncoding.EncodeToString(b))
+}
+
+// CompareCustom orders the custom type values a and b by their encoding,
+// with nil first.
+func CompareCustom[T any, P CustomType[T]](a, b P) int {
+	if a == nil || b == nil {
+		return CompareBool(a != nil, b != nil)
+	}
+	ab, _ := CustomBytes(a)
+	bb, _ := CustomBytes(b)
+	return bytes.Compare(ab, bb)
+}
+
+// CompareCustomSlice orders the repeated custom type values a and b.
+func CompareCustomSlice[T any, P CustomType[T]](a, b []T) int {
+	return slices.CompareFunc(a, b, func(a, b T) int {
+		return CompareCustom[T, P](&a, &b)
+	})
+}
+
+// CopyCustom copies the custom type value src into dst, allocating dst if it
+// is nil. A nil src yields nil.
+func CopyCustom[T any, P CustomType[T]](dst, src P) P {
+	if src == nil {
+		return nil
+	}
+	if dst == nil {
+		return src.CloneVT()
+	}
+	*dst = *src.CloneVT()
+	return dst
+}
+
+// CopyCustomSlice copies the repeated custom type values src into dst,
+// reusing the capacity of dst.
+func CopyCustomSlice[T any, P CustomType[T]](dst, src []T) []T {
+	if src == nil {
+		return nil
+	}
+	dst = resizeSlice(dst, len(src))
+	for i := range src {
+		dst[i] = *P(&src[i]).CloneVT()
+	}
+	return dst
+}
+
+// HashCustom writes the encoding of the custom type value v.
+func HashCustom[T any, P CustomType[T]](w *Hasher, v P) {
+	b, _ := CustomBytes(v)
+	w.Bytes(b)
+}
+
+// AppendDiffCustom appends a difference if the custom type values a and b
+// differ.
+func AppendDiffCustom[T any, P CustomType[T]](diffs []FieldDiff, prefix, name string, a, b P) []FieldDiff {
+	if EqualCustom(a, b) {
+		return diffs
+	}
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if a != nil {
+		d.Old = *a
+	}
+	if b != nil {
+		d.New = *b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffCustomSlice appends the differences between two repeated custom
+// type fields.
+func AppendDiffCustomSlice[T any, P CustomType[T]](diffs []FieldDiff, prefix, name string, a, b []T) []FieldDiff {
+	return appendDiffElems(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b T) []FieldDiff {
+		if P(&a).EqualVT(&b) {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: a, New: b})
+	})
+}
@@ -0,0 +1,308 @@
+package protobuf_go_lite
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FieldDiff is one difference between two messages reported by DiffVT.
+type FieldDiff struct {
+	// Path locates the field, such as spec.items[3].name or labels["env"].
+	// Unknown fields are reported under the <unknown> path element.
+	Path string
+	// Old is the value in the receiver of DiffVT, nil if it is unset.
+	Old any
+	// New is the value in the argument of DiffVT, nil if it is unset.
+	New any
+}
+
+// String formats d as "path: old -> new".
+func (d FieldDiff) String() string {
+	return d.Path + ": " + formatDiffValue(d.Old) + " -> " + formatDiffValue(d.New)
+}
+
+func formatDiffValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "<unset>"
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return fmt.Sprintf("%x", v)
+	case TextMarshaler:
+		return v.MarshalProtoText()
+	}
+	return fmt.Sprint(v)
+}
+
+// DiffFunc appends the differences between two messages of type T to diffs,
+// prefixing their paths with prefix. Generated AppendDiffVT methods satisfy it
+// as method expressions.
+type DiffFunc[T any] func(m *T, diffs []FieldDiff, prefix string, that *T) []FieldDiff
+
+// DiffPath joins a field name to a path prefix.
+func DiffPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func diffIndexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func diffKeyPath(path string, key any) string {
+	if s, ok := key.(string); ok {
+		return path + "[" + strconv.Quote(s) + "]"
+	}
+	return path + "[" + fmt.Sprint(key) + "]"
+}
+
+// AppendDiff appends a difference if the implicit values a and b differ.
+func AppendDiff[T comparable](diffs []FieldDiff, prefix, name string, a, b T) []FieldDiff {
+	if a == b {
+		return diffs
+	}
+	var zero T
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if a != zero {
+		d.Old = a
+	}
+	if b != zero {
+		d.New = b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffPtr appends a difference if the explicit values a and b differ.
+func AppendDiffPtr[T comparable](diffs []FieldDiff, prefix, name string, a, b *T) []FieldDiff {
+	if a == nil && b == nil || a != nil && b != nil && *a == *b {
+		return diffs
+	}
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if a != nil {
+		d.Old = *a
+	}
+	if b != nil {
+		d.New = *b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffBytes appends a difference if the implicit bytes a and b differ.
+func AppendDiffBytes(diffs []FieldDiff, prefix, name string, a, b []byte) []FieldDiff {
+	if EqualBytes(a, b) {
+		return diffs
+	}
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if len(a) != 0 {
+		d.Old = a
+	}
+	if len(b) != 0 {
+		d.New = b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffBytesPresent appends a difference if the explicit bytes a and b
+// differ, where nil is unset.
+func AppendDiffBytesPresent(diffs []FieldDiff, prefix, name string, a, b []byte) []FieldDiff {
+	if EqualBytesPresent(a, b) {
+		return diffs
+	}
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if a != nil {
+		d.Old = a
+	}
+	if b != nil {
+		d.New = b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffVTValue appends the differences between the sub-messages a and b.
+// If only one is set, the whole sub-message is reported.
+func AppendDiffVTValue[T any](diffs []FieldDiff, prefix, name string, a, b *T, diff DiffFunc[T]) []FieldDiff {
+	return appendDiffVT(diffs, DiffPath(prefix, name), a, b, diff)
+}
+
+func appendDiffVT[T any](diffs []FieldDiff, path string, a, b *T, diff DiffFunc[T]) []FieldDiff {
+	switch {
+	case a == nil && b == nil:
+		return diffs
+	case a == nil:
+		return append(diffs, FieldDiff{Path: path, New: b})
+	case b == nil:
+		return append(diffs, FieldDiff{Path: path, Old: a})
+	}
+	return diff(a, diffs, path, b)
+}
+
+// appendDiffElems compares the elements of two repeated fields by index.
+func appendDiffElems[E any](diffs []FieldDiff, path string, a, b []E, diffElem func([]FieldDiff, string, E, E) []FieldDiff) []FieldDiff {
+	for i := range max(len(a), len(b)) {
+		switch {
+		case i >= len(a):
+			diffs = append(diffs, FieldDiff{Path: diffIndexPath(path, i), New: b[i]})
+		case i >= len(b):
+			diffs = append(diffs, FieldDiff{Path: diffIndexPath(path, i), Old: a[i]})
+		default:
+			diffs = diffElem(diffs, diffIndexPath(path, i), a[i], b[i])
+		}
+	}
+	return diffs
+}
+
+// AppendDiffSlice appends the differences between two repeated scalar fields.
+func AppendDiffSlice[S ~[]E, E comparable](diffs []FieldDiff, prefix, name string, a, b S) []FieldDiff {
+	if slices.Equal(a, b) {
+		return diffs
+	}
+	return appendDiffElems(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b E) []FieldDiff {
+		if a == b {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: a, New: b})
+	})
+}
+
+// AppendDiffBytesSlice appends the differences between two repeated bytes fields.
+func AppendDiffBytesSlice[S ~[]E, E ~[]byte](diffs []FieldDiff, prefix, name string, a, b S) []FieldDiff {
+	return appendDiffElems(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b E) []FieldDiff {
+		if EqualBytes(a, b) {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: []byte(a), New: []byte(b)})
+	})
+}
+
+// AppendDiffVTSlice appends the differences between two repeated message
+// fields, comparing the elements by index.
+func AppendDiffVTSlice[T any](diffs []FieldDiff, prefix, name string, a, b []*T, diff DiffFunc[T]) []FieldDiff {
+	return appendDiffElems(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b *T) []FieldDiff {
+		return appendDiffVT(diffs, path, a, b, diff)
+	})
+}
+
+// appendDiffEntries compares the entries of two maps in key order.
+func appendDiffEntries[M ~map[K]V, K comparable, V any](diffs []FieldDiff, path string, a, b M, diffValue func([]FieldDiff, string, V, V) []FieldDiff) []FieldDiff {
+	keys := slices.AppendSeq(make([]K, 0, len(a)+len(b)), maps.Keys(a))
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.SortFunc(keys, compareMapKeys)
+	for _, k := range keys {
+		av, aok := a[k]
+		bv, bok := b[k]
+		switch {
+		case !aok:
+			diffs = append(diffs, FieldDiff{Path: diffKeyPath(path, k), New: bv})
+		case !bok:
+			diffs = append(diffs, FieldDiff{Path: diffKeyPath(path, k), Old: av})
+		default:
+			diffs = diffValue(diffs, diffKeyPath(path, k), av, bv)
+		}
+	}
+	return diffs
+}
+
+// compareMapKeys orders protobuf map keys, which are bools, integers or strings.
+func compareMapKeys[K comparable](a, b K) int {
+	switch a := any(a).(type) {
+	case bool:
+		b := any(b).(bool)
+		if a == b {
+			return 0
+		}
+		if !a {
+			return -1
+		}
+		return 1
+	case string:
+		return strings.Compare(a, any(b).(string))
+	case int32:
+		return cmp.Compare(a, any(b).(int32))
+	case int64:
+		return cmp.Compare(a, any(b).(int64))
+	case uint32:
+		return cmp.Compare(a, any(b).(uint32))
+	case uint64:
+		return cmp.Compare(a, any(b).(uint64))
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// AppendDiffMap appends the differences between two maps with comparable values.
+func AppendDiffMap[M ~map[K]V, K comparable, V comparable](diffs []FieldDiff, prefix, name string, a, b M) []FieldDiff {
+	if maps.Equal(a, b) {
+		return diffs
+	}
+	return appendDiffEntries(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b V) []FieldDiff {
+		if a == b {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: a, New: b})
+	})
+}
+
+// AppendDiffBytesMap appends the differences between two maps with bytes values.
+func AppendDiffBytesMap[M ~map[K]V, K comparable, V ~[]byte](diffs []FieldDiff, prefix, name string, a, b M) []FieldDiff {
+	return appendDiffEntries(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b V) []FieldDiff {
+		if EqualBytes(a, b) {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: []byte(a), New: []byte(b)})
+	})
+}
+
+// AppendDiffVTMap appends the differences between two maps with message values.
+func AppendDiffVTMap[M ~map[K]*T, K comparable, T any](diffs []FieldDiff, prefix, name string, a, b M, diff DiffFunc[T]) []FieldDiff {
+	return appendDiffEntries(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b *T) []FieldDiff {
+		return appendDiffVT(diffs, path, a, b, diff)
+	})
+}
+
+// AppendDiffStdPtr appends a difference if the explicit native well-known type
+// values a and b differ.
+func AppendDiffStdPtr[T Std](diffs []FieldDiff, prefix, name string, a, b *T) []FieldDiff {
+	if EqualStdPtr(a, b) {
+		return diffs
+	}
+	d := FieldDiff{Path: DiffPath(prefix, name)}
+	if a != nil {
+		d.Old = *a
+	}
+	if b != nil {
+		d.New = *b
+	}
+	return append(diffs, d)
+}
+
+// AppendDiffStdSlice appends the differences between two repeated native
+// well-known type fields.
+func AppendDiffStdSlice[S ~[]E, E Std](diffs []FieldDiff, prefix, name string, a, b S) []FieldDiff {
+	return appendDiffElems(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b E) []FieldDiff {
+		if EqualStd(a, b) {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: a, New: b})
+	})
+}
+
+// AppendDiffStdMap appends the differences between two maps with native
+// well-known type values.
+func AppendDiffStdMap[M ~map[K]V, K comparable, V Std](diffs []FieldDiff, prefix, name string, a, b M) []FieldDiff {
+	return appendDiffEntries(diffs, DiffPath(prefix, name), a, b, func(diffs []FieldDiff, path string, a, b V) []FieldDiff {
+		if EqualStd(a, b) {
+			return diffs
+		}
+		return append(diffs, FieldDiff{Path: path, Old: a, New: b})
+	})
+}
@@ -0,0 +1,10 @@
+package protobuf_go_lite
+
+// PresentPtr returns v if present is set and nil otherwise. It views an
+// embedded sub-message field with tracked presence as a pointer field.
+func PresentPtr[T any](v *T, present bool) *T {
+	if !present {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,184 @@
+package protobuf_go_lite
+
+import (
+	"math"
+	"slices"
+)
+
+// EmptyMessagePolicy selects how EqualVTOpts compares a nil sub-message with a
+// set but empty one.
+type EmptyMessagePolicy uint8
+
+const (
+	// NilDiffersFromEmpty treats a nil sub-message as unequal to an empty one,
+	// as EqualVT does for singular message fields.
+	NilDiffersFromEmpty EmptyMessagePolicy = iota
+	// NilEqualsEmpty treats a nil sub-message as equal to an empty one.
+	NilEqualsEmpty
+)
+
+// EqualOptions configures the comparison performed by EqualVTOpts. The zero
+// value compares like EqualVT.
+type EqualOptions struct {
+	// IgnoreFields lists the paths of fields to skip, such as status or
+	// metadata.updated_at. Paths join proto field names with dots; the
+	// elements of repeated and map fields share the path of the field.
+	IgnoreFields []string
+	// IgnoreUnknown skips comparing unknown fields.
+	IgnoreUnknown bool
+	// FloatEpsilon is the largest difference at which float and double values
+	// are still considered equal.
+	FloatEpsilon float64
+	// NaNEqual treats NaN float and double values as equal to each other.
+	NaNEqual bool
+	// EmptyMessages selects how nil and empty sub-messages compare.
+	EmptyMessages EmptyMessagePolicy
+}
+
+// EqualOptsFunc compares two messages of type T under opts, where prefix is the
+// path of the messages. Generated EqualVTOptsPrefix methods satisfy it as
+// method expressions.
+type EqualOptsFunc[T any] func(this, that *T, opts *EqualOptions, prefix string) bool
+
+// FieldPath returns the path of the field name below prefix, and false if the
+// field is ignored. The path is only built if IgnoreFields is set.
+func (o *EqualOptions) FieldPath(prefix, name string) (string, bool) {
+	if len(o.IgnoreFields) == 0 {
+		return "", true
+	}
+	path := DiffPath(prefix, name)
+	return path, !slices.Contains(o.IgnoreFields, path)
+}
+
+// Float is the constraint satisfied by float and double field types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// EqualOptsFloat compares two float values under opts.
+func EqualOptsFloat[T Float](opts *EqualOptions, a, b T) bool {
+	if a == b {
+		return true
+	}
+	fa, fb := float64(a), float64(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return opts.NaNEqual && math.IsNaN(fa) && math.IsNaN(fb)
+	}
+	return math.Abs(fa-fb) <= opts.FloatEpsilon
+}
+
+// EqualOptsFloatPtr compares two explicit float values under opts.
+func EqualOptsFloatPtr[T Float](opts *EqualOptions, a, b *T) bool {
+	return a == nil && b == nil || a != nil && b != nil && EqualOptsFloat(opts, *a, *b)
+}
+
+// EqualOptsFloatSlice compares repeated float values under opts.
+func EqualOptsFloatSlice[S ~[]E, E Float](opts *EqualOptions, a, b S) bool {
+	return slices.EqualFunc(a, b, func(a, b E) bool {
+		return EqualOptsFloat(opts, a, b)
+	})
+}
+
+// EqualOptsFloatMap compares maps with float values under opts.
+func EqualOptsFloatMap[M ~map[K]V, K comparable, V Float](opts *EqualOptions, a, b M) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !EqualOptsFloat(opts, av, bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualVTOptsValue compares two singular sub-messages under opts, following
+// opts.EmptyMessages if only one of them is nil.
+func EqualVTOptsValue[T any](a, b *T, opts *EqualOptions, path string, eq EqualOptsFunc[T]) bool {
+	if a == b {
+		return true
+	}
+	if (a == nil || b == nil) && opts.EmptyMessages != NilEqualsEmpty {
+		return false
+	}
+	return eq(a, b, opts, path)
+}
+
+// EqualVTOptsImplicit compares two sub-messages under opts, where nil is
+// always equivalent to an empty message.
+func EqualVTOptsImplicit[T any](a, b *T, opts *EqualOptions, path string, eq EqualOptsFunc[T]) bool {
+	if a == b {
+		return true
+	}
+	if a == nil {
+		a = new(T)
+	}
+	if b == nil {
+		b = new(T)
+	}
+	return eq(a, b, opts, path)
+}
+
+// EqualVTOptsSlice compares repeated sub-messages under opts, where nil
+// elements are empty messages.
+func EqualVTOptsSlice[S ~[]*T, T any](a, b S, opts *EqualOptions, path string, eq EqualOptsFunc[T]) bool {
+	return slices.EqualFunc(a, b, func(a, b *T) bool {
+		return EqualVTOptsImplicit(a, b, opts, path, eq)
+	})
+}
+
+// EqualVTOptsMap compares maps with message values under opts, where nil
+// values are empty messages.
+func EqualVTOptsMap[M ~map[K]*T, K comparable, T any](a, b M, opts *EqualOptions, path string, eq EqualOptsFunc[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !EqualVTOptsImplicit(av, bv, opts, path, eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualOptsStd compares two native well-known type values under opts. Float
+// wrapper values follow the float options.
+func EqualOptsStd[T Std](opts *EqualOptions, a, b T) bool {
+	switch a := any(a).(type) {
+	case float64:
+		return EqualOptsFloat(opts, a, any(b).(float64))
+	case float32:
+		return EqualOptsFloat(opts, a, any(b).(float32))
+	}
+	return EqualStd(a, b)
+}
+
+// EqualOptsStdPtr compares two explicit native well-known type values under
+// opts.
+func EqualOptsStdPtr[T Std](opts *EqualOptions, a, b *T) bool {
+	return a == nil && b == nil || a != nil && b != nil && EqualOptsStd(opts, *a, *b)
+}
+
+// EqualOptsStdSlice compares repeated native well-known type values under
+// opts.
+func EqualOptsStdSlice[S ~[]E, E Std](opts *EqualOptions, a, b S) bool {
+	return slices.EqualFunc(a, b, func(a, b E) bool {
+		return EqualOptsStd(opts, a, b)
+	})
+}
+
+// EqualOptsStdMap compares maps with native well-known type values under opts.
+func EqualOptsStdMap[M ~map[K]V, K comparable, V Std](opts *EqualOptions, a, b M) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok || !EqualOptsStd(opts, av, bv) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,21 @@
+// Package all registers the built-in features of protoc-gen-go-lite. Custom
+// generator binaries built with generator.Main import it to include them.
+package all
+
+import (
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/clone"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/compare"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/diff"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/encoding"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/equal"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/hash"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/json"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/marshal"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/merge"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/redact"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/size"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/slog"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/sql"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/text"
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/unmarshal"
+)
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 )
 
 const (
@@ -19,9 +20,11 @@ const (
 )
 
 func init() {
+	// CloneMessageVT returns a protobuf_go_lite.CloneMessage, which embeds the
+	// Message interface implemented by size, marshal and unmarshal.
 	generator.RegisterFeature("clone", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &clone{GeneratedFile: gen}
-	})
+	}, "size", "marshal", "unmarshal")
 }
 
 type clone struct {
@@ -54,6 +57,54 @@ func (p *clone) cloneOneofField(lhsBase, rhsBase string, oneof *protogen.Oneof)
 	p.P(`}`)
 }
 
+// cloneStdField generates the statement cloning a field of a native Go type
+// standing for a well-known type, returning false for other fields.
+func (p *clone) cloneStdField(lhsBase, rhsBase string, field *protogen.Field) bool {
+	sem := p.FieldSemantics(field)
+	if sem.Std == "" || sem.RealOneof {
+		return false
+	}
+	helper := "CloneStdPtr"
+	switch {
+	case sem.Map:
+		helper = "CloneStdMap"
+	case sem.List:
+		helper = "CloneStdSlice"
+	}
+	p.P(lhsBase, `.`, field.GoName, ` = `, p.Helper(helper), `(`, rhsBase, `.`, field.GoName, `)`)
+	return true
+}
+
+// cloneCustomField generates the statement cloning a field of a custom type,
+// returning false for other fields.
+func (p *clone) cloneCustomField(lhsBase, rhsBase string, field *protogen.Field) bool {
+	sem := p.FieldSemantics(field)
+	if sem.Custom == "" || sem.RealOneof {
+		return false
+	}
+	helper := "CloneCustom"
+	if sem.List {
+		helper = "CloneCustomSlice"
+	}
+	p.P(lhsBase, `.`, field.GoName, ` = `, p.Helper(helper), `(`, rhsBase, `.`, field.GoName, `)`)
+	return true
+}
+
+// cloneEmbeddedField generates the statements cloning an embedded sub-message
+// field and its presence, returning false for other fields.
+func (p *clone) cloneEmbeddedField(lhsBase, rhsBase string, field *protogen.Field) bool {
+	sem := p.FieldSemantics(field)
+	if !sem.Embedded {
+		return false
+	}
+	p.P(lhsBase, `.`, field.GoName, ` = *`, rhsBase, `.`, field.GoName, `.`, cloneName, `()`)
+	if sem.Presence {
+		presenceName := fieldsem.PresenceGoName(field)
+		p.P(lhsBase, `.`, presenceName, ` = `, rhsBase, `.`, presenceName)
+	}
+	return true
+}
+
 func (p *clone) cloneFieldHelper(lhsBase, rhsBase string, field *protogen.Field) bool {
 	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
 		return false
@@ -130,6 +181,9 @@ func (p *clone) cloneFieldSingular(lhs, rhs string, kind protoreflect.Kind) {
 
 // cloneField generates the code for cloning a field in a protobuf.
 func (p *clone) cloneField(lhsBase, rhsBase string, field *protogen.Field) {
+	if p.cloneStdField(lhsBase, rhsBase, field) || p.cloneCustomField(lhsBase, rhsBase, field) || p.cloneEmbeddedField(lhsBase, rhsBase, field) {
+		return
+	}
 	if p.Config.HelperCodegen() && p.cloneFieldHelper(lhsBase, rhsBase, field) {
 		return
 	}
@@ -240,7 +294,7 @@ func (p *clone) body(ccTypeName string, message *protogen.Message, cloneUnknownF
 		// nil-safe.
 		if field.Desc.Cardinality() != protoreflect.Repeated {
 			switch {
-			case p.IsLocalMessage(field.Message):
+			case p.IsLocalMessage(field.Message) && p.FieldSemantics(field).Std == "" && !p.FieldSemantics(field).Embedded:
 				p.P(`r.`, field.GoName, ` = m.`, field.GoName, `.`, cloneName, `()`)
 				continue
 			}
@@ -252,6 +306,12 @@ func (p *clone) body(ccTypeName string, message *protogen.Message, cloneUnknownF
 	for _, field := range refFields {
 		p.cloneField("r", "m", field)
 	}
+	for _, field := range fields {
+		if p.FieldSemantics(field).Lazy {
+			lazyName := fieldsem.LazyGoName(field)
+			p.P(`r.`, lazyName, ` = m.`, lazyName, `.Clone((*`, field.Message.GoIdent, `).`, cloneName, `)`)
+		}
+	}
 
 	if cloneUnknownFields {
 		// Clone unknown fields, if any
@@ -273,6 +333,15 @@ func (p *clone) bodyForOneOf(ccTypeName string, field *protogen.Field) {
 
 	p.P("r", " := new(", ccTypeName, `)`)
 
+	if p.FieldSemantics(field).Std != "" {
+		p.P(`r.`, field.GoName, ` = `, p.Helper("CloneStd"), `(m.`, field.GoName, `)`)
+		p.P(`return r`)
+		return
+	}
+	if p.cloneCustomField("r", "m", field) {
+		p.P(`return r`)
+		return
+	}
 	if !oneofWrapperReference(field) {
 		p.P(`r.`, field.GoName, ` = m.`, field.GoName)
 		p.P(`return r`)
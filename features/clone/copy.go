@@ -0,0 +1,175 @@
+package clone
+
+import (
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+const copyName = "CopyVT"
+
+func init() {
+	// copy clones the lazy fields it copies, so it requires clone.
+	generator.RegisterOptionalFeature("copy", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &copier{clone: clone{GeneratedFile: gen}}
+	}, "clone")
+}
+
+// copier generates CopyVT with the field helpers of clone.
+type copier struct {
+	clone
+}
+
+var _ generator.FeatureGenerator = (*copier)(nil)
+
+func (p *copier) Name() string {
+	return "copy"
+}
+
+func (p *copier) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+
+	return p.once
+}
+
+func (p *copier) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+
+	p.generateCopyMethodForMessage(message)
+}
+
+// generateCopyMethodForMessage generates CopyVT, which deep copies m into dst
+// reusing the slices, maps and sub-messages already allocated in dst.
+func (p *clone) generateCopyMethodForMessage(message *protogen.Message) {
+	ccTypeName := message.GoIdent.GoName
+	p.P(`// `, copyName, ` overwrites dst with a deep copy of m, reusing the slices, maps and`)
+	p.P(`// sub-messages already allocated in dst. A nil m resets dst, and a nil dst`)
+	p.P(`// is left unchanged.`)
+	p.P(`func (m *`, ccTypeName, `) `, copyName, `(dst *`, ccTypeName, `) {`)
+	p.P(`if m == dst || dst == nil {`)
+	p.P(`return`)
+	p.P(`}`)
+	p.P(`if m == nil {`)
+	p.P(`dst.Reset()`)
+	p.P(`return`)
+	p.P(`}`)
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				p.copyOneof(oneof)
+			}
+			continue
+		}
+		p.copyField(`dst.`+field.GoName, `m.`+field.GoName, field)
+		if p.FieldSemantics(field).Presence {
+			presenceName := fieldsem.PresenceGoName(field)
+			p.P(`dst.`, presenceName, ` = m.`, presenceName)
+		}
+		if p.FieldSemantics(field).Lazy {
+			lazyName := fieldsem.LazyGoName(field)
+			p.P(`dst.`, lazyName, ` = m.`, lazyName, `.Clone((*`, field.Message.GoIdent, `).`, cloneName, `)`)
+		}
+	}
+	p.P(`dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)`)
+	p.P(`}`)
+	p.P()
+}
+
+// copyMethod returns the method expression copying the message type of field.
+func (p *clone) copyMethod(message *protogen.Message) string {
+	return `(*` + p.QualifiedGoIdent(message.GoIdent) + `).` + copyName
+}
+
+// copyField generates the assignment of a deep copy of rhs to lhs, reusing the
+// value already held by lhs.
+func (p *clone) copyField(lhs, rhs string, field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	kind := field.Desc.Kind()
+
+	switch {
+	case sem.Std != "" && sem.Map:
+		p.P(lhs, ` = `, p.Helper("CopyStdMap"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Std != "" && sem.List:
+		p.P(lhs, ` = `, p.Helper("CopyStdSlice"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Std != "":
+		p.P(lhs, ` = `, p.Helper("CopyStdPtr"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Custom != "" && sem.List:
+		p.P(lhs, ` = `, p.Helper("CopyCustomSlice"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Custom != "":
+		p.P(lhs, ` = `, p.Helper("CopyCustom"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Embedded:
+		p.P(rhs, `.`, copyName, `(&`, lhs, `)`)
+	case sem.Map:
+		value := field.Message.Fields[1]
+		switch {
+		case value.Message != nil:
+			p.P(lhs, ` = `, p.Helper("CopyVTMap"), `(`, lhs, `, `, rhs, `, `, p.copyMethod(value.Message), `)`)
+		case value.Desc.Kind() == protoreflect.BytesKind:
+			p.P(lhs, ` = `, p.Helper("CopyBytesMap"), `(`, lhs, `, `, rhs, `)`)
+		default:
+			p.P(lhs, ` = `, p.Helper("CopyMap"), `(`, lhs, `, `, rhs, `)`)
+		}
+	case sem.List:
+		switch {
+		case field.Message != nil:
+			p.P(lhs, ` = `, p.Helper("CopyVTSlice"), `(`, lhs, `, `, rhs, `, `, p.copyMethod(field.Message), `)`)
+		case kind == protoreflect.BytesKind:
+			p.P(lhs, ` = `, p.Helper("CopyBytesSlice"), `(`, lhs, `, `, rhs, `)`)
+		default:
+			p.P(lhs, ` = `, p.Helper("CopySlice"), `(`, lhs, `, `, rhs, `)`)
+		}
+	case field.Message != nil:
+		p.P(lhs, ` = `, p.Helper("CopyVTValue"), `(`, lhs, `, `, rhs, `, `, p.copyMethod(field.Message), `)`)
+	case kind == protoreflect.BytesKind:
+		p.P(lhs, ` = `, p.Helper("CopyBytes"), `(`, lhs, `, `, rhs, `)`)
+	case sem.Pointer:
+		p.P(lhs, ` = `, p.Helper("CopyPtr"), `(`, lhs, `, `, rhs, `)`)
+	default:
+		p.P(lhs, ` = `, rhs)
+	}
+}
+
+// copyOneof generates a type switch copying a oneof, reusing the wrapper held
+// by dst if it is of the same case.
+func (p *clone) copyOneof(oneof *protogen.Oneof) {
+	p.P(`switch v := m.`, oneof.GoName, `.(type) {`)
+	p.P(`case nil:`)
+	p.P(`dst.`, oneof.GoName, ` = nil`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.P(`d, ok := dst.`, oneof.GoName, `.(*`, field.GoIdent, `)`)
+		p.P(`if !ok {`)
+		p.P(`d = &`, field.GoIdent, `{}`)
+		p.P(`dst.`, oneof.GoName, ` = d`)
+		p.P(`}`)
+		lhs, rhs := `d.`+field.GoName, `v.`+field.GoName
+		switch {
+		case p.FieldSemantics(field).Std != "":
+			p.P(lhs, ` = `, p.Helper("CopyStd"), `(`, lhs, `, `, rhs, `)`)
+		case p.FieldSemantics(field).Custom != "":
+			p.P(lhs, ` = `, p.Helper("CopyCustom"), `(`, lhs, `, `, rhs, `)`)
+		case field.Message != nil:
+			p.P(lhs, ` = `, p.Helper("CopyVTValue"), `(`, lhs, `, `, rhs, `, `, p.copyMethod(field.Message), `)`)
+		case field.Desc.Kind() == protoreflect.BytesKind:
+			p.P(lhs, ` = `, p.Helper("CopyBytes"), `(`, lhs, `, `, rhs, `)`)
+		default:
+			p.P(lhs, ` = `, rhs)
+		}
+	}
+	p.P(`}`)
+}
@@ -0,0 +1,205 @@
+package compare
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+const compareName = "CompareVT"
+
+func init() {
+	generator.RegisterOptionalFeature("compare", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &compare{GeneratedFile: gen}
+	})
+}
+
+type compare struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*compare)(nil)
+
+func (p *compare) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *compare) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// `, compareName, ` returns -1, 0 or +1 as m orders before, equal to or after that.`)
+	p.P(`// Fields are compared in field number order: unset fields order before set`)
+	p.P(`// ones, repeated fields compare element by element and maps entry by entry in`)
+	p.P(`// key order. A nil message orders before any other.`)
+	p.P(`func (m *`, ccTypeName, `) `, compareName, `(that *`, ccTypeName, `) int {`)
+	p.P(`if m == that {`)
+	p.P(`return 0`)
+	p.P(`}`)
+	p.P(`if m == nil {`)
+	p.P(`return -1`)
+	p.P(`}`)
+	p.P(`if that == nil {`)
+	p.P(`return 1`)
+	p.P(`}`)
+	fields := slices.SortedFunc(slices.Values(message.Fields), func(a, b *protogen.Field) int {
+		return cmp.Compare(a.Desc.Number(), b.Desc.Number())
+	})
+	for _, field := range fields {
+		if p.FieldSemantics(field).RealOneof {
+			p.oneofField(field)
+			continue
+		}
+		p.field(field)
+	}
+	p.P(`return `, p.Ident("bytes", "Compare"), `(m.unknownFields, that.unknownFields)`)
+	p.P(`}`)
+	p.P()
+}
+
+// compareMethod returns the CompareVT method expression of message.
+func (p *compare) compareMethod(message *protogen.Message) string {
+	return `(*` + p.QualifiedGoIdent(message.GoIdent) + `).` + compareName
+}
+
+// compareFunc returns the function ordering two values of the scalar field.
+func (p *compare) compareFunc(field *protogen.Field) any {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return p.Helper("CompareBool")
+	case protoreflect.BytesKind:
+		return p.Ident("bytes", "Compare")
+	}
+	goType, _ := p.FieldGoType(field)
+	return p.Ident("cmp", "Compare") + `[` + goType + `]`
+}
+
+// compareStd returns the function ordering two values of the native Go type
+// goType standing for a well-known type.
+func (p *compare) compareStd(goType string) string {
+	return p.QualifiedGoIdent(p.Helper("CompareStd")) + `[` + goType + `]`
+}
+
+func (p *compare) call(fn any, args ...any) []any {
+	call := []any{fn, `(`}
+	for i, arg := range args {
+		if i != 0 {
+			call = append(call, `, `)
+		}
+		call = append(call, arg)
+	}
+	return append(call, `)`)
+}
+
+func (p *compare) check(expr []any) {
+	line := append([]any{`if c := `}, expr...)
+	line = append(line, `; c != 0 {`)
+	p.P(line...)
+	p.P(`return c`)
+	p.P(`}`)
+}
+
+func (p *compare) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	a, b := `m.`+field.GoName, `that.`+field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case sem.Std != "" && sem.Map:
+		p.check(p.call(p.Helper("CompareMap"), a, b, p.compareFunc(field.Message.Fields[0]), p.compareStd(sem.Std)))
+	case sem.Std != "" && sem.List:
+		p.check(p.call(p.Ident("slices", "CompareFunc"), a, b, p.compareStd(sem.Std)))
+	case sem.Std != "":
+		p.check(p.call(p.Helper("CompareStdPtr"), a, b))
+	case sem.Custom != "" && sem.List:
+		p.check(p.call(p.Helper("CompareCustomSlice"), a, b))
+	case sem.Custom != "":
+		p.check(p.call(p.Helper("CompareCustom"), a, b))
+	case sem.Map:
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		if value.Message != nil {
+			p.check(p.call(p.Helper("CompareVTMap"), a, b, p.compareFunc(key), p.compareMethod(value.Message)))
+			break
+		}
+		p.check(p.call(p.Helper("CompareMap"), a, b, p.compareFunc(key), p.compareFunc(value)))
+	case sem.List:
+		switch {
+		case field.Message != nil:
+			p.check(p.call(p.Helper("CompareVTSlice"), a, b, p.compareMethod(field.Message)))
+		case kind == protoreflect.BoolKind || kind == protoreflect.BytesKind:
+			p.check(p.call(p.Ident("slices", "CompareFunc"), a, b, p.compareFunc(field)))
+		default:
+			p.check(p.call(p.Ident("slices", "Compare"), a, b))
+		}
+	case sem.Embedded:
+		if sem.Presence {
+			presenceName := fieldsem.PresenceGoName(field)
+			p.check(p.call(p.Helper("CompareBool"), `m.`+presenceName, `that.`+presenceName))
+		}
+		p.check(p.call(a+`.`+compareName, `&`+b))
+	case field.Message != nil:
+		if sem.Lazy {
+			a, b = `m.Get`+field.GoName+`()`, `that.Get`+field.GoName+`()`
+		}
+		p.check(p.call(a+`.`+compareName, b))
+	case kind == protoreflect.BytesKind && field.Desc.HasPresence():
+		p.check(p.call(p.Helper("CompareBytesPresent"), a, b))
+	case kind == protoreflect.BytesKind:
+		p.check(p.call(p.Ident("bytes", "Compare"), a, b))
+	case kind == protoreflect.BoolKind && sem.Pointer:
+		p.check(p.call(p.Helper("CompareBoolPtr"), a, b))
+	case kind == protoreflect.BoolKind:
+		p.check(p.call(p.Helper("CompareBool"), a, b))
+	case sem.Pointer:
+		p.check(p.call(p.Helper("ComparePtr"), a, b))
+	default:
+		p.check(p.call(p.Ident("cmp", "Compare"), a, b))
+	}
+}
+
+// oneofField compares one member of a oneof like an explicit field, which is
+// set when the oneof holds that member.
+func (p *compare) oneofField(field *protogen.Field) {
+	a, b := `a.`+field.GoName, `b.`+field.GoName
+
+	p.P(`{`)
+	p.P(`a, aok := m.`, field.Oneof.GoName, `.(*`, field.GoIdent, `)`)
+	p.P(`b, bok := that.`, field.Oneof.GoName, `.(*`, field.GoIdent, `)`)
+	p.check(p.call(p.Helper("CompareBool"), `aok`, `bok`))
+	p.P(`if aok {`)
+	switch kind := field.Desc.Kind(); {
+	case p.FieldSemantics(field).Std != "":
+		p.check(p.call(p.Helper("CompareStd"), a, b))
+	case p.FieldSemantics(field).Custom != "":
+		p.check(p.call(p.Helper("CompareCustom"), a, b))
+	case field.Message != nil:
+		p.check(p.call(p.Helper("CompareVTImplicit"), a, b, p.compareMethod(field.Message)))
+	case kind == protoreflect.BoolKind || kind == protoreflect.BytesKind:
+		p.check(p.call(p.compareFunc(field), a, b))
+	default:
+		p.check(p.call(p.Ident("cmp", "Compare"), a, b))
+	}
+	p.P(`}`)
+	p.P(`}`)
+}
@@ -0,0 +1,201 @@
+package diff
+
+import (
+	"strconv"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+)
+
+const (
+	diffName       = "DiffVT"
+	appendDiffName = "AppendDiffVT"
+)
+
+// unknownPathElem is the path element differences in unknown fields are
+// reported under.
+const unknownPathElem = "<unknown>"
+
+func init() {
+	generator.RegisterOptionalFeature("diff", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &diff{GeneratedFile: gen}
+	})
+}
+
+type diff struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*diff)(nil)
+
+func (p *diff) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *diff) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+	fieldDiff := p.Helper("FieldDiff")
+
+	p.P(`// `, diffName, ` returns the differences between m and that, in field order. A nil`)
+	p.P(`// message is treated as empty.`)
+	p.P(`func (m *`, ccTypeName, `) `, diffName, `(that *`, ccTypeName, `) []`, fieldDiff, ` {`)
+	p.P(`return m.`, appendDiffName, `(nil, "", that)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`// `, appendDiffName, ` appends the differences between m and that to diffs, prefixing`)
+	p.P(`// their paths with prefix.`)
+	p.P(`func (m *`, ccTypeName, `) `, appendDiffName, `(diffs []`, fieldDiff, `, prefix string, that *`, ccTypeName, `) []`, fieldDiff, ` {`)
+	p.P(`if m == that {`)
+	p.P(`return diffs`)
+	p.P(`}`)
+	p.P(`if m == nil {`)
+	p.P(`m = &`, ccTypeName, `{}`)
+	p.P(`}`)
+	p.P(`if that == nil {`)
+	p.P(`that = &`, ccTypeName, `{}`)
+	p.P(`}`)
+	for _, field := range message.Fields {
+		p.field(field)
+	}
+	p.P(`diffs = `, p.Helper("AppendDiffBytes"), `(diffs, prefix, `, strconv.Quote(unknownPathElem), `, m.unknownFields, that.unknownFields)`)
+	p.P(`return diffs`)
+	p.P(`}`)
+	p.P()
+}
+
+// diffMethod returns the AppendDiffVT method expression of message.
+func (p *diff) diffMethod(message *protogen.Message) string {
+	return `(*` + p.QualifiedGoIdent(message.GoIdent) + `).` + appendDiffName
+}
+
+func (p *diff) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	name := strconv.Quote(string(field.Desc.Name()))
+	kind := field.Desc.Kind()
+	a, b := `m.`+field.GoName, `that.`+field.GoName
+
+	switch {
+	case sem.RealOneof:
+		p.oneofField(field, name)
+	case sem.Std != "" && sem.Map:
+		p.P(`diffs = `, p.Helper("AppendDiffStdMap"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Std != "" && sem.List:
+		p.P(`diffs = `, p.Helper("AppendDiffStdSlice"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Std != "":
+		p.P(`diffs = `, p.Helper("AppendDiffStdPtr"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Custom != "" && sem.List:
+		p.P(`diffs = `, p.Helper("AppendDiffCustomSlice"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Custom != "":
+		p.P(`diffs = `, p.Helper("AppendDiffCustom"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Map:
+		value := field.Message.Fields[1]
+		switch {
+		case value.Message != nil:
+			p.P(`diffs = `, p.Helper("AppendDiffVTMap"), `(diffs, prefix, `, name, `, `, a, `, `, b, `, `, p.diffMethod(value.Message), `)`)
+		case value.Desc.Kind() == protoreflect.BytesKind:
+			p.P(`diffs = `, p.Helper("AppendDiffBytesMap"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+		default:
+			p.P(`diffs = `, p.Helper("AppendDiffMap"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+		}
+	case sem.List:
+		switch {
+		case field.Message != nil:
+			p.P(`diffs = `, p.Helper("AppendDiffVTSlice"), `(diffs, prefix, `, name, `, `, a, `, `, b, `, `, p.diffMethod(field.Message), `)`)
+		case kind == protoreflect.BytesKind:
+			p.P(`diffs = `, p.Helper("AppendDiffBytesSlice"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+		default:
+			p.P(`diffs = `, p.Helper("AppendDiffSlice"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+		}
+	case field.Message != nil:
+		switch {
+		case sem.Lazy:
+			a, b = `m.Get`+field.GoName+`()`, `that.Get`+field.GoName+`()`
+		case sem.Embedded:
+			a, b = p.EmbeddedPtr(`m`, field), p.EmbeddedPtr(`that`, field)
+		}
+		p.P(`diffs = `, p.Helper("AppendDiffVTValue"), `(diffs, prefix, `, name, `, `, a, `, `, b, `, `, p.diffMethod(field.Message), `)`)
+	case kind == protoreflect.BytesKind && field.Desc.HasPresence():
+		p.P(`diffs = `, p.Helper("AppendDiffBytesPresent"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case kind == protoreflect.BytesKind:
+		p.P(`diffs = `, p.Helper("AppendDiffBytes"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	case sem.Pointer:
+		p.P(`diffs = `, p.Helper("AppendDiffPtr"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	default:
+		p.P(`diffs = `, p.Helper("AppendDiff"), `(diffs, prefix, `, name, `, `, a, `, `, b, `)`)
+	}
+}
+
+// oneofField compares one member of a oneof, treating it as an explicit field
+// that is set when the oneof holds that member.
+func (p *diff) oneofField(field *protogen.Field, name string) {
+	kind := field.Desc.Kind()
+	sem := p.FieldSemantics(field)
+	std := sem.Std
+	var typ string
+	switch {
+	case std != "":
+		typ = `*` + std
+	case sem.Custom != "":
+		typ = `*` + sem.Custom
+	case field.Message != nil:
+		typ = `*` + p.QualifiedGoIdent(field.Message.GoIdent)
+	case kind == protoreflect.BytesKind:
+		typ = `[]byte`
+	default:
+		goType, _ := p.FieldGoType(field)
+		typ = `*` + goType
+	}
+
+	p.P(`{`)
+	p.P(`var a, b `, typ)
+	for _, side := range [][2]string{{"a", "m"}, {"b", "that"}} {
+		p.P(`if v, ok := `, side[1], `.`, field.Oneof.GoName, `.(*`, field.GoIdent, `); ok {`)
+		switch {
+		case std != "":
+			p.P(side[0], ` = &v.`, field.GoName)
+		case sem.Custom != "" || field.Message != nil:
+			p.P(side[0], ` = v.`, field.GoName)
+		case kind == protoreflect.BytesKind:
+			p.P(side[0], ` = v.`, field.GoName)
+			p.P(`if `, side[0], ` == nil {`)
+			p.P(side[0], ` = []byte{}`)
+			p.P(`}`)
+		default:
+			p.P(side[0], ` = &v.`, field.GoName)
+		}
+		p.P(`}`)
+	}
+	switch {
+	case std != "":
+		p.P(`diffs = `, p.Helper("AppendDiffStdPtr"), `(diffs, prefix, `, name, `, a, b)`)
+	case sem.Custom != "":
+		p.P(`diffs = `, p.Helper("AppendDiffCustom"), `(diffs, prefix, `, name, `, a, b)`)
+	case field.Message != nil:
+		p.P(`diffs = `, p.Helper("AppendDiffVTValue"), `(diffs, prefix, `, name, `, a, b, `, p.diffMethod(field.Message), `)`)
+	case kind == protoreflect.BytesKind:
+		p.P(`diffs = `, p.Helper("AppendDiffBytesPresent"), `(diffs, prefix, `, name, `, a, b)`)
+	default:
+		p.P(`diffs = `, p.Helper("AppendDiffPtr"), `(diffs, prefix, `, name, `, a, b)`)
+	}
+	p.P(`}`)
+}
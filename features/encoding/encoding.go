@@ -0,0 +1,75 @@
+package encoding
+
+import (
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+)
+
+func init() {
+	generator.Register(generator.FeatureRegistration{
+		Name: "encoding",
+		Feature: func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+			return &encoding{GeneratedFile: gen}
+		},
+		Optional: true,
+		Requires: []string{"size", "marshal", "unmarshal"},
+		Local:    true,
+	})
+}
+
+type encoding struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*encoding)(nil)
+
+func (p *encoding) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *encoding) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// MarshalBinary implements encoding.BinaryMarshaler with MarshalVT.`)
+	p.P(`func (m *`, ccTypeName, `) MarshalBinary() ([]byte, error) {`)
+	p.P(`return m.MarshalVT()`)
+	p.P(`}`)
+	p.P()
+	p.P(`// AppendBinary implements encoding.BinaryAppender, appending the wire format`)
+	p.P(`// of m to b.`)
+	p.P(`func (m *`, ccTypeName, `) AppendBinary(b []byte) ([]byte, error) {`)
+	p.P(`if m == nil {`)
+	p.P(`return b, nil`)
+	p.P(`}`)
+	p.P(`size := m.SizeVT()`)
+	p.P(`b = `, p.Ident("slices", "Grow"), `(b, size)`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(b[len(b) : len(b)+size])`)
+	p.P(`if err != nil {`)
+	p.P(`return b, err`)
+	p.P(`}`)
+	p.P(`return b[:len(b)+n], nil`)
+	p.P(`}`)
+	p.P()
+	p.P(`// UnmarshalBinary implements encoding.BinaryUnmarshaler, resetting m and`)
+	p.P(`// decoding data with UnmarshalVT. data is not retained.`)
+	p.P(`func (m *`, ccTypeName, `) UnmarshalBinary(data []byte) error {`)
+	p.P(`m.Reset()`)
+	p.P(`return m.UnmarshalVT(data)`)
+	p.P(`}`)
+	p.P()
+}
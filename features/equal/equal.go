@@ -141,6 +141,10 @@ func (p *equal) oneof(field *protogen.Field) {
 	rhs := fmt.Sprintf("that.%s", fieldname)
 	kind := field.Desc.Kind()
 	switch {
+	case p.FieldSemantics(field).Std != "":
+		p.helperCheck("EqualStd", lhs, rhs)
+	case p.FieldSemantics(field).Custom != "":
+		p.helperCheck("EqualCustom", lhs, rhs)
 	case isScalar(kind):
 		p.compareScalar(lhs, rhs, false)
 	case kind == protoreflect.BytesKind:
@@ -189,10 +193,67 @@ func (p *equal) emptyMessageFunc(message *protogen.Message) string {
 	return fmt.Sprintf("func() *%s { return &%s{} }", ident, ident)
 }
 
+// fieldAccessors returns the expressions reading field from this and that.
+// Lazy fields are read through their getters so pending bytes are compared,
+// and embedded fields as pointers so they compare like sub-message pointers.
+func (p *equal) fieldAccessors(field *protogen.Field) (lhs, rhs string) {
+	switch sem := p.FieldSemantics(field); {
+	case sem.Lazy:
+		return fmt.Sprintf("this.Get%s()", field.GoName), fmt.Sprintf("that.Get%s()", field.GoName)
+	case sem.Embedded:
+		return p.EmbeddedPtr("this", field), p.EmbeddedPtr("that", field)
+	}
+	return fmt.Sprintf("this.%s", field.GoName), fmt.Sprintf("that.%s", field.GoName)
+}
+
+// stdField generates the comparison of a field of a native Go type standing
+// for a well-known type, returning false for other fields.
+func (p *equal) stdField(field *protogen.Field) bool {
+	sem := p.FieldSemantics(field)
+	if sem.Std == "" {
+		return false
+	}
+	lhs, rhs := p.fieldAccessors(field)
+	switch {
+	case sem.Map:
+		p.helperCheck("EqualStdMap", lhs, rhs)
+	case sem.List:
+		p.helperCheck("EqualStdSlice", lhs, rhs)
+	default:
+		p.helperCheck("EqualStdPtr", lhs, rhs)
+	}
+	return true
+}
+
+// customField generates the comparison of a field of a custom type,
+// returning false for other fields.
+func (p *equal) customField(field *protogen.Field) bool {
+	sem := p.FieldSemantics(field)
+	if sem.Custom == "" {
+		return false
+	}
+	lhs, rhs := p.fieldAccessors(field)
+	if sem.List {
+		p.helperCheck("EqualCustomSlice", lhs, rhs)
+	} else {
+		p.helperCheck("EqualCustom", lhs, rhs)
+	}
+	return true
+}
+
+// embeddedField generates the comparison of an embedded sub-message field,
+// returning false for other fields.
+func (p *equal) embeddedField(field *protogen.Field) bool {
+	if !p.FieldSemantics(field).Embedded {
+		return false
+	}
+	lhs, rhs := p.fieldAccessors(field)
+	p.helperCheck("IsEqualVT", lhs, rhs)
+	return true
+}
+
 func (p *equal) helperField(field *protogen.Field, nullable bool) {
-	fieldname := field.GoName
-	lhs := fmt.Sprintf("this.%s", fieldname)
-	rhs := fmt.Sprintf("that.%s", fieldname)
+	lhs, rhs := p.fieldAccessors(field)
 
 	if field.Desc.IsMap() {
 		valueField := field.Message.Fields[1]
@@ -249,16 +310,16 @@ func (p *equal) helperField(field *protogen.Field, nullable bool) {
 }
 
 func (p *equal) field(field *protogen.Field, nullable bool) {
+	if p.stdField(field) || p.customField(field) || p.embeddedField(field) {
+		return
+	}
 	if p.Config.HelperCodegen() {
 		p.helperField(field, nullable)
 		return
 	}
 
-	fieldname := field.GoName
-
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
-	lhs := fmt.Sprintf("this.%s", fieldname)
-	rhs := fmt.Sprintf("that.%s", fieldname)
+	lhs, rhs := p.fieldAccessors(field)
 
 	if repeated {
 		p.P(`if len(`, lhs, `) != len(`, rhs, `) {`)
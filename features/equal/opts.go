@@ -0,0 +1,227 @@
+package equal
+
+import (
+	"strconv"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+)
+
+const (
+	equalOptsName       = "EqualVTOpts"
+	equalOptsPrefixName = "EqualVTOptsPrefix"
+)
+
+func init() {
+	generator.RegisterOptionalFeature("equal_opts", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &equalOpts{equal: equal{GeneratedFile: gen}}
+	})
+}
+
+// equalOpts generates EqualVTOpts with the field helpers of equal.
+type equalOpts struct {
+	equal
+}
+
+var _ generator.FeatureGenerator = (*equalOpts)(nil)
+
+func (p *equalOpts) Name() string { return "equal_opts" }
+
+func (p *equalOpts) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+func (p *equalOpts) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+
+	p.generateEqualOpts(message)
+}
+
+// generateEqualOpts generates EqualVTOpts, which compares two messages with the
+// ignored paths, float tolerance and nil policy of protobuf_go_lite.EqualOptions.
+func (p *equal) generateEqualOpts(message *protogen.Message) {
+	ccTypeName := message.GoIdent.GoName
+	equalOptions := p.Helper("EqualOptions")
+
+	p.P()
+	p.P(`// `, equalOptsName, ` reports whether this and that are equal under opts.`)
+	p.P(`func (this *`, ccTypeName, `) `, equalOptsName, `(that *`, ccTypeName, `, opts `, equalOptions, `) bool {`)
+	p.P(`return this.`, equalOptsPrefixName, `(that, &opts, "")`)
+	p.P(`}`)
+	p.P()
+	p.P(`// `, equalOptsPrefixName, ` reports whether this and that are equal under opts, where`)
+	p.P(`// prefix is the path of the messages.`)
+	p.P(`func (this *`, ccTypeName, `) `, equalOptsPrefixName, `(that *`, ccTypeName, `, opts *`, equalOptions, `, prefix string) bool {`)
+	p.P(`if this == that {`)
+	p.P(`return true`)
+	p.P(`}`)
+	p.P(`if this == nil || that == nil {`)
+	p.P(`if opts.EmptyMessages != `, p.Helper("NilEqualsEmpty"), ` {`)
+	p.P(`return false`)
+	p.P(`}`)
+	p.P(`if this == nil {`)
+	p.P(`this = &`, ccTypeName, `{}`)
+	p.P(`}`)
+	p.P(`if that == nil {`)
+	p.P(`that = &`, ccTypeName, `{}`)
+	p.P(`}`)
+	p.P(`}`)
+	for _, field := range message.Fields {
+		if p.FieldSemantics(field).RealOneof {
+			p.optsOneofField(field)
+			continue
+		}
+		p.optsField(field)
+	}
+	p.P(`return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)`)
+	p.P(`}`)
+}
+
+// optsMethod returns the EqualVTOptsPrefix method expression of message.
+func (p *equal) optsMethod(message *protogen.Message) string {
+	return `(*` + p.QualifiedGoIdent(message.GoIdent) + `).` + equalOptsPrefixName
+}
+
+func isFloat(kind protoreflect.Kind) bool {
+	return kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
+}
+
+// optsField generates the comparison of one field, skipped if its path is
+// ignored.
+func (p *equal) optsField(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	lhs, rhs := p.fieldAccessors(field)
+	kind := field.Desc.Kind()
+
+	// path is only needed to recurse into sub-messages.
+	path := `_`
+	var differ []any
+	switch {
+	case sem.Std != "" && sem.Map:
+		differ = p.helperCall("EqualOptsStdMap", `opts`, lhs, rhs)
+	case sem.Std != "" && sem.List:
+		differ = p.helperCall("EqualOptsStdSlice", `opts`, lhs, rhs)
+	case sem.Std != "":
+		differ = p.helperCall("EqualOptsStdPtr", `opts`, lhs, rhs)
+	case sem.Custom != "" && sem.List:
+		differ = p.helperCall("EqualCustomSlice", lhs, rhs)
+	case sem.Custom != "":
+		differ = p.helperCall("EqualCustom", lhs, rhs)
+	case sem.Map:
+		value := field.Message.Fields[1]
+		switch vkind := value.Desc.Kind(); {
+		case value.Message != nil:
+			path = `path`
+			differ = p.helperCall("EqualVTOptsMap", lhs, rhs, `opts`, `path`, p.optsMethod(value.Message))
+		case vkind == protoreflect.BytesKind:
+			differ = p.helperCall("EqualBytesMap", lhs, rhs)
+		case isFloat(vkind):
+			differ = p.helperCall("EqualOptsFloatMap", `opts`, lhs, rhs)
+		default:
+			differ = []any{p.Ident("maps", "Equal"), `(`, lhs, `, `, rhs, `)`}
+		}
+	case sem.List:
+		switch {
+		case field.Message != nil:
+			path = `path`
+			differ = p.helperCall("EqualVTOptsSlice", lhs, rhs, `opts`, `path`, p.optsMethod(field.Message))
+		case kind == protoreflect.BytesKind:
+			differ = p.helperCall("EqualBytesSlice", lhs, rhs)
+		case isFloat(kind):
+			differ = p.helperCall("EqualOptsFloatSlice", `opts`, lhs, rhs)
+		default:
+			differ = []any{p.Ident("slices", "Equal"), `(`, lhs, `, `, rhs, `)`}
+		}
+	case field.Message != nil:
+		path = `path`
+		differ = p.helperCall("EqualVTOptsValue", lhs, rhs, `opts`, `path`, p.optsMethod(field.Message))
+	case kind == protoreflect.BytesKind && field.Desc.HasPresence():
+		p.optsCheck(field, `(`, lhs, ` == nil) != (`, rhs, ` == nil) || string(`, lhs, `) != string(`, rhs, `)`)
+		return
+	case kind == protoreflect.BytesKind:
+		p.optsCheck(field, `string(`, lhs, `) != string(`, rhs, `)`)
+		return
+	case isFloat(kind) && sem.Pointer:
+		differ = p.helperCall("EqualOptsFloatPtr", `opts`, lhs, rhs)
+	case isFloat(kind):
+		differ = p.helperCall("EqualOptsFloat", `opts`, lhs, rhs)
+	case sem.Pointer:
+		p.optsCheck(field, `(`, lhs, ` == nil) != (`, rhs, ` == nil) || `, lhs, ` != nil && *`, lhs, ` != *`, rhs)
+		return
+	default:
+		p.P(`if _, ok := opts.FieldPath(prefix, `, strconv.Quote(string(field.Desc.Name())), `); ok && `, lhs, ` != `, rhs, ` {`)
+		p.P(`return false`)
+		p.P(`}`)
+		return
+	}
+
+	line := []any{`if `, path, `, ok := opts.FieldPath(prefix, `, strconv.Quote(string(field.Desc.Name())), `); ok && !`}
+	line = append(line, differ...)
+	line = append(line, ` {`)
+	p.P(line...)
+	p.P(`return false`)
+	p.P(`}`)
+}
+
+// optsCheck generates a check returning false if the field is not ignored and
+// the values differ, as reported by the expression differ.
+func (p *equal) optsCheck(field *protogen.Field, differ ...any) {
+	line := []any{`if _, ok := opts.FieldPath(prefix, `, strconv.Quote(string(field.Desc.Name())), `); ok && (`}
+	line = append(line, differ...)
+	line = append(line, `) {`)
+	p.P(line...)
+	p.P(`return false`)
+	p.P(`}`)
+}
+
+// optsOneofField generates the comparison of one member of a oneof, which is
+// equal if neither message holds it or both hold equal values.
+func (p *equal) optsOneofField(field *protogen.Field) {
+	kind := field.Desc.Kind()
+	lhs, rhs := `a.`+field.GoName, `b.`+field.GoName
+
+	path := `_`
+	var differ []any
+	switch {
+	case p.FieldSemantics(field).Std != "":
+		differ = append([]any{`!`}, p.helperCall("EqualOptsStd", `opts`, lhs, rhs)...)
+	case p.FieldSemantics(field).Custom != "":
+		differ = append([]any{`!`}, p.helperCall("EqualCustom", lhs, rhs)...)
+	case field.Message != nil:
+		path = `path`
+		differ = append([]any{`!`}, p.helperCall("EqualVTOptsImplicit", lhs, rhs, `opts`, `path`, p.optsMethod(field.Message))...)
+	case kind == protoreflect.BytesKind:
+		differ = []any{`string(`, lhs, `) != string(`, rhs, `)`}
+	case isFloat(kind):
+		differ = append([]any{`!`}, p.helperCall("EqualOptsFloat", `opts`, lhs, rhs)...)
+	default:
+		differ = []any{lhs, ` != `, rhs}
+	}
+
+	p.P(`if `, path, `, ok := opts.FieldPath(prefix, `, strconv.Quote(string(field.Desc.Name())), `); ok {`)
+	p.P(`a, aok := this.`, field.Oneof.GoName, `.(*`, field.GoIdent, `)`)
+	p.P(`b, bok := that.`, field.Oneof.GoName, `.(*`, field.GoIdent, `)`)
+	line := []any{`if aok != bok || aok && `}
+	line = append(line, differ...)
+	line = append(line, ` {`)
+	p.P(line...)
+	p.P(`return false`)
+	p.P(`}`)
+	p.P(`}`)
+}
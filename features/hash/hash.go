@@ -0,0 +1,206 @@
+package hash
+
+import (
+	"strconv"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+)
+
+const (
+	hashName      = "HashVT"
+	hash64Name    = "Hash64VT"
+	writeHashName = "WriteHashVT"
+)
+
+func init() {
+	generator.RegisterOptionalFeature("hash", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &hash{GeneratedFile: gen}
+	})
+}
+
+type hash struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*hash)(nil)
+
+func (p *hash) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *hash) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+	hasher := p.Helper("Hasher")
+
+	p.P(`// `, hashName, ` writes the semantic contents of m to h, such that messages equal`)
+	p.P(`// under EqualVT hash the same. The order of map entries and unknown fields`)
+	p.P(`// does not matter.`)
+	p.P(`func (m *`, ccTypeName, `) `, hashName, `(h `, p.Ident("hash", "Hash64"), `) {`)
+	p.P(`w := `, p.Helper("NewHasher"), `(h)`)
+	p.P(`m.`, writeHashName, `(w)`)
+	p.P(`w.Flush()`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`// `, hash64Name, ` returns the 64-bit FNV-1a hash of the semantic contents of m.`)
+	p.P(`func (m *`, ccTypeName, `) `, hash64Name, `() uint64 {`)
+	p.P(`h := `, p.Ident("hash/fnv", "New64a"), `()`)
+	p.P(`m.`, hashName, `(h)`)
+	p.P(`return h.Sum64()`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`// `, writeHashName, ` writes the semantic contents of m to w. A nil message is`)
+	p.P(`// written like an empty one.`)
+	p.P(`func (m *`, ccTypeName, `) `, writeHashName, `(w *`, hasher, `) {`)
+	p.P(`if m != nil {`)
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				p.oneof(oneof)
+			}
+			continue
+		}
+		p.field(field)
+	}
+	p.P(`w.Unknown(m.unknownFields)`)
+	p.P(`}`)
+	p.P(`w.End()`)
+	p.P(`}`)
+	p.P()
+}
+
+func number(field *protogen.Field) string {
+	return strconv.Itoa(int(field.Desc.Number()))
+}
+
+// value generates the statement writing the value v of field, which is not a
+// list or map. The value of a custom type field is a pointer.
+func (p *hash) value(field *protogen.Field, v string) {
+	if p.FieldSemantics(field).Std != "" {
+		p.P(p.Helper("HashStd"), `(w, `, v, `)`)
+		return
+	}
+	if p.FieldSemantics(field).Custom != "" {
+		p.P(p.Helper("HashCustom"), `(w, `, v, `)`)
+		return
+	}
+	switch kind := field.Desc.Kind(); kind {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		p.P(v, `.`, writeHashName, `(w)`)
+	case protoreflect.BoolKind:
+		p.P(`w.Bool(`, v, `)`)
+	case protoreflect.StringKind:
+		p.P(`w.String(`, v, `)`)
+	case protoreflect.BytesKind:
+		p.P(`w.Bytes(`, v, `)`)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		p.P(`w.Float64(float64(`, v, `))`)
+	default:
+		p.P(`w.Uint64(uint64(`, v, `))`)
+	}
+}
+
+// isSet returns the condition under which the implicit field value v is set.
+func isSet(field *protogen.Field, v string) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind:
+		return v + ` != ""`
+	case protoreflect.BytesKind:
+		return `len(` + v + `) != 0`
+	default:
+		return v + ` != 0`
+	}
+}
+
+func (p *hash) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	v := `m.` + field.GoName
+
+	switch {
+	case sem.Map:
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		keyType, _ := p.FieldGoType(key)
+		valueType, _ := p.FieldGoType(value)
+		p.P(`if len(`, v, `) != 0 {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.P(p.Helper("HashMap"), `(w, `, v, `, func(w *`, p.Helper("Hasher"), `, k `, keyType, `, v `, valueType, `) {`)
+		p.value(key, `k`)
+		p.value(value, `v`)
+		p.P(`})`)
+		p.P(`}`)
+	case sem.List:
+		p.P(`if len(`, v, `) != 0 {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.P(`w.Uint64(uint64(len(`, v, `)))`)
+		p.P(`for _, v := range `, v, ` {`)
+		if sem.Custom != "" {
+			p.value(field, `&v`)
+		} else {
+			p.value(field, `v`)
+		}
+		p.P(`}`)
+		p.P(`}`)
+	case field.Message != nil && sem.Std == "", sem.Custom != "":
+		switch {
+		case sem.Lazy:
+			v = `m.Get` + field.GoName + `()`
+		case sem.Embedded:
+			v = p.EmbeddedPtr(`m`, field)
+		}
+		p.P(`if v := `, v, `; v != nil {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.value(field, `v`)
+		p.P(`}`)
+	case sem.Pointer:
+		p.P(`if `, v, ` != nil {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.value(field, `*`+v)
+		p.P(`}`)
+	case field.Desc.Kind() == protoreflect.BytesKind && field.Desc.HasPresence():
+		p.P(`if `, v, ` != nil {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.value(field, v)
+		p.P(`}`)
+	default:
+		p.P(`if `, isSet(field, v), ` {`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.value(field, v)
+		p.P(`}`)
+	}
+}
+
+// oneof generates a type switch writing the member held by a oneof. Message
+// members are nil-safe, so a nil member hashes like an empty one, as EqualVT
+// compares them.
+func (p *hash) oneof(oneof *protogen.Oneof) {
+	p.P(`switch v := m.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.P(`w.Field(`, number(field), `)`)
+		p.value(field, `v.`+field.GoName)
+	}
+	p.P(`}`)
+}
@@ -29,6 +29,17 @@ func hasDisableJsonComment(comments protogen.Comments) bool {
 	return false
 }
 
+// GeneratesMessage reports whether the json feature generates the JSON
+// methods of message when it is enabled for it: the file is proto3 or editions
+// and the message has no disable-json directive.
+func GeneratesMessage(message *protogen.Message) bool {
+	switch message.Desc.ParentFile().Syntax() {
+	case protoreflect.Proto3, protoreflect.Editions:
+		return !hasDisableJsonComment(message.Comments.Leading)
+	}
+	return false
+}
+
 type jsonGenerator struct {
 	gen  *protogen.Plugin
 	file *protogen.File
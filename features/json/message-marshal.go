@@ -5,8 +5,12 @@
 package json
 
 import (
+	"fmt"
+
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 )
 
 // genMessageMarshaler emits the custom JSON marshaler for a message.
@@ -57,6 +61,15 @@ nextField:
 			nilable           = g.fieldIsNilable(field)
 			fieldJsonName     = field.Desc.JSONName()
 		)
+		if sem.Lazy {
+			// Lazy fields are read through their getters to decode pending
+			// bytes, whose decode error fails the marshaling.
+			g.P("if _, err := x.Get", field.GoName, "Err(); err != nil {")
+			g.P(`s.SetError(err)`)
+			g.P("return")
+			g.P("}")
+			fieldGoName = "Get" + field.GoName + "()"
+		}
 
 		if field.Desc.IsMap() {
 			// If the field is a map, the field type is a MapEntry message.
@@ -72,6 +85,7 @@ nextField:
 
 			// Write the field name and a colon.
 			g.P(`s.WriteObjectField("`, fieldJsonName, `")`)
+			g.genRedactStart(sem)
 
 			g.P("s.WriteObjectStart()")
 
@@ -93,11 +107,16 @@ nextField:
 			case protoreflect.EnumKind:
 				g.P("v.MarshalProtoJSON(s)")
 			case protoreflect.MessageKind, protoreflect.GroupKind:
+				if lib := g.stdLibName(value); lib != "" {
+					g.P("s.Write", lib, "(v)")
+					break
+				}
 				g.P(`v.MarshalProtoJSON(s.WithField("`, fieldJsonName, `"))`)
 			}
 
 			g.P("}") // end for k, v := range x.{fieldGoName} {
 			g.P("s.WriteObjectEnd()")
+			g.genRedactEnd(sem)
 			g.P("}") // end if x.{fieldGoName} != nil {
 
 			continue nextField
@@ -112,10 +131,25 @@ nextField:
 
 			// Write the field name and a colon.
 			g.P(`s.WriteObjectField("`, fieldJsonName, `")`)
+			g.genRedactStart(sem)
 
 			switch field.Desc.Kind() {
 			default:
-				g.P("s.Write", g.libNameForField(field), "Array(x.", fieldGoName, ")")
+				if sem.Cast == "" && sem.Custom == "" {
+					g.P("s.Write", g.libNameForField(field), "Array(x.", fieldGoName, ")")
+					break
+				}
+				g.P("s.WriteArrayStart()")
+				g.P("var wroteElement bool")
+				g.P("for _, element := range x.", fieldGoName, " {")
+				g.P("s.WriteMoreIf(&wroteElement)")
+				if sem.Custom != "" {
+					g.genCustomWrite(field, "&element")
+				} else {
+					g.P("s.Write", g.libNameForField(field), "(", g.castValue(field, "element"), ")")
+				}
+				g.P("}") // end for _, element := range x.{fieldGoName} {
+				g.P("s.WriteArrayEnd()")
 			case protoreflect.EnumKind:
 				g.P("s.WriteArrayStart()")
 
@@ -132,6 +166,13 @@ nextField:
 				g.P("}") // end for _, element := range x.{fieldGoName} {
 				g.P("s.WriteArrayEnd()")
 			case protoreflect.MessageKind, protoreflect.GroupKind:
+				lib := g.stdLibName(field)
+				if lib != "" && lib != "Time" && lib != "Duration" {
+					// Native wrapper values are written as arrays of their scalar type.
+					g.P("s.Write", lib, "Array(x.", fieldGoName, ")")
+					break
+				}
+
 				g.P("s.WriteArrayStart()")
 
 				// wroteElement keeps track of whether we wrote an element of the list, so that we know when to add a comma before the next.
@@ -142,8 +183,12 @@ nextField:
 				// Write a comma if this isn't the first element of the list.
 				g.P("s.WriteMoreIf(&wroteElement)")
 
-				// If the list element is of type message, and the message has a marshaler, use that.
-				g.P(`element.MarshalProtoJSON(s.WithField("`, fieldJsonName, `"))`)
+				if lib != "" {
+					g.P("s.Write", lib, "(element)")
+				} else {
+					// If the list element is of type message, and the message has a marshaler, use that.
+					g.P(`element.MarshalProtoJSON(s.WithField("`, fieldJsonName, `"))`)
+				}
 				// Otherwise delegate to the library.
 				// g.P("// NOTE: ", field.Message.GoIdent.GoName, " does not seem to implement MarshalProtoJSON.")
 				// g.P(jsonPluginPackage.Ident("MarshalMessage"), "(s, ", ifThenElse(nullable, "", "&"), "element)")
@@ -152,6 +197,8 @@ nextField:
 				g.P("s.WriteArrayEnd()")
 			}
 
+			g.genRedactEnd(sem)
+
 			g.P("}") // end if len(x.{fieldGoName}) > 0 {
 
 			continue nextField
@@ -178,7 +225,12 @@ nextField:
 			if nilable {
 				switch field.Desc.Kind() {
 				case protoreflect.MessageKind, protoreflect.GroupKind:
-					g.P("if ", messageOrOneofIdent, ".", fieldGoName, ` != nil || s.HasField("`, fieldJsonName, `") {`)
+					if sem.Std == "" {
+						g.P("if ", messageOrOneofIdent, ".", fieldGoName, ` != nil || s.HasField("`, fieldJsonName, `") {`)
+						break
+					}
+					// A native well-known type has no null to write for a masked field.
+					fallthrough
 				default:
 					// A field mask must not fabricate presence for an optional scalar.
 					g.P("if ", messageOrOneofIdent, ".", fieldGoName, " != nil {")
@@ -202,6 +254,10 @@ nextField:
 				case protoreflect.BytesKind:
 					g.P("if len(", messageOrOneofIdent, ".", fieldGoName, `) > 0 || s.HasField("`, fieldJsonName, `") {`)
 				case protoreflect.MessageKind, protoreflect.GroupKind:
+					if sem.Presence {
+						g.P("if ", messageOrOneofIdent, ".", fieldsem.PresenceGoName(field), ` || s.HasField("`, fieldJsonName, `") {`)
+						break
+					}
 					// For not-nullable messages we have a dummy check.
 					g.P("if true { ")
 				}
@@ -213,16 +269,25 @@ nextField:
 
 		// Write the field name and a colon.
 		g.P(`s.WriteObjectField("`, fieldJsonName, `")`)
+		g.genRedactStart(sem)
 
 		switch field.Desc.Kind() {
 		default:
+			if sem.Custom != "" {
+				g.genCustomWrite(field, fmt.Sprint(messageOrOneofIdent, ".", fieldGoName))
+				break
+			}
 			// Scalar types can be written by the library.
 			if sem.Pointer {
-				g.P("s.Write", g.libNameForField(field), "(*", messageOrOneofIdent, ".", fieldGoName, ")")
+				g.P("s.Write", g.libNameForField(field), "(", g.castValue(field, fmt.Sprint("*", messageOrOneofIdent, ".", fieldGoName)), ")")
 			} else {
-				g.P("s.Write", g.libNameForField(field), "(", messageOrOneofIdent, ".", fieldGoName, ")")
+				g.P("s.Write", g.libNameForField(field), "(", g.castValue(field, fmt.Sprint(messageOrOneofIdent, ".", fieldGoName)), ")")
 			}
 		case protoreflect.BytesKind:
+			if sem.Custom != "" {
+				g.genCustomWrite(field, fmt.Sprint(messageOrOneofIdent, ".", fieldGoName))
+				break
+			}
 			g.P("s.Write", g.libNameForField(field), "(", messageOrOneofIdent, ".", fieldGoName, ")")
 		case protoreflect.EnumKind:
 			// If the field is of type enum, and the enum has a marshaler, use that.
@@ -234,6 +299,15 @@ nextField:
 			// Otherwise we write the enum with the standard settings.
 			// g.P("s.WriteEnum(int32(", messageOrOneofIdent, ".", fieldGoName, "), ", field.Enum.GoIdent, "_name)")
 		case protoreflect.MessageKind, protoreflect.GroupKind:
+			if lib := g.stdLibName(field); lib != "" {
+				g.P("s.Write", lib, "(", ifThenElse(sem.Pointer, "*", ""), messageOrOneofIdent, ".", fieldGoName, ")")
+				break
+			}
+			if sem.Presence {
+				// An unset field selected by the field mask is written as null.
+				g.P(g.EmbeddedPtr(messageOrOneofIdent, field), `.MarshalProtoJSON(s.WithField("`, fieldJsonName, `"))`)
+				break
+			}
 			// If the field is of type message, and the message has a marshaler, use that.
 			g.P(messageOrOneofIdent, ".", fieldGoName, `.MarshalProtoJSON(s.WithField("`, fieldJsonName, `"))`)
 			// Otherwise delegate to the library.
@@ -241,6 +315,8 @@ nextField:
 			// g.P(jsonPluginPackage.Ident("MarshalMessage"), "(s, ", ifThenElse(nullable, "", "&"), messageOrOneofIdent, ".", fieldGoName, ")")
 		}
 
+		g.genRedactEnd(sem)
+
 		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() || interleavedOneofs[field.Oneof] {
 			g.P("}") // end field presence or interleaved oneof type guard
 		} else if field == field.Oneof.Fields[len(field.Oneof.Fields)-1] {
@@ -263,3 +339,21 @@ func (g *jsonGenerator) genStdMessageMarshaler(message *protogen.Message) {
 	g.P("}")
 	g.P()
 }
+
+// genRedactStart opens a branch writing a placeholder instead of the value of a
+// debug_redact field if the marshaler redacts. It is closed by genRedactEnd.
+func (g *jsonGenerator) genRedactStart(sem fieldsem.Field) {
+	if !sem.Redact {
+		return
+	}
+	g.P("if s.Redact() {")
+	g.P("s.WriteString(", jsonPluginPackage.Ident("RedactedValue"), ")")
+	g.P("} else {")
+}
+
+// genRedactEnd closes the branch opened by genRedactStart.
+func (g *jsonGenerator) genRedactEnd(sem fieldsem.Field) {
+	if sem.Redact {
+		g.P("}")
+	}
+}
@@ -6,8 +6,10 @@ package json
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -55,7 +57,7 @@ nextField:
 		// For sub-messages, field mask handling will be handled by the unmarshaler of the sub-message.
 		// For scalar types and fields that don't support field masks (lists, maps, fields without unmarshalers) we do field mask handling here.
 		delegateMask := "true"
-		if field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() {
+		if field.Message == nil || sem.Std != "" || field.Desc.IsList() || field.Desc.IsMap() {
 			delegateMask = "false"
 			g.P(`s.AddField("`, field.Desc.Name(), `")`)
 		}
@@ -73,7 +75,11 @@ nextField:
 			value := field.Message.Fields[1]
 
 			// Allocate an empty map[T(key)]T(value).
-			g.P("x.", fieldGoName, " = make(map[", g.goTypeForField(key), "]", ifThenElse(g.fieldIsNilable(value), "*", ""), g.goTypeForField(value), ")")
+			if sem.Std != "" {
+				g.P("x.", fieldGoName, " = make(map[", g.goTypeForField(key), "]", sem.Std, ")")
+			} else {
+				g.P("x.", fieldGoName, " = make(map[", g.goTypeForField(key), "]", ifThenElse(g.fieldIsNilable(value), "*", ""), g.goTypeForField(value), ")")
+			}
 
 			// Tell the library to read a map with keys of the given type, passing our handler func that will be called for each key.
 			g.P("s.Read", g.libNameForField(key), "Map(func(key ", g.goTypeForField(key), ") {")
@@ -89,6 +95,10 @@ nextField:
 				g.P(`v.UnmarshalProtoJSON(s)`)
 				g.P("x.", fieldGoName, "[key] = v")
 			case protoreflect.MessageKind, protoreflect.GroupKind:
+				if lib := g.stdLibName(value); lib != "" {
+					g.genStdRead(lib, "x."+field.GoName+"[key] = ")
+					break
+				}
 				// If the map value is of type message, and the message has a marshaler,
 				// allocate a zero message, call the unmarshaler and set the map value for the key to the message.
 				g.P("var v ", value.Message.GoIdent)
@@ -117,8 +127,21 @@ nextField:
 
 			switch field.Desc.Kind() {
 			default:
-				// Lists of scalar types can be read by the library.
-				g.P("x.", fieldGoName, " = s.Read", g.libNameForField(field), "Array()")
+				switch {
+				case sem.Custom != "":
+					g.P("s.ReadArray(func() {")
+					g.P("var v ", sem.Custom)
+					g.genCustomRead(field, "v")
+					g.P("x.", fieldGoName, " = append(x.", fieldGoName, ", v)")
+					g.P("})") // end s.ReadArray()
+				case sem.Cast != "":
+					g.P("s.ReadArray(func() {")
+					g.P("x.", fieldGoName, " = append(x.", fieldGoName, ", ", g.castRead(field, "s.Read"+g.libNameForField(field)+"()"), ")")
+					g.P("})") // end s.ReadArray()
+				default:
+					// Lists of scalar types can be read by the library.
+					g.P("x.", fieldGoName, " = s.Read", g.libNameForField(field), "Array()")
+				}
 			case protoreflect.EnumKind:
 				g.P("s.ReadArray(func() {")
 				// If the list value is of type enum, and the enum has an unmarshaler,
@@ -132,8 +155,21 @@ nextField:
 
 				g.P("})") // end s.ReadArray()
 			case protoreflect.MessageKind, protoreflect.GroupKind:
+				lib := g.stdLibName(field)
+				if lib != "" && lib != "Time" && lib != "Duration" {
+					// Native wrapper values are read as arrays of their scalar type.
+					g.P("x.", fieldGoName, " = s.Read", lib, "Array()")
+					break
+				}
+
 				g.P("s.ReadArray(func() {")
 
+				if lib != "" {
+					g.genStdRead(lib, "x."+field.GoName+" = append(x."+field.GoName+", ", ")")
+					g.P("})") // end s.ReadArray()
+					break
+				}
+
 				if nilable {
 					// If we read nil, append nil and return so that we can continue with the next key.
 					g.P("if s.ReadNil() {")
@@ -173,6 +209,23 @@ nextField:
 			messageOrOneofIdent = "ov"
 		}
 
+		if sem.Embedded {
+			// Null resets an embedded sub-message, and any other value
+			// replaces it.
+			g.P("x.", fieldGoName, " = ", field.Message.GoIdent, "{}")
+			if sem.Presence {
+				g.P("x.", fieldsem.PresenceGoName(field), " = false")
+			}
+			g.P("if s.ReadNil() {")
+			g.P("return")
+			g.P("}")
+			g.P("x.", fieldGoName, `.UnmarshalProtoJSON(s.WithField("`, field.Desc.Name(), `", `, delegateMask, `))`)
+			if sem.Presence {
+				g.P("x.", fieldsem.PresenceGoName(field), " = true")
+			}
+			continue nextField
+		}
+
 		// If the field is nullable (it's a message, or bytes with custom type)
 		// and we read null, set the field to nil.
 		if nilable {
@@ -194,17 +247,30 @@ nextField:
 		// If the field has a custom unmarshaler, call that
 		switch field.Desc.Kind() {
 		default:
+			if sem.Custom != "" {
+				g.P("v := new(", sem.Custom, ")")
+				g.genCustomRead(field, "v")
+				g.P(messageOrOneofIdent, ".", fieldGoName, " = v")
+				break
+			}
 			// Scalar types can be read by the library.
+			read := g.castRead(field, "s.Read"+g.libNameForField(field)+"()")
 			if field.Oneof != nil && field.Oneof.Desc.IsSynthetic() {
-				g.P("t := s.Read", g.libNameForField(field), "()")
+				g.P("t := ", read)
 				g.P(messageOrOneofIdent, ".", fieldGoName, " = &t")
 			} else if sem.Pointer {
-				g.P("t := s.Read", g.libNameForField(field), "()")
+				g.P("t := ", read)
 				g.P(messageOrOneofIdent, ".", fieldGoName, " = &t")
 			} else {
-				g.P(messageOrOneofIdent, ".", fieldGoName, " = s.Read", g.libNameForField(field), "()")
+				g.P(messageOrOneofIdent, ".", fieldGoName, " = ", read)
 			}
 		case protoreflect.BytesKind:
+			if sem.Custom != "" {
+				g.P("v := new(", sem.Custom, ")")
+				g.genCustomRead(field, "v")
+				g.P(messageOrOneofIdent, ".", fieldGoName, " = v")
+				break
+			}
 			g.P(messageOrOneofIdent, ".", fieldGoName, " = s.Read", g.libNameForField(field), "()")
 		case protoreflect.EnumKind:
 			// If the field is of type enum, and the enum has an unmarshaler, call the unmarshaler.
@@ -219,6 +285,17 @@ nextField:
 			// Otherwise we let the library read the enum.
 			// g.P(messageOrOneofIdent, ".", fieldGoName, " = ", field.Enum.GoIdent, "(s.ReadEnum(", field.Enum.GoIdent, "_value))")
 		case protoreflect.MessageKind, protoreflect.GroupKind:
+			if lib := g.stdLibName(field); lib != "" {
+				if sem.Pointer && (lib == "Time" || lib == "Duration") {
+					g.P(messageOrOneofIdent, ".", fieldGoName, " = s.Read", lib, "()")
+				} else if sem.Pointer {
+					g.P("t := s.Read", lib, "()")
+					g.P(messageOrOneofIdent, ".", fieldGoName, " = &t")
+				} else {
+					g.genStdRead(lib, messageOrOneofIdent+"."+field.GoName+" = ")
+				}
+				break
+			}
 			if nilable {
 				// Set the field (or enum wrapper) to a newly allocated custom type.
 				g.P(messageOrOneofIdent, ".", fieldGoName, " = &", field.Message.GoIdent, "{}")
@@ -246,6 +323,20 @@ nextField:
 	g.P()
 }
 
+// genStdRead generates reading a value of a native well-known type and passing
+// it to the assignment formed by prefix and suffix.
+func (g *jsonGenerator) genStdRead(lib, prefix string, suffix ...string) {
+	end := strings.Join(suffix, "")
+	if lib == "Time" || lib == "Duration" {
+		// The library returns nil for null, which has no native value.
+		g.P("if t := s.Read", lib, "(); t != nil {")
+		g.P(prefix, "*t", end)
+		g.P("}")
+		return
+	}
+	g.P(prefix, "s.Read", lib, "()", end)
+}
+
 func (g *jsonGenerator) genStdMessageUnmarshaler(message *protogen.Message) {
 	g.P("// UnmarshalJSON unmarshals the ", message.GoIdent, " from JSON.")
 	g.P("func (x *", message.GoIdent, ") UnmarshalJSON(b []byte) error {")
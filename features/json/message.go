@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/internal/genid"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -39,6 +40,17 @@ func (g *jsonGenerator) fieldIsNilable(field *protogen.Field) bool {
 	if sem.Pointer {
 		return true
 	}
+	if sem.Std != "" {
+		// Native list elements, map values and oneof members are values.
+		return false
+	}
+	if sem.Custom != "" {
+		return true
+	}
+	if sem.Embedded {
+		// Embedded sub-messages are values.
+		return false
+	}
 	switch field.Desc.Kind() {
 	case protoreflect.BytesKind:
 		return field.Desc.HasPresence() && !sem.RealOneof
@@ -49,6 +61,68 @@ func (g *jsonGenerator) fieldIsNilable(field *protogen.Field) bool {
 	}
 }
 
+// stdLibName returns the name used in the protojson func that corresponds to
+// a well-known type field generated with a native Go type, or "" otherwise.
+func (g *jsonGenerator) stdLibName(field *protogen.Field) string {
+	if g.FieldSemantics(field).Std == "" {
+		return ""
+	}
+	switch field.Message.Desc.FullName() {
+	case genid.Timestamp_message_fullname:
+		return "Time"
+	case genid.Duration_message_fullname:
+		return "Duration"
+	default:
+		// Wrappers are written as their value field.
+		return g.libNameForField(field.Message.Fields[0])
+	}
+}
+
+// genCustomWrite emits the statements writing the custom type value v of field
+// as the bytes or string it encodes to.
+func (g *jsonGenerator) genCustomWrite(field *protogen.Field, v string) {
+	g.P("if b, err := ", g.Helper("CustomBytes"), "(", v, "); err != nil {")
+	g.P("s.SetError(err)")
+	g.P("} else {")
+	if field.Desc.Kind() == protoreflect.StringKind {
+		g.P("s.WriteString(string(b))")
+	} else {
+		g.P("s.WriteBytes(b)")
+	}
+	g.P("}")
+}
+
+// castValue returns the expression converting the value v of a casttype field
+// to the Go type read and written by the library.
+func (g *jsonGenerator) castValue(field *protogen.Field, v string) string {
+	if g.FieldSemantics(field).Cast == "" {
+		return v
+	}
+	return fmt.Sprint(g.goTypeForField(field), "(", v, ")")
+}
+
+// castRead returns the expression converting the value v read by the library
+// to the Go type of a casttype field.
+func (g *jsonGenerator) castRead(field *protogen.Field, v string) string {
+	if cast := g.FieldSemantics(field).Cast; cast != "" {
+		return cast + "(" + v + ")"
+	}
+	return v
+}
+
+// genCustomRead emits the statements reading the bytes or string encoding of a
+// custom type value into the variable v, which points to a zero value.
+func (g *jsonGenerator) genCustomRead(field *protogen.Field, v string) {
+	read := "s.ReadBytes()"
+	if field.Desc.Kind() == protoreflect.StringKind {
+		read = "[]byte(s.ReadString())"
+	}
+	g.P("if err := ", v, ".UnmarshalVT(", read, "); err != nil {")
+	g.P("s.SetError(err)")
+	g.P("return")
+	g.P("}")
+}
+
 func fieldGoName(field *protogen.Field) any {
 	var fieldGoName any = field.GoName
 	return fieldGoName
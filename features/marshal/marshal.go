@@ -14,6 +14,7 @@ import (
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"github.com/aperturerobotics/protobuf-go-lite/encoding/protowire"
 	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -22,11 +23,11 @@ var fmtPackage = protogen.GoImportPath("fmt")
 func init() {
 	generator.RegisterFeature("marshal", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &marshal{GeneratedFile: gen, Stable: false, strict: false}
-	})
+	}, "size")
 
 	generator.RegisterFeature("marshal_strict", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &marshal{GeneratedFile: gen, Stable: false, strict: true}
-	})
+	}, "size")
 }
 
 type counter int
@@ -176,13 +177,83 @@ func (p *marshal) mapField(kvField *protogen.Field, varName string) {
 	case protoreflect.Sint64Kind:
 		p.encodeZigzag64(varName)
 	case protoreflect.MessageKind:
+		if p.FieldSemantics(kvField).Std != "" {
+			p.encodeStd(varName)
+			return
+		}
 		p.marshalBackward(varName, true, kvField.Message)
 	}
 }
 
+// encodeStd encodes a well-known type value generated with a native Go type.
+func (p *marshal) encodeStd(varName ...string) {
+	p.P(`i = `, p.Helper("EncodeStd"), `(dAtA, i, `, strings.Join(varName, ""), `)`)
+}
+
+// customField marshals a field of a custom type, whose values are encoded
+// like sub-messages from the bytes they marshal to.
+func (p *marshal) customField(oneof bool, field *protogen.Field) {
+	fieldname := field.GoName
+	fieldNumber := field.Desc.Number()
+	switch {
+	case field.Desc.IsList():
+		p.P(`for iNdEx := len(m.`, fieldname, `) - 1; iNdEx >= 0; iNdEx-- {`)
+		p.P(`size, err := m.`, fieldname, `[iNdEx].MarshalToSizedBufferVT(dAtA[:i])`)
+		p.marshalBackwardSize(true)
+		p.encodeKey(fieldNumber, protowire.BytesType)
+		p.P(`}`)
+		return
+	case field.Desc.Cardinality() == protoreflect.Required:
+		p.P(`if m.`, fieldname, ` == nil {`)
+		p.P(`return 0, `, fmtPackage.Ident("Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
+		p.P(`} else {`)
+	default:
+		p.P(`if m.`, fieldname, ` != nil {`)
+	}
+	p.P(`size, err := m.`, fieldname, `.MarshalToSizedBufferVT(dAtA[:i])`)
+	p.marshalBackwardSize(true)
+	p.encodeKey(fieldNumber, protowire.BytesType)
+	if oneof {
+		// An unset custom oneof value is encoded as empty, like a message.
+		p.P("} else {")
+		p.P("i = ", p.Helper("EncodeVarint"), "(dAtA, i, 0)")
+		p.encodeKey(fieldNumber, protowire.BytesType)
+	}
+	p.P(`}`)
+}
+
+// embeddedField marshals an embedded sub-message field, which is always
+// encoded unless it tracks its presence and is unset.
+func (p *marshal) embeddedField(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	switch {
+	case sem.Presence && sem.Required:
+		p.P(`if !m.`, fieldsem.PresenceGoName(field), ` {`)
+		p.P(`return 0, `, fmtPackage.Ident("Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
+		p.P(`} else {`)
+	case sem.Presence:
+		p.P(`if m.`, fieldsem.PresenceGoName(field), ` {`)
+	default:
+		p.P(`{`)
+	}
+	p.marshalBackward(`m.`+field.GoName, true, field.Message)
+	p.encodeKey(field.Desc.Number(), protowire.BytesType)
+	p.P(`}`)
+}
+
 func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
+	if p.FieldSemantics(field).Custom != "" {
+		p.customField(oneof, field)
+		return
+	}
+	if p.FieldSemantics(field).Embedded {
+		p.embeddedField(field)
+		return
+	}
 	fieldname := field.GoName
-	nullable := field.Message != nil || (!oneof && field.Desc.HasPresence())
+	std := p.FieldSemantics(field).Std != ""
+	// Native oneof members are values with no nil state.
+	nullable := field.Message != nil && !(oneof && std) || (!oneof && field.Desc.HasPresence())
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated {
 		p.P(`if len(m.`, fieldname, `) > 0 {`)
@@ -438,11 +509,21 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.encodeVarint(`baseI - i`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
+		} else if repeated && std {
+			p.encodeStd(p.reverseListRange(`m.`, fieldname))
+			p.encodeKey(fieldNumber, wireType)
+			p.P(`}`)
 		} else if repeated {
 			val := p.reverseListRange(`m.`, fieldname)
 			p.marshalBackward(val, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
+		} else if std && oneof {
+			p.encodeStd(`m.`, fieldname)
+			p.encodeKey(fieldNumber, wireType)
+		} else if std {
+			p.encodeStd(`*m.`, fieldname)
+			p.encodeKey(fieldNumber, wireType)
 		} else {
 			p.marshalBackward(`m.`+fieldname, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
@@ -563,7 +644,7 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 	}
 	// Empty protobufs should emit a message or compatibility with Golang protobuf;
 	// See https://github.com/planetscale/vtprotobuf/issues/61
-	if oneof && field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsMap() && !field.Desc.IsList() {
+	if oneof && !std && field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsMap() && !field.Desc.IsList() {
 		p.P("} else {")
 		p.P("i = ", p.Helper("EncodeVarint"), "(dAtA, i, 0)")
 		p.encodeKey(fieldNumber, wireType)
@@ -614,6 +695,14 @@ func (p *marshal) message(message *protogen.Message) {
 	var numGen counter
 	ccTypeName := message.GoIdent.GoName
 
+	var table, strictFuncs string
+	if p.TableMessage(message) {
+		table = p.Table(message)
+		if p.strict {
+			strictFuncs = p.TableStrict(message)
+		}
+	}
+
 	p.P(`func (m *`, ccTypeName, `) `, p.methodMarshal(), `() (dAtA []byte, err error) {`)
 	p.P(`if m == nil {`)
 	p.P(`return nil, nil`)
@@ -632,6 +721,18 @@ func (p *marshal) message(message *protogen.Message) {
 	p.P(`return m.`, p.methodMarshalToSizedBuffer(), `(dAtA[:size])`)
 	p.P(`}`)
 	p.P(``)
+	if table != "" {
+		p.P(`func (m *`, ccTypeName, `) `, p.methodMarshalToSizedBuffer(), `(dAtA []byte) (int, error) {`)
+		if p.strict {
+			p.P(`return `, p.Helper("TableMarshalStrict"), `(&`, table, `, `, strictFuncs, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA)`)
+		} else {
+			p.P(`return `, p.Helper("TableMarshal"), `(&`, table, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA)`)
+		}
+		p.P(`}`)
+		p.P()
+		return
+	}
+
 	p.P(`func (m *`, ccTypeName, `) `, p.methodMarshalToSizedBuffer(), `(dAtA []byte) (int, error) {`)
 	p.P(`if m == nil {`)
 	p.P(`return 0, nil`)
@@ -666,6 +767,9 @@ func (p *marshal) message(message *protogen.Message) {
 			oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 			if !oneof {
 				p.field(false, &numGen, field)
+				if p.FieldSemantics(field).Lazy {
+					p.lazyField(field)
+				}
 			} else {
 				p.P(`if msg, ok := m.`, field.Oneof.GoName, `.(*`, field.GoIdent.GoName, `); ok {`)
 				marshalForwardOneOf("msg")
@@ -699,6 +803,9 @@ func (p *marshal) message(message *protogen.Message) {
 			oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 			if !oneof {
 				p.field(false, &numGen, field)
+				if p.FieldSemantics(field).Lazy {
+					p.lazyField(field)
+				}
 			}
 		}
 	}
@@ -742,6 +849,16 @@ func (p *marshal) marshalBackwardSize(varInt bool) {
 	}
 }
 
+// lazyField marshals the pending wire bytes or decoded value of a lazy field
+// that has not been assigned.
+func (p *marshal) lazyField(field *protogen.Field) {
+	p.P(`if m.`, field.GoName, ` == nil && m.`, fieldsem.LazyGoName(field), ` != nil {`)
+	p.P(`size, err := m.`, fieldsem.LazyGoName(field), `.MarshalToSizedBuffer(dAtA[:i], (*`, field.Message.GoIdent, `).`, p.methodMarshalToSizedBuffer(), `)`)
+	p.marshalBackwardSize(true)
+	p.encodeKey(field.Desc.Number(), protowire.BytesType)
+	p.P(`}`)
+}
+
 func (p *marshal) marshalBackward(varName string, varInt bool, message *protogen.Message) {
 	switch {
 	case p.IsLocalMessage(message):
@@ -0,0 +1,232 @@
+package merge
+
+import (
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+const (
+	mergeName        = "MergeVT"
+	mergeMessageName = "MergeMessageVT"
+)
+
+func init() {
+	generator.RegisterOptionalFeature("merge", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &merge{GeneratedFile: gen}
+	})
+}
+
+type merge struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*merge)(nil)
+
+func (p *merge) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *merge) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// `, mergeName, ` merges src into m: set scalars overwrite, repeated fields append,`)
+	p.P(`// map entries overwrite, sub-messages merge recursively and a set oneof`)
+	p.P(`// replaces the current one. Values are deep copied from src.`)
+	p.P(`func (m *`, ccTypeName, `) `, mergeName, `(src *`, ccTypeName, `) {`)
+	p.P(`if m == nil || src == nil {`)
+	p.P(`return`)
+	p.P(`}`)
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				p.oneof(oneof)
+			}
+			continue
+		}
+		p.field(field)
+	}
+	p.P(`m.unknownFields = append(m.unknownFields, src.unknownFields...)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (m *`, ccTypeName, `) `, mergeMessageName, `(src any) bool {`)
+	p.P(`s, ok := src.(*`, ccTypeName, `)`)
+	p.P(`if !ok {`)
+	p.P(`return false`)
+	p.P(`}`)
+	p.P(`m.`, mergeName, `(s)`)
+	p.P(`return true`)
+	p.P(`}`)
+	p.P()
+}
+
+// copyMessage emits a statement declaring lhs as a deep copy of the message
+// rhs, keeping nil as nil.
+func (p *merge) copyMessage(lhs, rhs string, message *protogen.Message) {
+	p.P(`var `, lhs, ` *`, message.GoIdent)
+	p.P(`if `, rhs, ` != nil {`)
+	p.P(lhs, ` = new(`, message.GoIdent, `)`)
+	p.P(lhs, `.`, mergeName, `(`, rhs, `)`)
+	p.P(`}`)
+}
+
+// copyValue returns an expression copying the non-message value rhs of kind.
+func (p *merge) copyValue(rhs string, kind protoreflect.Kind) string {
+	if kind == protoreflect.BytesKind {
+		return p.Ident("slices", "Clone") + `(` + rhs + `)`
+	}
+	return rhs
+}
+
+func (p *merge) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	lhs := `m.` + field.GoName
+	rhs := `src.` + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case sem.Map:
+		goType, _ := p.FieldGoType(field)
+		value := field.Message.Fields[1]
+		p.P(`if len(`, rhs, `) > 0 {`)
+		p.P(`if `, lhs, ` == nil {`)
+		p.P(lhs, ` = make(`, goType, `, len(`, rhs, `))`)
+		p.P(`}`)
+		p.P(`for k, v := range `, rhs, ` {`)
+		if sem.Std != "" {
+			p.P(lhs, `[k] = `, p.Helper("CloneStd"), `(v)`)
+		} else if value.Message != nil {
+			p.copyMessage("e", "v", value.Message)
+			p.P(lhs, `[k] = e`)
+		} else {
+			p.P(lhs, `[k] = `, p.copyValue("v", value.Desc.Kind()))
+		}
+		p.P(`}`)
+		p.P(`}`)
+	case sem.List && sem.Std != "":
+		p.P(lhs, ` = append(`, lhs, `, `, p.Helper("CloneStdSlice"), `(`, rhs, `)...)`)
+	case sem.Std != "":
+		// Native well-known type values merge like their messages.
+		p.P(`if `, rhs, ` != nil {`)
+		p.P(`if `, lhs, ` == nil {`)
+		p.P(lhs, ` = new(`, sem.Std, `)`)
+		p.P(`}`)
+		p.P(p.Helper("MergeStd"), `(`, lhs, `, *`, rhs, `)`)
+		p.P(`}`)
+	case sem.List && sem.Custom != "":
+		p.P(lhs, ` = append(`, lhs, `, `, p.Helper("CloneCustomSlice"), `(`, rhs, `)...)`)
+	case sem.Custom != "":
+		// Custom type values replace the current one, like the bytes they encode to.
+		p.P(`if `, rhs, ` != nil {`)
+		p.P(lhs, ` = `, p.Helper("CloneCustom"), `(`, rhs, `)`)
+		p.P(`}`)
+	case sem.List && field.Message != nil:
+		p.P(`for _, v := range `, rhs, ` {`)
+		p.copyMessage("e", "v", field.Message)
+		p.P(lhs, ` = append(`, lhs, `, e)`)
+		p.P(`}`)
+	case sem.List && kind == protoreflect.BytesKind:
+		p.P(`for _, v := range `, rhs, ` {`)
+		p.P(lhs, ` = append(`, lhs, `, `, p.copyValue("v", kind), `)`)
+		p.P(`}`)
+	case sem.List:
+		p.P(lhs, ` = append(`, lhs, `, `, rhs, `...)`)
+	case sem.Lazy:
+		lazyName := fieldsem.LazyGoName(field)
+		p.P(p.Helper("MergeLazy"), `(&`, lhs, `, &m.`, lazyName, `, `, rhs, `, src.`, lazyName, `, (*`, field.Message.GoIdent, `).`, mergeName, `)`)
+	case sem.Presence:
+		presenceName := fieldsem.PresenceGoName(field)
+		p.P(`if src.`, presenceName, ` {`)
+		p.P(lhs, `.`, mergeName, `(&`, rhs, `)`)
+		p.P(`m.`, presenceName, ` = true`)
+		p.P(`}`)
+	case sem.Embedded:
+		p.P(lhs, `.`, mergeName, `(&`, rhs, `)`)
+	case field.Message != nil:
+		p.P(`if `, rhs, ` != nil {`)
+		p.P(`if `, lhs, ` == nil {`)
+		p.P(lhs, ` = new(`, field.Message.GoIdent, `)`)
+		p.P(`}`)
+		p.P(lhs, `.`, mergeName, `(`, rhs, `)`)
+		p.P(`}`)
+	case sem.Pointer:
+		p.P(`if `, rhs, ` != nil {`)
+		p.P(`v := *`, rhs)
+		p.P(lhs, ` = &v`)
+		p.P(`}`)
+	case kind == protoreflect.BytesKind && field.Desc.HasPresence():
+		p.P(`if `, rhs, ` != nil {`)
+		p.P(lhs, ` = `, p.copyValue(rhs, kind))
+		p.P(`}`)
+	case kind == protoreflect.BytesKind:
+		p.P(`if len(`, rhs, `) > 0 {`)
+		p.P(lhs, ` = `, p.copyValue(rhs, kind))
+		p.P(`}`)
+	case kind == protoreflect.BoolKind:
+		p.P(`if `, rhs, ` {`)
+		p.P(lhs, ` = true`)
+		p.P(`}`)
+	case kind == protoreflect.StringKind:
+		p.P(`if `, rhs, ` != "" {`)
+		p.P(lhs, ` = `, rhs)
+		p.P(`}`)
+	default:
+		p.P(`if `, rhs, ` != 0 {`)
+		p.P(lhs, ` = `, rhs)
+		p.P(`}`)
+	}
+}
+
+// oneof emits a type switch setting the oneof of m from src. A sub-message
+// case merges into the current value if m already holds the same case.
+func (p *merge) oneof(oneof *protogen.Oneof) {
+	lhs := `m.` + oneof.GoName
+	p.P(`switch v := src.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		if p.FieldSemantics(field).Custom != "" {
+			p.P(lhs, ` = &`, field.GoIdent, `{`, field.GoName, `: `, p.Helper("CloneCustom"), `(v.`, field.GoName, `)}`)
+			continue
+		}
+		if field.Message == nil {
+			p.P(lhs, ` = &`, field.GoIdent, `{`, field.GoName, `: `, p.copyValue(`v.`+field.GoName, field.Desc.Kind()), `}`)
+			continue
+		}
+		if p.FieldSemantics(field).Std != "" {
+			p.P(`if cur, ok := `, lhs, `.(*`, field.GoIdent, `); ok {`)
+			p.P(p.Helper("MergeStd"), `(&cur.`, field.GoName, `, v.`, field.GoName, `)`)
+			p.P(`} else {`)
+			p.P(lhs, ` = &`, field.GoIdent, `{`, field.GoName, `: `, p.Helper("CloneStd"), `(v.`, field.GoName, `)}`)
+			p.P(`}`)
+			continue
+		}
+		p.P(`if cur, ok := `, lhs, `.(*`, field.GoIdent, `); ok && cur.`, field.GoName, ` != nil {`)
+		p.P(`cur.`, field.GoName, `.`, mergeName, `(v.`, field.GoName, `)`)
+		p.P(`} else {`)
+		p.P(`e := new(`, field.Message.GoIdent, `)`)
+		p.P(`e.`, mergeName, `(v.`, field.GoName, `)`)
+		p.P(lhs, ` = &`, field.GoIdent, `{`, field.GoName, `: e}`)
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
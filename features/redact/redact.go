@@ -0,0 +1,150 @@
+package redact
+
+import (
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+const redactName = "RedactVT"
+
+func init() {
+	generator.RegisterOptionalFeature("redact", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &redact{GeneratedFile: gen}
+	})
+}
+
+type redact struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*redact)(nil)
+
+func (p *redact) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *redact) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// `, redactName, ` clears the fields of m marked debug_redact, recursing into`)
+	p.P(`// sub-messages, including those held by repeated fields, maps and oneofs.`)
+	p.P(`func (m *`, ccTypeName, `) `, redactName, `() {`)
+	p.P(`if m == nil {`)
+	p.P(`return`)
+	p.P(`}`)
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				p.oneof(oneof)
+			}
+			continue
+		}
+		p.field(field)
+	}
+	p.P(`}`)
+	p.P()
+}
+
+// isMessage reports whether field holds messages, directly or as map values.
+func isMessage(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		field = field.Message.Fields[1]
+	}
+	return field.Message != nil
+}
+
+func (p *redact) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	if sem.Weak {
+		return
+	}
+	v := `m.` + field.GoName
+
+	if sem.Redact {
+		switch kind := field.Desc.Kind(); {
+		case sem.Embedded:
+			p.P(v, ` = `, field.Message.GoIdent, `{}`)
+		case sem.Reference:
+			p.P(v, ` = nil`)
+		case kind == protoreflect.BoolKind:
+			p.P(v, ` = false`)
+		case kind == protoreflect.StringKind:
+			p.P(v, ` = ""`)
+		default:
+			p.P(v, ` = 0`)
+		}
+		if sem.Lazy {
+			p.P(`m.`, fieldsem.LazyGoName(field), ` = nil`)
+		}
+		if sem.Presence {
+			p.P(`m.`, fieldsem.PresenceGoName(field), ` = false`)
+		}
+		return
+	}
+
+	switch {
+	case !isMessage(field) || sem.Std != "":
+	case sem.List || sem.Map:
+		p.P(`for _, v := range `, v, ` {`)
+		p.P(`v.`, redactName, `()`)
+		p.P(`}`)
+	case sem.Lazy:
+		// Decode the pending bytes, which may hold redacted fields. Bytes that
+		// fail to decode are dropped, and the field reports the error.
+		p.P(`if `, v, ` == nil {`)
+		p.P(v, ` = `, p.Helper("TakeLazy"), `(&m.`, fieldsem.LazyGoName(field), `)`)
+		p.P(`}`)
+		p.P(v, `.`, redactName, `()`)
+	default:
+		p.P(v, `.`, redactName, `()`)
+	}
+}
+
+// oneof generates a type switch clearing a oneof holding a debug_redact member
+// and redacting a member message.
+func (p *redact) oneof(oneof *protogen.Oneof) {
+	var redacted, messages []*protogen.Field
+	for _, field := range oneof.Fields {
+		switch {
+		case p.FieldSemantics(field).Redact:
+			redacted = append(redacted, field)
+		case field.Message != nil && p.FieldSemantics(field).Std == "":
+			messages = append(messages, field)
+		}
+	}
+	if len(redacted) == 0 && len(messages) == 0 {
+		return
+	}
+
+	if len(messages) == 0 {
+		p.P(`switch m.`, oneof.GoName, `.(type) {`)
+	} else {
+		p.P(`switch v := m.`, oneof.GoName, `.(type) {`)
+	}
+	for _, field := range redacted {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.P(`m.`, oneof.GoName, ` = nil`)
+	}
+	for _, field := range messages {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.P(`v.`, field.GoName, `.`, redactName, `()`)
+	}
+	p.P(`}`)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"github.com/aperturerobotics/protobuf-go-lite/encoding/protowire"
 	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -78,6 +79,9 @@ func (p *size) helperMapPart(keySize int, field *protogen.Field, varName, sizeNa
 	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
 		return p.helperExpr("SizeZigzagValue", strconv.Itoa(keySize), varName)
 	case protoreflect.MessageKind:
+		if p.FieldSemantics(field).Std != "" {
+			return p.helperExpr("SizeStdValue", strconv.Itoa(keySize), varName)
+		}
 		p.P(`l = 0`)
 		p.P(`if `, varName, ` != nil {`)
 		p.messageSize(varName, sizeName, field.Message)
@@ -210,6 +214,12 @@ func (p *size) helperField(oneof bool, field *protogen.Field, sizeName string) {
 			p.P(append(append([]any{`mapEntrySize := `}, keyPart...), append([]any{` + `}, valuePart...)...)...)
 			p.P(`n += `, p.Helper("SizeMessage"), `(`, strconv.Itoa(fieldKeySize), `, mapEntrySize)`)
 			p.P(`}`)
+		} else if std := p.FieldSemantics(field).Std != ""; std && field.Desc.IsList() {
+			p.P(`n += `, p.Helper("SizeStdSlice"), `(`, keyArg, `, `, accessor, `)`)
+		} else if std && oneof {
+			p.P(`n += `, p.Helper("SizeStdValue"), `(`, keyArg, `, `, accessor, `)`)
+		} else if std {
+			p.P(`n += `, p.Helper("SizeStdPtr"), `(`, keyArg, `, `, accessor, `)`)
 		} else if field.Desc.IsList() {
 			p.P(`for _, e := range `, accessor, ` {`)
 			p.messageSize(`e`, sizeName, field.Message)
@@ -233,8 +243,58 @@ func (p *size) helperField(oneof bool, field *protogen.Field, sizeName string) {
 	}
 }
 
-func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
+// customField sizes a field of a custom type, whose values are encoded like
+// sub-messages.
+func (p *size) customField(oneof bool, field *protogen.Field) {
+	key := generator.KeySize(field.Desc.Number(), protowire.BytesType)
+	keyArg := strconv.Itoa(key)
+	accessor := `m.` + field.GoName
+	if field.Desc.IsList() {
+		p.P(`for iNdEx := range `, accessor, ` {`)
+		p.P(`l = `, accessor, `[iNdEx].SizeVT()`)
+		p.P(`n += `, p.Helper("SizeMessage"), `(`, keyArg, `, l)`)
+		p.P(`}`)
+		return
+	}
+	p.P(`if `, accessor, ` != nil {`)
+	p.P(`l = `, accessor, `.SizeVT()`)
+	p.P(`n += `, p.Helper("SizeMessage"), `(`, keyArg, `, l)`)
+	if oneof {
+		p.P(`} else {`)
+		p.P(`n += `, strconv.Itoa(key+1))
+	}
+	p.P(`}`)
+}
+
+// embeddedField sizes an embedded sub-message field, which is always encoded
+// unless it tracks its presence and is unset.
+func (p *size) embeddedField(field *protogen.Field, sizeName string) {
+	key := generator.KeySize(field.Desc.Number(), protowire.BytesType)
+	if p.FieldSemantics(field).Presence {
+		p.P(`if m.`, fieldsem.PresenceGoName(field), ` {`)
+	} else {
+		p.P(`{`)
+	}
+	p.messageSize(`m.`+field.GoName, sizeName, field.Message)
 	if p.Config.HelperCodegen() {
+		p.P(`n += `, p.Helper("SizeMessage"), `(`, strconv.Itoa(key), `, l)`)
+	} else {
+		p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
+	}
+	p.P(`}`)
+}
+
+func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
+	if p.FieldSemantics(field).Custom != "" {
+		p.customField(oneof, field)
+		return
+	}
+	if p.FieldSemantics(field).Embedded {
+		p.embeddedField(field, sizeName)
+		return
+	}
+	if p.Config.HelperCodegen() || p.FieldSemantics(field).Std != "" {
+		// Native well-known type values are always sized by the runtime.
 		p.helperField(oneof, field, sizeName)
 		return
 	}
@@ -455,6 +515,20 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 	}
 }
 
+// lazyField sizes the pending wire bytes or decoded value of a lazy field
+// that has not been assigned.
+func (p *size) lazyField(field *protogen.Field, sizeName string) {
+	key := generator.KeySize(field.Desc.Number(), protowire.BytesType)
+	p.P(`if m.`, field.GoName, ` == nil && m.`, fieldsem.LazyGoName(field), ` != nil {`)
+	p.P(`l = m.`, fieldsem.LazyGoName(field), `.Size((*`, field.Message.GoIdent, `).`, sizeName, `)`)
+	if p.Config.HelperCodegen() {
+		p.P(`n += `, p.Helper("SizeMessage"), `(`, strconv.Itoa(key), `, l)`)
+	} else {
+		p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
+	}
+	p.P(`}`)
+}
+
 func (p *size) message(message *protogen.Message) {
 	for _, nested := range message.Messages {
 		p.message(nested)
@@ -469,6 +543,15 @@ func (p *size) message(message *protogen.Message) {
 	sizeName := "SizeVT"
 	ccTypeName := message.GoIdent.GoName
 
+	if p.TableMessage(message) {
+		table := p.Table(message)
+		p.P(`func (m *`, ccTypeName, `) `, sizeName, `() (n int) {`)
+		p.P(`return `, p.Helper("TableSize"), `(&`, table, `, `, p.Ident("unsafe", "Pointer"), `(m))`)
+		p.P(`}`)
+		p.P()
+		return
+	}
+
 	p.P(`func (m *`, ccTypeName, `) `, sizeName, `() (n int) {`)
 	p.P(`if m == nil {`)
 	p.P(`return 0`)
@@ -480,6 +563,9 @@ func (p *size) message(message *protogen.Message) {
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 		if !oneof {
 			p.field(false, field, sizeName)
+			if p.FieldSemantics(field).Lazy {
+				p.lazyField(field, sizeName)
+			}
 		} else {
 			fieldname := field.Oneof.GoName
 			if _, ok := oneofs[fieldname]; ok {
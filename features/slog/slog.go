@@ -0,0 +1,257 @@
+package slog
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+const (
+	logValueName   = "LogValue"
+	logValueVTName = "LogValueVT"
+	slogPackage    = protogen.GoImportPath("log/slog")
+)
+
+func init() {
+	generator.RegisterOptionalFeature("slog", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &logValue{GeneratedFile: gen}
+	})
+}
+
+type logValue struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*logValue)(nil)
+
+func (p *logValue) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+
+	return p.once
+}
+
+func (p *logValue) slog(name string) string {
+	return p.QualifiedGoIdent(slogPackage.Ident(name))
+}
+
+func (p *logValue) helper(name string) string {
+	return p.QualifiedGoIdent(p.Helper(name))
+}
+
+func (p *logValue) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// `, logValueName, ` implements slog.LogValuer, logging m as a group of its set fields`)
+	p.P(`// expanded to LogMaxDepth levels of sub-messages.`)
+	p.P(`func (m *`, ccTypeName, `) `, logValueName, `() `, p.slog("Value"), ` {`)
+	p.P(`return m.`, logValueVTName, `(`, p.Helper("LogMaxDepth"), `)`)
+	p.P(`}`)
+	p.P()
+
+	// Each field and each oneof logs at most one attribute.
+	var fields []*protogen.Field
+	var attrs int
+	for _, field := range message.Fields {
+		if p.FieldSemantics(field).Weak {
+			continue
+		}
+		fields = append(fields, field)
+		if oneof := field.Oneof; oneof == nil || oneof.Desc.IsSynthetic() || oneof.Fields[0] == field {
+			attrs++
+		}
+	}
+
+	p.P(`// `, logValueVTName, ` returns m as a slog.GroupValue of its set fields keyed by field`)
+	p.P(`// name, expanding depth levels of sub-messages. Repeated and map fields are`)
+	p.P(`// truncated to LogMaxElements elements and fields marked debug_redact are`)
+	p.P(`// logged as LogRedacted.`)
+	p.P(`func (m *`, ccTypeName, `) `, logValueVTName, `(depth int) `, p.slog("Value"), ` {`)
+	p.P(`if m == nil {`)
+	p.P(`return `, p.slog("AnyValue"), `(nil)`)
+	p.P(`}`)
+	if len(fields) == 0 {
+		p.P(`return `, p.slog("GroupValue"), `()`)
+		p.P(`}`)
+		p.P()
+		return
+	}
+	p.P(`attrs := make([]`, p.slog("Attr"), `, 0, `, attrs, `)`)
+	for _, field := range fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if oneof.Fields[0] == field {
+				p.oneof(oneof)
+			}
+			continue
+		}
+		p.field(field)
+	}
+	p.P(`return `, p.slog("GroupValue"), `(attrs...)`)
+	p.P(`}`)
+	p.P()
+}
+
+// appendAttr appends the attribute of field holding value to attrs.
+func (p *logValue) appendAttr(field *protogen.Field, value string) {
+	p.P(`attrs = append(attrs, `, p.slog("Attr"), `{Key: `, strconv.Quote(string(field.Desc.Name())), `, Value: `, value, `})`)
+}
+
+func (p *logValue) redacted() string {
+	return p.slog("StringValue") + `(` + p.helper("LogRedacted") + `)`
+}
+
+func (p *logValue) field(field *protogen.Field) {
+	sem := p.FieldSemantics(field)
+	v := `m.` + field.GoName
+
+	var cond string
+	switch kind := field.Desc.Kind(); {
+	case sem.List || sem.Map:
+		cond = `len(` + v + `) > 0`
+	case sem.Lazy:
+		v = `v`
+		cond = `v := m.Get` + field.GoName + `(); v != nil`
+	case sem.Embedded:
+		v = `&` + v
+		if sem.Presence {
+			cond = `m.` + fieldsem.PresenceGoName(field)
+		}
+	case sem.Pointer || sem.EmitDefault || field.Message != nil || sem.Custom != "":
+		cond = v + ` != nil`
+	case kind == protoreflect.BytesKind:
+		cond = `len(` + v + `) != 0`
+	case kind == protoreflect.StringKind:
+		cond = v + ` != ""`
+	case kind == protoreflect.BoolKind:
+		cond = v
+	default:
+		cond = v + ` != 0`
+	}
+
+	if cond != "" {
+		p.P(`if `, cond, ` {`)
+	}
+	switch {
+	case sem.Redact:
+		p.appendAttr(field, p.redacted())
+	case sem.Map:
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		p.appendAttr(field, p.helper("LogMap")+`(`+v+`, `+p.compareKey(key)+`, `+p.valueFunc(value)+`)`)
+	case sem.List:
+		p.appendAttr(field, p.helper("LogSlice")+`(`+v+`, `+p.valueFunc(field)+`)`)
+	case sem.Pointer && field.Desc.Kind() != protoreflect.EnumKind:
+		p.appendAttr(field, p.value(field, `*`+v))
+	default:
+		p.appendAttr(field, p.value(field, v))
+	}
+	if cond != "" {
+		p.P(`}`)
+	}
+}
+
+// oneof generates a type switch logging the member a oneof holds.
+func (p *logValue) oneof(oneof *protogen.Oneof) {
+	redacted := true
+	for _, field := range oneof.Fields {
+		redacted = redacted && p.FieldSemantics(field).Redact
+	}
+
+	if redacted {
+		p.P(`switch m.`, oneof.GoName, `.(type) {`)
+	} else {
+		p.P(`switch v := m.`, oneof.GoName, `.(type) {`)
+	}
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		if p.FieldSemantics(field).Redact {
+			p.appendAttr(field, p.redacted())
+			continue
+		}
+		p.appendAttr(field, p.value(field, `v.`+field.GoName))
+	}
+	p.P(`}`)
+}
+
+// compareKey returns the function ordering the keys of a map field.
+func (p *logValue) compareKey(key *protogen.Field) string {
+	if key.Desc.Kind() == protoreflect.BoolKind {
+		return p.helper("CompareBool")
+	}
+	return p.Ident("cmp", "Compare") + `[` + p.FieldSemantics(key).Type + `]`
+}
+
+// valueFunc returns a function literal returning the slog.Value of an element
+// of the repeated field or of a map value.
+func (p *logValue) valueFunc(field *protogen.Field) string {
+	if std := p.FieldSemantics(field).Std; std != "" {
+		return p.helper("LogStd") + `[` + std + `]`
+	}
+	goType := strings.TrimPrefix(p.FieldSemantics(field).Type, `[]`)
+	if custom := p.FieldSemantics(field).Custom; custom != "" {
+		return `func(v ` + custom + `) ` + p.slog("Value") + ` { return ` + p.value(field, `&v`) + ` }`
+	}
+	if field.Desc.Kind() == protoreflect.BytesKind {
+		goType = `[]byte`
+	}
+	return `func(v ` + goType + `) ` + p.slog("Value") + ` { return ` + p.value(field, `v`) + ` }`
+}
+
+// value returns the slog.Value of the singular value v of field.
+func (p *logValue) value(field *protogen.Field, v string) string {
+	sem := p.FieldSemantics(field)
+	if sem.Std != "" {
+		return p.helper("LogStd") + `(` + v + `)`
+	}
+	if sem.Custom != "" {
+		return p.helper("LogCustom") + `(` + v + `, ` + strconv.FormatBool(field.Desc.Kind() == protoreflect.StringKind) + `)`
+	}
+	if sem.Cast != "" {
+		// Named Go types are logged as their underlying integer type.
+		switch field.Desc.Kind() {
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			v = `int64(` + v + `)`
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			v = `uint64(` + v + `)`
+		}
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return p.helper("LogMessage") + `(` + v + `, depth-1)`
+	case protoreflect.EnumKind:
+		return p.slog("StringValue") + `(` + v + `.String())`
+	case protoreflect.BoolKind:
+		return p.slog("BoolValue") + `(` + v + `)`
+	case protoreflect.StringKind:
+		return p.slog("StringValue") + `(` + v + `)`
+	case protoreflect.BytesKind:
+		return p.slog("StringValue") + `(` + p.Ident("encoding/base64", "StdEncoding") + `.EncodeToString(` + v + `))`
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return p.slog("Int64Value") + `(` + v + `)`
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return p.slog("Int64Value") + `(int64(` + v + `))`
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return p.slog("Uint64Value") + `(` + v + `)`
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return p.slog("Uint64Value") + `(uint64(` + v + `))`
+	case protoreflect.DoubleKind:
+		return p.slog("Float64Value") + `(` + v + `)`
+	default:
+		return p.slog("Float64Value") + `(float64(` + v + `))`
+	}
+}
@@ -0,0 +1,152 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/aperturerobotics/protobuf-go-lite/features/json"
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+// sqlComment marks a message, or every message of a file when it precedes the
+// syntax, edition or package statement, for Scan and Value generation. It is
+// followed by "=binary" (the default) or "=json" to select the column format.
+const sqlComment = "protobuf-go-lite:sql"
+
+// format is the column format of a message.
+type format string
+
+const (
+	formatNone   format = ""
+	formatBinary format = "binary"
+	formatJSON   format = "json"
+)
+
+// parseSQLComment returns the format set by the sql comment in comments.
+func parseSQLComment(comments string) format {
+	for _, line := range strings.Split(strings.TrimSuffix(comments, "\n"), "\n") {
+		rest, ok := strings.CutPrefix(strings.TrimSpace(line), sqlComment)
+		if !ok {
+			continue
+		}
+		switch rest {
+		case "", "=" + string(formatBinary):
+			return formatBinary
+		case "=" + string(formatJSON):
+			return formatJSON
+		}
+	}
+	return formatNone
+}
+
+func init() {
+	generator.Register(generator.FeatureRegistration{
+		Name: "sql",
+		Feature: func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+			return &sql{GeneratedFile: gen}
+		},
+		Local: true,
+	})
+}
+
+type sql struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*sql)(nil)
+
+func (p *sql) GenerateFile(file *protogen.File) bool {
+	f := parseSQLComment(fieldsem.FileComments(file.Desc))
+	for _, message := range file.Messages {
+		p.message(message, f)
+	}
+
+	return p.once
+}
+
+func (p *sql) message(message *protogen.Message, fileFormat format) {
+	for _, nested := range message.Messages {
+		p.message(nested, fileFormat)
+	}
+
+	if message.Desc.IsMapEntry() {
+		return
+	}
+	f := parseSQLComment(string(message.Comments.Leading))
+	if f == formatNone {
+		f = fileFormat
+	}
+	if f == formatNone {
+		return
+	}
+
+	unmarshal, marshal := "UnmarshalVT", "MarshalVT"
+	required := []string{"marshal", "unmarshal"}
+	if f == formatJSON {
+		unmarshal, marshal = "UnmarshalJSON", "MarshalJSON"
+		required = []string{"json"}
+	}
+	for _, name := range required {
+		if !p.MessageFeature(message, name) {
+			p.Error(fmt.Errorf("%s: sql=%s requires feature %q, which is disabled", message.Desc.FullName(), f, name))
+			return
+		}
+	}
+	if f == formatJSON && !json.GeneratesMessage(message) {
+		p.Error(fmt.Errorf("%s: sql=json requires the JSON methods, which are not generated for the message", message.Desc.FullName()))
+		return
+	}
+
+	for _, field := range message.Fields {
+		name, desc := field.GoName, protoreflect.Descriptor(field.Desc)
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			name, desc = field.Oneof.GoName, field.Oneof.Desc
+		}
+		if name == "Scan" || name == "Value" {
+			p.Error(fmt.Errorf("%s: Go name %q is also used by the %s method of sql", desc.FullName(), name, name))
+			return
+		}
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`// Scan implements sql.Scanner, resetting m and decoding the `, f, ` format of`)
+	p.P(`// m from a []byte or string column. A NULL column leaves m empty.`)
+	p.P(`func (m *`, ccTypeName, `) Scan(src any) error {`)
+	p.P(`m.Reset()`)
+	p.P(`switch src := src.(type) {`)
+	p.P(`case nil:`)
+	p.P(`return nil`)
+	p.P(`case []byte:`)
+	p.P(`return m.`, unmarshal, `(src)`)
+	p.P(`case string:`)
+	p.P(`return m.`, unmarshal, `([]byte(src))`)
+	p.P(`}`)
+	p.P(`return `, p.Ident("fmt", "Errorf"), `("cannot scan %T into `, ccTypeName, `", src)`)
+	p.P(`}`)
+	p.P()
+	p.P(`// Value implements driver.Valuer, encoding m in the `, f, ` format. A nil m is`)
+	p.P(`// stored as NULL.`)
+	p.P(`func (m *`, ccTypeName, `) Value() (`, p.Ident("database/sql/driver", "Value"), `, error) {`)
+	p.P(`if m == nil {`)
+	p.P(`return nil, nil`)
+	p.P(`}`)
+	if f == formatJSON {
+		// Text columns hold JSON in most databases, so store it as a string.
+		p.P(`data, err := m.`, marshal, `()`)
+		p.P(`if err != nil {`)
+		p.P(`return nil, err`)
+		p.P(`}`)
+		p.P(`return string(data), nil`)
+	} else {
+		p.P(`return m.`, marshal, `()`)
+	}
+	p.P(`}`)
+	p.P()
+}
@@ -4,6 +4,7 @@
 package text
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
@@ -114,7 +115,7 @@ func (g *textGenerator) genMessage(message *protogen.Message) {
 			}
 			g.P("}")
 		} else {
-			accessor := "x." + field.GoName
+			accessor := g.fieldAccessor(field)
 			g.genField(initialSbLen, field, accessor)
 		}
 	}
@@ -159,7 +160,7 @@ func (g *textGenerator) genMessageHelper(message *protogen.Message) {
 			}
 			g.P("}")
 		} else {
-			accessor := "x." + field.GoName
+			accessor := g.fieldAccessor(field)
 			g.genFieldHelper(field, accessor)
 		}
 	}
@@ -172,10 +173,42 @@ func (g *textGenerator) genMessageHelper(message *protogen.Message) {
 	g.P("}")
 }
 
+// fieldAccessor returns the expression reading field from x. Lazy fields are
+// read through their getters to decode pending bytes, and embedded fields as
+// pointers like other sub-message fields.
+func (g *textGenerator) fieldAccessor(field *protogen.Field) string {
+	switch sem := g.FieldSemantics(field); {
+	case sem.Lazy:
+		return "x.Get" + field.GoName + "()"
+	case sem.Embedded:
+		return g.EmbeddedPtr("x", field)
+	}
+	return "x." + field.GoName
+}
+
+// messageSet returns the condition under which the singular sub-message field
+// read by accessor is written.
+func (g *textGenerator) messageSet(field *protogen.Field, accessor string) string {
+	if sem := g.FieldSemantics(field); sem.Embedded && !sem.Presence {
+		// An embedded field without tracked presence is always set.
+		return "true"
+	}
+	return accessor + " != nil"
+}
+
 func (g *textGenerator) genFieldHelper(field *protogen.Field, accessor string) {
 	sem := g.FieldSemantics(field)
 	fieldName := string(field.Desc.Name())
 
+	if sem.Lazy {
+		g.genLazyError(0, field)
+	}
+
+	if sem.Redact {
+		g.genRedactedField(0, field, accessor)
+		return
+	}
+
 	if field.Desc.IsList() {
 		g.P("if len(", accessor, ") > 0 {")
 		g.P(g.Helper("TextWriteListStart"), "(&sb, initialLen, \"", fieldName, "\")")
@@ -209,13 +242,17 @@ func (g *textGenerator) genFieldHelper(field *protogen.Field, accessor string) {
 			g.P(g.Helper("TextWriteMapEnd"), "(&sb)")
 			g.P("}")
 		} else {
-			g.P("if ", accessor, " != nil {")
+			g.P("if ", g.messageSet(field, accessor), " {")
 			g.P(g.Helper("TextWriteFieldPrefix"), "(&sb, initialLen, \"", fieldName, "\")")
-			g.P(g.Helper("TextWriteTextMarshaler"), "(&sb, ", accessor, ")")
+			if sem.Std != "" {
+				g.genFieldValueHelper(field, accessor, false)
+			} else {
+				g.P(g.Helper("TextWriteTextMarshaler"), "(&sb, ", accessor, ")")
+			}
 			g.P("}")
 		}
 	case protoreflect.StringKind, protoreflect.BytesKind:
-		if sem.Pointer || sem.EmitDefault {
+		if sem.Pointer || sem.EmitDefault || sem.Custom != "" {
 			g.P("if ", accessor, " != nil {")
 		} else if field.Desc.Kind() == protoreflect.BytesKind {
 			g.P("if len(", accessor, ") != 0 {")
@@ -248,8 +285,16 @@ func (g *textGenerator) genFieldHelper(field *protogen.Field, accessor string) {
 
 func (g *textGenerator) genFieldValueHelper(field *protogen.Field, accessor string, isList bool) {
 	isPointer := g.FieldSemantics(field).Pointer && !isList
+	if g.FieldSemantics(field).Custom != "" {
+		g.genCustomValue(field, accessor, isList)
+		return
+	}
 	switch field.Desc.Kind() {
 	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if g.FieldSemantics(field).Std != "" {
+			g.genStdValue(accessor, isPointer)
+			break
+		}
 		g.P("if ", accessor, " == nil {")
 		g.P(g.Helper("TextWriteTextMarshaler"), "(&sb, &", field.Message.GoIdent, "{})")
 		g.P("} else {")
@@ -312,6 +357,15 @@ func (g *textGenerator) genField(sbInitialLen int, field *protogen.Field, access
 		g.P("}")
 	}
 
+	if sem.Lazy {
+		g.genLazyError(sbInitialLen, field)
+	}
+
+	if sem.Redact {
+		g.genRedactedField(sbInitialLen, field, accessor)
+		return
+	}
+
 	if field.Desc.IsList() {
 		g.P("if len(", accessor, ") > 0 {")
 		maybeAddSpace()
@@ -350,14 +404,23 @@ func (g *textGenerator) genField(sbInitialLen int, field *protogen.Field, access
 			g.P("sb.WriteString(\" }\")")
 			g.P("}")
 		} else {
-			g.P("if ", accessor, " != nil {")
+			g.P("if ", g.messageSet(field, accessor), " {")
 			maybeAddSpace()
 			g.P("sb.WriteString(\"", field.Desc.Name(), ": \")")
-			g.P("sb.WriteString(", accessor, ".MarshalProtoText())")
+			if sem.Std != "" {
+				g.genFieldValue(field, accessor, false)
+			} else {
+				if sem.Embedded {
+					// Call the method on the embedded value rather than its address.
+					g.P("sb.WriteString(x.", field.GoName, ".MarshalProtoText())")
+				} else {
+					g.P("sb.WriteString(", accessor, ".MarshalProtoText())")
+				}
+			}
 			g.P("}")
 		}
 	case protoreflect.StringKind, protoreflect.BytesKind:
-		if sem.Pointer || sem.EmitDefault {
+		if sem.Pointer || sem.EmitDefault || sem.Custom != "" {
 			g.P("if ", accessor, " != nil {")
 		} else {
 			emptyCheck := "\"\""
@@ -396,8 +459,16 @@ func (g *textGenerator) genField(sbInitialLen int, field *protogen.Field, access
 
 func (g *textGenerator) genFieldValue(field *protogen.Field, accessor string, isList bool) {
 	isPointer := g.FieldSemantics(field).Pointer && !isList
+	if g.FieldSemantics(field).Custom != "" {
+		g.genCustomValue(field, accessor, isList)
+		return
+	}
 	switch field.Desc.Kind() {
 	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if g.FieldSemantics(field).Std != "" {
+			g.genStdValue(accessor, isPointer)
+			break
+		}
 		g.P("if ", accessor, " == nil {")
 		g.P("sb.WriteString((&", field.Message.GoIdent, "{}).MarshalProtoText())")
 		g.P("} else {")
@@ -456,3 +527,79 @@ func (g *textGenerator) genFieldValue(field *protogen.Field, accessor string, is
 		}
 	}
 }
+
+// genStdValue writes a well-known type value generated with a native Go type,
+// which has no MarshalProtoText method of its own.
+func (g *textGenerator) genStdValue(accessor string, isPointer bool) {
+	if isPointer {
+		accessor = "*" + accessor
+	}
+	g.P(g.Helper("TextWriteStd"), "(&sb, ", accessor, ")")
+}
+
+// genCustomValue writes a custom type value as the string or bytes it encodes
+// to. List elements are values rather than pointers.
+func (g *textGenerator) genCustomValue(field *protogen.Field, accessor string, isList bool) {
+	if isList {
+		accessor = "&" + accessor
+	}
+	str := strconv.FormatBool(field.Desc.Kind() == protoreflect.StringKind)
+	g.P(g.Helper("TextWriteCustom"), "(&sb, ", accessor, ", ", str, ")")
+}
+
+// redactedText replaces the value of a field marked debug_redact.
+const redactedText = "[REDACTED]"
+
+// genRedactedField writes the name of a set debug_redact field followed by
+// redactedText instead of its value.
+func (g *textGenerator) genRedactedField(sbInitialLen int, field *protogen.Field, accessor string) {
+	sem := g.FieldSemantics(field)
+	var cond string
+	switch kind := field.Desc.Kind(); {
+	case sem.RealOneof:
+	case sem.List || sem.Map:
+		cond = "len(" + accessor + ") > 0"
+	case sem.Embedded:
+		cond = g.messageSet(field, accessor)
+	case sem.Pointer || sem.EmitDefault:
+		cond = accessor + " != nil"
+	case kind == protoreflect.BytesKind:
+		cond = "len(" + accessor + ") != 0"
+	case kind == protoreflect.StringKind:
+		cond = accessor + " != \"\""
+	case kind == protoreflect.BoolKind:
+		cond = accessor
+	default:
+		cond = accessor + " != 0"
+	}
+
+	if cond != "" {
+		g.P("if ", cond, " {")
+	}
+	g.genFieldPrefix(sbInitialLen, field)
+	g.P("sb.WriteString(\"", redactedText, "\")")
+	if cond != "" {
+		g.P("}")
+	}
+}
+
+// genLazyError writes the decode error of the pending bytes of a lazy field,
+// which its getter reads as nil, in place of its value.
+func (g *textGenerator) genLazyError(sbInitialLen int, field *protogen.Field) {
+	g.P("if _, err := x.Get", field.GoName, "Err(); err != nil {")
+	g.genFieldPrefix(sbInitialLen, field)
+	g.P(g.Helper("TextWriteError"), "(&sb, err)")
+	g.P("}")
+}
+
+// genFieldPrefix writes the separator and name of field before its value.
+func (g *textGenerator) genFieldPrefix(sbInitialLen int, field *protogen.Field) {
+	if g.Config.HelperCodegen() {
+		g.P(g.Helper("TextWriteFieldPrefix"), "(&sb, initialLen, \"", field.Desc.Name(), "\")")
+		return
+	}
+	g.P("if sb.Len() > ", sbInitialLen, " {")
+	g.P("sb.WriteString(\" \")")
+	g.P("}")
+	g.P("sb.WriteString(\"", field.Desc.Name(), ": \")")
+}
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
 )
 
 var fmtPackage = protogen.GoImportPath("fmt")
@@ -27,11 +28,23 @@ func init() {
 	generator.RegisterFeature("unmarshal_unsafe", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &unmarshal{GeneratedFile: gen, unsafe: true}
 	})
+
+	// unmarshal_fields has its own copy of the decode loop, so it is optional
+	// to keep the copy out of the generated code by default.
+	generator.RegisterOptionalFeature("unmarshal_fields", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, fields: true}
+	}, "unmarshal")
+
+	generator.RegisterOptionalFeature("unmarshal_fields_unsafe", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, unsafe: true, fields: true}
+	}, "unmarshal_unsafe")
 }
 
 type unmarshal struct {
 	*generator.GeneratedFile
 	unsafe bool
+	// fields generates methodUnmarshalFields instead of methodUnmarshal.
+	fields bool
 	once   bool
 }
 
@@ -52,6 +65,13 @@ func (p *unmarshal) methodUnmarshal() string {
 	return "UnmarshalVT"
 }
 
+func (p *unmarshal) methodUnmarshalFields() string {
+	if p.unsafe {
+		return "UnmarshalVTFieldsUnsafe"
+	}
+	return "UnmarshalVTFields"
+}
+
 func (p *unmarshal) decodeMessage(varName, buf string, message *protogen.Message) {
 	switch {
 	case p.IsLocalMessage(message):
@@ -66,6 +86,64 @@ func (p *unmarshal) decodeMessage(varName, buf string, message *protogen.Message
 	}
 }
 
+// decodeStd merges the well-known type message in buf into the native Go value
+// pointed to by ptr.
+func (p *unmarshal) decodeStd(ptr, buf string) {
+	p.P(`if err := `, p.Helper("UnmarshalStd"), `(`, buf, `, `, ptr, `); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+}
+
+// stdField decodes buf into a well-known type field generated with a native Go
+// type.
+func (p *unmarshal) stdField(field *protogen.Field, fieldname, buf string) {
+	std := p.FieldSemantics(field).Std
+	switch {
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
+		p.decodeStd("&oneof."+field.GoName, buf)
+		p.P(`} else {`)
+		p.P(`var v `, std)
+		p.decodeStd("&v", buf)
+		p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+		p.P(`}`)
+	case field.Desc.IsList():
+		p.P(`var v `, std)
+		p.decodeStd("&v", buf)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
+	default:
+		p.P(`if m.`, fieldname, ` == nil {`)
+		p.P(`m.`, fieldname, ` = new(`, std, `)`)
+		p.P(`}`)
+		p.decodeStd("m."+fieldname, buf)
+	}
+}
+
+// lazyField keeps the wire bytes of a lazy field for decoding on first getter
+// access. Occurrences after the field was assigned are decoded eagerly.
+func (p *unmarshal) lazyField(field *protogen.Field, buf string) {
+	appendLazy := "AppendLazy"
+	if p.unsafe {
+		appendLazy = "AppendLazyUnsafe"
+	}
+	p.P(`if m.`, field.GoName, ` == nil {`)
+	p.P(`if err := `, p.Helper(appendLazy), `(&m.`, fieldsem.LazyGoName(field), `, `, buf, `, (*`, field.Message.GoIdent, `).`, p.methodUnmarshal(), `); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`} else {`)
+	p.decodeMessage("m."+field.GoName, buf, field.Message)
+	p.P(`}`)
+}
+
+// embeddedField merges the sub-message in buf into the embedded field,
+// marking it set.
+func (p *unmarshal) embeddedField(field *protogen.Field, buf string) {
+	p.decodeMessage("m."+field.GoName, buf, field.Message)
+	if p.FieldSemantics(field).Presence {
+		p.P(`m.`, fieldsem.PresenceGoName(field), ` = true`)
+	}
+}
+
 func (p *unmarshal) decodeVarint(varName string, typName string) {
 	switch typName {
 	case "int32":
@@ -211,11 +289,16 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field) {
 		p.validateUTF8(field, varName)
 		p.P(`iNdEx = postStringIndex`, varName)
 	case protoreflect.MessageKind:
+		std := p.FieldSemantics(field).Std != ""
 		if p.Config.HelperCodegen() {
 			p.decodeLengthDelimited("msgStart"+varName, "postmsgIndex"+varName)
 			buf := `dAtA[msgStart` + varName + `:postmsgIndex` + varName + `]`
-			p.P(varName, ` = &`, p.noStarOrSliceType(field), `{}`)
-			p.decodeMessage(varName, buf, field.Message)
+			if std {
+				p.decodeStd("&"+varName, buf)
+			} else {
+				p.P(varName, ` = &`, p.noStarOrSliceType(field), `{}`)
+				p.decodeMessage(varName, buf, field.Message)
+			}
 			p.P(`iNdEx = postmsgIndex`, varName)
 			break
 		}
@@ -232,8 +315,12 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field) {
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
 		buf := `dAtA[iNdEx:postmsgIndex]`
-		p.P(varName, ` = &`, p.noStarOrSliceType(field), `{}`)
-		p.decodeMessage(varName, buf, field.Message)
+		if std {
+			p.decodeStd("&"+varName, buf)
+		} else {
+			p.P(varName, ` = &`, p.noStarOrSliceType(field), `{}`)
+			p.decodeMessage(varName, buf, field.Message)
+		}
 		p.P(`iNdEx = postmsgIndex`)
 	case protoreflect.BytesKind:
 		if p.Config.HelperCodegen() {
@@ -305,6 +392,9 @@ func (p *unmarshal) mapMessageField(fieldname string, field *protogen.Field, pos
 	goTyp, _ := p.FieldGoType(field)
 	goTypK, _ := p.FieldGoType(field.Message.Fields[0])
 	goTypV, _ := p.FieldGoType(field.Message.Fields[1])
+	if std := p.FieldSemantics(field).Std; std != "" {
+		goTypV = std
+	}
 
 	p.P(`if m.`, fieldname, ` == nil {`)
 	p.P(`m.`, fieldname, ` = make(`, goTyp, `)`)
@@ -348,7 +438,41 @@ func (p *unmarshal) mapMessageField(fieldname string, field *protogen.Field, pos
 	p.P(`m.`, fieldname, `[mapkey] = mapvalue`)
 }
 
+// decodeCustom decodes buf into the custom type value varName. Custom types
+// have no unsafe variant and must not retain buf.
+func (p *unmarshal) decodeCustom(varName, buf string) {
+	p.P(`if err := `, varName, `.UnmarshalVT(`, buf, `); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+}
+
+// customField decodes a field of a custom type from its length-delimited
+// bytes. A singular value is replaced by each occurrence, like bytes.
+func (p *unmarshal) customField(field *protogen.Field, fieldname string) {
+	custom := p.FieldSemantics(field).Custom
+	p.decodeLengthDelimited("msgStart", "postIndex")
+	buf := `dAtA[msgStart:postIndex]`
+	switch {
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		p.P(`v := new(`, custom, `)`)
+		p.decodeCustom("v", buf)
+		p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+	case field.Desc.IsList():
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, custom, `{})`)
+		p.decodeCustom(fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname), buf)
+	default:
+		p.P(`v := new(`, custom, `)`)
+		p.decodeCustom("v", buf)
+		p.P(`m.`, fieldname, ` = v`)
+	}
+	p.P(`iNdEx = postIndex`)
+}
+
 func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *protogen.Message) {
+	if p.FieldSemantics(field).Custom != "" {
+		p.customField(field, fieldname)
+		return
+	}
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	typ := p.noStarOrSliceType(field)
 	oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
@@ -589,6 +713,8 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			if field.Desc.IsMap() {
 				p.P(`iNdEx = msgStart`)
 				p.mapMessageField(fieldname, field, "postIndex")
+			} else if p.FieldSemantics(field).Std != "" {
+				p.stdField(field, fieldname, buf)
 			} else if oneof {
 				msgname := p.noStarOrSliceType(field)
 				p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
@@ -602,6 +728,10 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
 				varname := fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname)
 				p.decodeMessage(varname, buf, field.Message)
+			} else if p.FieldSemantics(field).Embedded {
+				p.embeddedField(field, buf)
+			} else if p.FieldSemantics(field).Lazy {
+				p.lazyField(field, buf)
 			} else {
 				p.P(`if m.`, fieldname, ` == nil {`)
 				p.P(`m.`, fieldname, ` = &`, field.Message.GoIdent, `{}`)
@@ -623,7 +753,9 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		if oneof {
+		if p.FieldSemantics(field).Std != "" && !field.Desc.IsMap() {
+			p.stdField(field, fieldname, "dAtA[iNdEx:postIndex]")
+		} else if oneof {
 			buf := `dAtA[iNdEx:postIndex]`
 			msgname := p.noStarOrSliceType(field)
 			p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
@@ -640,6 +772,10 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			varname := fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname)
 			buf := `dAtA[iNdEx:postIndex]`
 			p.decodeMessage(varname, buf, field.Message)
+		} else if p.FieldSemantics(field).Embedded {
+			p.embeddedField(field, "dAtA[iNdEx:postIndex]")
+		} else if p.FieldSemantics(field).Lazy {
+			p.lazyField(field, "dAtA[iNdEx:postIndex]")
 		} else {
 			p.P(`if m.`, fieldname, ` == nil {`)
 			p.P(`m.`, fieldname, ` = &`, field.Message.GoIdent, `{}`)
@@ -930,9 +1066,64 @@ func (p *unmarshal) message(message *protogen.Message) {
 
 	p.once = true
 	ccTypeName := message.GoIdent.GoName
-	required := message.Desc.RequiredNumbers()
 
+	if p.TableMessage(message) {
+		table := p.Table(message)
+		var unsafeFuncs string
+		if p.unsafe {
+			unsafeFuncs = p.TableUnsafe(message)
+		}
+		if p.fields {
+			p.fieldsDoc()
+			p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshalFields(), `(dAtA []byte, fields ...int32) error {`)
+			p.P(`if fields == nil {`)
+			p.P(`fields = []int32{}`)
+			p.P(`}`)
+			if p.unsafe {
+				p.P(`return `, p.Helper("TableUnmarshalFieldsUnsafe"), `(&`, table, `, `, unsafeFuncs, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA, fields)`)
+			} else {
+				p.P(`return `, p.Helper("TableUnmarshalFields"), `(&`, table, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA, fields)`)
+			}
+			p.P(`}`)
+			p.P()
+			return
+		}
+		p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshal(), `(dAtA []byte) error {`)
+		if p.unsafe {
+			p.P(`return `, p.Helper("TableUnmarshalUnsafe"), `(&`, table, `, `, unsafeFuncs, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA)`)
+		} else {
+			p.P(`return `, p.Helper("TableUnmarshal"), `(&`, table, `, `, p.Ident("unsafe", "Pointer"), `(m), dAtA)`)
+		}
+		p.P(`}`)
+		p.rangeFields(message)
+		p.P()
+		return
+	}
+
+	if p.fields {
+		p.fieldsDoc()
+		p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshalFields(), `(dAtA []byte, fields ...int32) error {`)
+		p.decodeLoop(message, true)
+		p.P()
+		return
+	}
 	p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshal(), `(dAtA []byte) error {`)
+	p.decodeLoop(message, false)
+	p.rangeFields(message)
+}
+
+// fieldsDoc emits the doc comment of methodUnmarshalFields.
+func (p *unmarshal) fieldsDoc() {
+	p.P(`// `, p.methodUnmarshalFields(), ` is like `, p.methodUnmarshal(), ` but only decodes the listed field numbers.`)
+	p.P(`// Other fields are skipped without being decoded or kept as unknown fields.`)
+	p.P(`// Required fields are only checked if they are listed.`)
+}
+
+// decodeLoop emits the body of an unmarshal method of message. If filtered,
+// the fields whose numbers are not in the fields parameter are skipped, and
+// so are their required checks.
+func (p *unmarshal) decodeLoop(message *protogen.Message, filtered bool) {
+	required := message.Desc.RequiredNumbers()
 	if required.Len() > 0 {
 		p.P(`var hasFields [`, strconv.Itoa(1+(required.Len()-1)/64), `]uint64`)
 	}
@@ -951,6 +1142,9 @@ func (p *unmarshal) message(message *protogen.Message) {
 	p.P(`if fieldNum <= 0 {`)
 	p.P(`return `, fmtPackage.Ident("Errorf"), `("proto: `, message.GoIdent.GoName, `: illegal tag %d (wire type %d)", fieldNum, wire)`)
 	p.P(`}`)
+	if filtered {
+		p.skipUnlisted()
+	}
 	p.P(`switch fieldNum {`)
 	for _, field := range message.Fields {
 		p.field(field, message, required)
@@ -986,7 +1180,11 @@ func (p *unmarshal) message(message *protogen.Message) {
 		if fieldBit == required.Len() {
 			panic("missing required field")
 		}
-		p.P(`if hasFields[`, strconv.Itoa(fieldBit/64), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0 {`)
+		var listed string
+		if filtered {
+			listed = ` && ` + p.Ident("slices", "Contains") + `(fields, ` + strconv.Itoa(int(field.Desc.Number())) + `)`
+		}
+		p.P(`if hasFields[`, strconv.Itoa(fieldBit/64), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0`, listed, ` {`)
 		p.P(`return `, fmtPackage.Ident("Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
 		p.P(`}`)
 	}
@@ -997,3 +1195,52 @@ func (p *unmarshal) message(message *protogen.Message) {
 	p.P(`return nil`)
 	p.P(`}`)
 }
+
+// skipUnlisted emits the skipping of a field whose number is not in the fields
+// parameter of methodUnmarshalFields.
+func (p *unmarshal) skipUnlisted() {
+	p.P(`if !`, p.Ident("slices", "Contains"), `(fields, fieldNum) {`)
+	if p.Config.HelperCodegen() {
+		p.P(`iNdEx, err = `, p.Helper("SkipWithin"), `(dAtA, preIndex, l)`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+	} else {
+		p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[preIndex:])`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`if (skippy < 0) || (preIndex + skippy) < 0 {`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
+		p.P(`}`)
+		p.P(`if (preIndex + skippy) > l {`)
+		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
+		p.P(`}`)
+		p.P(`iNdEx = preIndex + skippy`)
+	}
+	p.P(`continue`)
+	p.P(`}`)
+}
+
+// rangeFields emits a Range<Message><Field>VT function for each repeated
+// message field, iterating the elements straight from the wire bytes of the
+// parent.
+func (p *unmarshal) rangeFields(message *protogen.Message) {
+	if p.unsafe {
+		return
+	}
+	for _, field := range message.Fields {
+		if !fieldsem.HasRange(field) {
+			continue
+		}
+		name := fieldsem.RangeGoName(field)
+		p.P()
+		p.P(`// `, name, ` iterates over the `, field.GoName, ` elements encoded in dAtA, a serialized `, message.GoIdent.GoName, `,`)
+		p.P(`// without decoding the other fields.`)
+		p.P(`// The yielded message is reused between iterations and must be copied to be kept.`)
+		p.P(`// Only the top-level struct is reused: its nested fields are allocated for each element.`)
+		p.P(`func `, name, `(dAtA []byte) `, p.Ident("iter", "Seq2"), `[*`, field.Message.GoIdent, `, error] {`)
+		p.P(`return `, p.Helper("RangeMessages"), `(dAtA, `, strconv.Itoa(int(field.Desc.Number())), `, (*`, field.Message.GoIdent, `).UnmarshalVT)`)
+		p.P(`}`)
+	}
+}
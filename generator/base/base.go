@@ -310,6 +310,7 @@ func genMessageField(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, fie
 	if m.isTracked {
 		tags = append(tags, gotrackTags...)
 	}
+	tags = tags.with(fieldGoTags(field))
 
 	name := field.GoName
 	if field.Desc.IsWeak() {
@@ -319,10 +320,32 @@ func genMessageField(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, fie
 	leadingComments := appendDeprecationSuffix(field.Comments.Leading,
 		field.Desc.ParentFile(),
 		field.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated())
+	sem := fieldsem.Resolve(g, field)
+	if sem.Presence {
+		// Presence is tracked apart from the value, so warn that assigning the
+		// field is not enough to encode it.
+		if leadingComments != "" {
+			leadingComments += "\n"
+		}
+		leadingComments += protogen.Comments(fmt.Sprintf(
+			" Set it with Set%[1]s: a value assigned directly is ignored when\n"+
+				" encoding, comparing or hashing unless Has%[1]s reports true.\n", field.GoName))
+	}
 	g.P(leadingComments,
 		name, " ", goType, tags,
 		trailingComment(field.Comments.Trailing))
 	sf.append(field.GoName)
+
+	if sem.Lazy {
+		lazyName := fieldsem.LazyGoName(field)
+		g.P(lazyName, " *", protogen.ProtobufGoLitePackage.Ident("Lazy"), "[", field.Message.GoIdent, "]")
+		sf.append(lazyName)
+	}
+	if sem.Presence {
+		presenceName := fieldsem.PresenceGoName(field)
+		g.P(presenceName, " bool")
+		sf.append(presenceName)
+	}
 }
 
 // genMessageDefaultDecls generates consts and vars holding the default
@@ -330,7 +353,8 @@ func genMessageField(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, fie
 func genMessageDefaultDecls(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
 	var consts, vars []string
 	for _, field := range m.Fields {
-		if !field.Desc.HasDefault() {
+		if !field.Desc.HasDefault() || fieldsem.Resolve(g, field).Custom != "" {
+			// Custom type fields have no Go constant for their default.
 			continue
 		}
 		name := "Default_" + m.GoIdent.GoName + "_" + field.GoName
@@ -408,6 +432,68 @@ func genMessageBaseMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInf
 	// ProtoMessage method.
 	g.P("func (*", m.GoIdent, ") ProtoMessage() {}")
 	g.P()
+
+	genMessageUnknownMethods(g, m)
+}
+
+// genMessageUnknownMethods generates the methods reading and clearing the
+// unknown fields of a message.
+func genMessageUnknownMethods(g *protogen.GeneratedFile, m *messageInfo) {
+	g.P("// GetUnknownFieldsVT returns the encoded fields of x that were not recognized")
+	g.P("// when decoding. The returned slice is not a copy.")
+	g.P("func (x *", m.GoIdent, ") GetUnknownFieldsVT() []byte {")
+	g.P("if x != nil {")
+	g.P("return x.", genid.UnknownFields_goname)
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+
+	g.P("// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold")
+	g.P("// encoded fields. b is retained, not copied.")
+	g.P("func (x *", m.GoIdent, ") SetUnknownFieldsVT(b []byte) {")
+	g.P("x.", genid.UnknownFields_goname, " = b")
+	g.P("}")
+	g.P()
+
+	g.P("// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.")
+	g.P("func (x *", m.GoIdent, ") DiscardUnknownVT() {")
+	g.P("if x == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("x.", genid.UnknownFields_goname, " = nil")
+	for _, field := range m.Fields {
+		if field.Desc.IsWeak() {
+			continue
+		}
+		sem := fieldsem.Resolve(g, field)
+		switch {
+		case sem.Std != "":
+			// Native well-known type values hold no unknown fields.
+		case field.Desc.IsMap():
+			if field.Message.Fields[1].Message == nil {
+				continue
+			}
+			g.P("for _, v := range x.", field.GoName, " {")
+			g.P("v.DiscardUnknownVT()")
+			g.P("}")
+		case field.Message == nil:
+		case sem.List:
+			g.P("for _, v := range x.", field.GoName, " {")
+			g.P("v.DiscardUnknownVT()")
+			g.P("}")
+		case sem.RealOneof:
+			g.P("if v, ok := x.", field.Oneof.GoName, ".(*", field.GoIdent, "); ok {")
+			g.P("v.", field.GoName, ".DiscardUnknownVT()")
+			g.P("}")
+		case sem.Lazy:
+			g.P("x.Get", field.GoName, "().DiscardUnknownVT()")
+		default:
+			g.P("x.", field.GoName, ".DiscardUnknownVT()")
+		}
+	}
+	g.P("}")
+	g.P()
 }
 
 func genMessageGetterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo) {
@@ -436,6 +522,25 @@ func genMessageGetterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageI
 		switch {
 		case field.Desc.IsWeak():
 		// NOTE: weak fields not supported
+		case fieldsem.Resolve(g, field).Lazy:
+			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
+			g.P("v, _ := x.Get", field.GoName, "Err()")
+			g.P("return v")
+			g.P("}")
+			g.P()
+			g.P("// Get", field.GoName, "Err is like Get", field.GoName, ", but returns the error decoding the")
+			g.P("// pending bytes of the field instead of nil.")
+			g.P("func (x *", m.GoIdent, ") Get", field.GoName, "Err() (", goType, ", error) {")
+			g.P("if x != nil {")
+			g.P("if x.", field.GoName, " == nil {")
+			g.P("return x.", fieldsem.LazyGoName(field), ".Get()")
+			g.P("}")
+			g.P("return x.", field.GoName, ", nil")
+			g.P("}")
+			g.P("return nil, nil")
+			g.P("}")
+		case fieldsem.Resolve(g, field).Embedded:
+			genEmbeddedFieldMethods(g, m, field, leadingComments)
 		case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
 			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
 			g.P("if x, ok := x.Get", field.Oneof.GoName, "().(*", field.GoIdent, "); ok {")
@@ -445,7 +550,7 @@ func genMessageGetterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageI
 			g.P("}")
 		default:
 			g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() ", goType, " {")
-			if !field.Desc.HasPresence() || defaultValue == "nil" {
+			if !field.Desc.HasPresence() || defaultValue == "nil" && !pointer {
 				g.P("if x != nil {")
 			} else {
 				g.P("if x != nil && x.", field.GoName, " != nil {")
@@ -463,6 +568,40 @@ func genMessageGetterMethods(g *protogen.GeneratedFile, f *fileInfo, m *messageI
 	}
 }
 
+// genEmbeddedFieldMethods generates the getter of an embedded field, which
+// returns a pointer to the embedded message, and the methods reading and
+// changing its presence if it is tracked.
+func genEmbeddedFieldMethods(g *protogen.GeneratedFile, m *messageInfo, field *protogen.Field, leadingComments protogen.Comments) {
+	g.P(leadingComments, "func (x *", m.GoIdent, ") Get", field.GoName, "() *", field.Message.GoIdent, " {")
+	g.P("if x != nil {")
+	g.P("return &x.", field.GoName)
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	if !fieldsem.Resolve(g, field).Presence {
+		return
+	}
+	presenceName := fieldsem.PresenceGoName(field)
+	g.P()
+	g.P("// Has", field.GoName, " reports whether the ", field.Desc.Name(), " field is set by Set", field.GoName, ",")
+	g.P("// decoding or merging. Assigning the field directly does not set it.")
+	g.P("func (x *", m.GoIdent, ") Has", field.GoName, "() bool {")
+	g.P("return x != nil && x.", presenceName)
+	g.P("}")
+	g.P()
+	g.P("// Set", field.GoName, " sets the ", field.Desc.Name(), " field to v.")
+	g.P("func (x *", m.GoIdent, ") Set", field.GoName, "(v ", field.Message.GoIdent, ") {")
+	g.P("x.", field.GoName, " = v")
+	g.P("x.", presenceName, " = true")
+	g.P("}")
+	g.P()
+	g.P("// Clear", field.GoName, " resets the ", field.Desc.Name(), " field and marks it unset.")
+	g.P("func (x *", m.GoIdent, ") Clear", field.GoName, "() {")
+	g.P("x.", field.GoName, " = ", field.Message.GoIdent, "{}")
+	g.P("x.", presenceName, " = false")
+	g.P("}")
+}
+
 // fieldGoType returns the Go type used for a field.
 //
 // If it returns pointer=true, the struct field is a pointer to the type.
@@ -483,6 +622,13 @@ func fieldDefaultValue(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, f
 	if field.Desc.IsList() {
 		return "nil"
 	}
+	sem := fieldsem.Resolve(g, field)
+	switch {
+	case sem.Std != "" && !sem.Map:
+		return stdZeroValue(field, sem.Std)
+	case sem.Custom != "":
+		return "nil"
+	}
 	if field.Desc.HasDefault() {
 		defVarName := "Default_" + m.GoIdent.GoName + "_" + field.GoName
 		if field.Desc.Kind() == protoreflect.BytesKind {
@@ -512,6 +658,22 @@ func fieldDefaultValue(g *protogen.GeneratedFile, f *fileInfo, m *messageInfo, f
 	}
 }
 
+// stdZeroValue returns the zero value of goType, the native Go type of a
+// well-known type field.
+func stdZeroValue(field *protogen.Field, goType string) string {
+	switch field.Message.Desc.FullName() {
+	case genid.Timestamp_message_fullname:
+		return goType + "{}"
+	case genid.BoolValue_message_fullname:
+		return "false"
+	case genid.StringValue_message_fullname:
+		return `""`
+	case genid.BytesValue_message_fullname:
+		return "nil"
+	}
+	return "0"
+}
+
 func fieldJSONTagValue(field *protogen.Field) string {
 	return field.Desc.JSONName() + ",omitempty"
 }
@@ -539,6 +701,7 @@ func genMessageOneofWrapperTypes(g *protogen.GeneratedFile, f *fileInfo, m *mess
 			if m.isTracked {
 				tags = append(tags, gotrackTags...)
 			}
+			tags = tags.with(fieldGoTags(field))
 			leadingComments := appendDeprecationSuffix(field.Comments.Leading,
 				field.Desc.ParentFile(),
 				field.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated())
@@ -593,6 +756,29 @@ func (tags structTags) String() string {
 	return "`" + strings.Join(ss, " ") + "`"
 }
 
+// with returns tags with extra appended, where an extra tag with the key of a
+// tag in tags replaces it.
+func (tags structTags) with(extra structTags) structTags {
+Extra:
+	for _, tag := range extra {
+		for i := range tags {
+			if tags[i][0] == tag[0] {
+				tags[i] = tag
+				continue Extra
+			}
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// fieldGoTags returns the struct tags set on field with the go_tags option or
+// the gotags directive. Malformed tags are reported before generating files.
+func fieldGoTags(field *protogen.Field) structTags {
+	tags, _ := fieldsem.GoTags(field)
+	return tags
+}
+
 // appendDeprecationSuffix optionally appends a deprecation notice as a suffix.
 func appendDeprecationSuffix(prefix protogen.Comments, parentFile protoreflect.FileDescriptor, deprecated bool) protogen.Comments {
 	fileDeprecated := parentFile.Options().(*descriptorpb.FileOptions).GetDeprecated()
@@ -33,6 +33,42 @@ func TestConfigSetCodegenMode(t *testing.T) {
 	if !cfg.HelperCodegen() {
 		t.Fatal("helper mode should use helper codegen")
 	}
+	if cfg.TableCodegen() {
+		t.Fatal("helper mode should not use table codegen")
+	}
+
+	if err := cfg.SetCodegenMode(string(CodegenModeTable)); err != nil {
+		t.Fatalf("table mode: %v", err)
+	}
+	if cfg.CodegenMode != CodegenModeTable {
+		t.Fatalf("CodegenMode = %q, want %q", cfg.CodegenMode, CodegenModeTable)
+	}
+	if !cfg.TableCodegen() {
+		t.Fatal("table mode should use table codegen")
+	}
+	if !cfg.HelperCodegen() {
+		t.Fatal("table mode should use helper codegen for fallback messages")
+	}
+}
+
+func TestValidateTableFeatures(t *testing.T) {
+	features := defaultFeatures
+	defaultFeatures = map[string]Feature{
+		"marshal":   nil,
+		"size":      nil,
+		"unmarshal": nil,
+	}
+	t.Cleanup(func() { defaultFeatures = features })
+
+	if err := validateTableFeatures([]string{"all"}); err != nil {
+		t.Fatalf("all features: %v", err)
+	}
+	if err := validateTableFeatures([]string{"size", "marshal", "unmarshal"}); err != nil {
+		t.Fatalf("size, marshal, and unmarshal: %v", err)
+	}
+	if err := validateTableFeatures([]string{"size", "marshal"}); err != errTableFeatures {
+		t.Fatalf("missing unmarshal: got %v, want %v", err, errTableFeatures)
+	}
 }
 
 func TestConfigSetCodegenModeRejectsUnknown(t *testing.T) {
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+// checkEmbeddedCycles returns an error if a message of plugin embeds itself,
+// directly or through other messages, with fields generated by value with the
+// nullable=false directive. Go cannot represent such a recursive struct type.
+func checkEmbeddedCycles(plugin *protogen.Plugin) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[*protogen.Message]int)
+	var path []*protogen.Field
+	var visit func(*protogen.Message) error
+	visit = func(message *protogen.Message) error {
+		switch state[message] {
+		case visiting:
+			var names []string
+			for i := len(path) - 1; i >= 0; i-- {
+				names = append([]string{string(path[i].Desc.FullName())}, names...)
+				if path[i].Parent == message {
+					break
+				}
+			}
+			return fmt.Errorf("%v: nullable=false fields embed the message in itself: %s",
+				message.Desc.FullName(), strings.Join(names, " -> "))
+		case visited:
+			return nil
+		}
+		state[message] = visiting
+		for _, field := range message.Fields {
+			if !fieldsem.IsEmbedded(field) {
+				continue
+			}
+			path = append(path, field)
+			if err := visit(field.Message); err != nil {
+				return err
+			}
+			path = path[:len(path)-1]
+		}
+		state[message] = visited
+		for _, nested := range message.Messages {
+			if err := visit(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, file := range plugin.Files {
+		for _, message := range file.Messages {
+			if err := visit(message); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
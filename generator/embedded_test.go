@@ -0,0 +1,34 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+func TestEmbeddedCycles(t *testing.T) {
+	for _, tc := range []struct {
+		proto string
+		want  string
+	}{{
+		proto: "message Node {\n  // protobuf-go-lite:nullable=false\n  Node child = 1;\n}\n",
+		want:  `errtest.Node: nullable=false fields embed the message in itself: errtest.Node.child`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:nullable=false\n  B b = 1;\n}\nmessage B {\n  // protobuf-go-lite:nullable=false\n  A a = 1;\n}\n",
+		want:  `errtest.A: nullable=false fields embed the message in itself: errtest.A.b -> errtest.B.a`,
+	}} {
+		src := "syntax = \"proto3\";\npackage errtest;\noption go_package = \"example.com/errtest\";\n" + tc.proto
+		_, err := generatortest.Generate(map[string]string{"errtest.proto": src}, nil)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q) = %v, want %s", tc.proto, err, tc.want)
+		}
+	}
+
+	// A message embedding another by value and referring back to it through a
+	// pointer is not recursive.
+	src := "syntax = \"proto3\";\npackage ok;\noption go_package = \"example.com/ok\";\n" +
+		"message A {\n  // protobuf-go-lite:nullable=false\n  B b = 1;\n}\nmessage B {\n  A a = 1;\n}\n"
+	if _, err := generatortest.Generate(map[string]string{"ok.proto": src}, nil); err != nil {
+		t.Fatal(err)
+	}
+}
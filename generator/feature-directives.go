@@ -0,0 +1,322 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FeaturesComment is the prefix of a features directive, which enables
+// (+name) or disables (-name) features for the element it precedes, such as
+// "protobuf-go-lite:features=-clone,+marshal_strict". Before the syntax,
+// edition or package statement it applies to every message of the file, before
+// a message to the message and its nested messages, and before a field, which
+// can only disable features, to the code generated for the field.
+const FeaturesComment = "protobuf-go-lite:features="
+
+// wireFeatures generate the wire format of a message, which must include every
+// field, so they cannot be disabled for a field.
+var wireFeatures = map[string]bool{
+	"size":             true,
+	"marshal":          true,
+	"marshal_strict":   true,
+	"unmarshal":        true,
+	"unmarshal_unsafe": true,
+}
+
+// featureChange is an entry of a features directive.
+type featureChange struct {
+	name   string
+	enable bool
+}
+
+// parseFeaturesComment returns the changes of the features directives in
+// comments, in order.
+func parseFeaturesComment(comments string) ([]featureChange, error) {
+	var changes []featureChange
+	for _, line := range strings.Split(strings.TrimSuffix(comments, "\n"), "\n") {
+		list, ok := strings.CutPrefix(strings.TrimSpace(line), FeaturesComment)
+		if !ok {
+			continue
+		}
+		for _, entry := range strings.Split(list, ",") {
+			entry = strings.TrimSpace(entry)
+			if len(entry) < 2 || (entry[0] != '+' && entry[0] != '-') {
+				return nil, fmt.Errorf("features directive entry %q is not +name or -name", entry)
+			}
+			name := entry[1:]
+			if _, ok := lookupFeature(name); !ok {
+				return nil, fmt.Errorf("unknown feature %q in features directive", name)
+			}
+			changes = append(changes, featureChange{name: name, enable: entry[0] == '+'})
+		}
+	}
+	return changes, nil
+}
+
+// applyFeatureChanges returns a copy of set with changes applied.
+func applyFeatureChanges(set map[string]bool, changes []featureChange) map[string]bool {
+	out := make(map[string]bool, len(set)+len(changes))
+	for name := range set {
+		out[name] = true
+	}
+	for _, c := range changes {
+		if c.enable {
+			out[c.name] = true
+		} else {
+			delete(out, c.name)
+		}
+	}
+	return out
+}
+
+// featureSelection holds the features generated for each file, message and
+// field of a plugin, as selected by the features parameter and directives.
+type featureSelection struct {
+	// files are the features of each file, run for its enums and top-level
+	// declarations.
+	files map[*protogen.File]map[string]bool
+	// messages are the features of each generated message.
+	messages map[protoreflect.FullName]map[string]bool
+	// disabled are the features disabled for each field.
+	disabled map[*protogen.Field]map[string]bool
+}
+
+// selectFeatures resolves the features directives of the generated files of
+// plugin, starting from the features selected by the parameter, and checks
+// that the features each message and field uses are enabled.
+func selectFeatures(plugin *protogen.Plugin, features []string, cfg *Config) (*featureSelection, error) {
+	base := make(map[string]bool, len(features))
+	for _, name := range features {
+		base[name] = true
+	}
+	sel := &featureSelection{
+		files:    make(map[*protogen.File]map[string]bool),
+		messages: make(map[protoreflect.FullName]map[string]bool),
+		disabled: make(map[*protogen.Field]map[string]bool),
+	}
+
+	var addMessage func(message *protogen.Message, parent map[string]bool) error
+	addMessage = func(message *protogen.Message, parent map[string]bool) error {
+		changes, err := parseFeaturesComment(string(message.Comments.Leading))
+		if err != nil {
+			return fmt.Errorf("%s: %w", message.Desc.FullName(), err)
+		}
+		set := applyFeatureChanges(parent, changes)
+		sel.messages[message.Desc.FullName()] = set
+		for _, field := range message.Fields {
+			changes, err := parseFeaturesComment(string(field.Comments.Leading))
+			if err != nil {
+				return fmt.Errorf("%s: %w", field.Desc.FullName(), err)
+			}
+			for _, c := range changes {
+				switch {
+				case c.enable:
+					return fmt.Errorf("%s: features directive of a field cannot enable feature %q", field.Desc.FullName(), c.name)
+				case wireFeatures[c.name]:
+					return fmt.Errorf("%s: feature %q cannot be disabled for a field", field.Desc.FullName(), c.name)
+				}
+				if sel.disabled[field] == nil {
+					sel.disabled[field] = make(map[string]bool)
+				}
+				sel.disabled[field][c.name] = true
+			}
+		}
+		for _, nested := range message.Messages {
+			if err := addMessage(nested, set); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		changes, err := parseFeaturesComment(fieldsem.FileComments(file.Desc))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Desc.Path(), err)
+		}
+		set := applyFeatureChanges(base, changes)
+		sel.files[file] = set
+		for _, message := range file.Messages {
+			if err := addMessage(message, set); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		if err := sel.checkMessages(file.Messages, cfg); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+// checkMessages checks that the features of messages and their nested
+// messages have the features they require, and that the message types of
+// their fields have the features that use them.
+func (sel *featureSelection) checkMessages(messages []*protogen.Message, cfg *Config) error {
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			continue
+		}
+		set := sel.messages[message.Desc.FullName()]
+		if cfg != nil && cfg.Registry {
+			for _, name := range []string{"size", "marshal", "unmarshal"} {
+				if !set[name] {
+					return fmt.Errorf("%s: registry=true requires feature %q, which is disabled", message.Desc.FullName(), name)
+				}
+			}
+		}
+		for _, name := range sortedFeatures(set) {
+			for _, req := range featureRequires[name] {
+				if !set[req] {
+					return fmt.Errorf("%s: feature %q requires feature %q, which is disabled", message.Desc.FullName(), name, req)
+				}
+			}
+			if localFeatures[name] {
+				continue
+			}
+			for _, field := range message.Fields {
+				if sel.disabled[field][name] {
+					continue
+				}
+				for _, req := range featureRequires[name] {
+					if sel.disabled[field][req] {
+						return fmt.Errorf("%s: feature %q requires feature %q, which is disabled for the field", field.Desc.FullName(), name, req)
+					}
+				}
+				target := fieldMessage(field)
+				if target == nil {
+					continue
+				}
+				if targetSet, ok := sel.messages[target.Desc.FullName()]; ok && !targetSet[name] {
+					return fmt.Errorf("%s: feature %q is disabled for %s, the type of the field", field.Desc.FullName(), name, target.Desc.FullName())
+				}
+			}
+		}
+		if err := sel.checkMessages(message.Messages, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldMessage returns the message type of field, or of its map values.
+func fieldMessage(field *protogen.Field) *protogen.Message {
+	if field.Desc.IsMap() {
+		return field.Message.Fields[1].Message
+	}
+	return field.Message
+}
+
+// sortedFeatures returns the names of set in order.
+func sortedFeatures(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fileFeatures returns the features to run for file: its own and those
+// enabled for any of its messages.
+func (sel *featureSelection) fileFeatures(file *protogen.File) []string {
+	set := make(map[string]bool)
+	for name := range sel.files[file] {
+		set[name] = true
+	}
+	var add func(messages []*protogen.Message)
+	add = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			for name := range sel.messages[message.Desc.FullName()] {
+				set[name] = true
+			}
+			add(message.Messages)
+		}
+	}
+	add(file.Messages)
+	return sortedFeatures(set)
+}
+
+// view returns file as the feature named name sees it: without the messages
+// the feature is disabled for, whose nested messages and enums take their
+// place, and without the fields it is disabled for.
+func (sel *featureSelection) view(file *protogen.File, name string) *protogen.File {
+	v := *file
+	v.Messages, v.Enums = nil, slices.Clone(file.Enums)
+	v.Messages, v.Enums = sel.viewMessages(file.Messages, name, v.Messages, v.Enums)
+	return &v
+}
+
+// viewMessages appends the view of messages for the feature named name to
+// out, and the enums of the messages left out to enums.
+func (sel *featureSelection) viewMessages(messages []*protogen.Message, name string, out []*protogen.Message, enums []*protogen.Enum) ([]*protogen.Message, []*protogen.Enum) {
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			out = append(out, message)
+			continue
+		}
+		if !sel.messages[message.Desc.FullName()][name] {
+			enums = append(enums, message.Enums...)
+			out, enums = sel.viewMessages(message.Messages, name, out, enums)
+			continue
+		}
+		v := *message
+		v.Messages, v.Enums = nil, slices.Clone(message.Enums)
+		v.Messages, v.Enums = sel.viewMessages(message.Messages, name, v.Messages, v.Enums)
+		sel.viewFields(&v, name)
+		out = append(out, &v)
+	}
+	return out, enums
+}
+
+// viewFields removes the fields of message the feature named name is
+// disabled for, and the oneofs left without fields.
+func (sel *featureSelection) viewFields(message *protogen.Message, name string) {
+	disabled := func(field *protogen.Field) bool {
+		return sel.disabled[field][name]
+	}
+	if !slices.ContainsFunc(message.Fields, disabled) {
+		return
+	}
+	oneofs := make(map[*protogen.Oneof]*protogen.Oneof)
+	fields := make([]*protogen.Field, 0, len(message.Fields))
+	for _, field := range message.Fields {
+		if disabled(field) {
+			continue
+		}
+		f := *field
+		if field.Oneof != nil {
+			oneof, ok := oneofs[field.Oneof]
+			if !ok {
+				o := *field.Oneof
+				o.Fields = nil
+				oneof = &o
+				oneofs[field.Oneof] = oneof
+			}
+			oneof.Fields = append(oneof.Fields, &f)
+			f.Oneof = oneof
+		}
+		fields = append(fields, &f)
+	}
+	message.Fields = fields
+	var kept []*protogen.Oneof
+	for _, oneof := range message.Oneofs {
+		if o, ok := oneofs[oneof]; ok {
+			kept = append(kept, o)
+		}
+	}
+	message.Oneofs = kept
+}
@@ -0,0 +1,181 @@
+package generator_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+const directivesProto = `// protobuf-go-lite:features=-json
+syntax = "proto3";
+
+package directives;
+
+option go_package = "example.com/directives;directives";
+
+// protobuf-go-lite:features=-clone,+json
+message Light {
+  string name = 1;
+
+  // protobuf-go-lite:features=+clone
+  message Inner {
+    int32 id = 1;
+  }
+}
+
+message Full {
+  string name = 1;
+  // protobuf-go-lite:features=-equal,-hash
+  int64 revision = 2;
+  Light.Inner inner = 3;
+  oneof choice {
+    // protobuf-go-lite:features=-text
+    string a = 4;
+    int32 b = 5;
+  }
+}
+`
+
+const directivesTest = `package directives
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDirectives(t *testing.T) {
+	a := &Full{Name: "x", Revision: 1, Choice: &Full_A{A: "a"}}
+	b := &Full{Name: "x", Revision: 2, Choice: &Full_A{A: "a"}}
+	if !a.EqualVT(b) || a.Hash64VT() != b.Hash64VT() {
+		t.Fatal("revision is compared")
+	}
+	if a.CloneVT().Revision != 1 {
+		t.Fatal("revision is not cloned")
+	}
+	if got := a.MarshalProtoText(); strings.Contains(got, "choice") || strings.Contains(got, "\"a\"") {
+		t.Fatalf("text contains the disabled field: %q", got)
+	}
+	if _, err := (&Light{Name: "x"}).MarshalJSON(); err != nil {
+		t.Fatal(err)
+	}
+	if (&Light_Inner{Id: 1}).CloneVT().Id != 1 {
+		t.Fatal("inner is not cloned")
+	}
+}
+`
+
+func TestFeaturesDirectives(t *testing.T) {
+	files, err := generatortest.Generate(map[string]string{"directives.proto": directivesProto}, &generatortest.Options{
+		Features: []string{"all", "hash"},
+		Params:   []string{"paths=source_relative"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := files["directives.pb.go"]
+	for _, tc := range []struct {
+		pattern string
+		want    bool
+	}{
+		{`func \(m \*Light\) CloneVT\(\)`, false},
+		{`func \(m \*Light_Inner\) CloneVT\(\)`, true},
+		{`func \(m \*Full\) CloneVT\(\)`, true},
+		{`func \(x \*Light\) MarshalProtoJSON\(`, true},
+		{`func \(x \*Light_Inner\) MarshalProtoJSON\(`, true},
+		{`func \(x \*Full\) MarshalProtoJSON\(`, false},
+		{`(?s)func \(this \*Full\) EqualVT\(.*?\n}\n`, true},
+	} {
+		if got := regexp.MustCompile(tc.pattern).MatchString(out); got != tc.want {
+			t.Errorf("generated code matches %s = %v, want %v", tc.pattern, got, tc.want)
+		}
+	}
+	equal := regexp.MustCompile(`(?s)func \(this \*Full\) EqualVT\(.*?\n}\n`).FindString(out)
+	if strings.Contains(equal, "Revision") || !strings.Contains(equal, "Name") {
+		t.Errorf("EqualVT of Full does not skip only the revision:\n%s", equal)
+	}
+
+	files["directives_test.go"] = directivesTest
+	sandbox, err := generatortest.NewSandbox(t.TempDir(), "example.com/directives")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sandbox.WriteFiles(files); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := sandbox.GoTest(); err != nil {
+		t.Fatalf("%v:\n%s", err, out)
+	}
+}
+
+func TestFeaturesDirectivesErrors(t *testing.T) {
+	for _, tc := range []struct {
+		proto string
+		want  string
+	}{{
+		proto: "// protobuf-go-lite:features=-size,-clone\nmessage A {}\n",
+		want:  `errtest.A: feature "marshal" requires feature "size", which is disabled`,
+	}, {
+		proto: "// protobuf-go-lite:features=-unmarshal\nmessage A {}\n",
+		want:  `errtest.A: feature "clone" requires feature "unmarshal", which is disabled`,
+	}, {
+		proto: "message A {\n  B b = 1;\n}\n// protobuf-go-lite:features=-clone\nmessage B {}\n",
+		want:  `errtest.A.b: feature "clone" is disabled for errtest.B, the type of the field`,
+	}, {
+		proto: "message A {\n  map<string, B> b = 1;\n}\n// protobuf-go-lite:features=-equal\nmessage B {}\n",
+		want:  `errtest.A.b: feature "equal" is disabled for errtest.B, the type of the field`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:features=-marshal\n  int32 a = 1;\n}\n",
+		want:  `errtest.A.a: feature "marshal" cannot be disabled for a field`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:features=+clone\n  int32 a = 1;\n}\n",
+		want:  `errtest.A.a: features directive of a field cannot enable feature "clone"`,
+	}, {
+		proto: "// protobuf-go-lite:features=-missing\nmessage A {}\n",
+		want:  `errtest.A: unknown feature "missing" in features directive`,
+	}, {
+		proto: "// protobuf-go-lite:features=clone\nmessage A {}\n",
+		want:  `errtest.A: features directive entry "clone" is not +name or -name`,
+	}} {
+		src := "syntax = \"proto3\";\npackage errtest;\noption go_package = \"example.com/errtest\";\n" + tc.proto
+		_, err := generatortest.Generate(map[string]string{"errtest.proto": src}, nil)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q) = %v, want %s", tc.proto, err, tc.want)
+		}
+	}
+}
+
+func TestSQLFeatureErrors(t *testing.T) {
+	for _, tc := range []struct {
+		features []string
+		proto    string
+		want     string
+	}{{
+		features: []string{"sql"},
+		proto:    "// protobuf-go-lite:sql\nmessage A {}\n",
+		want:     `errtest.A: sql=binary requires feature "marshal", which is disabled`,
+	}, {
+		features: []string{"size", "marshal", "unmarshal", "sql"},
+		proto:    "// protobuf-go-lite:sql=json\nmessage A {}\n",
+		want:     `errtest.A: sql=json requires feature "json", which is disabled`,
+	}, {
+		proto: "// protobuf-go-lite:sql\n// protobuf-go-lite:features=-unmarshal,-clone\nmessage A {}\n",
+		want:  `errtest.A: sql=binary requires feature "unmarshal", which is disabled`,
+	}, {
+		proto: "// protobuf-go-lite:sql=json\n// protobuf-go-lite:disable-json\nmessage A {}\n",
+		want:  `errtest.A: sql=json requires the JSON methods, which are not generated for the message`,
+	}, {
+		proto: "// protobuf-go-lite:sql\nmessage A {\n  int32 value = 1;\n}\n",
+		want:  `errtest.A.value: Go name "Value" is also used by the Value method of sql`,
+	}, {
+		proto: "// protobuf-go-lite:sql\nmessage A {\n  oneof scan {\n    int32 b = 1;\n  }\n}\n",
+		want:  `errtest.A.scan: Go name "Scan" is also used by the Scan method of sql`,
+	}} {
+		src := "syntax = \"proto3\";\npackage errtest;\noption go_package = \"example.com/errtest\";\n" + tc.proto
+		_, err := generatortest.Generate(map[string]string{"errtest.proto": src}, &generatortest.Options{Features: tc.features})
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q) = %v, want %s", tc.proto, err, tc.want)
+		}
+	}
+}
@@ -7,48 +7,101 @@ package generator
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 )
 
 var defaultFeatures = make(map[string]Feature)
 
-func findFeatures(featureNames []string) ([]Feature, error) {
-	required := make(map[string]Feature)
+// optionalFeatures are only generated when named, not by "all".
+var optionalFeatures = make(map[string]Feature)
+
+// featureRequires lists the features an optional feature generates code for.
+var featureRequires = make(map[string][]string)
+
+// localFeatures are the features whose code for a message does not use their
+// code for its sub-messages.
+var localFeatures = make(map[string]bool)
+
+// lookupFeature returns the registered feature named name.
+func lookupFeature(name string) (Feature, bool) {
+	feat, ok := defaultFeatures[name]
+	if !ok {
+		feat, ok = optionalFeatures[name]
+	}
+	return feat, ok
+}
+
+// findFeatures returns the sorted names of the features selected by
+// featureNames.
+func findFeatures(featureNames []string) ([]string, error) {
+	required := make(map[string]bool)
 	for _, name := range featureNames {
 		if name == "all" {
-			required = defaultFeatures
-			break
+			for name := range defaultFeatures {
+				required[name] = true
+			}
+			continue
 		}
 
-		feat, ok := defaultFeatures[name]
-		if !ok {
+		if _, ok := lookupFeature(name); !ok {
 			return nil, fmt.Errorf("unknown feature: %q", name)
 		}
-		required[name] = feat
+		if requires := featureRequires[name]; !hasFeatures(featureNames, requires...) {
+			return nil, fmt.Errorf("feature %q requires the %s features", name, strings.Join(requires, ", "))
+		}
+		required[name] = true
 	}
 
-	type namefeat struct {
-		name string
-		feat Feature
-	}
-	var sorted []namefeat
-	for name, feat := range required {
-		sorted = append(sorted, namefeat{name, feat})
+	features := make([]string, 0, len(required))
+	for name := range required {
+		features = append(features, name)
 	}
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].name < sorted[j].name
-	})
+	sort.Strings(features)
+	return features, nil
+}
+
+// FeatureRegistration describes a feature to register.
+type FeatureRegistration struct {
+	// Name is the name of the feature in the features parameter and in
+	// features directives.
+	Name    string
+	Feature Feature
+	// Optional features are not included in "all" and must be enabled by
+	// name, such as "all+name".
+	Optional bool
+	// Requires are the features the code of the feature uses for the same
+	// message, which must be enabled with it.
+	Requires []string
+	// Local features generate code for a message that does not use their
+	// code for its sub-messages, so they may be enabled for a message and
+	// disabled for the types of its fields.
+	Local bool
+}
 
-	var features []Feature
-	for _, sp := range sorted {
-		features = append(features, sp.feat)
+// Register registers a feature.
+func Register(reg FeatureRegistration) {
+	if reg.Optional {
+		optionalFeatures[reg.Name] = reg.Feature
+	} else {
+		defaultFeatures[reg.Name] = reg.Feature
 	}
-	return features, nil
+	featureRequires[reg.Name] = reg.Requires
+	localFeatures[reg.Name] = reg.Local
+}
+
+// RegisterFeature registers a feature included in "all". Enabling it by name
+// fails unless the features it requires are enabled as well.
+func RegisterFeature(name string, feat Feature, requires ...string) {
+	Register(FeatureRegistration{Name: name, Feature: feat, Requires: requires})
 }
 
-func RegisterFeature(name string, feat Feature) {
-	defaultFeatures[name] = feat
+// RegisterOptionalFeature registers a feature that is not included in "all"
+// and must be enabled by name, such as "all+name". Enabling it fails unless
+// the features it requires are enabled as well.
+func RegisterOptionalFeature(name string, feat Feature, requires ...string) {
+	Register(FeatureRegistration{Name: name, Feature: feat, Optional: true, Requires: requires})
 }
 
 type Feature func(gen *GeneratedFile) FeatureGenerator
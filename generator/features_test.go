@@ -0,0 +1,46 @@
+package generator
+
+import "testing"
+
+func TestFindFeaturesOptional(t *testing.T) {
+	features, optional, requires := defaultFeatures, optionalFeatures, featureRequires
+	defaultFeatures = map[string]Feature{
+		"clone": nil,
+		"size":  nil,
+	}
+	optionalFeatures = map[string]Feature{
+		"copy":  nil,
+		"merge": nil,
+	}
+	featureRequires = map[string][]string{
+		"copy": {"clone"},
+	}
+	t.Cleanup(func() {
+		defaultFeatures, optionalFeatures, featureRequires = features, optional, requires
+	})
+
+	for _, tc := range []struct {
+		names []string
+		want  int
+	}{
+		{[]string{"all"}, 2},
+		{[]string{"all", "merge"}, 3},
+		{[]string{"merge", "size"}, 2},
+		{[]string{"all", "copy"}, 3},
+		{[]string{"copy", "clone"}, 2},
+	} {
+		found, err := findFeatures(tc.names)
+		if err != nil {
+			t.Fatalf("findFeatures(%v): %v", tc.names, err)
+		}
+		if len(found) != tc.want {
+			t.Errorf("findFeatures(%v) found %d features, want %d", tc.names, len(found), tc.want)
+		}
+	}
+	if len(defaultFeatures) != 2 {
+		t.Fatal("findFeatures modified the default features")
+	}
+	if _, err := findFeatures([]string{"copy", "size"}); err == nil {
+		t.Fatal("findFeatures enabled copy without the clone feature")
+	}
+}
@@ -4,9 +4,11 @@ package fieldsem
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Qualifier qualifies Go identifiers for the generated file currently being
@@ -19,6 +21,26 @@ type Qualifier interface {
 // protobuf field.
 type Field struct {
 	Type string
+	// Std is the native Go type of a well-known type field generated with a
+	// std directive, such as time.Time or int64, and empty otherwise. For a
+	// map field it describes the map value.
+	Std string
+	// Custom is the Go type of a bytes or string field generated with the
+	// customtype directive, and empty otherwise. Singular and oneof fields
+	// hold a *Custom and repeated fields a []Custom.
+	Custom string
+	// Cast is the named Go type of an integer field generated with the
+	// casttype directive, and empty otherwise.
+	Cast string
+
+	// Embedded is set for a singular sub-message field generated with the
+	// nullable=false directive, which holds the message by value instead of
+	// behind a pointer.
+	Embedded bool
+	// Presence is set for an embedded field generated with the presence
+	// directive, whose presence is tracked in the unexported struct field
+	// named by PresenceGoName. An embedded field without it is always present.
+	Presence bool
 
 	Pointer     bool
 	Reference   bool
@@ -30,6 +52,264 @@ type Field struct {
 	Synthetic   bool
 	Weak        bool
 	EmitDefault bool
+	Lazy        bool
+	Redact      bool
+}
+
+// LazyComment marks a singular sub-message field whose wire bytes are kept at
+// unmarshal time and decoded on first getter access.
+const LazyComment = "protobuf-go-lite:lazy"
+
+// LazyGoName returns the name of the unexported struct field holding the
+// pending wire bytes of a lazy field.
+func LazyGoName(field *protogen.Field) string {
+	return "lazy" + field.GoName
+}
+
+// hasLazyComment checks if the leading comments of field have the lazy comment.
+func hasLazyComment(field *protogen.Field) bool {
+	return hasComment(string(field.Comments.Leading), LazyComment)
+}
+
+// Directives generating a singular sub-message field by value.
+const (
+	// NonNullableComment embeds the sub-message in the struct of the message
+	// instead of allocating it separately.
+	NonNullableComment = "protobuf-go-lite:nullable=false"
+	// PresenceComment tracks whether an embedded sub-message field is set, so
+	// that an unset field is not encoded.
+	PresenceComment = "protobuf-go-lite:presence"
+)
+
+// PresenceGoName returns the name of the unexported struct field recording
+// whether an embedded field with presence is set.
+func PresenceGoName(field *protogen.Field) string {
+	return "has" + field.GoName
+}
+
+// RangeGoName returns the name of the package-level function iterating over
+// the elements of the repeated message field in a serialized parent.
+func RangeGoName(field *protogen.Field) string {
+	return "Range" + field.Parent.GoIdent.GoName + field.GoName + "VT"
+}
+
+// HasRange reports whether the unmarshal feature generates the function named
+// by RangeGoName for field, a repeated message field not generated with a
+// native Go type.
+func HasRange(field *protogen.Field) bool {
+	if !field.Desc.IsList() || field.Desc.Kind() != protoreflect.MessageKind {
+		return false
+	}
+	std, ok := stdTypes[field.Message.Desc.FullName()]
+	return !ok || !hasStdComment(field.Desc, std.directive)
+}
+
+// HasPresenceMethods reports whether field is an embedded field with the
+// presence directive, whose presence is read and changed by the generated
+// Has, Set and Clear methods.
+func HasPresenceMethods(field *protogen.Field) bool {
+	return IsEmbedded(field) && hasComment(string(field.Comments.Leading), PresenceComment)
+}
+
+// IsEmbedded reports whether field is a singular sub-message field generated
+// by value with the nullable=false directive.
+func IsEmbedded(field *protogen.Field) bool {
+	if field.Desc.Kind() != protoreflect.MessageKind || field.Desc.IsList() || field.Desc.IsMap() ||
+		(field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) || hasLazyComment(field) {
+		return false
+	}
+	if std, ok := stdTypes[field.Message.Desc.FullName()]; ok && hasStdComment(field.Desc, std.directive) {
+		return false
+	}
+	return hasComment(string(field.Comments.Leading), NonNullableComment)
+}
+
+// hasComment checks if comments contain directive on a line of its own.
+func hasComment(comments, directive string) bool {
+	for _, line := range strings.Split(strings.TrimSuffix(comments, "\n"), "\n") {
+		if strings.TrimSpace(line) == directive {
+			return true
+		}
+	}
+	return false
+}
+
+// Directives generating well-known type fields as native Go types. Each applies
+// to the field it precedes, or to every field of the file when it precedes the
+// syntax, edition or package statement.
+const (
+	// StdTimeComment generates google.protobuf.Timestamp fields as time.Time.
+	StdTimeComment = "protobuf-go-lite:stdtime"
+	// StdDurationComment generates google.protobuf.Duration fields as
+	// time.Duration.
+	StdDurationComment = "protobuf-go-lite:stdduration"
+	// WKTPointerComment generates google.protobuf wrapper fields as their
+	// wrapped Go value, such as *int64 for an Int64Value field.
+	WKTPointerComment = "protobuf-go-lite:wktpointer"
+)
+
+var timePackage = protogen.GoImportPath("time")
+
+// stdTypes maps the well-known types with a native Go representation to the
+// directive enabling it and the Go type.
+var stdTypes = map[protoreflect.FullName]struct {
+	directive string
+	goType    protogen.GoIdent
+}{
+	"google.protobuf.Timestamp":   {StdTimeComment, timePackage.Ident("Time")},
+	"google.protobuf.Duration":    {StdDurationComment, timePackage.Ident("Duration")},
+	"google.protobuf.DoubleValue": {WKTPointerComment, protogen.GoIdent{GoName: "float64"}},
+	"google.protobuf.FloatValue":  {WKTPointerComment, protogen.GoIdent{GoName: "float32"}},
+	"google.protobuf.Int64Value":  {WKTPointerComment, protogen.GoIdent{GoName: "int64"}},
+	"google.protobuf.UInt64Value": {WKTPointerComment, protogen.GoIdent{GoName: "uint64"}},
+	"google.protobuf.Int32Value":  {WKTPointerComment, protogen.GoIdent{GoName: "int32"}},
+	"google.protobuf.UInt32Value": {WKTPointerComment, protogen.GoIdent{GoName: "uint32"}},
+	"google.protobuf.BoolValue":   {WKTPointerComment, protogen.GoIdent{GoName: "bool"}},
+	"google.protobuf.StringValue": {WKTPointerComment, protogen.GoIdent{GoName: "string"}},
+	"google.protobuf.BytesValue":  {WKTPointerComment, protogen.GoIdent{GoName: "[]byte"}},
+}
+
+// stdType returns the native Go type of the well-known type field, or "" if
+// the field keeps the message type.
+func stdType(q Qualifier, field protoreflect.FieldDescriptor) string {
+	if field.Kind() != protoreflect.MessageKind {
+		return ""
+	}
+	std, ok := stdTypes[field.Message().FullName()]
+	if !ok || !hasStdComment(field, std.directive) {
+		return ""
+	}
+	if std.goType.GoImportPath == "" {
+		return std.goType.GoName
+	}
+	return q.QualifiedGoIdent(std.goType)
+}
+
+// hasStdComment checks if directive precedes field, the map field declaring
+// the entry of field, or the syntax, edition or package statement of the file.
+func hasStdComment(field protoreflect.FieldDescriptor, directive string) bool {
+	if entry, ok := field.Parent().(protoreflect.MessageDescriptor); ok && entry.IsMapEntry() {
+		if parent, ok := entry.Parent().(protoreflect.MessageDescriptor); ok {
+			fields := parent.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				if fields.Get(i).Message() == entry {
+					field = fields.Get(i)
+					break
+				}
+			}
+		}
+	}
+	if hasComment(field.ParentFile().SourceLocations().ByDescriptor(field).LeadingComments, directive) {
+		return true
+	}
+	return hasFileComment(field.ParentFile(), directive)
+}
+
+// hasFileComment checks if directive precedes the syntax, edition or package
+// statement of file.
+func hasFileComment(file protoreflect.FileDescriptor, directive string) bool {
+	return hasComment(FileComments(file), directive)
+}
+
+// FileComments returns the comments before the syntax, edition and package
+// statements of file, where directives apply to the whole file, one comment
+// per line.
+func FileComments(file protoreflect.FileDescriptor) string {
+	const (
+		packagePath = 2
+		syntaxPath  = 12
+		editionPath = 14
+	)
+	var b strings.Builder
+	locs := file.SourceLocations()
+	for _, path := range []int32{syntaxPath, editionPath, packagePath} {
+		loc := locs.ByPath(protoreflect.SourcePath{path})
+		for _, comments := range append(loc.LeadingDetachedComments, loc.LeadingComments) {
+			if comments == "" {
+				continue
+			}
+			b.WriteString(strings.TrimSuffix(comments, "\n"))
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Directives setting the Go type of a field to a type of the user, given as an
+// import path and type name such as "example.com/money.Cents". A name without
+// an import path refers to a type of the generated package.
+const (
+	// CustomTypeComment generates a bytes or string field as a Go type whose
+	// pointer implements protobuf_go_lite.CustomType, encoded as the bytes of
+	// the field.
+	CustomTypeComment = "protobuf-go-lite:customtype="
+	// CastTypeComment generates an integer field as a named Go type with the
+	// underlying type of the field.
+	CastTypeComment = "protobuf-go-lite:casttype="
+)
+
+// goTypeComment returns the Go type named by the directive with prefix in the
+// leading comments of field, or "" if there is none.
+func goTypeComment(q Qualifier, field *protogen.Field, prefix string) string {
+	value := goTypeDirective(field, prefix)
+	if value == "" {
+		return ""
+	}
+	ident := protogen.GoIdent{GoName: value, GoImportPath: field.GoIdent.GoImportPath}
+	if i := strings.LastIndexByte(value, '.'); i > strings.LastIndexByte(value, '/') {
+		ident = protogen.GoIdent{GoName: value[i+1:], GoImportPath: protogen.GoImportPath(value[:i])}
+	}
+	return q.QualifiedGoIdent(ident)
+}
+
+// goTypeDirective returns the value of the first directive with prefix in the
+// leading comments of field, or "" if there is none.
+func goTypeDirective(field *protogen.Field, prefix string) string {
+	for _, line := range strings.Split(strings.TrimSuffix(string(field.Comments.Leading), "\n"), "\n") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), prefix); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// CheckGoType returns an error if field has a customtype or casttype directive
+// that does not apply to it, which would otherwise be ignored. A customtype
+// field has no default value, as its getter returns nil when it is unset.
+func CheckGoType(field *protogen.Field) error {
+	kind := field.Desc.Kind()
+	if goTypeDirective(field, CustomTypeComment) != "" {
+		switch {
+		case field.Desc.IsMap() || (kind != protoreflect.StringKind && kind != protoreflect.BytesKind):
+			return fmt.Errorf("customtype directive applies only to bytes and string fields")
+		case field.Desc.HasDefault():
+			return fmt.Errorf("customtype directive does not apply to a field with a default value")
+		}
+	}
+	if goTypeDirective(field, CastTypeComment) != "" {
+		switch kind {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+			protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		default:
+			return fmt.Errorf("casttype directive applies only to integer fields")
+		}
+	}
+	return nil
+}
+
+// RedactComment marks the field it precedes as sensitive, like the
+// debug_redact field option, for schemas that cannot set the option.
+const RedactComment = "protobuf-go-lite:redact"
+
+// isDebugRedact reports whether field sets the debug_redact field option or
+// has the redact directive.
+func isDebugRedact(field *protogen.Field) bool {
+	if opts, ok := field.Desc.Options().(*descriptorpb.FieldOptions); ok && opts.GetDebugRedact() {
+		return true
+	}
+	return hasComment(string(field.Comments.Leading), RedactComment)
 }
 
 // Resolve resolves the generated Go representation for field.
@@ -42,6 +322,7 @@ func Resolve(q Qualifier, field *protogen.Field) Field {
 		RealOneof: field.Oneof != nil && !field.Oneof.Desc.IsSynthetic(),
 		Synthetic: field.Oneof != nil && field.Oneof.Desc.IsSynthetic(),
 		Weak:      field.Desc.IsWeak(),
+		Redact:    isDebugRedact(field),
 	}
 	if sem.Weak {
 		sem.Type = "struct{}"
@@ -57,24 +338,47 @@ func Resolve(q Qualifier, field *protogen.Field) Field {
 		goType = q.QualifiedGoIdent(field.Enum.GoIdent)
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
 		goType = "int32"
+		sem.Cast = goTypeComment(q, field, CastTypeComment)
 	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
 		goType = "uint32"
+		sem.Cast = goTypeComment(q, field, CastTypeComment)
 	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
 		goType = "int64"
+		sem.Cast = goTypeComment(q, field, CastTypeComment)
 	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
 		goType = "uint64"
+		sem.Cast = goTypeComment(q, field, CastTypeComment)
 	case protoreflect.FloatKind:
 		goType = "float32"
 	case protoreflect.DoubleKind:
 		goType = "float64"
 	case protoreflect.StringKind:
 		goType = "string"
+		sem.Custom = goTypeComment(q, field, CustomTypeComment)
 	case protoreflect.BytesKind:
 		goType = "[]byte"
 		pointer = false
+		sem.Custom = goTypeComment(q, field, CustomTypeComment)
 	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if sem.Std = stdType(q, field.Desc); sem.Std != "" {
+			goType = sem.Std
+			break
+		}
 		goType = "*" + q.QualifiedGoIdent(field.Message.GoIdent)
 		pointer = false
+		if sem.Embedded = IsEmbedded(field); sem.Embedded {
+			goType = goType[1:]
+			sem.Presence = hasComment(string(field.Comments.Leading), PresenceComment)
+		}
+	}
+	switch {
+	case sem.Cast != "":
+		goType = sem.Cast
+	case sem.Custom != "" && sem.List:
+		goType = sem.Custom
+	case sem.Custom != "":
+		goType = "*" + sem.Custom
+		pointer = false
 	}
 
 	switch {
@@ -85,16 +389,19 @@ func Resolve(q Qualifier, field *protogen.Field) Field {
 		key := Resolve(q, field.Message.Fields[0])
 		val := Resolve(q, field.Message.Fields[1])
 		sem.Type = fmt.Sprintf("map[%v]%v", key.Type, val.Type)
+		sem.Std = val.Std
 		sem.Pointer = false
 	default:
 		sem.Type = goType
 		sem.Pointer = pointer
 	}
 
-	sem.Reference = sem.Pointer || sem.List || sem.Map || sem.RealOneof ||
+	sem.Reference = sem.Pointer || sem.List || sem.Map || sem.RealOneof || sem.Custom != "" ||
 		field.Desc.Kind() == protoreflect.BytesKind ||
 		field.Desc.Kind() == protoreflect.MessageKind ||
 		field.Desc.Kind() == protoreflect.GroupKind
 	sem.EmitDefault = field.Desc.HasPresence() && !sem.List && !sem.Map
+	sem.Lazy = field.Desc.Kind() == protoreflect.MessageKind &&
+		!sem.List && !sem.Map && !sem.RealOneof && sem.Std == "" && hasLazyComment(field)
 	return sem
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -57,6 +58,10 @@ func TestResolveEditionFieldSemantics(t *testing.T) {
 			name: "choice_int32",
 			want: Field{Type: "int32", Reference: true, RealOneof: true, EmitDefault: true},
 		},
+		{
+			name: "redacted_string",
+			want: Field{Type: "string", Pointer: true, Reference: true, EmitDefault: true, Redact: true},
+		},
 	}
 
 	for _, test := range tests {
@@ -122,6 +127,16 @@ func testEditionFields(t *testing.T) map[string]*protogen.Field {
 						OneofIndex: proto.Int32(0),
 						JsonName:   proto.String("choiceInt32"),
 					},
+					{
+						Name:     proto.String("redacted_string"),
+						Number:   proto.Int32(10),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						JsonName: proto.String("redactedString"),
+						Options: &descriptorpb.FieldOptions{
+							DebugRedact: proto.Bool(true),
+						},
+					},
 				},
 				NestedType: []*descriptorpb.DescriptorProto{
 					{
@@ -199,3 +214,31 @@ func repeatedField(name string, num int32, features *descriptorpb.FeatureSet) *d
 		},
 	}
 }
+
+func TestFileComments(t *testing.T) {
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("comments.proto"),
+		Syntax:  proto.String("proto3"),
+		Package: proto.String("comments"),
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{{
+			Path:                    []int32{12},
+			Span:                    []int32{3, 0, 18},
+			LeadingDetachedComments: []string{" License.\n"},
+			LeadingComments:         proto.String(" protobuf-go-lite:stdtime\n"),
+		}, {
+			Path:            []int32{2},
+			Span:            []int32{5, 0, 18},
+			LeadingComments: proto.String(" protobuf-go-lite:sql=json"),
+		}}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = " License.\n protobuf-go-lite:stdtime\n protobuf-go-lite:sql=json\n"
+	if got := FileComments(fd); got != want {
+		t.Errorf("FileComments() = %q, want %q", got, want)
+	}
+	if !hasFileComment(fd, StdTimeComment) || hasFileComment(fd, StdDurationComment) {
+		t.Error("hasFileComment does not match the file comments")
+	}
+}
@@ -0,0 +1,203 @@
+package fieldsem
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Directives setting the Go names and struct tags of the generated code. Each
+// has an equivalent option declared in golite/golite.proto, which takes
+// precedence over the directive.
+const (
+	// GoTagsComment adds struct tags to the Go field of the field it precedes,
+	// such as `protobuf-go-lite:gotags=db:"id" yaml:"id"`. A tag with the key
+	// of a generated tag, such as json, replaces it.
+	GoTagsComment = "protobuf-go-lite:gotags="
+	// GoNameComment sets the Go name of the field or message it precedes.
+	GoNameComment = "protobuf-go-lite:goname="
+)
+
+// Directives shortening the Go names of enum values. Each applies to the enum
+// it precedes, or to every enum of the file when it precedes the syntax,
+// edition or package statement. The value names of the _name and _value maps
+// and of the JSON and text encodings are unchanged.
+const (
+	// StripEnumPrefixComment drops the prefix naming the enum type, or the
+	// parent message of a nested enum, generating ACTIVE for Status_ACTIVE.
+	StripEnumPrefixComment = "protobuf-go-lite:stripenumprefix"
+	// StripValuePrefixComment drops the prefix shared by the value names up to
+	// its last underscore, generating Status_ACTIVE for Status_STATUS_ACTIVE.
+	StripValuePrefixComment = "protobuf-go-lite:stripvalueprefix"
+)
+
+// Field numbers of the golite.proto options, which are decoded from the
+// unknown fields of the descriptor options to avoid a dependency on the
+// generated golite package.
+const (
+	goTagsFieldNumber              protowire.Number = 52401 // FieldOptions.go_tags
+	goNameFieldNumber              protowire.Number = 52402 // FieldOptions.go_name
+	goTypeNameFieldNumber          protowire.Number = 52403 // MessageOptions.go_type_name
+	stripEnumPrefixFieldNumber     protowire.Number = 52404 // EnumOptions.go_strip_enum_prefix
+	stripValuePrefixFieldNumber    protowire.Number = 52405 // EnumOptions.go_strip_value_prefix
+	stripEnumPrefixAllFieldNumber  protowire.Number = 52406 // FileOptions.go_strip_enum_prefix_all
+	stripValuePrefixAllFieldNumber protowire.Number = 52407 // FileOptions.go_strip_value_prefix_all
+)
+
+// GoTags returns the struct tags added to the Go field of field with the
+// go_tags option or the gotags directive, as key and unquoted value pairs.
+func GoTags(field *protogen.Field) ([][2]string, error) {
+	tags, ok := stringOption(field.Desc.Options(), goTagsFieldNumber)
+	if !ok {
+		tags = commentValue(string(field.Comments.Leading), GoTagsComment)
+	}
+	return parseGoTags(tags)
+}
+
+// GoName returns the Go name of field set with the go_name option or the
+// goname directive, or "" if there is none.
+func GoName(field *protogen.Field) string {
+	if name, ok := stringOption(field.Desc.Options(), goNameFieldNumber); ok {
+		return name
+	}
+	return commentValue(string(field.Comments.Leading), GoNameComment)
+}
+
+// MessageGoName returns the Go type name of message set with the go_type_name
+// option or the goname directive, or "" if there is none.
+func MessageGoName(message *protogen.Message) string {
+	if name, ok := stringOption(message.Desc.Options(), goTypeNameFieldNumber); ok {
+		return name
+	}
+	return commentValue(string(message.Comments.Leading), GoNameComment)
+}
+
+// StripEnumPrefix reports whether the Go names of the values of enum drop the
+// prefix naming the enum type, as set by the go_strip_enum_prefix option or
+// the stripenumprefix directive of the enum or its file.
+func StripEnumPrefix(enum *protogen.Enum) bool {
+	return enumFlag(enum, StripEnumPrefixComment, stripEnumPrefixFieldNumber, stripEnumPrefixAllFieldNumber)
+}
+
+// StripValuePrefix reports whether the Go names of the values of enum drop the
+// prefix shared by the value names, as set by the go_strip_value_prefix option
+// or the stripvalueprefix directive of the enum or its file.
+func StripValuePrefix(enum *protogen.Enum) bool {
+	return enumFlag(enum, StripValuePrefixComment, stripValuePrefixFieldNumber, stripValuePrefixAllFieldNumber)
+}
+
+// enumFlag reports whether the enum option enumNum, the file option fileNum or
+// directive is set for enum. An option set to false overrides a directive or
+// option of the file.
+func enumFlag(enum *protogen.Enum, directive string, enumNum, fileNum protowire.Number) bool {
+	if v, ok := boolOption(enum.Desc.Options(), enumNum); ok {
+		return v
+	}
+	if hasComment(string(enum.Comments.Leading), directive) {
+		return true
+	}
+	file := enum.Desc.ParentFile()
+	if v, ok := boolOption(file.Options(), fileNum); ok {
+		return v
+	}
+	return hasFileComment(file, directive)
+}
+
+// stringOption decodes the string option with number num from the unknown
+// fields of opts. The last occurrence wins, as for any singular field.
+func stringOption(opts protoreflect.ProtoMessage, num protowire.Number) (value string, ok bool) {
+	rangeOptions(opts, func(n protowire.Number, typ protowire.Type, b []byte) {
+		if n == num && typ == protowire.BytesType {
+			if v, l := protowire.ConsumeBytes(b); l >= 0 {
+				value, ok = string(v), true
+			}
+		}
+	})
+	return value, ok
+}
+
+// boolOption decodes the bool option with number num from the unknown fields
+// of opts. The last occurrence wins, as for any singular field.
+func boolOption(opts protoreflect.ProtoMessage, num protowire.Number) (value bool, ok bool) {
+	rangeOptions(opts, func(n protowire.Number, typ protowire.Type, b []byte) {
+		if n == num && typ == protowire.VarintType {
+			if v, l := protowire.ConsumeVarint(b); l >= 0 {
+				value, ok = protowire.DecodeBool(v), true
+			}
+		}
+	})
+	return value, ok
+}
+
+// rangeOptions calls f with the number, wire type and remaining bytes starting
+// at the value of each unknown field of opts.
+func rangeOptions(opts protoreflect.ProtoMessage, f func(protowire.Number, protowire.Type, []byte)) {
+	if opts == nil {
+		return
+	}
+	b := opts.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, l := protowire.ConsumeTag(b)
+		if l < 0 {
+			return
+		}
+		b = b[l:]
+		f(num, typ, b)
+		l = protowire.ConsumeFieldValue(num, typ, b)
+		if l < 0 {
+			return
+		}
+		b = b[l:]
+	}
+}
+
+// commentValue returns the value of the last directive with prefix in
+// comments, or "" if there is none.
+func commentValue(comments, prefix string) string {
+	var value string
+	for _, line := range strings.Split(strings.TrimSuffix(comments, "\n"), "\n") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(line), prefix); ok {
+			value = strings.TrimSpace(v)
+		}
+	}
+	return value
+}
+
+// parseGoTags parses struct tags in the conventional format of
+// reflect.StructTag, a space separated list of key:"value" pairs.
+func parseGoTags(tags string) ([][2]string, error) {
+	var out [][2]string
+	for s := strings.TrimSpace(tags); s != ""; s = strings.TrimLeft(s, " ") {
+		i := 0
+		for i < len(s) && s[i] > ' ' && s[i] != ':' && s[i] != '"' && s[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(s) || s[i] != ':' || s[i+1] != '"' {
+			return nil, fmt.Errorf("malformed struct tags %q", tags)
+		}
+		key := s[:i]
+		s = s[i+1:]
+
+		i = 1
+		for i < len(s) && s[i] != '"' {
+			if s[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("malformed struct tags %q", tags)
+		}
+		value, err := strconv.Unquote(s[:i+1])
+		if err != nil {
+			return nil, fmt.Errorf("malformed struct tags %q", tags)
+		}
+		out = append(out, [2]string{key, value})
+		s = s[i+1:]
+	}
+	return out, nil
+}
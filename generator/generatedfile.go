@@ -14,6 +14,22 @@ type GeneratedFile struct {
 	*protogen.GeneratedFile
 	Config        *Config
 	LocalPackages map[protoreflect.FullName]bool
+
+	plugin *protogen.Plugin
+	sel    *featureSelection
+	tables map[string]bool
+}
+
+// MessageFeature reports whether the feature named name is enabled for
+// message, as selected by the features parameter and directives.
+func (p *GeneratedFile) MessageFeature(message *protogen.Message, name string) bool {
+	return p.sel != nil && p.sel.messages[message.Desc.FullName()][name]
+}
+
+// Error reports err as a generator error, failing the generation. Only the
+// first error is reported.
+func (p *GeneratedFile) Error(err error) {
+	p.plugin.Error(err)
 }
 
 func (p *GeneratedFile) Ident(path, ident string) string {
@@ -38,6 +54,17 @@ func (p *GeneratedFile) FieldSemantics(field *protogen.Field) fieldsem.Field {
 	return fieldsem.Resolve(p, field)
 }
 
+// EmbeddedPtr returns the expression viewing the embedded sub-message field of
+// recv as a pointer, which is nil if the field tracks its presence and is
+// unset.
+func (p *GeneratedFile) EmbeddedPtr(recv string, field *protogen.Field) string {
+	ptr := `&` + recv + `.` + field.GoName
+	if !p.FieldSemantics(field).Presence {
+		return ptr
+	}
+	return p.QualifiedGoIdent(p.Helper("PresentPtr")) + `(` + ptr + `, ` + recv + `.` + fieldsem.PresenceGoName(field) + `)`
+}
+
 func (p *GeneratedFile) IsLocalMessage(message *protogen.Message) bool {
 	if message == nil {
 		return false
@@ -57,11 +84,33 @@ func (p *GeneratedFile) IsLocalField(field *protogen.Field) bool {
 const vtHelpersPackage = protogen.GoImportPath("github.com/aperturerobotics/protobuf-go-lite")
 
 var helpers = map[string]protogen.GoIdent{
+	"AppendDiff":                    {GoName: "AppendDiff", GoImportPath: vtHelpersPackage},
+	"AppendDiffBytes":               {GoName: "AppendDiffBytes", GoImportPath: vtHelpersPackage},
+	"AppendDiffBytesMap":            {GoName: "AppendDiffBytesMap", GoImportPath: vtHelpersPackage},
+	"AppendDiffBytesPresent":        {GoName: "AppendDiffBytesPresent", GoImportPath: vtHelpersPackage},
+	"AppendDiffBytesSlice":          {GoName: "AppendDiffBytesSlice", GoImportPath: vtHelpersPackage},
+	"AppendDiffMap":                 {GoName: "AppendDiffMap", GoImportPath: vtHelpersPackage},
+	"AppendDiffPtr":                 {GoName: "AppendDiffPtr", GoImportPath: vtHelpersPackage},
+	"AppendDiffSlice":               {GoName: "AppendDiffSlice", GoImportPath: vtHelpersPackage},
+	"AppendDiffCustom":              {GoName: "AppendDiffCustom", GoImportPath: vtHelpersPackage},
+	"AppendDiffCustomSlice":         {GoName: "AppendDiffCustomSlice", GoImportPath: vtHelpersPackage},
+	"AppendDiffStdMap":              {GoName: "AppendDiffStdMap", GoImportPath: vtHelpersPackage},
+	"AppendDiffStdPtr":              {GoName: "AppendDiffStdPtr", GoImportPath: vtHelpersPackage},
+	"AppendDiffStdSlice":            {GoName: "AppendDiffStdSlice", GoImportPath: vtHelpersPackage},
+	"AppendDiffVTMap":               {GoName: "AppendDiffVTMap", GoImportPath: vtHelpersPackage},
+	"AppendDiffVTSlice":             {GoName: "AppendDiffVTSlice", GoImportPath: vtHelpersPackage},
+	"AppendDiffVTValue":             {GoName: "AppendDiffVTValue", GoImportPath: vtHelpersPackage},
+	"AppendLazy":                    {GoName: "AppendLazy", GoImportPath: vtHelpersPackage},
+	"AppendLazyUnsafe":              {GoName: "AppendLazyUnsafe", GoImportPath: vtHelpersPackage},
+	"MergeLazy":                     {GoName: "MergeLazy", GoImportPath: vtHelpersPackage},
+	"TakeLazy":                      {GoName: "TakeLazy", GoImportPath: vtHelpersPackage},
+	"TextWriteError":                {GoName: "TextWriteError", GoImportPath: vtHelpersPackage},
 	"EncodeBool":                    {GoName: "EncodeBool", GoImportPath: vtHelpersPackage},
 	"EncodeBytes":                   {GoName: "EncodeBytes", GoImportPath: vtHelpersPackage},
 	"EncodeFixed32":                 {GoName: "EncodeFixed32", GoImportPath: vtHelpersPackage},
 	"EncodeFixed64":                 {GoName: "EncodeFixed64", GoImportPath: vtHelpersPackage},
 	"EncodeRawBytes":                {GoName: "EncodeRawBytes", GoImportPath: vtHelpersPackage},
+	"EncodeStd":                     {GoName: "EncodeStd", GoImportPath: vtHelpersPackage},
 	"EncodeString":                  {GoName: "EncodeString", GoImportPath: vtHelpersPackage},
 	"EncodeVarint":                  {GoName: "EncodeVarint", GoImportPath: vtHelpersPackage},
 	"EncodeVarintPacked":            {GoName: "EncodeVarintPacked", GoImportPath: vtHelpersPackage},
@@ -75,6 +124,7 @@ var helpers = map[string]protogen.GoIdent{
 	"SizeOfZigzag":                  {GoName: "SizeOfZigzag", GoImportPath: vtHelpersPackage},
 	"Skip":                          {GoName: "Skip", GoImportPath: vtHelpersPackage},
 	"SkipWithin":                    {GoName: "SkipWithin", GoImportPath: vtHelpersPackage},
+	"RangeMessages":                 {GoName: "RangeMessages", GoImportPath: vtHelpersPackage},
 	"ErrInvalidLength":              {GoName: "ErrInvalidLength", GoImportPath: vtHelpersPackage},
 	"ErrIntOverflow":                {GoName: "ErrIntOverflow", GoImportPath: vtHelpersPackage},
 	"ErrUnexpectedEndOfGroup":       {GoName: "ErrUnexpectedEndOfGroup", GoImportPath: vtHelpersPackage},
@@ -94,26 +144,95 @@ var helpers = map[string]protogen.GoIdent{
 	"DecodeLengthDelimited":         {GoName: "DecodeLengthDelimited", GoImportPath: vtHelpersPackage},
 	"DecodeString":                  {GoName: "DecodeString", GoImportPath: vtHelpersPackage},
 	"DecodeStringUnsafe":            {GoName: "DecodeStringUnsafe", GoImportPath: vtHelpersPackage},
+	"UnmarshalStd":                  {GoName: "UnmarshalStd", GoImportPath: vtHelpersPackage},
+	"MergeStd":                      {GoName: "MergeStd", GoImportPath: vtHelpersPackage},
 	"CloneBytes":                    {GoName: "CloneBytes", GoImportPath: vtHelpersPackage},
 	"CloneBytesMap":                 {GoName: "CloneBytesMap", GoImportPath: vtHelpersPackage},
 	"CloneBytesSlice":               {GoName: "CloneBytesSlice", GoImportPath: vtHelpersPackage},
 	"CloneMap":                      {GoName: "CloneMap", GoImportPath: vtHelpersPackage},
 	"ClonePtr":                      {GoName: "ClonePtr", GoImportPath: vtHelpersPackage},
 	"CloneSlice":                    {GoName: "CloneSlice", GoImportPath: vtHelpersPackage},
+	"CloneCustom":                   {GoName: "CloneCustom", GoImportPath: vtHelpersPackage},
+	"CloneCustomSlice":              {GoName: "CloneCustomSlice", GoImportPath: vtHelpersPackage},
+	"CloneStd":                      {GoName: "CloneStd", GoImportPath: vtHelpersPackage},
+	"CloneStdMap":                   {GoName: "CloneStdMap", GoImportPath: vtHelpersPackage},
+	"CloneStdPtr":                   {GoName: "CloneStdPtr", GoImportPath: vtHelpersPackage},
+	"CloneStdSlice":                 {GoName: "CloneStdSlice", GoImportPath: vtHelpersPackage},
 	"CloneVTMap":                    {GoName: "CloneVTMap", GoImportPath: vtHelpersPackage},
 	"CloneVTSlice":                  {GoName: "CloneVTSlice", GoImportPath: vtHelpersPackage},
 	"CloneVTValue":                  {GoName: "CloneVTValue", GoImportPath: vtHelpersPackage},
+	"CompareBool":                   {GoName: "CompareBool", GoImportPath: vtHelpersPackage},
+	"CompareBoolPtr":                {GoName: "CompareBoolPtr", GoImportPath: vtHelpersPackage},
+	"CompareBytesPresent":           {GoName: "CompareBytesPresent", GoImportPath: vtHelpersPackage},
+	"CompareMap":                    {GoName: "CompareMap", GoImportPath: vtHelpersPackage},
+	"ComparePtr":                    {GoName: "ComparePtr", GoImportPath: vtHelpersPackage},
+	"CompareCustom":                 {GoName: "CompareCustom", GoImportPath: vtHelpersPackage},
+	"CompareCustomSlice":            {GoName: "CompareCustomSlice", GoImportPath: vtHelpersPackage},
+	"CompareStd":                    {GoName: "CompareStd", GoImportPath: vtHelpersPackage},
+	"CompareStdPtr":                 {GoName: "CompareStdPtr", GoImportPath: vtHelpersPackage},
+	"CompareVTImplicit":             {GoName: "CompareVTImplicit", GoImportPath: vtHelpersPackage},
+	"CompareVTMap":                  {GoName: "CompareVTMap", GoImportPath: vtHelpersPackage},
+	"CompareVTSlice":                {GoName: "CompareVTSlice", GoImportPath: vtHelpersPackage},
+	"CopyBytes":                     {GoName: "CopyBytes", GoImportPath: vtHelpersPackage},
+	"CopyBytesMap":                  {GoName: "CopyBytesMap", GoImportPath: vtHelpersPackage},
+	"CopyBytesSlice":                {GoName: "CopyBytesSlice", GoImportPath: vtHelpersPackage},
+	"CopyMap":                       {GoName: "CopyMap", GoImportPath: vtHelpersPackage},
+	"CopyPtr":                       {GoName: "CopyPtr", GoImportPath: vtHelpersPackage},
+	"CopySlice":                     {GoName: "CopySlice", GoImportPath: vtHelpersPackage},
+	"CopyCustom":                    {GoName: "CopyCustom", GoImportPath: vtHelpersPackage},
+	"CopyCustomSlice":               {GoName: "CopyCustomSlice", GoImportPath: vtHelpersPackage},
+	"CopyStd":                       {GoName: "CopyStd", GoImportPath: vtHelpersPackage},
+	"CopyStdMap":                    {GoName: "CopyStdMap", GoImportPath: vtHelpersPackage},
+	"CopyStdPtr":                    {GoName: "CopyStdPtr", GoImportPath: vtHelpersPackage},
+	"CopyStdSlice":                  {GoName: "CopyStdSlice", GoImportPath: vtHelpersPackage},
+	"CopyVTMap":                     {GoName: "CopyVTMap", GoImportPath: vtHelpersPackage},
+	"CopyVTSlice":                   {GoName: "CopyVTSlice", GoImportPath: vtHelpersPackage},
+	"CopyVTValue":                   {GoName: "CopyVTValue", GoImportPath: vtHelpersPackage},
+	"FieldDiff":                     {GoName: "FieldDiff", GoImportPath: vtHelpersPackage},
 	"EqualBytes":                    {GoName: "EqualBytes", GoImportPath: vtHelpersPackage},
+	"EqualOptions":                  {GoName: "EqualOptions", GoImportPath: vtHelpersPackage},
+	"EqualOptsFloat":                {GoName: "EqualOptsFloat", GoImportPath: vtHelpersPackage},
+	"EqualOptsFloatMap":             {GoName: "EqualOptsFloatMap", GoImportPath: vtHelpersPackage},
+	"EqualOptsFloatPtr":             {GoName: "EqualOptsFloatPtr", GoImportPath: vtHelpersPackage},
+	"EqualOptsFloatSlice":           {GoName: "EqualOptsFloatSlice", GoImportPath: vtHelpersPackage},
+	"EqualOptsStd":                  {GoName: "EqualOptsStd", GoImportPath: vtHelpersPackage},
+	"EqualOptsStdMap":               {GoName: "EqualOptsStdMap", GoImportPath: vtHelpersPackage},
+	"EqualOptsStdPtr":               {GoName: "EqualOptsStdPtr", GoImportPath: vtHelpersPackage},
+	"EqualOptsStdSlice":             {GoName: "EqualOptsStdSlice", GoImportPath: vtHelpersPackage},
+	"EqualVTOptsImplicit":           {GoName: "EqualVTOptsImplicit", GoImportPath: vtHelpersPackage},
+	"EqualVTOptsMap":                {GoName: "EqualVTOptsMap", GoImportPath: vtHelpersPackage},
+	"EqualVTOptsSlice":              {GoName: "EqualVTOptsSlice", GoImportPath: vtHelpersPackage},
+	"EqualVTOptsValue":              {GoName: "EqualVTOptsValue", GoImportPath: vtHelpersPackage},
+	"NewHasher":                     {GoName: "NewHasher", GoImportPath: vtHelpersPackage},
+	"NilEqualsEmpty":                {GoName: "NilEqualsEmpty", GoImportPath: vtHelpersPackage},
 	"EqualBytesMap":                 {GoName: "EqualBytesMap", GoImportPath: vtHelpersPackage},
 	"EqualBytesPresent":             {GoName: "EqualBytesPresent", GoImportPath: vtHelpersPackage},
 	"EqualBytesSlice":               {GoName: "EqualBytesSlice", GoImportPath: vtHelpersPackage},
 	"EqualMap":                      {GoName: "EqualMap", GoImportPath: vtHelpersPackage},
 	"EqualPtr":                      {GoName: "EqualPtr", GoImportPath: vtHelpersPackage},
 	"EqualSlice":                    {GoName: "EqualSlice", GoImportPath: vtHelpersPackage},
+	"EqualCustom":                   {GoName: "EqualCustom", GoImportPath: vtHelpersPackage},
+	"EqualCustomSlice":              {GoName: "EqualCustomSlice", GoImportPath: vtHelpersPackage},
+	"EqualStd":                      {GoName: "EqualStd", GoImportPath: vtHelpersPackage},
+	"EqualStdMap":                   {GoName: "EqualStdMap", GoImportPath: vtHelpersPackage},
+	"EqualStdPtr":                   {GoName: "EqualStdPtr", GoImportPath: vtHelpersPackage},
+	"EqualStdSlice":                 {GoName: "EqualStdSlice", GoImportPath: vtHelpersPackage},
 	"EqualVTImplicit":               {GoName: "EqualVTImplicit", GoImportPath: vtHelpersPackage},
 	"EqualVTMapImplicit":            {GoName: "EqualVTMapImplicit", GoImportPath: vtHelpersPackage},
 	"EqualVTSliceImplicit":          {GoName: "EqualVTSliceImplicit", GoImportPath: vtHelpersPackage},
+	"HashMap":                       {GoName: "HashMap", GoImportPath: vtHelpersPackage},
+	"HashCustom":                    {GoName: "HashCustom", GoImportPath: vtHelpersPackage},
+	"HashStd":                       {GoName: "HashStd", GoImportPath: vtHelpersPackage},
+	"Hasher":                        {GoName: "Hasher", GoImportPath: vtHelpersPackage},
 	"IsEqualVT":                     {GoName: "IsEqualVT", GoImportPath: vtHelpersPackage},
+	"LogMap":                        {GoName: "LogMap", GoImportPath: vtHelpersPackage},
+	"LogMaxDepth":                   {GoName: "LogMaxDepth", GoImportPath: vtHelpersPackage},
+	"LogMaxElements":                {GoName: "LogMaxElements", GoImportPath: vtHelpersPackage},
+	"LogMessage":                    {GoName: "LogMessage", GoImportPath: vtHelpersPackage},
+	"LogRedacted":                   {GoName: "LogRedacted", GoImportPath: vtHelpersPackage},
+	"LogSlice":                      {GoName: "LogSlice", GoImportPath: vtHelpersPackage},
+	"LogCustom":                     {GoName: "LogCustom", GoImportPath: vtHelpersPackage},
+	"LogStd":                        {GoName: "LogStd", GoImportPath: vtHelpersPackage},
 	"SizeBoolNonZero":               {GoName: "SizeBoolNonZero", GoImportPath: vtHelpersPackage},
 	"SizeBoolPacked":                {GoName: "SizeBoolPacked", GoImportPath: vtHelpersPackage},
 	"SizeBoolPtr":                   {GoName: "SizeBoolPtr", GoImportPath: vtHelpersPackage},
@@ -135,6 +254,9 @@ var helpers = map[string]protogen.GoIdent{
 	"SizeFixed64Value":              {GoName: "SizeFixed64Value", GoImportPath: vtHelpersPackage},
 	"SizeGroup":                     {GoName: "SizeGroup", GoImportPath: vtHelpersPackage},
 	"SizeMessage":                   {GoName: "SizeMessage", GoImportPath: vtHelpersPackage},
+	"SizeStdPtr":                    {GoName: "SizeStdPtr", GoImportPath: vtHelpersPackage},
+	"SizeStdSlice":                  {GoName: "SizeStdSlice", GoImportPath: vtHelpersPackage},
+	"SizeStdValue":                  {GoName: "SizeStdValue", GoImportPath: vtHelpersPackage},
 	"SizeStringNonEmpty":            {GoName: "SizeStringNonEmpty", GoImportPath: vtHelpersPackage},
 	"SizeStringPtr":                 {GoName: "SizeStringPtr", GoImportPath: vtHelpersPackage},
 	"SizeStringSlice":               {GoName: "SizeStringSlice", GoImportPath: vtHelpersPackage},
@@ -149,6 +271,13 @@ var helpers = map[string]protogen.GoIdent{
 	"SizeZigzagPtr":                 {GoName: "SizeZigzagPtr", GoImportPath: vtHelpersPackage},
 	"SizeZigzagSlice":               {GoName: "SizeZigzagSlice", GoImportPath: vtHelpersPackage},
 	"SizeZigzagValue":               {GoName: "SizeZigzagValue", GoImportPath: vtHelpersPackage},
+	"TableMarshal":                  {GoName: "TableMarshal", GoImportPath: vtHelpersPackage},
+	"TableMarshalStrict":            {GoName: "TableMarshalStrict", GoImportPath: vtHelpersPackage},
+	"TableSize":                     {GoName: "TableSize", GoImportPath: vtHelpersPackage},
+	"TableUnmarshal":                {GoName: "TableUnmarshal", GoImportPath: vtHelpersPackage},
+	"TableUnmarshalUnsafe":          {GoName: "TableUnmarshalUnsafe", GoImportPath: vtHelpersPackage},
+	"TableUnmarshalFields":          {GoName: "TableUnmarshalFields", GoImportPath: vtHelpersPackage},
+	"TableUnmarshalFieldsUnsafe":    {GoName: "TableUnmarshalFieldsUnsafe", GoImportPath: vtHelpersPackage},
 	"TextBuilder":                   {GoName: "TextBuilder", GoImportPath: vtHelpersPackage},
 	"TextFinishMessage":             {GoName: "TextFinishMessage", GoImportPath: vtHelpersPackage},
 	"TextSortedMapKeys":             {GoName: "TextSortedMapKeys", GoImportPath: vtHelpersPackage},
@@ -166,6 +295,10 @@ var helpers = map[string]protogen.GoIdent{
 	"TextWriteMapEntryPrefix":       {GoName: "TextWriteMapEntryPrefix", GoImportPath: vtHelpersPackage},
 	"TextWriteMapKeyValueSeparator": {GoName: "TextWriteMapKeyValueSeparator", GoImportPath: vtHelpersPackage},
 	"TextWriteMapStart":             {GoName: "TextWriteMapStart", GoImportPath: vtHelpersPackage},
+	"PresentPtr":                    {GoName: "PresentPtr", GoImportPath: vtHelpersPackage},
+	"TextWriteCustom":               {GoName: "TextWriteCustom", GoImportPath: vtHelpersPackage},
+	"CustomBytes":                   {GoName: "CustomBytes", GoImportPath: vtHelpersPackage},
+	"TextWriteStd":                  {GoName: "TextWriteStd", GoImportPath: vtHelpersPackage},
 	"TextWriteString":               {GoName: "TextWriteString", GoImportPath: vtHelpersPackage},
 	"TextWriteStringer":             {GoName: "TextWriteStringer", GoImportPath: vtHelpersPackage},
 	"TextWriteTextMarshaler":        {GoName: "TextWriteTextMarshaler", GoImportPath: vtHelpersPackage},
@@ -70,6 +70,7 @@ type CodegenMode string
 const (
 	CodegenModeHelper   CodegenMode = "helper"
 	CodegenModeUnrolled CodegenMode = "unrolled"
+	CodegenModeTable    CodegenMode = "table"
 )
 
 func (c *Config) SetCodegenMode(mode string) error {
@@ -80,6 +81,9 @@ func (c *Config) SetCodegenMode(mode string) error {
 	case CodegenModeUnrolled:
 		c.CodegenMode = CodegenModeUnrolled
 		return nil
+	case CodegenModeTable:
+		c.CodegenMode = CodegenModeTable
+		return nil
 	default:
 		return fmt.Errorf("unknown codegen mode: %q", mode)
 	}
@@ -89,10 +93,16 @@ func (c *Config) HelperCodegen() bool {
 	return c == nil || c.CodegenMode != CodegenModeUnrolled
 }
 
+// TableCodegen reports whether size, marshal, and unmarshal use static field
+// tables interpreted by the runtime package.
+func (c *Config) TableCodegen() bool {
+	return c != nil && c.CodegenMode == CodegenModeTable
+}
+
 type Generator struct {
 	plugin   *protogen.Plugin
 	cfg      *Config
-	features []Feature
+	features []string
 	local    map[protoreflect.FullName]bool
 }
 
@@ -113,6 +123,21 @@ func NewGenerator(plugin *protogen.Plugin, featureNames []string, cfg *Config) (
 			return nil, err
 		}
 	}
+	if cfg.TableCodegen() {
+		if err := validateTableFeatures(featureNames); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyGoNames(plugin); err != nil {
+		return nil, err
+	}
+	if err := checkEmbeddedCycles(plugin); err != nil {
+		return nil, err
+	}
+	if err := checkGoTypes(plugin); err != nil {
+		return nil, err
+	}
 
 	local := make(map[protoreflect.FullName]bool)
 	for _, f := range plugin.Files {
@@ -130,6 +155,11 @@ func NewGenerator(plugin *protogen.Plugin, featureNames []string, cfg *Config) (
 }
 
 func (gen *Generator) Generate() {
+	sel, err := selectFeatures(gen.plugin, gen.features, gen.cfg)
+	if err != nil {
+		gen.plugin.Error(err)
+		return
+	}
 	for _, file := range gen.plugin.Files {
 		if !file.Generate {
 			continue
@@ -145,6 +175,8 @@ func (gen *Generator) Generate() {
 			GeneratedFile: gf,
 			Config:        gen.cfg,
 			LocalPackages: gen.local,
+			plugin:        gen.plugin,
+			sel:           sel,
 		}
 
 		// Generate header
@@ -154,7 +186,7 @@ func (gen *Generator) Generate() {
 		generator_base.GenerateFile(gen.plugin, file, gf)
 
 		// Generate vtproto features
-		gen.generateFile(p, file)
+		gen.generateFile(p, file, sel)
 
 		if gen.cfg.Registry {
 			gen.generateRegistry(p, file)
@@ -179,9 +211,10 @@ func (gen *Generator) generateHeader(p *GeneratedFile, file *protogen.File) {
 	p.P()
 }
 
-func (gen *Generator) generateFile(p *GeneratedFile, file *protogen.File) {
-	for _, feat := range gen.features {
+func (gen *Generator) generateFile(p *GeneratedFile, file *protogen.File, sel *featureSelection) {
+	for _, name := range sel.fileFeatures(file) {
+		feat, _ := lookupFeature(name)
 		featGenerator := feat(p)
-		_ = featGenerator.GenerateFile(file)
+		_ = featGenerator.GenerateFile(sel.view(file, name))
 	}
 }
@@ -0,0 +1,214 @@
+// Package generatortest runs the generator in memory, for testing features
+// registered with generator.RegisterFeature without protoc.
+//
+// Generate compiles .proto sources with the built-in compiler and returns the
+// generated files, and Sandbox builds and tests them in a temporary Go module.
+// The built-in features are registered, so "all" selects the same features as
+// protoc-gen-go-lite; features under test are registered by their package as
+// usual.
+package generatortest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing/fstest"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protoparse"
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	// Register the built-in features, as protoc-gen-go-lite does.
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/all"
+)
+
+// Options configure a generator run.
+type Options struct {
+	// Features are the features to generate, as named by the features
+	// parameter. If empty, "all" is generated.
+	Features []string
+	// Config configures the generator. If nil, the defaults of
+	// protoc-gen-go-lite are used.
+	Config *generator.Config
+	// Params are plugin parameters handled by protogen, such as
+	// "paths=source_relative", "module=example.com/foo" or M mappings.
+	Params []string
+	// Files are the files to generate. If empty, every file outside
+	// google/protobuf/ is generated.
+	Files []string
+}
+
+// Generate compiles the .proto sources, keyed by their slash-separated path
+// relative to the import path, and generates code for them. The well-known
+// types may be imported without being in sources. It returns the contents of
+// the generated files keyed by name.
+func Generate(sources map[string]string, opts *Options) (map[string]string, error) {
+	fsys := make(fstest.MapFS, len(sources))
+	names := make([]string, 0, len(sources))
+	for name, src := range sources {
+		fsys[name] = &fstest.MapFile{Data: []byte(src)}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	c := &protoparse.Compiler{FS: fsys}
+	set, err := c.Compile(names...)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateFromDescriptors(set, opts)
+}
+
+// GenerateFromDescriptors generates code for the files of set, which must
+// hold the files with their imports, each after the files it imports, as
+// written by protoc -o --include_imports. It returns the contents of the
+// generated files keyed by name.
+func GenerateFromDescriptors(set *descriptorpb.FileDescriptorSet, opts *Options) (map[string]string, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: opts.Files,
+		ProtoFile:      set.GetFile(),
+	}
+	if len(opts.Files) == 0 {
+		for _, file := range set.GetFile() {
+			if !generator.IsWellKnownFile(file.GetName()) {
+				req.FileToGenerate = append(req.FileToGenerate, file.GetName())
+			}
+		}
+	}
+	if len(opts.Params) != 0 {
+		req.Parameter = proto.String(strings.Join(opts.Params, ","))
+	}
+	plugin, err := protogen.Options{
+		ParamFunc: func(name, _ string) error {
+			return fmt.Errorf("unknown parameter %q", name)
+		},
+	}.New(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := opts.Config
+	if cfg == nil {
+		cfg = &generator.Config{CodegenMode: generator.CodegenModeHelper}
+	}
+	features := opts.Features
+	if len(features) == 0 {
+		features = []string{"all"}
+	}
+	gen, err := generator.NewGenerator(plugin, features, cfg)
+	if err != nil {
+		return nil, err
+	}
+	gen.Generate()
+
+	resp := plugin.Response()
+	if resp.Error != nil {
+		return nil, errors.New(resp.GetError())
+	}
+	files := make(map[string]string, len(resp.GetFile()))
+	for _, file := range resp.GetFile() {
+		files[file.GetName()] = file.GetContent()
+	}
+	return files, nil
+}
+
+// Sandbox is a Go module in a directory, for building and testing generated
+// code. It requires protobuf-go-lite and the module of the working directory,
+// replaced by their local directories, so the generated code builds against
+// the runtime and features under test.
+type Sandbox struct {
+	// Dir is the directory of the module.
+	Dir string
+	// Module is the module path.
+	Module string
+}
+
+// NewSandbox writes the go.mod of a module named module to dir, which is
+// usually a testing.T TempDir.
+func NewSandbox(dir, module string) (*Sandbox, error) {
+	requires, err := localModules()
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "module %s\n\ngo %s\n", module, requires[0].goVersion)
+	for _, m := range requires {
+		fmt.Fprintf(&b, "\nrequire %s v0.0.0\n\nreplace %s => %s\n", m.path, m.path, m.dir)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(b.String()), 0o644); err != nil {
+		return nil, err
+	}
+	return &Sandbox{Dir: dir, Module: module}, nil
+}
+
+// WriteFiles writes files, keyed by slash-separated path relative to the
+// module directory, such as the result of Generate and test files using it.
+func (s *Sandbox) WriteFiles(files map[string]string) error {
+	for name, content := range files {
+		path := filepath.Join(s.Dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GoTest runs go test with args, or ./... if none, in the module and returns
+// its combined output. The error is non-nil if the code does not build or a
+// test fails.
+func (s *Sandbox) GoTest(args ...string) (string, error) {
+	if len(args) == 0 {
+		args = []string{"./..."}
+	}
+	cmd := exec.Command("go", append([]string{"test", "-mod=mod"}, args...)...)
+	cmd.Dir = s.Dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("go test: %w", err)
+	}
+	return string(out), nil
+}
+
+// localModule is a module required by a sandbox.
+type localModule struct {
+	path, dir, goVersion string
+}
+
+// localModules returns the main module of the working directory and, if it is
+// another module, protobuf-go-lite.
+func localModules() ([]localModule, error) {
+	const liteModule = "github.com/aperturerobotics/protobuf-go-lite"
+	var modules []localModule
+	for _, args := range [][]string{{}, {liteModule}} {
+		cmd := exec.Command("go", append([]string{"list", "-m", "-f", "{{.Path}}\t{{.Dir}}\t{{.GoVersion}}"}, args...)...)
+		out, err := cmd.Output()
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return nil, fmt.Errorf("go list -m %s: %s", strings.Join(args, " "), exitErr.Stderr)
+			}
+			return nil, err
+		}
+		fields := strings.Split(strings.TrimSpace(string(out)), "\t")
+		if len(fields) != 3 || fields[1] == "" {
+			return nil, fmt.Errorf("go list -m %s: unexpected output %q", strings.Join(args, " "), out)
+		}
+		m := localModule{path: fields[0], dir: fields[1], goVersion: fields[2]}
+		if len(modules) == 0 || modules[0].path != m.path {
+			modules = append(modules, m)
+		}
+	}
+	return modules, nil
+}
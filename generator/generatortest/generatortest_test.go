@@ -0,0 +1,155 @@
+package generatortest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+const demoProto = `syntax = "proto3";
+
+package demo;
+
+import "google/protobuf/timestamp.proto";
+
+option go_package = "example.com/demo;demo";
+
+message Event {
+  string name = 1;
+  google.protobuf.Timestamp at = 2;
+}
+`
+
+const demoTest = `package demo
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	data, err := (&Event{Name: "x"}).MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Event
+	if err := got.UnmarshalVT(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetName() != "x" || got.Greeting() != "hello from Event" {
+		t.Fatalf("got %q, %q", got.GetName(), got.Greeting())
+	}
+}
+`
+
+// greeting is a feature generating a Greeting method for each message.
+type greeting struct {
+	*generator.GeneratedFile
+}
+
+func (g *greeting) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		g.P(`func (m *`, message.GoIdent.GoName, `) Greeting() string {`)
+		g.P(`return "hello from `, message.GoIdent.GoName, `"`)
+		g.P(`}`)
+	}
+	return len(file.Messages) != 0
+}
+
+func init() {
+	generator.RegisterOptionalFeature("test_greeting", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &greeting{GeneratedFile: gen}
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	files, err := generatortest.Generate(map[string]string{"demo.proto": demoProto}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("generated %d files, want 1", len(files))
+	}
+	out, ok := files["example.com/demo/demo.pb.go"]
+	if !ok {
+		t.Fatalf("generated files %v, want example.com/demo/demo.pb.go", files)
+	}
+	for _, want := range []string{"func (m *Event) MarshalVT()", "func (m *Event) CloneVT()", "func (this *Event) EqualVT("} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated file does not contain %q", want)
+		}
+	}
+	if strings.Contains(out, "Greeting()") {
+		t.Error("optional feature generated without being selected")
+	}
+}
+
+func TestGenerateFeature(t *testing.T) {
+	files, err := generatortest.Generate(map[string]string{"demo.proto": demoProto}, &generatortest.Options{
+		Features: []string{"test_greeting"},
+		Params:   []string{"paths=source_relative"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := files["demo.pb.go"]
+	if !strings.Contains(out, `return "hello from Event"`) {
+		t.Fatalf("feature output missing:\n%s", out)
+	}
+	if strings.Contains(out, "MarshalVT") {
+		t.Error("unselected features generated")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		sources map[string]string
+		opts    *generatortest.Options
+		want    string
+	}{{
+		sources: map[string]string{"bad.proto": "syntax = \"proto3\";\nmessage Bad {\n  Missing m = 1;\n}\n"},
+		want:    `bad.proto:3:3: "Missing" is not defined.`,
+	}, {
+		sources: map[string]string{"demo.proto": demoProto},
+		opts:    &generatortest.Options{Features: []string{"missing"}},
+		want:    `unknown feature: "missing"`,
+	}, {
+		sources: map[string]string{"demo.proto": demoProto},
+		opts:    &generatortest.Options{Params: []string{"unknown=1"}},
+		want:    `unknown parameter "unknown"`,
+	}} {
+		_, err := generatortest.Generate(tc.sources, tc.opts)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("Generate(%v) = %v, want %s", tc.opts, err, tc.want)
+		}
+	}
+}
+
+func TestSandbox(t *testing.T) {
+	files, err := generatortest.Generate(map[string]string{"demo.proto": demoProto}, &generatortest.Options{
+		Features: []string{"all", "test_greeting"},
+		Params:   []string{"paths=source_relative"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["demo_test.go"] = demoTest
+
+	sandbox, err := generatortest.NewSandbox(t.TempDir(), "example.com/demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sandbox.WriteFiles(files); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := sandbox.GoTest(); err != nil {
+		t.Fatalf("%v:\n%s", err, out)
+	}
+
+	if err := sandbox.WriteFiles(map[string]string{"fail_test.go": "package demo\n\nimport \"testing\"\n\nfunc TestFail(t *testing.T) { t.Fatal(\"failed\") }\n"}); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := sandbox.GoTest("-run", "TestFail", "."); err == nil || !strings.Contains(out, "failed") {
+		t.Fatalf("failing test passed: %v\n%s", err, out)
+	}
+}
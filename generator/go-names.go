@@ -0,0 +1,304 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// applyGoNames renames the messages and fields of every file of plugin with a
+// Go name set by an option or the goname directive, and checks the struct tags
+// set on their fields. It then shortens the names of enum values as set by the
+// enum prefix options and directives, and checks that the names declared by
+// each Go package are unique. It runs before any code is generated, so that
+// the files referring to a renamed declaration use its new name.
+func applyGoNames(plugin *protogen.Plugin) error {
+	for _, file := range plugin.Files {
+		for _, message := range file.Messages {
+			if err := applyMessageGoNames(message); err != nil {
+				return err
+			}
+		}
+		if err := applyEnumGoNames(file); err != nil {
+			return err
+		}
+	}
+	return checkPackageGoNames(plugin)
+}
+
+// applyMessageGoNames applies the Go names of message, its fields and its
+// nested messages.
+func applyMessageGoNames(message *protogen.Message) error {
+	if name := fieldsem.MessageGoName(message); name != "" {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return errorAt(message.Desc, "Go name %q is not an exported identifier", name)
+		}
+		renameMessage(message, name)
+	}
+
+	// used holds the Go names of the struct fields and methods of message.
+	used := make(map[string]string, len(reservedMethods))
+	if !message.Desc.IsMapEntry() {
+		for _, name := range reservedMethods {
+			used[name] = "a generated method"
+		}
+	}
+	declare := func(desc protoreflect.Descriptor, name string) error {
+		getter := "Get" + name
+		if other, ok := used[name]; ok {
+			return errorAt(desc, "Go name %q is also used by %s", name, other)
+		}
+		if other, ok := used[getter]; ok {
+			return errorAt(desc, "Go name %q of the getter is also used by %s", getter, other)
+		}
+		used[name] = string(desc.Name())
+		used[getter] = "the getter of " + string(desc.Name())
+		return nil
+	}
+	for _, oneof := range message.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			continue
+		}
+		if err := declare(oneof.Desc, oneof.GoName); err != nil {
+			return err
+		}
+	}
+	for _, field := range message.Fields {
+		if _, err := fieldsem.GoTags(field); err != nil {
+			return errorAt(field.Desc, "%w", err)
+		}
+		if name := fieldsem.GoName(field); name != "" {
+			if !token.IsIdentifier(name) || !token.IsExported(name) {
+				return errorAt(field.Desc, "Go name %q is not an exported identifier", name)
+			}
+			field.GoName = name
+			field.GoIdent.GoName = message.GoIdent.GoName + "_" + name
+		}
+		if err := declare(field.Desc, field.GoName); err != nil {
+			return err
+		}
+		if fieldsem.HasPresenceMethods(field) {
+			for _, method := range []string{"Has", "Set", "Clear"} {
+				name := method + field.GoName
+				if other, ok := used[name]; ok {
+					return errorAt(field.Desc, "Go name %q of the %s method is also used by %s", name, method, other)
+				}
+				used[name] = "the " + method + " method of " + string(field.Desc.Name())
+			}
+		}
+	}
+
+	for _, nested := range message.Messages {
+		if err := applyMessageGoNames(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservedMethods are the names of the methods generated for messages by the
+// built-in features, whether or not they are enabled, which the Go names of
+// fields and their getters must not take. The sql feature checks the names of
+// its Scan and Value methods itself, as value is a common field name.
+var reservedMethods = []string{
+	"Reset", "String", "ProtoMessage",
+	"SizeVT",
+	"MarshalVT", "MarshalToVT", "MarshalToSizedBufferVT",
+	"MarshalVTStrict", "MarshalToVTStrict", "MarshalToSizedBufferVTStrict",
+	"UnmarshalVT", "UnmarshalVTUnsafe", "UnmarshalVTFields", "UnmarshalVTFieldsUnsafe",
+	"GetUnknownFieldsVT", "SetUnknownFieldsVT", "DiscardUnknownVT",
+	"CloneVT", "CloneMessageVT", "CopyVT",
+	"EqualVT", "EqualMessageVT", "EqualVTOpts", "EqualVTOptsPrefix",
+	"CompareVT", "DiffVT", "AppendDiffVT",
+	"HashVT", "Hash64VT", "WriteHashVT",
+	"MergeVT", "MergeMessageVT", "RedactVT",
+	"MarshalJSON", "UnmarshalJSON", "MarshalProtoJSON", "UnmarshalProtoJSON",
+	"MarshalProtoText", "LogValue", "LogValueVT",
+	"MarshalBinary", "UnmarshalBinary", "AppendBinary",
+}
+
+// errorAt returns an error about desc, prefixed with the position of desc in
+// its .proto file if the source info is known.
+func errorAt(desc protoreflect.Descriptor, format string, args ...any) error {
+	err := fmt.Errorf("%v: "+format, append([]any{desc.FullName()}, args...)...)
+	file := desc.ParentFile()
+	if file == nil {
+		return err
+	}
+	loc := file.SourceLocations().ByDescriptor(desc)
+	if loc.Path == nil {
+		return err
+	}
+	return fmt.Errorf("%s:%d:%d: %w", file.Path(), loc.StartLine+1, loc.StartColumn+1, err)
+}
+
+// renameMessage sets the Go type name of message, and of the declarations
+// nested in it, which are prefixed with the name of the message.
+func renameMessage(message *protogen.Message, name string) {
+	from, to := message.GoIdent.GoName+"_", name+"_"
+	message.GoIdent.GoName = name
+	renameNested(message, from, to)
+}
+
+// renameNested replaces the prefix from of the Go names declared in message by
+// to.
+func renameNested(message *protogen.Message, from, to string) {
+	rename := func(ident *protogen.GoIdent) {
+		if rest, ok := strings.CutPrefix(ident.GoName, from); ok {
+			ident.GoName = to + rest
+		}
+	}
+	for _, enum := range message.Enums {
+		rename(&enum.GoIdent)
+		for _, value := range enum.Values {
+			rename(&value.GoIdent)
+		}
+	}
+	for _, field := range message.Fields {
+		rename(&field.GoIdent)
+	}
+	for _, oneof := range message.Oneofs {
+		rename(&oneof.GoIdent)
+	}
+	for _, nested := range message.Messages {
+		rename(&nested.GoIdent)
+		renameNested(nested, from, to)
+	}
+}
+
+// applyEnumGoNames drops the prefixes of the enum value names of file selected
+// by the enum prefix options and directives.
+func applyEnumGoNames(file *protogen.File) error {
+	enums := slices.Clone(file.Enums)
+	var walk func([]*protogen.Message)
+	walk = func(list []*protogen.Message) {
+		for _, message := range list {
+			enums = append(enums, message.Enums...)
+			walk(message.Messages)
+		}
+	}
+	walk(file.Messages)
+
+	for _, enum := range enums {
+		if err := stripEnumPrefixes(enum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPackageGoNames checks that the Go types and enum values declared by the
+// files of plugin are unique among the files sharing their Go package. These
+// are the types of the messages, enums and oneof wrappers, the enum value
+// constants and the Range functions of repeated message fields.
+func checkPackageGoNames(plugin *protogen.Plugin) error {
+	packages := make(map[protogen.GoImportPath]map[string]string)
+	for _, file := range plugin.Files {
+		used := packages[file.GoImportPath]
+		if used == nil {
+			used = make(map[string]string)
+			packages[file.GoImportPath] = used
+		}
+		declare := func(desc protoreflect.Descriptor, ident protogen.GoIdent) error {
+			if other, ok := used[ident.GoName]; ok {
+				return errorAt(desc, "Go name %q is also used by %s", ident.GoName, other)
+			}
+			used[ident.GoName] = string(desc.FullName())
+			return nil
+		}
+		declareEnums := func(enums []*protogen.Enum) error {
+			for _, enum := range enums {
+				if err := declare(enum.Desc, enum.GoIdent); err != nil {
+					return err
+				}
+				for _, value := range enum.Values {
+					if err := declare(value.Desc, value.GoIdent); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+		var declareMessages func([]*protogen.Message) error
+		declareMessages = func(messages []*protogen.Message) error {
+			for _, message := range messages {
+				if err := declare(message.Desc, message.GoIdent); err != nil {
+					return err
+				}
+				for _, field := range message.Fields {
+					if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+						if err := declare(field.Desc, field.GoIdent); err != nil {
+							return err
+						}
+					}
+					if fieldsem.HasRange(field) {
+						if err := declare(field.Desc, protogen.GoIdent{GoName: fieldsem.RangeGoName(field)}); err != nil {
+							return err
+						}
+					}
+				}
+				if err := declareEnums(message.Enums); err != nil {
+					return err
+				}
+				if err := declareMessages(message.Messages); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := declareEnums(file.Enums); err != nil {
+			return err
+		}
+		if err := declareMessages(file.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stripEnumPrefixes drops the prefixes of the Go names of the values of enum
+// selected by its options and directives.
+func stripEnumPrefixes(enum *protogen.Enum) error {
+	stripType, stripValue := fieldsem.StripEnumPrefix(enum), fieldsem.StripValuePrefix(enum)
+	if !stripType && !stripValue {
+		return nil
+	}
+	var valuePrefix string
+	if stripValue {
+		valuePrefix = sharedValuePrefix(enum)
+	}
+	for _, value := range enum.Values {
+		name := string(value.Desc.Name())
+		typePrefix := strings.TrimSuffix(value.GoIdent.GoName, name)
+		if stripType {
+			typePrefix = ""
+		}
+		rest := strings.TrimPrefix(name, valuePrefix)
+		goName := typePrefix + rest
+		if rest == "" || !token.IsIdentifier(goName) || (stripType && !token.IsExported(goName)) {
+			return errorAt(value.Desc, "Go name %q is not an exported identifier", goName)
+		}
+		value.GoIdent.GoName = goName
+	}
+	return nil
+}
+
+// sharedValuePrefix returns the prefix shared by the value names of enum, up
+// to and including its last underscore.
+func sharedValuePrefix(enum *protogen.Enum) string {
+	if len(enum.Values) == 0 {
+		return ""
+	}
+	prefix := string(enum.Values[0].Desc.Name())
+	for _, value := range enum.Values[1:] {
+		for !strings.HasPrefix(string(value.Desc.Name()), prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix[:strings.LastIndexByte(prefix, '_')+1]
+}
@@ -0,0 +1,102 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+func TestGoNamesErrors(t *testing.T) {
+	for _, tc := range []struct {
+		proto string
+		want  string
+	}{{
+		proto: "message A {\n  // protobuf-go-lite:goname=b\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: Go name "b" is not an exported identifier`,
+	}, {
+		proto: "// protobuf-go-lite:goname=Not-A-Name\nmessage A {}\n",
+		want:  `errtest.proto:5:1: errtest.A: Go name "Not-A-Name" is not an exported identifier`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=B\n  int32 a = 1;\n  int32 b = 2;\n}\n",
+		want:  `errtest.proto:7:3: errtest.A.b: Go name "B" is also used by a`,
+	}, {
+		proto: "message A {\n  oneof c {\n    // protobuf-go-lite:goname=C\n    int32 a = 1;\n  }\n}\n",
+		want:  `errtest.proto:7:5: errtest.A.a: Go name "C" is also used by c`,
+	}, {
+		proto: "// protobuf-go-lite:goname=B\nmessage A {}\nmessage B {}\n",
+		want:  `errtest.proto:6:1: errtest.B: Go name "B" is also used by errtest.A`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=GetY\n  int32 x = 1;\n  int32 y = 2;\n}\n",
+		want:  `errtest.proto:7:3: errtest.A.y: Go name "GetY" of the getter is also used by x`,
+	}, {
+		proto: "message A {\n  oneof c {\n    // protobuf-go-lite:goname=B\n    int32 x = 1;\n  }\n  message B {}\n}\n",
+		want:  `errtest.proto:9:3: errtest.A.B: Go name "A_B" is also used by errtest.A.x`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:gotags=db:id\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: malformed struct tags "db:id"`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:gotags=db:\"id\" yaml:\"id\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: malformed struct tags "db:\"id\" yaml:\"id"`,
+	}, {
+		proto: "// protobuf-go-lite:stripenumprefix\nenum A {\n  B_X = 0;\n}\nenum B {\n  X = 0;\n}\n",
+		want:  `errtest.proto:9:3: errtest.X: Go name "B_X" is also used by errtest.B_X`,
+	}, {
+		proto: "// protobuf-go-lite:stripenumprefix\nenum A {\n  x = 0;\n}\n",
+		want:  `errtest.proto:6:3: errtest.x: Go name "x" is not an exported identifier`,
+	}, {
+		proto: "// protobuf-go-lite:stripvalueprefix\nenum A {\n  A_ = 0;\n  A_X = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A_: Go name "A_" is not an exported identifier`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=SizeVT\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: Go name "SizeVT" is also used by a generated method`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=Reset\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: Go name "Reset" is also used by a generated method`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=UnknownFieldsVT\n  int32 a = 1;\n}\n",
+		want:  `errtest.proto:6:3: errtest.A.a: Go name "GetUnknownFieldsVT" of the getter is also used by a generated method`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:nullable=false\n  // protobuf-go-lite:presence\n  B b = 1;\n  // protobuf-go-lite:goname=HasB\n  int32 c = 2;\n}\nmessage B {}\n",
+		want:  `errtest.proto:9:3: errtest.A.c: Go name "HasB" is also used by the Has method of b`,
+	}, {
+		proto: "message A {\n  // protobuf-go-lite:goname=ClearB\n  int32 c = 1;\n  // protobuf-go-lite:nullable=false\n  // protobuf-go-lite:presence\n  B b = 2;\n}\nmessage B {}\n",
+		want:  `errtest.proto:9:3: errtest.A.b: Go name "ClearB" of the Clear method is also used by c`,
+	}, {
+		proto: "message A {\n  repeated B items = 1;\n}\nmessage B {}\nmessage RangeAItemsVT {}\n",
+		want:  `errtest.proto:8:1: errtest.RangeAItemsVT: Go name "RangeAItemsVT" is also used by errtest.A.items`,
+	}} {
+		src := "syntax = \"proto3\";\npackage errtest;\noption go_package = \"example.com/errtest\";\n" + tc.proto
+		_, err := generatortest.Generate(map[string]string{"errtest.proto": src}, nil)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q) = %v, want %s", tc.proto, err, tc.want)
+		}
+	}
+}
+
+func TestGoNamesPackageErrors(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want string
+	}{{
+		a:    "// protobuf-go-lite:stripenumprefix\nenum Level {\n  Info = 0;\n}\n",
+		b:    "message Info {}\n",
+		want: `b.proto:4:1: errtest.b.Info: Go name "Info" is also used by errtest.a.Info`,
+	}, {
+		a:    "// protobuf-go-lite:stripenumprefix\nenum Level {\n  UNKNOWN = 0;\n}\n",
+		b:    "// protobuf-go-lite:stripenumprefix\nenum Kind {\n  UNKNOWN = 0;\n}\n",
+		want: `b.proto:6:3: errtest.b.UNKNOWN: Go name "UNKNOWN" is also used by errtest.a.UNKNOWN`,
+	}, {
+		a:    "message Level {}\n",
+		b:    "// protobuf-go-lite:goname=Level\nmessage Kind {}\n",
+		want: `b.proto:5:1: errtest.b.Kind: Go name "Level" is also used by errtest.a.Level`,
+	}} {
+		const header = "syntax = \"proto3\";\noption go_package = \"example.com/errtest\";\n"
+		_, err := generatortest.Generate(map[string]string{
+			"a.proto": header + "package errtest.a;\n" + tc.a,
+			"b.proto": header + "package errtest.b;\n" + tc.b,
+		}, nil)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q, %q) = %v, want %s", tc.a, tc.b, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+)
+
+// checkGoTypes returns an error if a field of plugin has a customtype or
+// casttype directive that does not apply to it.
+func checkGoTypes(plugin *protogen.Plugin) error {
+	var visit func([]*protogen.Message) error
+	visit = func(messages []*protogen.Message) error {
+		for _, message := range messages {
+			for _, field := range message.Fields {
+				if err := fieldsem.CheckGoType(field); err != nil {
+					return fmt.Errorf("%v: %w", field.Desc.FullName(), err)
+				}
+			}
+			if err := visit(message.Messages); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, file := range plugin.Files {
+		if err := visit(file.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
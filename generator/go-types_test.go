@@ -0,0 +1,45 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+func TestGoTypeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		syntax string
+		proto  string
+		want   string
+	}{{
+		syntax: "proto3",
+		proto:  "message A {\n  // protobuf-go-lite:customtype=Money\n  map<string, string> a = 1;\n}\n",
+		want:   `errtest.A.a: customtype directive applies only to bytes and string fields`,
+	}, {
+		syntax: "proto3",
+		proto:  "message A {\n  // protobuf-go-lite:customtype=Money\n  bool a = 1;\n}\n",
+		want:   `errtest.A.a: customtype directive applies only to bytes and string fields`,
+	}, {
+		syntax: "proto2",
+		proto:  "message A {\n  // protobuf-go-lite:customtype=Money\n  optional string a = 1 [default = \"x\"];\n}\n",
+		want:   `errtest.A.a: customtype directive does not apply to a field with a default value`,
+	}, {
+		syntax: "proto3",
+		proto:  "message A {\n  // protobuf-go-lite:casttype=Cents\n  map<int64, int64> a = 1;\n}\n",
+		want:   `errtest.A.a: casttype directive applies only to integer fields`,
+	}, {
+		syntax: "proto3",
+		proto:  "message A {\n  // protobuf-go-lite:casttype=Cents\n  double a = 1;\n}\n",
+		want:   `errtest.A.a: casttype directive applies only to integer fields`,
+	}, {
+		syntax: "proto3",
+		proto:  "enum E {\n  E_X = 0;\n}\nmessage A {\n  // protobuf-go-lite:casttype=Cents\n  E a = 1;\n}\n",
+		want:   `errtest.A.a: casttype directive applies only to integer fields`,
+	}} {
+		src := "syntax = \"" + tc.syntax + "\";\npackage errtest;\noption go_package = \"example.com/errtest\";\n" + tc.proto
+		_, err := generatortest.Generate(map[string]string{"errtest.proto": src}, nil)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Generate(%q) = %v, want %s", tc.proto, err, tc.want)
+		}
+	}
+}
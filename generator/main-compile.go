@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protoparse"
+	"google.golang.org/protobuf/proto"
+)
+
+// runCompile implements the compile command, which parses .proto files with
+// the built-in compiler and generates code for them without protoc:
+//
+//	protoc-gen-go-lite compile [-I dir ...] --out=dir [--opt=param,...] [--descriptor_set_out=foo.binpb] file.proto ...
+//
+// As with protoc, the files are named relative to an import path, or by a
+// path on disk inside one, and the current directory is the import path when
+// no -I is given. The well-known types need not be in the import paths.
+func runCompile(args []string, opts *MainOptions) error {
+	var importPaths, params []string
+	var out, descriptorSetOut string
+	f := flag.NewFlagSet("compile", flag.ContinueOnError)
+	f.Func("I", "directory to search for imports (repeatable)", func(v string) error {
+		importPaths = append(importPaths, v)
+		return nil
+	})
+	f.Func("proto_path", "same as -I", func(v string) error {
+		importPaths = append(importPaths, v)
+		return nil
+	})
+	f.StringVar(&out, "out", ".", "directory to write the generated files to")
+	f.Func("opt", "comma-separated generator parameters, as passed to --go-lite_opt (repeatable)", func(v string) error {
+		params = append(params, v)
+		return nil
+	})
+	f.StringVar(&descriptorSetOut, "descriptor_set_out", "", "also write the FileDescriptorSet of the files and their imports to this path")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if f.NArg() == 0 {
+		return errors.New("no input files")
+	}
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+
+	files := make([]string, f.NArg())
+	for i, arg := range f.Args() {
+		name, err := virtualPath(importPaths, arg)
+		if err != nil {
+			return err
+		}
+		files[i] = name
+	}
+	c := &protoparse.Compiler{ImportPaths: importPaths}
+	set, err := c.Compile(files...)
+	if err != nil {
+		return err
+	}
+	if descriptorSetOut != "" {
+		data, err := proto.Marshal(set)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(descriptorSetOut, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return generateFiles(set, files, strings.Join(params, ","), out, opts)
+}
+
+// virtualPath returns the name of the file arg relative to its import path.
+// A file existing on disk must be inside an import path; any other argument
+// is taken to be relative to one already.
+func virtualPath(importPaths []string, arg string) (string, error) {
+	if _, err := os.Stat(arg); err != nil {
+		return filepath.ToSlash(filepath.Clean(arg)), nil
+	}
+	abs, err := filepath.Abs(arg)
+	if err != nil {
+		return "", err
+	}
+	for _, dir := range importPaths {
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(dir, abs)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+	return "", fmt.Errorf("%s: file is not in any import path", arg)
+}
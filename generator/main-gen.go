@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// wellKnownPrefix is the path prefix of the well-known types, which are
+// provided by the types packages and not generated by default.
+const wellKnownPrefix = "google/protobuf/"
+
+// IsWellKnownFile reports whether the .proto file name is under
+// google/protobuf/, where the types provided by the types packages of this
+// module are declared. Such files are not generated unless named.
+func IsWellKnownFile(name string) bool {
+	return strings.HasPrefix(name, wellKnownPrefix)
+}
+
+// runGen implements the gen command, which generates code for the files of a
+// FileDescriptorSet without protoc:
+//
+//	protoc-gen-go-lite gen --descriptor_set=foo.binpb --out=dir [--opt=param,...] [file.proto ...]
+//
+// The descriptor set must contain the files to generate and their imports, as
+// written by protoc -o --include_imports or buf build. Without file arguments,
+// the files outside google/protobuf/ which no other file of the set imports
+// are generated, as the imported ones usually belong to other modules.
+func runGen(args []string, opts *MainOptions) error {
+	var descriptorSet, out string
+	var params []string
+	f := flag.NewFlagSet("gen", flag.ContinueOnError)
+	f.StringVar(&descriptorSet, "descriptor_set", "", "path of the FileDescriptorSet to generate code for")
+	f.StringVar(&out, "out", ".", "directory to write the generated files to")
+	f.Func("opt", "comma-separated generator parameters, as passed to --go-lite_opt (repeatable)", func(v string) error {
+		params = append(params, v)
+		return nil
+	})
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if descriptorSet == "" {
+		return errors.New("--descriptor_set is required")
+	}
+
+	data, err := os.ReadFile(descriptorSet)
+	if err != nil {
+		return err
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return fmt.Errorf("parse %s: %w", descriptorSet, err)
+	}
+
+	return generateFiles(set, f.Args(), strings.Join(params, ","), out, opts)
+}
+
+// generateFiles runs the generator on the files of set with the parameter
+// param and writes the generated files to the out directory.
+func generateFiles(set *descriptorpb.FileDescriptorSet, files []string, param, out string, opts *MainOptions) error {
+	req, err := newCodeGeneratorRequest(set, files, param)
+	if err != nil {
+		return err
+	}
+	po, generate := newPlugin(opts)
+	plugin, err := po.New(req)
+	if err != nil {
+		return err
+	}
+	if err := generate(plugin); err != nil {
+		plugin.Error(err)
+	}
+	return writeResponse(plugin.Response(), out)
+}
+
+// newCodeGeneratorRequest builds the request protoc would send the plugin for
+// the files of set, in dependency order.
+func newCodeGeneratorRequest(set *descriptorpb.FileDescriptorSet, files []string, param string) (*pluginpb.CodeGeneratorRequest, error) {
+	byName := make(map[string]*descriptorpb.FileDescriptorProto, len(set.GetFile()))
+	for _, file := range set.GetFile() {
+		byName[file.GetName()] = file
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: files,
+	}
+	if param != "" {
+		req.Parameter = proto.String(param)
+	}
+	if len(files) == 0 {
+		req.FileToGenerate = rootFiles(set)
+	}
+	for _, name := range req.FileToGenerate {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("file %q is not in the descriptor set", name)
+		}
+	}
+
+	// Add the files after their dependencies, as protoc does.
+	added := make(map[string]bool, len(byName))
+	var add func(name, importedBy string) error
+	add = func(name, importedBy string) error {
+		if added[name] {
+			return nil
+		}
+		file, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("import %q of %q is not in the descriptor set", name, importedBy)
+		}
+		added[name] = true
+		for _, dep := range file.GetDependency() {
+			if err := add(dep, name); err != nil {
+				return err
+			}
+		}
+		req.ProtoFile = append(req.ProtoFile, file)
+		return nil
+	}
+	for _, file := range set.GetFile() {
+		if err := add(file.GetName(), ""); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// rootFiles returns the names of the files of set outside google/protobuf/
+// which no other file of set imports, in the order of set.
+func rootFiles(set *descriptorpb.FileDescriptorSet) []string {
+	imported := make(map[string]bool)
+	for _, file := range set.GetFile() {
+		for _, dep := range file.GetDependency() {
+			imported[dep] = true
+		}
+	}
+	var names []string
+	for _, file := range set.GetFile() {
+		if name := file.GetName(); !imported[name] && !IsWellKnownFile(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// writeResponse writes the files of resp to the out directory. The names of
+// the files must be relative paths inside out; they are all checked before
+// any file is written.
+func writeResponse(resp *pluginpb.CodeGeneratorResponse, out string) error {
+	if resp.Error != nil {
+		return errors.New(resp.GetError())
+	}
+	for _, file := range resp.GetFile() {
+		if file.GetInsertionPoint() != "" {
+			return fmt.Errorf("%s: insertion points are not supported", file.GetName())
+		}
+		if !filepath.IsLocal(filepath.FromSlash(file.GetName())) {
+			return fmt.Errorf("%s: generated file name is not a relative path inside the output directory", file.GetName())
+		}
+	}
+	for _, file := range resp.GetFile() {
+		path := filepath.Join(out, filepath.FromSlash(file.GetName()))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(file.GetContent()), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/internal/version"
+)
+
+// MainOptions configure Main.
+type MainOptions struct {
+	// Features are registered in addition to the features registered by the
+	// imported feature packages.
+	Features []FeatureRegistration
+	// Params, if set, is called with the flag set of the generator
+	// parameters to define the parameters of the additional features, which
+	// are set from --go-lite_opt=name=value before code is generated.
+	Params func(f *flag.FlagSet)
+}
+
+// mainCommands are the subcommands run instead of the plugin when named by
+// the first argument.
+var mainCommands = map[string]func(args []string, opts *MainOptions) error{
+	"gen":     runGen,
+	"compile": runCompile,
+}
+
+// Main runs a protoc-gen-go-lite binary with the parameters, code generation
+// modes and gen and compile commands of the upstream one, generating the
+// registered features and those of opts. A custom binary imports the feature
+// packages it includes, usually features/all, and calls Main from its main
+// function.
+func Main(opts MainOptions) {
+	for _, reg := range opts.Features {
+		Register(reg)
+	}
+
+	name := filepath.Base(os.Args[0])
+	if len(os.Args) == 2 && os.Args[1] == "--version" {
+		fmt.Fprintf(os.Stdout, "%v %v\n", name, version.String())
+		os.Exit(0)
+	}
+	if len(os.Args) > 1 {
+		if run, ok := mainCommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:], &opts); err != nil {
+				fmt.Fprintf(os.Stderr, "%s %s: %v\n", name, os.Args[1], err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	po, generate := newPlugin(&opts)
+	po.Run(generate)
+}
+
+// newPlugin returns the plugin options parsing the generator parameters and
+// the function generating code for a plugin built with them.
+func newPlugin(opts *MainOptions) (protogen.Options, func(*protogen.Plugin) error) {
+	var cfg Config
+	var codegenMode string
+	var features string
+	var f flag.FlagSet
+
+	f.BoolVar(&cfg.AllowEmpty, "allow-empty", false, "allow generation of empty files")
+	f.StringVar(&codegenMode, "codegen", string(CodegenModeHelper), "code generation mode: helper, unrolled, or table")
+	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
+	f.StringVar(&cfg.BuildTag, "buildTag", "", "the go:build tag to set on generated files")
+	f.BoolVar(&cfg.Registry, "registry", false, "generate init-time message registry with flattened custom options")
+	if opts.Params != nil {
+		opts.Params(&f)
+	}
+
+	po := protogen.Options{
+		ParamFunc: f.Set,
+	}
+	return po, func(plugin *protogen.Plugin) error {
+		if err := cfg.SetCodegenMode(codegenMode); err != nil {
+			return err
+		}
+		featureNames := strings.Split(features, "+")
+		gen, err := NewGenerator(plugin, featureNames, &cfg)
+		if err != nil {
+			return err
+		}
+		gen.Generate()
+
+		return nil
+	}
+}
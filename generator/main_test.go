@@ -0,0 +1,114 @@
+package generator_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/generator/generatortest"
+)
+
+const customMainSource = `package main
+
+import (
+	"flag"
+	"strconv"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator"
+
+	_ "github.com/aperturerobotics/protobuf-go-lite/features/all"
+)
+
+var greeting string
+
+type hello struct {
+	*generator.GeneratedFile
+}
+
+func (h *hello) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		h.P("func (m *", message.GoIdent.GoName, ") Hello() string {")
+		h.P("return ", strconv.Quote(greeting))
+		h.P("}")
+	}
+	return len(file.Messages) != 0
+}
+
+func main() {
+	generator.Main(generator.MainOptions{
+		Features: []generator.FeatureRegistration{{
+			Name: "hello",
+			Feature: func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+				return &hello{GeneratedFile: gen}
+			},
+			Optional: true,
+			Requires: []string{"size"},
+		}},
+		Params: func(f *flag.FlagSet) {
+			f.StringVar(&greeting, "greeting", "hi", "the greeting returned by Hello")
+		},
+	})
+}
+`
+
+const customMainProto = `syntax = "proto3";
+
+package custom;
+
+option go_package = "example.com/custom;custom";
+
+message Msg {
+  string name = 1;
+}
+`
+
+func TestMainCustomBinary(t *testing.T) {
+	sandbox, err := generatortest.NewSandbox(t.TempDir(), "example.com/customgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sandbox.WriteFiles(map[string]string{"main.go": customMainSource}); err != nil {
+		t.Fatal(err)
+	}
+	plugin := filepath.Join(t.TempDir(), "protoc-gen-custom")
+	cmd := exec.Command("go", "build", "-mod=mod", "-o", plugin, ".")
+	cmd.Dir = sandbox.Dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build custom binary:\n%s", out)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "custom.proto"), []byte(customMainProto), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd = exec.Command(plugin, "compile", "--out=out", "--opt=paths=source_relative,features=all+hello,greeting=hey", "custom.proto")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compile:\n%s", out)
+	}
+	generated, err := os.ReadFile(filepath.Join(dir, "out", "custom.pb.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"func (m *Msg) MarshalVT()", `return "hey"`} {
+		if !strings.Contains(string(generated), want) {
+			t.Errorf("generated file does not contain %q:\n%s", want, generated)
+		}
+	}
+
+	cmd = exec.Command(plugin, "compile", "--out=out", "--opt=features=hello", "custom.proto")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), `feature "hello" requires the size features`) {
+		t.Fatalf("compile without the required features: %v\n%s", err, out)
+	}
+
+	cmd = exec.Command(plugin, "--version")
+	out, err = cmd.CombinedOutput()
+	if err != nil || !strings.HasPrefix(string(out), "protoc-gen-custom ") {
+		t.Fatalf("--version: %v\n%s", err, out)
+	}
+}
@@ -69,18 +69,25 @@ func (gen *Generator) generateRegistry(p *GeneratedFile, file *protogen.File) {
 }
 
 func validateRegistryFeatures(featureNames []string) error {
+	if !hasFeatures(featureNames, "size", "marshal", "unmarshal") {
+		return errRegistryFeatures
+	}
+	return nil
+}
+
+func hasFeatures(featureNames []string, required ...string) bool {
 	all := slices.Contains(featureNames, "all")
-	for _, required := range []string{"size", "marshal", "unmarshal"} {
+	for _, name := range required {
 		if all {
-			if _, ok := defaultFeatures[required]; ok {
+			if _, ok := defaultFeatures[name]; ok {
 				continue
 			}
-		} else if slices.Contains(featureNames, required) {
+		} else if slices.Contains(featureNames, name) {
 			continue
 		}
-		return errRegistryFeatures
+		return false
 	}
-	return nil
+	return true
 }
 
 var errRegistryFeatures = errors.New("registry=true requires size, marshal, and unmarshal features")
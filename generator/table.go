@@ -0,0 +1,247 @@
+package generator
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aperturerobotics/protobuf-go-lite/compiler/protogen"
+	"github.com/aperturerobotics/protobuf-go-lite/generator/fieldsem"
+	"github.com/aperturerobotics/protobuf-go-lite/internal/strs"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errTableFeatures = errors.New("codegen=table requires size, marshal, and unmarshal features")
+
+func validateTableFeatures(featureNames []string) error {
+	if !hasFeatures(featureNames, "size", "marshal", "unmarshal") {
+		return errTableFeatures
+	}
+	return nil
+}
+
+var tableKinds = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "TableKindBool",
+	protoreflect.EnumKind:     "TableKindInt32",
+	protoreflect.Int32Kind:    "TableKindInt32",
+	protoreflect.Int64Kind:    "TableKindInt64",
+	protoreflect.Uint32Kind:   "TableKindUint32",
+	protoreflect.Uint64Kind:   "TableKindUint64",
+	protoreflect.Sint32Kind:   "TableKindSint32",
+	protoreflect.Sint64Kind:   "TableKindSint64",
+	protoreflect.Fixed32Kind:  "TableKindFixed32",
+	protoreflect.Fixed64Kind:  "TableKindFixed64",
+	protoreflect.Sfixed32Kind: "TableKindSfixed32",
+	protoreflect.Sfixed64Kind: "TableKindSfixed64",
+	protoreflect.FloatKind:    "TableKindFloat",
+	protoreflect.DoubleKind:   "TableKindDouble",
+	protoreflect.StringKind:   "TableKindString",
+	protoreflect.BytesKind:    "TableKindBytes",
+	protoreflect.MessageKind:  "TableKindMessage",
+}
+
+// TableMessage reports whether the size, marshal, and unmarshal methods of
+// message are generated from a static field table.
+//
+// Messages using groups, weak fields, lazy fields, native well-known type
+// fields, custom type fields, embedded sub-message fields, more than 64
+// oneofs, or required fields past the 64th field keep the helper method
+// bodies.
+func (p *GeneratedFile) TableMessage(message *protogen.Message) bool {
+	if !p.Config.TableCodegen() || message.Desc.IsMapEntry() {
+		return false
+	}
+	oneofs := 0
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			oneofs++
+		}
+	}
+	if oneofs > 64 {
+		return false
+	}
+	for i, field := range sortedTableFields(message) {
+		if sem := p.FieldSemantics(field); field.Desc.IsWeak() || field.Desc.Kind() == protoreflect.GroupKind || sem.Lazy || sem.Std != "" || sem.Custom != "" || sem.Embedded {
+			return false
+		}
+		if field.Desc.Cardinality() == protoreflect.Required && i >= 64 {
+			return false
+		}
+		if field.Desc.IsMap() {
+			for _, kv := range field.Message.Fields {
+				if kv.Desc.Kind() == protoreflect.GroupKind {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Table returns the name of the static field table of message, emitting the
+// table declaration the first time it is requested for the file.
+func (p *GeneratedFile) Table(message *protogen.Message) string {
+	name := "table" + message.GoIdent.GoName
+	if p.tables == nil {
+		p.tables = make(map[string]bool)
+	}
+	if p.tables[name] {
+		return name
+	}
+	p.tables[name] = true
+
+	rt := func(ident string) string {
+		return p.QualifiedGoIdent(vtHelpersPackage.Ident(ident))
+	}
+	offsetof := p.Ident("unsafe", "Offsetof")
+	ccTypeName := message.GoIdent.GoName
+
+	oneofIndex := make(map[*protogen.Oneof]int)
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			oneofIndex[oneof] = len(oneofIndex) + 1
+		}
+	}
+
+	p.P(`var `, name, ` = `, rt("Table"), `{`)
+	p.P(`Name: `, strconv.Quote(string(message.Desc.FullName())), `,`)
+	p.P(`Unknown: `, offsetof, `(`, ccTypeName, `{}.unknownFields),`)
+	p.P(`Fields: []`, rt("TableField"), `{`)
+	for _, field := range sortedTableFields(message) {
+		goName := field.GoName
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			goName = oneof.GoName
+		}
+		entry := []string{
+			`Name: ` + strconv.Quote(string(field.Desc.Name())),
+			`Offset: ` + offsetof + `(` + ccTypeName + `{}.` + goName + `)`,
+		}
+		entry = append(entry, p.tableFieldEntry(field, false)...)
+		if idx := oneofIndex[field.Oneof]; idx != 0 {
+			entry = append(entry,
+				`Oneof: `+strconv.Itoa(idx),
+				`Case: `+rt("TableCase")+`[is`+field.Oneof.GoIdent.GoName+`, `+field.GoIdent.GoName+`]{}`,
+			)
+		}
+		if field.Desc.IsMap() {
+			keyType, _ := p.FieldGoType(field.Message.Fields[0])
+			valueType, _ := p.FieldGoType(field.Message.Fields[1])
+			entry = append(entry, `Map: &`+rt("TableMap")+`{`+
+				`Key: `+rt("TableField")+`{`+strings.Join(p.tableFieldEntry(field.Message.Fields[0], true), ", ")+`}, `+
+				`Value: `+rt("TableField")+`{`+strings.Join(p.tableFieldEntry(field.Message.Fields[1], true), ", ")+`}, `+
+				`Access: `+rt("TableMapOf")+`[`+keyType+`, `+valueType+`]{}}`)
+		}
+		p.P(`{`, strings.Join(entry, ", "), `},`)
+	}
+	p.P(`},`)
+	p.P(`}`)
+	p.P()
+	return name
+}
+
+// tableFieldEntry returns the number, kind, flags, and sub-message type keys
+// of the TableField literal for field. Map entry key and value fields are
+// always present and only carry validation flags.
+func (p *GeneratedFile) tableFieldEntry(field *protogen.Field, mapEntry bool) []string {
+	rt := func(ident string) string {
+		return p.QualifiedGoIdent(vtHelpersPackage.Ident(ident))
+	}
+	sem := p.FieldSemantics(field)
+	kind := rt(tableKinds[field.Desc.Kind()])
+	if sem.Map {
+		kind = rt("TableKindMap")
+	}
+	entry := []string{
+		`Number: ` + strconv.Itoa(int(field.Desc.Number())),
+		`Kind: ` + kind,
+	}
+
+	var flags []string
+	if mapEntry {
+		sem = fieldsem.Field{}
+	}
+	if sem.Pointer {
+		flags = append(flags, rt("TableFlagPointer"))
+	}
+	if sem.List {
+		flags = append(flags, rt("TableFlagRepeated"))
+	}
+	if sem.List && sem.Packed {
+		flags = append(flags, rt("TableFlagPacked"))
+	}
+	if sem.Required {
+		flags = append(flags, rt("TableFlagRequired"))
+	}
+	if field.Desc.Kind() == protoreflect.BytesKind && !mapEntry && !sem.List && !sem.RealOneof && field.Desc.HasPresence() {
+		flags = append(flags, rt("TableFlagPresence"))
+	}
+	if field.Desc.Kind() == protoreflect.StringKind &&
+		field.Desc.Syntax() == protoreflect.Editions &&
+		strs.EnforceUTF8(field.Desc) {
+		flags = append(flags, rt("TableFlagUTF8"))
+	}
+	if len(flags) != 0 {
+		entry = append(entry, `Flags: `+strings.Join(flags, " | "))
+	}
+	if field.Desc.Kind() == protoreflect.MessageKind && !sem.Map {
+		msg := p.QualifiedGoIdent(field.Message.GoIdent)
+		entry = append(entry, `Type: `+rt("TableMessage")+`[`+msg+`, *`+msg+`]{}`)
+	}
+	return entry
+}
+
+// TableStrict returns the name of the TableMarshalFuncs of message used by
+// TableMarshalStrict, emitting its declaration the first time it is requested
+// for the file. It returns "nil" if message has no sub-message fields.
+func (p *GeneratedFile) TableStrict(message *protogen.Message) string {
+	return p.tableSubs(message, "TableMarshalFuncs", "TableStrict")
+}
+
+// TableUnsafe returns the name of the TableUnmarshalFuncs of message used by
+// TableUnmarshalUnsafe, emitting its declaration the first time it is requested
+// for the file. It returns "nil" if message has no sub-message fields.
+func (p *GeneratedFile) TableUnsafe(message *protogen.Message) string {
+	return p.tableSubs(message, "TableUnmarshalFuncs", "TableUnsafe")
+}
+
+func (p *GeneratedFile) tableSubs(message *protogen.Message, typeName, fn string) string {
+	var entries []string
+	for i, field := range sortedTableFields(message) {
+		sub := field.Message
+		if field.Desc.IsMap() {
+			sub = field.Message.Fields[1].Message
+		}
+		if sub == nil {
+			continue
+		}
+		entries = append(entries, strconv.Itoa(i)+`: `+p.QualifiedGoIdent(vtHelpersPackage.Ident(fn))+`[`+p.QualifiedGoIdent(sub.GoIdent)+`]`)
+	}
+	if len(entries) == 0 {
+		return "nil"
+	}
+	name := "table" + message.GoIdent.GoName + strings.TrimPrefix(fn, "Table")
+	if p.tables == nil {
+		p.tables = make(map[string]bool)
+	}
+	if p.tables[name] {
+		return name
+	}
+	p.tables[name] = true
+	p.P(`var `, name, ` = `, p.QualifiedGoIdent(vtHelpersPackage.Ident(typeName)), `{`)
+	for _, entry := range entries {
+		p.P(entry, `,`)
+	}
+	p.P(`}`)
+	p.P()
+	return name
+}
+
+func sortedTableFields(message *protogen.Message) []*protogen.Field {
+	fields := slices.Clone(message.Fields)
+	slices.SortFunc(fields, func(a, b *protogen.Field) int {
+		return cmp.Compare(a.Desc.Number(), b.Desc.Number())
+	})
+	return fields
+}
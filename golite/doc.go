@@ -0,0 +1,5 @@
+// Package golite holds the custom options of golite.proto, which configure the
+// code generated by protoc-gen-go-lite. Each option has an equivalent leading
+// comment directive. The generator reads the options from the descriptors, so
+// the package declares no Go API of its own.
+package golite
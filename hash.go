@@ -0,0 +1,177 @@
+package protobuf_go_lite
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+	"time"
+)
+
+// hashFlushSize is the buffered length at which a Hasher writes to its hash.
+const hashFlushSize = 1024
+
+// hashUnknownMarker precedes the unknown fields of a message. It cannot collide
+// with a field number, which is at most 2^29-1.
+const hashUnknownMarker = 1 << 32
+
+// Hasher feeds the semantic contents of messages to a hash.Hash64, as written
+// by generated WriteHashVT methods. Small writes are buffered; call Flush
+// before reading the hash.
+type Hasher struct {
+	h     hash.Hash64
+	buf   []byte
+	entry *Hasher
+}
+
+// NewHasher returns a Hasher writing to h.
+func NewHasher(h hash.Hash64) *Hasher {
+	return &Hasher{h: h, buf: make([]byte, 0, 128)}
+}
+
+// Flush writes the buffered contents to the hash.
+func (w *Hasher) Flush() {
+	if len(w.buf) != 0 {
+		_, _ = w.h.Write(w.buf)
+		w.buf = w.buf[:0]
+	}
+}
+
+func (w *Hasher) maybeFlush() {
+	if len(w.buf) >= hashFlushSize {
+		w.Flush()
+	}
+}
+
+// Uint64 writes v.
+func (w *Hasher) Uint64(v uint64) {
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, v)
+	w.maybeFlush()
+}
+
+// Field writes the number of the field whose value follows.
+func (w *Hasher) Field(num int32) {
+	w.Uint64(uint64(num)) //nolint:gosec
+}
+
+// End writes the end of a message. Nil and empty messages both hash as End.
+func (w *Hasher) End() {
+	w.Uint64(0)
+}
+
+// Bool writes v.
+func (w *Hasher) Bool(v bool) {
+	var b byte
+	if v {
+		b = 1
+	}
+	w.buf = append(w.buf, b)
+	w.maybeFlush()
+}
+
+// Float64 writes v, hashing negative zero like zero since they compare equal.
+func (w *Hasher) Float64(v float64) {
+	if v == 0 {
+		v = 0
+	}
+	w.Uint64(math.Float64bits(v))
+}
+
+// String writes the length and contents of s.
+func (w *Hasher) String(s string) {
+	w.Uint64(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+	w.maybeFlush()
+}
+
+// Bytes writes the length and contents of b.
+func (w *Hasher) Bytes(b []byte) {
+	w.Uint64(uint64(len(b)))
+	w.buf = append(w.buf, b...)
+	w.maybeFlush()
+}
+
+// entryHasher returns the Hasher used to hash map entries and unknown fields
+// independently of their order, reset to the initial state.
+func (w *Hasher) entryHasher() *Hasher {
+	if w.entry == nil {
+		w.entry = NewHasher(fnv.New64a())
+	} else {
+		w.entry.buf = w.entry.buf[:0]
+		w.entry.h.Reset()
+	}
+	return w.entry
+}
+
+func (w *Hasher) sum64() uint64 {
+	w.Flush()
+	sum := w.h.Sum64()
+	w.h.Reset()
+	return sum
+}
+
+// HashMap writes the entries of m with hashEntry such that their order does
+// not matter: each entry is hashed on its own and the results are summed.
+func HashMap[M ~map[K]V, K comparable, V any](w *Hasher, m M, hashEntry func(w *Hasher, k K, v V)) {
+	e := w.entryHasher()
+	var sum uint64
+	for k, v := range m {
+		hashEntry(e, k, v)
+		sum += e.sum64()
+	}
+	w.Uint64(uint64(len(m)))
+	w.Uint64(sum)
+}
+
+// Unknown writes the unknown fields b such that the order of the fields does
+// not matter. Malformed unknown fields are written as they are.
+func (w *Hasher) Unknown(b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	w.Uint64(hashUnknownMarker)
+	e := w.entryHasher()
+	var sum uint64
+	var count int
+	for field, err := range RangeUnknownFields(b) {
+		if err != nil {
+			w.Bytes(b)
+			return
+		}
+		e.Bytes(field.Raw)
+		sum += e.sum64()
+		count++
+	}
+	w.Uint64(uint64(count)) //nolint:gosec
+	w.Uint64(sum)
+}
+
+// HashStd writes the native well-known type value v. Times are written as
+// their instant, so times equal under EqualStd hash the same.
+func HashStd[T Std](w *Hasher, v T) {
+	switch v := any(v).(type) {
+	case time.Time:
+		w.Uint64(uint64(v.Unix())) //nolint:gosec
+		w.Uint64(uint64(v.Nanosecond()))
+	case time.Duration:
+		w.Uint64(uint64(v)) //nolint:gosec
+	case float64:
+		w.Float64(v)
+	case float32:
+		w.Float64(float64(v))
+	case int64:
+		w.Uint64(uint64(v)) //nolint:gosec
+	case uint64:
+		w.Uint64(v)
+	case int32:
+		w.Uint64(uint64(v)) //nolint:gosec
+	case uint32:
+		w.Uint64(uint64(v))
+	case bool:
+		w.Bool(v)
+	case string:
+		w.String(v)
+	case []byte:
+		w.Bytes(v)
+	}
+}
@@ -0,0 +1,159 @@
+// Package sqltest registers a stand-in database/sql driver holding a single
+// in-memory key-value table, for testing sql.Scanner and driver.Valuer
+// implementations without a database.
+//
+// The driver understands two statements:
+//
+//	PUT    (key, value)  stores value under key
+//	GET    (key)         returns one row with the value stored under key
+package sqltest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// DriverName is the name the driver is registered under.
+const DriverName = "protobuf-go-lite-sqltest"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Open opens a database with its own empty table.
+func Open() (*sql.DB, error) {
+	return sql.Open(DriverName, strconv.FormatUint(nextDSN(), 10))
+}
+
+var (
+	dsnMtx  sync.Mutex
+	dsnNext uint64
+	tables  = make(map[string]*table)
+)
+
+func nextDSN() uint64 {
+	dsnMtx.Lock()
+	defer dsnMtx.Unlock()
+	dsnNext++
+	return dsnNext
+}
+
+// table is the key-value table of a database.
+type table struct {
+	mtx  sync.Mutex
+	rows map[string]driver.Value
+}
+
+// Driver is the stand-in driver. Connections with the same name share a table.
+type Driver struct{}
+
+// Open implements driver.Driver.
+func (*Driver) Open(name string) (driver.Conn, error) {
+	dsnMtx.Lock()
+	defer dsnMtx.Unlock()
+	t, ok := tables[name]
+	if !ok {
+		t = &table{rows: make(map[string]driver.Value)}
+		tables[name] = t
+	}
+	return &conn{table: t}, nil
+}
+
+type conn struct {
+	table *table
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	switch query {
+	case "PUT":
+		return &stmt{conn: c, put: true}, nil
+	case "GET":
+		return &stmt{conn: c}, nil
+	}
+	return nil, fmt.Errorf("sqltest: unknown statement %q", query)
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("sqltest: transactions are not supported")
+}
+
+type stmt struct {
+	conn *conn
+	put  bool
+}
+
+func (s *stmt) Close() error { return nil }
+
+func (s *stmt) NumInput() int {
+	if s.put {
+		return 2
+	}
+	return 1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !s.put {
+		return nil, errors.New("sqltest: GET is a query")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("sqltest: key is %T, not string", args[0])
+	}
+	value := args[1]
+	if b, ok := value.([]byte); ok {
+		// The caller may reuse b once Exec returns.
+		value = slices.Clone(b)
+	}
+
+	t := s.conn.table
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.rows[key] = value
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.put {
+		return nil, errors.New("sqltest: PUT is not a query")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("sqltest: key is %T, not string", args[0])
+	}
+
+	t := s.conn.table
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	value, ok := t.rows[key]
+	if !ok {
+		return &rows{}, nil
+	}
+	if b, ok := value.([]byte); ok {
+		value = slices.Clone(b)
+	}
+	return &rows{values: []driver.Value{value}}, nil
+}
+
+type rows struct {
+	values []driver.Value
+}
+
+func (r *rows) Columns() []string { return []string{"value"} }
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
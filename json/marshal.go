@@ -41,10 +41,18 @@ func (e *marshalError) Unwrap() error {
 type MarshalerConfig struct {
 	EnumsAsInts bool
 
+	// Redact writes RedactedValue in place of the values of fields marked with
+	// the debug_redact option.
+	Redact bool
+
 	// AnyTypeResolver is the resolver function for the any well-known type.
 	AnyTypeResolver anypb_resolver.AnyTypeResolver
 }
 
+// RedactedValue replaces the values of debug_redact fields when the marshaler
+// redacts.
+const RedactedValue = "[REDACTED]"
+
 // DefaultMarshalerConfig is the default configuration for the Marshaler.
 var DefaultMarshalerConfig = MarshalerConfig{
 	EnumsAsInts: true,
@@ -136,6 +144,12 @@ func (s *MarshalState) Config() MarshalerConfig {
 	return *s.config
 }
 
+// Redact returns whether the values of debug_redact fields are replaced with
+// RedactedValue.
+func (s *MarshalState) Redact() bool {
+	return s.config.Redact
+}
+
 // AnyTypeResolver returns the any type resolver.
 func (s *MarshalState) AnyTypeResolver() anypb_resolver.AnyTypeResolver {
 	if s.config.AnyTypeResolver != nil {
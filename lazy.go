@@ -0,0 +1,210 @@
+package protobuf_go_lite
+
+import (
+	"slices"
+	"sync"
+)
+
+// Lazy holds the wire bytes of a sub-message field marked with the
+// protobuf-go-lite:lazy comment until the first getter call decodes them.
+//
+// Generated code stores a *Lazy next to the exported field and only consults
+// it while the exported field is nil. A nil *Lazy holds no value.
+type Lazy[T any] struct {
+	mu     sync.Mutex
+	raw    []byte
+	decode func(*T, []byte) error
+	msg    *T
+	// err is the decode error of bytes that were discarded, which is reported
+	// in place of the value.
+	err error
+}
+
+// AppendLazy records the wire bytes of another occurrence of a lazy field,
+// copying raw. Pending bytes are concatenated, which merges the occurrences
+// when they are decoded. If the field was already decoded, raw is decoded into
+// the existing message.
+//
+// raw must be a well-formed sequence of fields: the structure is validated up
+// front so malformed input is still rejected at unmarshal time.
+func AppendLazy[T any](l **Lazy[T], raw []byte, decode func(*T, []byte) error) error {
+	return appendLazy(l, raw, decode, true)
+}
+
+// AppendLazyUnsafe is like AppendLazy but the first occurrence aliases raw
+// instead of copying it. Data passed to UnmarshalVTUnsafe has to be left
+// untouched for the lifetime of the message.
+func AppendLazyUnsafe[T any](l **Lazy[T], raw []byte, decode func(*T, []byte) error) error {
+	return appendLazy(l, raw, decode, false)
+}
+
+func appendLazy[T any](l **Lazy[T], raw []byte, decode func(*T, []byte) error, copyRaw bool) error {
+	for i := 0; i < len(raw); {
+		n, err := Skip(raw[i:])
+		if err != nil {
+			return err
+		}
+		i += n
+	}
+	if *l == nil {
+		if copyRaw {
+			raw = slices.Clone(raw)
+		}
+		if raw == nil {
+			raw = []byte{}
+		}
+		*l = &Lazy[T]{raw: raw, decode: decode}
+		return nil
+	}
+
+	lz := *l
+	lz.mu.Lock()
+	defer lz.mu.Unlock()
+	if lz.err != nil {
+		return lz.err
+	}
+	if lz.msg != nil {
+		return decode(lz.msg, raw)
+	}
+	// Always allocate: the pending bytes may be shared with clones.
+	lz.raw = slices.Concat(lz.raw, raw)
+	return nil
+}
+
+// Get returns the decoded message, decoding the pending bytes on the first
+// call. It returns nil and no error if l is nil. If the bytes fail to decode,
+// Get returns the error and keeps them, so the field still marshals verbatim.
+func (l *Lazy[T]) Get() (*T, error) {
+	if l == nil {
+		return nil, nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return nil, l.err
+	}
+	if l.msg == nil {
+		msg := new(T)
+		if err := l.decode(msg, l.raw); err != nil {
+			return nil, err
+		}
+		l.msg, l.raw = msg, nil
+	}
+	return l.msg, nil
+}
+
+// TakeLazy returns the decoded value of the lazy field *l and clears *l, for
+// code that moves the value to the exported field. If the pending bytes fail
+// to decode, they are discarded and *l is left reporting the error from Get,
+// Size and MarshalToSizedBuffer, so it is not lost.
+func TakeLazy[T any](l **Lazy[T]) *T {
+	msg, err := (*l).Get()
+	if err != nil {
+		*l = &Lazy[T]{decode: (*l).decode, err: err}
+		return nil
+	}
+	*l = nil
+	return msg
+}
+
+// MergeLazy merges the lazy field value src, held by srcLazy while src is nil,
+// into the value *dst, held by *dstLazy while *dst is nil, like merge does for
+// decoded values. Pending bytes are appended to pending bytes without decoding
+// them, since decoding the concatenation merges the occurrences. Otherwise both
+// values are decoded and merged; if either fails to decode, the field of dst
+// reports the error from Get in place of its value.
+func MergeLazy[T any](dst **T, dstLazy **Lazy[T], src *T, srcLazy *Lazy[T], merge func(dst, src *T)) {
+	if src == nil {
+		if srcLazy == nil {
+			return
+		}
+		if *dst == nil && mergePending(dstLazy, srcLazy) {
+			return
+		}
+		var err error
+		if src, err = srcLazy.Get(); err != nil {
+			*dst, *dstLazy = nil, &Lazy[T]{decode: srcLazy.decode, err: err}
+			return
+		}
+	}
+	if *dst == nil {
+		v, err := (*dstLazy).Get()
+		if err != nil {
+			// dst keeps its pending bytes, which report the error.
+			return
+		}
+		if v == nil {
+			v = new(T)
+		}
+		*dst, *dstLazy = v, nil
+	}
+	merge(*dst, src)
+}
+
+// mergePending appends the pending bytes of src to those of *dst, or shares
+// them with a new *dst if it is nil, and reports whether both were pending.
+func mergePending[T any](dst **Lazy[T], src *Lazy[T]) bool {
+	src.mu.Lock()
+	raw, pending := src.raw, src.msg == nil && src.err == nil
+	src.mu.Unlock()
+	if !pending {
+		return false
+	}
+	if *dst == nil {
+		*dst = &Lazy[T]{raw: raw, decode: src.decode}
+		return true
+	}
+	lz := *dst
+	lz.mu.Lock()
+	defer lz.mu.Unlock()
+	if lz.msg != nil || lz.err != nil {
+		return false
+	}
+	// Always allocate: the pending bytes may be shared with clones.
+	lz.raw = slices.Concat(lz.raw, raw)
+	return true
+}
+
+// Size returns the encoded size of the field value without its tag and length
+// prefix: the length of the pending bytes, or size of the decoded message.
+// A field reporting a decode error has no size.
+func (l *Lazy[T]) Size(size func(*T) int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return 0
+	}
+	if l.msg == nil {
+		return len(l.raw)
+	}
+	return size(l.msg)
+}
+
+// MarshalToSizedBuffer writes the field value to the end of dAtA, copying the
+// pending bytes verbatim if the field has not been decoded. It returns the
+// decode error of a field reporting one.
+func (l *Lazy[T]) MarshalToSizedBuffer(dAtA []byte, marshal func(*T, []byte) (int, error)) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return 0, l.err
+	}
+	if l.msg == nil {
+		return copy(dAtA[len(dAtA)-len(l.raw):], l.raw), nil
+	}
+	return marshal(l.msg, dAtA)
+}
+
+// Clone returns a copy of l. Pending bytes and a decode error are shared with
+// the copy, a decoded message is copied with clone.
+func (l *Lazy[T]) Clone(clone func(*T) *T) *Lazy[T] {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.msg == nil {
+		return &Lazy[T]{raw: l.raw, decode: l.decode, err: l.err}
+	}
+	return &Lazy[T]{decode: l.decode, msg: clone(l.msg)}
+}
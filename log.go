@@ -0,0 +1,119 @@
+package protobuf_go_lite
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	// LogMaxDepth is the number of message levels LogValue expands. Deeper
+	// sub-messages are logged as LogElided. LogValueVT takes the number of
+	// levels to expand instead.
+	LogMaxDepth = 8
+	// LogMaxElements is the number of elements of a repeated or map field
+	// LogValue logs. The number of omitted elements is logged under
+	// LogTruncatedKey.
+	LogMaxElements = 16
+	// LogRedacted is logged in place of the values of fields marked
+	// debug_redact.
+	LogRedacted = "[REDACTED]"
+	// LogElided is logged in place of sub-messages beyond LogMaxDepth.
+	LogElided = "..."
+	// LogTruncatedKey is the key of the number of elements omitted from a
+	// repeated or map field longer than LogMaxElements.
+	LogTruncatedKey = "..."
+)
+
+// LogValueVT is a message with a LogValueVT function.
+type LogValueVT interface {
+	// LogValueVT returns the message as a slog.GroupValue of its fields,
+	// expanding depth levels of sub-messages.
+	LogValueVT(depth int) slog.Value
+}
+
+// LogMessage returns the slog.Value of a sub-message logged with depth levels
+// remaining. Messages generated without the slog feature are logged with
+// their slog.LogValuer or fmt.Stringer implementation.
+func LogMessage(msg any, depth int) slog.Value {
+	if depth <= 0 {
+		return slog.StringValue(LogElided)
+	}
+	switch msg := msg.(type) {
+	case LogValueVT:
+		return msg.LogValueVT(depth)
+	case slog.LogValuer:
+		return slog.AnyValue(msg).Resolve()
+	case fmt.Stringer:
+		return slog.StringValue(msg.String())
+	}
+	return slog.AnyValue(msg)
+}
+
+// LogSlice returns a repeated field as a slog.GroupValue keyed by index,
+// truncated to LogMaxElements.
+func LogSlice[T any](s []T, value func(T) slog.Value) slog.Value {
+	n := min(len(s), LogMaxElements)
+	attrs := make([]slog.Attr, n, n+1)
+	for i, v := range s[:n] {
+		attrs[i] = slog.Attr{Key: strconv.Itoa(i), Value: value(v)}
+	}
+	return logTruncate(attrs, len(s))
+}
+
+// LogMap returns a map field as a slog.GroupValue keyed by map key in key
+// order, truncated to LogMaxElements.
+func LogMap[M ~map[K]V, K comparable, V any](m M, compareKey func(a, b K) int, value func(V) slog.Value) slog.Value {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, compareKey)
+	n := min(len(keys), LogMaxElements)
+	attrs := make([]slog.Attr, n, n+1)
+	for i, k := range keys[:n] {
+		attrs[i] = slog.Attr{Key: fmt.Sprint(k), Value: value(m[k])}
+	}
+	return logTruncate(attrs, len(keys))
+}
+
+// logTruncate returns attrs as a group, noting the number of elements omitted
+// from a field of n elements.
+func logTruncate(attrs []slog.Attr, n int) slog.Value {
+	if n > len(attrs) {
+		attrs = append(attrs, slog.Int(LogTruncatedKey, n-len(attrs)))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogStd returns the slog.Value of a native well-known type value.
+func LogStd[T Std](v T) slog.Value {
+	switch v := any(v).(type) {
+	case time.Time:
+		return slog.TimeValue(v)
+	case time.Duration:
+		return slog.DurationValue(v)
+	case float64:
+		return slog.Float64Value(v)
+	case float32:
+		return slog.Float64Value(float64(v))
+	case int64:
+		return slog.Int64Value(v)
+	case uint64:
+		return slog.Uint64Value(v)
+	case int32:
+		return slog.Int64Value(int64(v))
+	case uint32:
+		return slog.Uint64Value(uint64(v))
+	case bool:
+		return slog.BoolValue(v)
+	case string:
+		return slog.StringValue(v)
+	case []byte:
+		return slog.StringValue(base64.StdEncoding.EncodeToString(v))
+	}
+	return slog.AnyValue(v)
+}
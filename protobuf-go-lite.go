@@ -360,6 +360,14 @@ func TextWriteTextMarshaler(sb *TextBuilder, v TextMarshaler) {
 	sb.WriteString(v.MarshalProtoText())
 }
 
+// TextWriteError writes the error reading a field value, such as the decode
+// error of a lazy field, in place of the value.
+func TextWriteError(sb *TextBuilder, err error) {
+	sb.WriteString("[ERROR: ")
+	sb.WriteString(err.Error())
+	sb.WriteString("]")
+}
+
 // TextWriteString writes a quoted string proto text value.
 func TextWriteString(sb *TextBuilder, v string) {
 	sb.WriteString(strconv.Quote(v))
@@ -5,6 +5,9 @@ import (
 	"io"
 	"math"
 	"testing"
+	"time"
+
+	"github.com/aperturerobotics/protobuf-go-lite/encoding/protowire"
 )
 
 type testCase struct {
@@ -448,6 +451,43 @@ func TestSkipWithin(t *testing.T) {
 	}
 }
 
+func TestRangeUnknownFields(t *testing.T) {
+	buf := []byte{0x08, 0x01, 0x12, 0x02, 'a', 'b', 0x98, 0x06, 0x7b}
+	want := []UnknownField{
+		{Number: 1, Type: protowire.VarintType, Raw: buf[0:2]},
+		{Number: 2, Type: protowire.BytesType, Raw: buf[2:6]},
+		{Number: 99, Type: protowire.VarintType, Raw: buf[6:]},
+	}
+	var got []UnknownField
+	for field, err := range RangeUnknownFields(buf) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, field)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("RangeUnknownFields yielded %d fields, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Number != want[i].Number || got[i].Type != want[i].Type || !bytes.Equal(got[i].Raw, want[i].Raw) {
+			t.Fatalf("RangeUnknownFields field %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	var n int
+	var gotErr error
+	for _, err := range RangeUnknownFields(buf[:5]) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		n++
+	}
+	if n != 1 || gotErr != io.ErrUnexpectedEOF {
+		t.Fatalf("RangeUnknownFields truncated = %d fields, %v; want 1 field, ErrUnexpectedEOF", n, gotErr)
+	}
+}
+
 type testTextEnum int
 
 func (e testTextEnum) String() string {
@@ -721,3 +761,51 @@ func TestDecodeStringUnsafe(t *testing.T) {
 		t.Errorf("DecodeStringUnsafe empty got %q, want empty", result)
 	}
 }
+
+func TestStdHelpers(t *testing.T) {
+	var d time.Duration
+	// Duration { seconds: MaxInt64 } saturates.
+	wire := protowire.AppendTag(nil, 1, protowire.VarintType)
+	wire = protowire.AppendVarint(wire, math.MaxInt64)
+	if err := UnmarshalStd(wire, &d); err != nil {
+		t.Fatal(err)
+	}
+	if d != math.MaxInt64 {
+		t.Fatalf("UnmarshalStd(max seconds) = %d, want %d", d, int64(math.MaxInt64))
+	}
+
+	// Unknown fields of a wrapper are skipped.
+	var s string
+	wire = protowire.AppendTag(nil, 9, protowire.VarintType)
+	wire = protowire.AppendVarint(wire, 1)
+	wire = protowire.AppendTag(wire, 1, protowire.BytesType)
+	wire = protowire.AppendString(wire, "x")
+	if err := UnmarshalStd(wire, &s); err != nil || s != "x" {
+		t.Fatalf("UnmarshalStd() = %q, %v, want \"x\"", s, err)
+	}
+
+	// A value field with the wrong wire type is rejected.
+	var v int64
+	wire = protowire.AppendTag(nil, 1, protowire.BytesType)
+	wire = protowire.AppendBytes(wire, nil)
+	if err := UnmarshalStd(wire, &v); err == nil {
+		t.Fatal("UnmarshalStd() accepted a length-delimited int64 value")
+	}
+
+	// Zero values encode as empty messages.
+	for _, n := range []int{SizeStdValue(1, time.Duration(0)), SizeStdValue(1, false), SizeStdValue(1, []byte{})} {
+		if n != 2 {
+			t.Fatalf("SizeStdValue(zero) = %d, want 2", n)
+		}
+	}
+	buf := make([]byte, 32)
+	ts := time.Unix(-1, 5)
+	i := EncodeStd(buf, len(buf), ts)
+	if got := SizeStdValue(0, ts); got != len(buf)-i {
+		t.Fatalf("SizeStdValue() = %d, EncodeStd() wrote %d bytes", got, len(buf)-i)
+	}
+	var decoded time.Time
+	if err := UnmarshalStd(buf[i+1:], &decoded); err != nil || !decoded.Equal(ts) {
+		t.Fatalf("UnmarshalStd() = %v, %v, want %v", decoded, err, ts)
+	}
+}
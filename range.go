@@ -0,0 +1,64 @@
+package protobuf_go_lite
+
+import (
+	"fmt"
+	"iter"
+)
+
+// RangeMessages iterates over the occurrences of the length-delimited message
+// field num in dAtA, a serialized message, decoding each one with unmarshal.
+// Other fields are skipped, but still checked for well-formed wire structure.
+//
+// The same *T is reset and reused for every element: callers that keep an
+// element past the current iteration must copy it. Only the top-level struct
+// is reused: it is zeroed before each element, so the sub-messages, slices and
+// maps of the previous element are dropped and each element allocates its
+// own. Iteration stops after the first error is yielded.
+func RangeMessages[T any](dAtA []byte, num int32, unmarshal func(*T, []byte) error) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		var elem T
+		l := len(dAtA)
+		for iNdEx := 0; iNdEx < l; {
+			preIndex := iNdEx
+			wire, next, err := DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			fieldNum := int32(wire >> 3) //nolint:gosec
+			wireType := int(wire & 0x7)
+			if fieldNum <= 0 {
+				yield(nil, fmt.Errorf("proto: illegal tag %d (wire type %d)", fieldNum, wire))
+				return
+			}
+			if fieldNum != num {
+				if iNdEx, err = SkipWithin(dAtA, preIndex, l); err != nil {
+					yield(nil, err)
+					return
+				}
+				continue
+			}
+			if wireType != 2 {
+				yield(nil, fmt.Errorf("proto: wrong wireType = %d for field %d", wireType, num))
+				return
+			}
+			msgStart, postIndex, err := DecodeLengthDelimited(dAtA, next)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			// Zero the element rather than reset its fields in place, so that
+			// no state of the previous element leaks into the next one.
+			var zero T
+			elem = zero
+			if err := unmarshal(&elem, dAtA[msgStart:postIndex]); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(&elem, nil) {
+				return
+			}
+			iNdEx = postIndex
+		}
+	}
+}
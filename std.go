@@ -0,0 +1,501 @@
+package protobuf_go_lite
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Std is the constraint satisfied by the native Go types of well-known type
+// fields generated with the stdtime, stdduration and wktpointer directives.
+// Each value is encoded like the well-known type message it stands for.
+type Std interface {
+	time.Time | time.Duration |
+		float64 | float32 | int64 | uint64 | int32 | uint32 | bool | string | []byte
+}
+
+// timestampParts returns the seconds and nanos of the Timestamp message of t.
+func timestampParts(t time.Time) (int64, int32) {
+	return t.Unix(), int32(t.Nanosecond()) //nolint:gosec
+}
+
+// durationParts returns the seconds and nanos of the Duration message of d.
+func durationParts(d time.Duration) (int64, int32) {
+	return int64(d / time.Second), int32(d % time.Second) //nolint:gosec
+}
+
+// durationFromParts returns the duration of a Duration message, saturating on
+// overflow like durationpb.AsDuration.
+func durationFromParts(secs int64, nanos int32) time.Duration {
+	d := time.Duration(secs) * time.Second
+	overflow := d/time.Second != time.Duration(secs)
+	d += time.Duration(nanos)
+	overflow = overflow || (secs < 0 && nanos < 0 && d > 0)
+	overflow = overflow || (secs > 0 && nanos > 0 && d < 0)
+	if overflow {
+		switch {
+		case secs < 0:
+			return time.Duration(math.MinInt64)
+		case secs > 0:
+			return time.Duration(math.MaxInt64)
+		}
+	}
+	return d
+}
+
+// sizeParts returns the encoded size of the seconds and nanos fields.
+func sizeParts(secs int64, nanos int32) (n int) {
+	if secs != 0 {
+		n += 1 + SizeOfVarint(uint64(secs)) //nolint:gosec
+	}
+	if nanos != 0 {
+		n += 1 + SizeOfVarint(uint64(nanos)) //nolint:gosec
+	}
+	return n
+}
+
+// sizeStd returns the encoded size of the message body of v.
+func sizeStd[T Std](v T) int {
+	switch v := any(v).(type) {
+	case time.Time:
+		return sizeParts(timestampParts(v))
+	case time.Duration:
+		return sizeParts(durationParts(v))
+	case float64:
+		if v != 0 {
+			return 9
+		}
+	case float32:
+		if v != 0 {
+			return 5
+		}
+	case int64:
+		if v != 0 {
+			return 1 + SizeOfVarint(uint64(v)) //nolint:gosec
+		}
+	case uint64:
+		if v != 0 {
+			return 1 + SizeOfVarint(v)
+		}
+	case int32:
+		if v != 0 {
+			return 1 + SizeOfVarint(uint64(v)) //nolint:gosec
+		}
+	case uint32:
+		if v != 0 {
+			return 1 + SizeOfVarint(uint64(v))
+		}
+	case bool:
+		if v {
+			return 2
+		}
+	case string:
+		if v != "" {
+			return SizeStringValue(1, v)
+		}
+	case []byte:
+		if len(v) != 0 {
+			return SizeBytesValue(1, len(v))
+		}
+	}
+	return 0
+}
+
+// SizeStdValue returns the encoded field size for one native well-known type
+// value.
+func SizeStdValue[T Std](keySize int, v T) int {
+	return SizeMessage(keySize, sizeStd(v))
+}
+
+// SizeStdPtr returns the encoded field size for one explicit native well-known
+// type value.
+func SizeStdPtr[T Std](keySize int, v *T) int {
+	if v == nil {
+		return 0
+	}
+	return SizeStdValue(keySize, *v)
+}
+
+// SizeStdSlice returns the encoded field size for repeated native well-known
+// type values.
+func SizeStdSlice[S ~[]E, E Std](keySize int, vals S) (n int) {
+	for _, v := range vals {
+		n += SizeStdValue(keySize, v)
+	}
+	return n
+}
+
+// encodeParts writes the seconds and nanos fields before offset and returns
+// the new offset.
+func encodeParts(dAtA []byte, offset int, secs int64, nanos int32) int {
+	if nanos != 0 {
+		offset = EncodeVarint(dAtA, offset, uint64(nanos)) //nolint:gosec
+		offset--
+		dAtA[offset] = 0x10
+	}
+	if secs != 0 {
+		offset = EncodeVarint(dAtA, offset, uint64(secs)) //nolint:gosec
+		offset--
+		dAtA[offset] = 0x8
+	}
+	return offset
+}
+
+// EncodeStd writes the length-delimited message of a native well-known type
+// value before offset and returns the new offset.
+func EncodeStd[T Std](dAtA []byte, offset int, v T) int {
+	end := offset
+	switch v := any(v).(type) {
+	case time.Time:
+		s, n := timestampParts(v)
+		offset = encodeParts(dAtA, offset, s, n)
+	case time.Duration:
+		s, n := durationParts(v)
+		offset = encodeParts(dAtA, offset, s, n)
+	case float64:
+		if v != 0 {
+			offset = EncodeFixed64(dAtA, offset, math.Float64bits(v))
+			offset--
+			dAtA[offset] = 0x9
+		}
+	case float32:
+		if v != 0 {
+			offset = EncodeFixed32(dAtA, offset, math.Float32bits(v))
+			offset--
+			dAtA[offset] = 0xd
+		}
+	case int64:
+		if v != 0 {
+			offset = EncodeVarint(dAtA, offset, uint64(v)) //nolint:gosec
+			offset--
+			dAtA[offset] = 0x8
+		}
+	case uint64:
+		if v != 0 {
+			offset = EncodeVarint(dAtA, offset, v)
+			offset--
+			dAtA[offset] = 0x8
+		}
+	case int32:
+		if v != 0 {
+			offset = EncodeVarint(dAtA, offset, uint64(v)) //nolint:gosec
+			offset--
+			dAtA[offset] = 0x8
+		}
+	case uint32:
+		if v != 0 {
+			offset = EncodeVarint(dAtA, offset, uint64(v))
+			offset--
+			dAtA[offset] = 0x8
+		}
+	case bool:
+		if v {
+			offset = EncodeBool(dAtA, offset, v)
+			offset--
+			dAtA[offset] = 0x8
+		}
+	case string:
+		if v != "" {
+			offset = EncodeString(dAtA, offset, v)
+			offset--
+			dAtA[offset] = 0xa
+		}
+	case []byte:
+		if len(v) != 0 {
+			offset = EncodeBytes(dAtA, offset, v)
+			offset--
+			dAtA[offset] = 0xa
+		}
+	}
+	return EncodeVarint(dAtA, offset, uint64Len(end-offset))
+}
+
+// decodeStdFields calls decode with the number, wire type and value index of
+// each field of the message in dAtA. decode returns the index after the value,
+// or 0 to skip the field.
+func decodeStdFields(dAtA []byte, decode func(num int32, wireType, idx int) (int, error)) error {
+	l := len(dAtA)
+	for idx := 0; idx < l; {
+		wire, next, err := DecodeVarint(dAtA, idx)
+		if err != nil {
+			return err
+		}
+		num := int32(wire >> 3) //nolint:gosec
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: wiretype end group for non-group")
+		}
+		if num <= 0 {
+			return fmt.Errorf("proto: illegal tag %d (wire type %d)", num, wire)
+		}
+		if next, err = decode(num, wireType, next); err != nil {
+			return err
+		}
+		if next == 0 {
+			if next, err = SkipWithin(dAtA, idx, l); err != nil {
+				return err
+			}
+		}
+		idx = next
+	}
+	return nil
+}
+
+// checkWireType returns an error if the wire type of the field name is not
+// want.
+func checkWireType(wireType, want int, name string) error {
+	if wireType != want {
+		return fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, name)
+	}
+	return nil
+}
+
+// unmarshalParts merges the seconds and nanos fields in dAtA into secs and
+// nanos.
+func unmarshalParts(dAtA []byte, secs *int64, nanos *int32) error {
+	return decodeStdFields(dAtA, func(num int32, wireType, idx int) (int, error) {
+		var err error
+		switch num {
+		case 1:
+			if err := checkWireType(wireType, 0, "Seconds"); err != nil {
+				return 0, err
+			}
+			*secs, idx, err = DecodeVarintInt64(dAtA, idx)
+		case 2:
+			if err := checkWireType(wireType, 0, "Nanos"); err != nil {
+				return 0, err
+			}
+			*nanos, idx, err = DecodeVarintInt32(dAtA, idx)
+		default:
+			return 0, nil
+		}
+		return idx, err
+	})
+}
+
+// UnmarshalStd merges the well-known type message in dAtA into v. A zero
+// time.Time is merged into like an empty Timestamp.
+func UnmarshalStd[T Std](dAtA []byte, v *T) error {
+	switch v := any(v).(type) {
+	case *time.Time:
+		var s int64
+		var n int32
+		if !v.IsZero() {
+			s, n = timestampParts(*v)
+		}
+		if err := unmarshalParts(dAtA, &s, &n); err != nil {
+			return err
+		}
+		*v = time.Unix(s, int64(n)).UTC()
+		return nil
+	case *time.Duration:
+		s, n := durationParts(*v)
+		if err := unmarshalParts(dAtA, &s, &n); err != nil {
+			return err
+		}
+		*v = durationFromParts(s, n)
+		return nil
+	}
+	return decodeStdFields(dAtA, func(num int32, wireType, idx int) (int, error) {
+		if num != 1 {
+			return 0, nil
+		}
+		var err error
+		switch v := any(v).(type) {
+		case *float64:
+			if err := checkWireType(wireType, 1, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeFloat64(dAtA, idx)
+		case *float32:
+			if err := checkWireType(wireType, 5, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeFloat32(dAtA, idx)
+		case *int64:
+			if err := checkWireType(wireType, 0, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeVarintInt64(dAtA, idx)
+		case *uint64:
+			if err := checkWireType(wireType, 0, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeVarint(dAtA, idx)
+		case *int32:
+			if err := checkWireType(wireType, 0, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeVarintInt32(dAtA, idx)
+		case *uint32:
+			if err := checkWireType(wireType, 0, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeVarintUint32(dAtA, idx)
+		case *bool:
+			if err := checkWireType(wireType, 0, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeVarintBool(dAtA, idx)
+		case *string:
+			if err := checkWireType(wireType, 2, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeString(dAtA, idx)
+		case *[]byte:
+			if err := checkWireType(wireType, 2, "Value"); err != nil {
+				return 0, err
+			}
+			*v, idx, err = DecodeBytesAppend(*v, dAtA, idx)
+		}
+		return idx, err
+	})
+}
+
+// MergeStd merges src into dst like the well-known type messages they stand
+// for: the fields set in src replace those of dst.
+func MergeStd[T Std](dst *T, src T) {
+	switch d := any(dst).(type) {
+	case *time.Time:
+		var s, ss int64
+		var n, sn int32
+		if !d.IsZero() {
+			s, n = timestampParts(*d)
+		}
+		if src := any(src).(time.Time); !src.IsZero() {
+			ss, sn = timestampParts(src)
+		}
+		*d = time.Unix(cmp.Or(ss, s), int64(cmp.Or(sn, n))).UTC()
+	case *time.Duration:
+		s, n := durationParts(*d)
+		ss, sn := durationParts(any(src).(time.Duration))
+		*d = durationFromParts(cmp.Or(ss, s), cmp.Or(sn, n))
+	case *[]byte:
+		if src := any(src).([]byte); len(src) != 0 {
+			*d = append((*d)[:0], src...)
+		}
+	default:
+		var zero T
+		if any(src) != any(zero) {
+			*dst = src
+		}
+	}
+}
+
+// CloneStd clones one native well-known type value.
+func CloneStd[T Std](v T) T {
+	if b, ok := any(v).([]byte); ok {
+		return any(bytes.Clone(b)).(T)
+	}
+	return v
+}
+
+// CloneStdPtr clones one explicit native well-known type value.
+func CloneStdPtr[T Std](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	out := CloneStd(*v)
+	return &out
+}
+
+// CloneStdSlice clones repeated native well-known type values.
+func CloneStdSlice[S ~[]E, E Std](s S) S {
+	if s == nil {
+		return nil
+	}
+	out := make(S, len(s))
+	for i, v := range s {
+		out[i] = CloneStd(v)
+	}
+	return out
+}
+
+// CloneStdMap clones a map whose values are native well-known type values.
+func CloneStdMap[M ~map[K]V, K comparable, V Std](m M) M {
+	if m == nil {
+		return nil
+	}
+	out := make(M, len(m))
+	for k, v := range m {
+		out[k] = CloneStd(v)
+	}
+	return out
+}
+
+// EqualStd compares two native well-known type values. Times are equal if
+// they are the same instant.
+func EqualStd[T Std](a, b T) bool {
+	switch a := any(a).(type) {
+	case time.Time:
+		return a.Equal(any(b).(time.Time))
+	case []byte:
+		return bytes.Equal(a, any(b).([]byte))
+	}
+	return any(a) == any(b)
+}
+
+// EqualStdPtr compares two explicit native well-known type values.
+func EqualStdPtr[T Std](a, b *T) bool {
+	return a == nil && b == nil || a != nil && b != nil && EqualStd(*a, *b)
+}
+
+// EqualStdSlice compares repeated native well-known type values.
+func EqualStdSlice[S ~[]E, E Std](a, b S) bool {
+	return slices.EqualFunc(a, b, EqualStd[E])
+}
+
+// EqualStdMap compares maps with native well-known type values.
+func EqualStdMap[M ~map[K]V, K comparable, V Std](a, b M) bool {
+	return maps.EqualFunc(a, b, EqualStd[V])
+}
+
+// TextWriteStd writes a native well-known type value as the proto text of the
+// message it stands for.
+func TextWriteStd[T Std](sb *TextBuilder, v T) {
+	switch v := any(v).(type) {
+	case time.Time:
+		textWriteParts(sb, v.Unix(), v.Nanosecond())
+	case time.Duration:
+		s, n := durationParts(v)
+		textWriteParts(sb, s, int(n))
+	case float64:
+		sb.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case float32:
+		sb.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case int64:
+		sb.WriteString(strconv.FormatInt(v, 10))
+	case uint64:
+		sb.WriteString(strconv.FormatUint(v, 10))
+	case int32:
+		sb.WriteString(strconv.FormatInt(int64(v), 10))
+	case uint32:
+		sb.WriteString(strconv.FormatUint(uint64(v), 10))
+	case bool:
+		sb.WriteString(strconv.FormatBool(v))
+	case string:
+		TextWriteString(sb, v)
+	case []byte:
+		TextWriteBytes(sb, v)
+	}
+}
+
+// textWriteParts writes the seconds and nanos of a Timestamp or Duration.
+func textWriteParts(sb *TextBuilder, secs int64, nanos int) {
+	if secs != 0 {
+		sb.WriteString("seconds:")
+		sb.WriteString(strconv.FormatInt(secs, 10))
+	}
+	if nanos != 0 {
+		if secs != 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("nanos:")
+		sb.WriteString(strconv.Itoa(nanos))
+	}
+}
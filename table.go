@@ -0,0 +1,943 @@
+package protobuf_go_lite
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// TableKind is the protobuf kind of a TableField.
+type TableKind uint8
+
+const (
+	// TableKindBool is a bool field.
+	TableKindBool TableKind = iota + 1
+	// TableKindInt32 is an int32 or enum field.
+	TableKindInt32
+	// TableKindInt64 is an int64 field.
+	TableKindInt64
+	// TableKindUint32 is a uint32 field.
+	TableKindUint32
+	// TableKindUint64 is a uint64 field.
+	TableKindUint64
+	// TableKindSint32 is a zigzag-encoded sint32 field.
+	TableKindSint32
+	// TableKindSint64 is a zigzag-encoded sint64 field.
+	TableKindSint64
+	// TableKindFixed32 is a fixed32 field.
+	TableKindFixed32
+	// TableKindFixed64 is a fixed64 field.
+	TableKindFixed64
+	// TableKindSfixed32 is a sfixed32 field.
+	TableKindSfixed32
+	// TableKindSfixed64 is a sfixed64 field.
+	TableKindSfixed64
+	// TableKindFloat is a float field.
+	TableKindFloat
+	// TableKindDouble is a double field.
+	TableKindDouble
+	// TableKindString is a string field.
+	TableKindString
+	// TableKindBytes is a bytes field.
+	TableKindBytes
+	// TableKindMessage is a length-delimited sub-message field.
+	TableKindMessage
+	// TableKindMap is a map field.
+	TableKindMap
+)
+
+// TableFlags are the field representation flags of a TableField.
+type TableFlags uint8
+
+const (
+	// TableFlagPointer marks a scalar with explicit presence stored as *T.
+	TableFlagPointer TableFlags = 1 << iota
+	// TableFlagRepeated marks a repeated field stored as []T.
+	TableFlagRepeated
+	// TableFlagPacked marks a repeated scalar field marshaled in packed form.
+	TableFlagPacked
+	// TableFlagRequired marks a legacy required field.
+	TableFlagRequired
+	// TableFlagPresence marks a bytes field that is marshaled whenever it is non-nil.
+	TableFlagPresence
+	// TableFlagUTF8 marks a string field that must contain valid UTF-8.
+	TableFlagUTF8
+)
+
+// Table is the static field table of a message generated with codegen=table.
+//
+// The table is interpreted by TableSize, TableMarshal and TableUnmarshal using
+// field offsets instead of reflection.
+type Table struct {
+	// Name is the full protobuf name of the message.
+	Name string
+	// Fields are the message fields ordered by field number.
+	Fields []TableField
+	// Unknown is the offset of the unknownFields byte slice.
+	Unknown uintptr
+}
+
+// TableField describes one field of a Table.
+type TableField struct {
+	// Name is the protobuf name of the field.
+	Name string
+	// Offset is the offset of the field (or oneof interface) in the message struct.
+	Offset uintptr
+	// Number is the protobuf field number.
+	Number int32
+	// Kind is the protobuf kind of the field.
+	Kind TableKind
+	// Flags are the representation flags of the field.
+	Flags TableFlags
+	// Oneof is the 1-based index of the oneof containing the field, or 0.
+	Oneof uint8
+	// Type is the sub-message type for message fields and message map values.
+	Type TableType
+	// Case accesses the oneof wrapper for fields in a oneof.
+	Case TableOneof
+	// Map describes the entries of map fields.
+	Map *TableMap
+}
+
+// TableType provides the sub-message operations of one message type.
+//
+// It is implemented by TableMessage.
+type TableType interface {
+	new() unsafe.Pointer
+	size(p unsafe.Pointer) int
+	marshal(p unsafe.Pointer, dAtA []byte) (int, error)
+	unmarshal(p unsafe.Pointer, dAtA []byte) error
+}
+
+// TableMessage implements TableType for the generated message type T.
+type TableMessage[T any, P interface {
+	*T
+	SizeVT() int
+	MarshalToSizedBufferVT(dAtA []byte) (int, error)
+	UnmarshalVT(dAtA []byte) error
+}] struct{}
+
+func (TableMessage[T, P]) new() unsafe.Pointer {
+	return unsafe.Pointer(new(T))
+}
+
+func (TableMessage[T, P]) size(p unsafe.Pointer) int {
+	return P(p).SizeVT()
+}
+
+func (TableMessage[T, P]) marshal(p unsafe.Pointer, dAtA []byte) (int, error) {
+	return P(p).MarshalToSizedBufferVT(dAtA)
+}
+
+func (TableMessage[T, P]) unmarshal(p unsafe.Pointer, dAtA []byte) error {
+	return P(p).UnmarshalVT(dAtA)
+}
+
+// TableMarshalFuncs holds the strict marshal functions of the message and
+// message-valued map fields of a Table, indexed like Table.Fields.
+//
+// It is kept apart from the Table so that strict marshaling of sub-messages is
+// only linked in when MarshalVTStrict is used.
+type TableMarshalFuncs []func(p unsafe.Pointer, dAtA []byte) (int, error)
+
+// TableUnmarshalFuncs holds the unsafe unmarshal functions of the message and
+// message-valued map fields of a Table, indexed like Table.Fields.
+type TableUnmarshalFuncs []func(p unsafe.Pointer, dAtA []byte) error
+
+// TableStrict calls MarshalToSizedBufferVTStrict on the message at p.
+func TableStrict[T any, P interface {
+	*T
+	MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
+}](p unsafe.Pointer, dAtA []byte) (int, error) {
+	return P(p).MarshalToSizedBufferVTStrict(dAtA)
+}
+
+// TableUnsafe calls UnmarshalVTUnsafe on the message at p.
+func TableUnsafe[T any, P interface {
+	*T
+	UnmarshalVTUnsafe(dAtA []byte) error
+}](p unsafe.Pointer, dAtA []byte) error {
+	return P(p).UnmarshalVTUnsafe(dAtA)
+}
+
+// TableOneof accesses one oneof case wrapper stored in a oneof interface.
+//
+// It is implemented by TableCase.
+type TableOneof interface {
+	get(p unsafe.Pointer) unsafe.Pointer
+	set(p unsafe.Pointer) unsafe.Pointer
+}
+
+// TableCase implements TableOneof for the oneof case wrapper W of interface I.
+//
+// W must be a generated oneof wrapper struct with a single field.
+type TableCase[I any, W any] struct{}
+
+func (TableCase[I, W]) get(p unsafe.Pointer) unsafe.Pointer {
+	if w, ok := any(*(*I)(p)).(*W); ok {
+		return unsafe.Pointer(w)
+	}
+	return nil
+}
+
+func (TableCase[I, W]) set(p unsafe.Pointer) unsafe.Pointer {
+	w := new(W)
+	*(*I)(p) = any(w).(I)
+	return unsafe.Pointer(w)
+}
+
+// TableMap describes the entries of a map field.
+type TableMap struct {
+	// Key is the map entry key field.
+	Key TableField
+	// Value is the map entry value field.
+	Value TableField
+	// Access accesses the Go map.
+	Access TableMapAccess
+}
+
+// TableMapAccess accesses a Go map field.
+//
+// It is implemented by TableMapOf.
+type TableMapAccess interface {
+	len(p unsafe.Pointer) int
+	each(p unsafe.Pointer, fn func(k, v unsafe.Pointer) error) error
+	insert(p unsafe.Pointer, fn func(k, v unsafe.Pointer) error) error
+}
+
+// TableMapOf implements TableMapAccess for map[K]V.
+type TableMapOf[K comparable, V any] struct{}
+
+func (TableMapOf[K, V]) len(p unsafe.Pointer) int {
+	return len(*(*map[K]V)(p))
+}
+
+func (TableMapOf[K, V]) each(p unsafe.Pointer, fn func(k, v unsafe.Pointer) error) error {
+	var kv K
+	var vv V
+	for k, v := range *(*map[K]V)(p) {
+		kv, vv = k, v
+		if err := fn(unsafe.Pointer(&kv), unsafe.Pointer(&vv)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (TableMapOf[K, V]) insert(p unsafe.Pointer, fn func(k, v unsafe.Pointer) error) error {
+	var k K
+	var v V
+	if err := fn(unsafe.Pointer(&k), unsafe.Pointer(&v)); err != nil {
+		return err
+	}
+	m := (*map[K]V)(p)
+	if *m == nil {
+		*m = make(map[K]V)
+	}
+	(*m)[k] = v
+	return nil
+}
+
+// tableSlice mirrors the runtime layout of a slice header.
+type tableSlice struct {
+	data unsafe.Pointer
+	len  int
+	cap  int
+}
+
+// wireType returns the protobuf wire type of one field element.
+func (f *TableField) wireType() int {
+	switch f.Kind {
+	case TableKindFixed64, TableKindSfixed64, TableKindDouble:
+		return 1
+	case TableKindString, TableKindBytes, TableKindMessage, TableKindMap:
+		return 2
+	case TableKindFixed32, TableKindSfixed32, TableKindFloat:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// key returns the encoded field key for wire type wt.
+func (f *TableField) key(wt int) uint64 {
+	return uint64(f.Number)<<3 | uint64(wt) //nolint:gosec
+}
+
+// width returns the in-memory size of one element.
+func (f *TableField) width() uintptr {
+	switch f.Kind {
+	case TableKindBool:
+		return 1
+	case TableKindInt32, TableKindUint32, TableKindSint32, TableKindFixed32, TableKindSfixed32, TableKindFloat:
+		return 4
+	case TableKindString:
+		return unsafe.Sizeof("")
+	case TableKindBytes:
+		return unsafe.Sizeof([]byte(nil))
+	case TableKindMessage:
+		return unsafe.Sizeof(unsafe.Pointer(nil))
+	default:
+		return 8
+	}
+}
+
+// isZero reports whether the value at p is the implicit-presence default.
+func (f *TableField) isZero(p unsafe.Pointer) bool {
+	switch f.Kind {
+	case TableKindBool:
+		return !*(*bool)(p)
+	case TableKindFloat:
+		return *(*float32)(p) == 0
+	case TableKindDouble:
+		return *(*float64)(p) == 0
+	case TableKindString:
+		return len(*(*string)(p)) == 0
+	case TableKindBytes:
+		return len(*(*[]byte)(p)) == 0
+	case TableKindMessage:
+		return *(*unsafe.Pointer)(p) == nil
+	}
+	if f.width() == 4 {
+		return *(*uint32)(p) == 0
+	}
+	return *(*uint64)(p) == 0
+}
+
+// varint returns the varint encoding of the scalar value at p.
+func (f *TableField) varint(p unsafe.Pointer) uint64 {
+	switch f.Kind {
+	case TableKindBool:
+		if *(*bool)(p) {
+			return 1
+		}
+		return 0
+	case TableKindInt32:
+		return uint64(*(*int32)(p)) //nolint:gosec
+	case TableKindUint32:
+		return uint64(*(*uint32)(p))
+	case TableKindSint32:
+		v := *(*int32)(p)
+		return uint64((uint32(v) << 1) ^ uint32(v>>31)) //nolint:gosec
+	case TableKindSint64:
+		v := *(*int64)(p)
+		return (uint64(v) << 1) ^ uint64(v>>63) //nolint:gosec
+	default:
+		return *(*uint64)(p)
+	}
+}
+
+// valueSize returns the encoded size of the value at p without its key.
+func (f *TableField) valueSize(p unsafe.Pointer) int {
+	switch f.wireType() {
+	case 0:
+		return SizeOfVarint(f.varint(p))
+	case 1:
+		return 8
+	case 5:
+		return 4
+	}
+	var l int
+	switch f.Kind {
+	case TableKindString:
+		l = len(*(*string)(p))
+	case TableKindBytes:
+		l = len(*(*[]byte)(p))
+	case TableKindMessage:
+		if mp := *(*unsafe.Pointer)(p); mp != nil {
+			l = f.Type.size(mp)
+		}
+	}
+	return SizeOfVarint(uint64(l)) + l //nolint:gosec
+}
+
+// marshalValue writes the value at p before offset i without its key.
+//
+// Sub-messages are marshaled with sub if it is set.
+func (f *TableField) marshalValue(p unsafe.Pointer, dAtA []byte, i int, sub func(p unsafe.Pointer, dAtA []byte) (int, error)) (int, error) {
+	switch f.Kind {
+	case TableKindFixed32, TableKindSfixed32, TableKindFloat:
+		return EncodeFixed32(dAtA, i, *(*uint32)(p)), nil
+	case TableKindFixed64, TableKindSfixed64, TableKindDouble:
+		return EncodeFixed64(dAtA, i, *(*uint64)(p)), nil
+	case TableKindString:
+		return EncodeString(dAtA, i, *(*string)(p)), nil
+	case TableKindBytes:
+		return EncodeBytes(dAtA, i, *(*[]byte)(p)), nil
+	case TableKindMessage:
+		mp := *(*unsafe.Pointer)(p)
+		if mp == nil {
+			return EncodeVarint(dAtA, i, 0), nil
+		}
+		var size int
+		var err error
+		if sub != nil {
+			size, err = sub(mp, dAtA[:i])
+		} else {
+			size, err = f.Type.marshal(mp, dAtA[:i])
+		}
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		return EncodeVarint(dAtA, i, uint64Len(size)), nil
+	default:
+		return EncodeVarint(dAtA, i, f.varint(p)), nil
+	}
+}
+
+// element returns the address of element idx of the slice at p.
+func (f *TableField) element(p unsafe.Pointer, idx int) unsafe.Pointer {
+	return unsafe.Add((*tableSlice)(p).data, uintptr(idx)*f.width())
+}
+
+// present returns the address of the field value at p if it is set.
+func (f *TableField) present(p unsafe.Pointer) unsafe.Pointer {
+	switch {
+	case f.Case != nil:
+		return f.Case.get(p)
+	case f.Flags&TableFlagPointer != 0:
+		return *(*unsafe.Pointer)(p)
+	case f.Flags&TableFlagPresence != 0:
+		if *(*[]byte)(p) == nil {
+			return nil
+		}
+		return p
+	case f.isZero(p):
+		return nil
+	default:
+		return p
+	}
+}
+
+// size returns the encoded size of the field stored in the message at mp.
+func (f *TableField) size(mp unsafe.Pointer) (n int) {
+	p := unsafe.Add(mp, f.Offset)
+	if f.Kind == TableKindMap {
+		return f.mapSize(p)
+	}
+	if f.Flags&TableFlagRepeated != 0 {
+		l := (*tableSlice)(p).len
+		if l == 0 {
+			return 0
+		}
+		for idx := 0; idx < l; idx++ {
+			n += f.valueSize(f.element(p, idx))
+		}
+		if f.Flags&TableFlagPacked != 0 {
+			return SizeOfVarint(f.key(2)) + SizeOfVarint(uint64(n)) + n //nolint:gosec
+		}
+		return n + l*SizeOfVarint(f.key(f.wireType()))
+	}
+	vp := f.present(p)
+	if vp == nil {
+		return 0
+	}
+	return SizeOfVarint(f.key(f.wireType())) + f.valueSize(vp)
+}
+
+// mapSize returns the encoded size of the map field at p.
+func (f *TableField) mapSize(p unsafe.Pointer) (n int) {
+	mt := f.Map
+	if mt.Access.len(p) == 0 {
+		return 0
+	}
+	keySize := SizeOfVarint(f.key(2))
+	_ = mt.Access.each(p, func(k, v unsafe.Pointer) error {
+		l := SizeOfVarint(mt.Key.key(mt.Key.wireType())) + mt.Key.valueSize(k) +
+			SizeOfVarint(mt.Value.key(mt.Value.wireType())) + mt.Value.valueSize(v)
+		n += keySize + SizeOfVarint(uint64(l)) + l //nolint:gosec
+		return nil
+	})
+	return n
+}
+
+// marshal writes the field stored in the message at mp before offset i.
+func (f *TableField) marshal(mp unsafe.Pointer, dAtA []byte, i int, sub func(p unsafe.Pointer, dAtA []byte) (int, error)) (int, error) {
+	p := unsafe.Add(mp, f.Offset)
+	var err error
+	if f.Kind == TableKindMap {
+		return f.mapMarshal(p, dAtA, i, sub)
+	}
+	if f.Flags&TableFlagRepeated != 0 {
+		l := (*tableSlice)(p).len
+		if l == 0 {
+			return i, nil
+		}
+		if f.Flags&TableFlagPacked != 0 {
+			end := i
+			for idx := l - 1; idx >= 0; idx-- {
+				if i, err = f.marshalValue(f.element(p, idx), dAtA, i, sub); err != nil {
+					return 0, err
+				}
+			}
+			i = EncodeVarint(dAtA, i, uint64Len(end-i))
+			return EncodeVarint(dAtA, i, f.key(2)), nil
+		}
+		key := f.key(f.wireType())
+		for idx := l - 1; idx >= 0; idx-- {
+			if i, err = f.marshalValue(f.element(p, idx), dAtA, i, sub); err != nil {
+				return 0, err
+			}
+			i = EncodeVarint(dAtA, i, key)
+		}
+		return i, nil
+	}
+	vp := f.present(p)
+	if vp == nil {
+		if f.Flags&TableFlagRequired != 0 {
+			return 0, fmt.Errorf("proto: required field %s not set", f.Name)
+		}
+		return i, nil
+	}
+	if i, err = f.marshalValue(vp, dAtA, i, sub); err != nil {
+		return 0, err
+	}
+	return EncodeVarint(dAtA, i, f.key(f.wireType())), nil
+}
+
+// mapMarshal writes the map field at p before offset i.
+func (f *TableField) mapMarshal(p unsafe.Pointer, dAtA []byte, i int, sub func(p unsafe.Pointer, dAtA []byte) (int, error)) (int, error) {
+	mt := f.Map
+	if mt.Access.len(p) == 0 {
+		return i, nil
+	}
+	key := f.key(2)
+	err := mt.Access.each(p, func(k, v unsafe.Pointer) error {
+		var err error
+		baseI := i
+		if i, err = mt.Value.marshalValue(v, dAtA, i, sub); err != nil {
+			return err
+		}
+		i = EncodeVarint(dAtA, i, mt.Value.key(mt.Value.wireType()))
+		if i, err = mt.Key.marshalValue(k, dAtA, i, nil); err != nil {
+			return err
+		}
+		i = EncodeVarint(dAtA, i, mt.Key.key(mt.Key.wireType()))
+		i = EncodeVarint(dAtA, i, uint64Len(baseI-i))
+		i = EncodeVarint(dAtA, i, key)
+		return nil
+	})
+	return i, err
+}
+
+// decodeScalar decodes one non-length-delimited value at idx into p.
+func (f *TableField) decodeScalar(p unsafe.Pointer, dAtA []byte, idx int) (int, error) {
+	switch f.wireType() {
+	case 1:
+		v, idx, err := DecodeFixed64(dAtA, idx)
+		if err != nil {
+			return 0, err
+		}
+		*(*uint64)(p) = v
+		return idx, nil
+	case 5:
+		v, idx, err := DecodeFixed32(dAtA, idx)
+		if err != nil {
+			return 0, err
+		}
+		*(*uint32)(p) = v
+		return idx, nil
+	}
+	v, idx, err := DecodeVarint(dAtA, idx)
+	if err != nil {
+		return 0, err
+	}
+	switch f.Kind {
+	case TableKindBool:
+		*(*bool)(p) = v != 0
+	case TableKindInt32, TableKindUint32:
+		*(*uint32)(p) = uint32(v) //nolint:gosec
+	case TableKindSint32:
+		*(*int32)(p) = int32((uint32(v) >> 1) ^ uint32((int32(v&1)<<31)>>31)) //nolint:gosec
+	case TableKindSint64:
+		*(*uint64)(p) = (v >> 1) ^ uint64((int64(v&1)<<63)>>63) //nolint:gosec
+	default:
+		*(*uint64)(p) = v
+	}
+	return idx, nil
+}
+
+// decodeValue decodes one value at idx into p, which holds the field element.
+//
+// Sub-messages are decoded with sub if it is set.
+func (f *TableField) decodeValue(t *Table, p unsafe.Pointer, dAtA []byte, idx int, unsafeDecode bool, sub func(p unsafe.Pointer, dAtA []byte) error) (int, error) {
+	var err error
+	switch f.Kind {
+	case TableKindString:
+		var s string
+		if unsafeDecode {
+			s, idx, err = DecodeStringUnsafe(dAtA, idx)
+		} else {
+			s, idx, err = DecodeString(dAtA, idx)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if f.Flags&TableFlagUTF8 != 0 && !utf8.ValidString(s) {
+			return 0, fmt.Errorf("proto: field %s.%s contains invalid UTF-8", t.Name, f.Name)
+		}
+		*(*string)(p) = s
+		return idx, nil
+	case TableKindBytes:
+		*(*[]byte)(p), idx, err = DecodeBytes(dAtA, idx, !unsafeDecode)
+		return idx, err
+	case TableKindMessage:
+		start, end, err := DecodeLengthDelimited(dAtA, idx)
+		if err != nil {
+			return 0, err
+		}
+		mp := *(*unsafe.Pointer)(p)
+		if mp == nil {
+			mp = f.Type.new()
+			*(*unsafe.Pointer)(p) = mp
+		}
+		if sub != nil {
+			err = sub(mp, dAtA[start:end])
+		} else {
+			err = f.Type.unmarshal(mp, dAtA[start:end])
+		}
+		if err != nil {
+			return 0, err
+		}
+		return end, nil
+	default:
+		return f.decodeScalar(p, dAtA, idx)
+	}
+}
+
+// appendScalar appends the decoded scalar held in v to the slice at p.
+func (f *TableField) appendScalar(p unsafe.Pointer, v unsafe.Pointer) {
+	switch f.width() {
+	case 1:
+		s := (*[]bool)(p)
+		*s = append(*s, *(*bool)(v))
+	case 4:
+		s := (*[]uint32)(p)
+		*s = append(*s, *(*uint32)(v))
+	default:
+		s := (*[]uint64)(p)
+		*s = append(*s, *(*uint64)(v))
+	}
+}
+
+// growScalar preallocates room for n elements in the empty slice at p.
+func (f *TableField) growScalar(p unsafe.Pointer, n int) {
+	if n == 0 || (*tableSlice)(p).len != 0 {
+		return
+	}
+	switch f.width() {
+	case 1:
+		*(*[]bool)(p) = make([]bool, 0, n)
+	case 4:
+		*(*[]uint32)(p) = make([]uint32, 0, n)
+	default:
+		*(*[]uint64)(p) = make([]uint64, 0, n)
+	}
+}
+
+// unmarshal decodes the field at idx into the message at mp.
+func (f *TableField) unmarshal(t *Table, mp unsafe.Pointer, dAtA []byte, idx, wireType int, unsafeDecode bool, sub func(p unsafe.Pointer, dAtA []byte) error) (int, error) {
+	p := unsafe.Add(mp, f.Offset)
+	wt := f.wireType()
+	if f.Flags&TableFlagRepeated != 0 && wt != 2 {
+		var v uint64
+		if wireType == wt {
+			idx, err := f.decodeScalar(unsafe.Pointer(&v), dAtA, idx)
+			if err != nil {
+				return 0, err
+			}
+			f.appendScalar(p, unsafe.Pointer(&v))
+			return idx, nil
+		}
+		if wireType != 2 {
+			return 0, fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, f.Name)
+		}
+		start, end, err := DecodeLengthDelimited(dAtA, idx)
+		if err != nil {
+			return 0, err
+		}
+		switch wt {
+		case 1:
+			f.growScalar(p, PackedFixedElementCount(dAtA[start:end], 8))
+		case 5:
+			f.growScalar(p, PackedFixedElementCount(dAtA[start:end], 4))
+		default:
+			f.growScalar(p, PackedVarintElementCount(dAtA[start:end]))
+		}
+		for idx = start; idx < end; {
+			if idx, err = f.decodeScalar(unsafe.Pointer(&v), dAtA, idx); err != nil {
+				return 0, err
+			}
+			f.appendScalar(p, unsafe.Pointer(&v))
+		}
+		return idx, nil
+	}
+	if wireType != wt {
+		return 0, fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, f.Name)
+	}
+
+	switch {
+	case f.Kind == TableKindMap:
+		return f.mapUnmarshal(t, p, dAtA, idx, unsafeDecode, sub)
+	case f.Flags&TableFlagRepeated != 0:
+		switch f.Kind {
+		case TableKindString:
+			s := (*[]string)(p)
+			*s = append(*s, "")
+		case TableKindBytes:
+			s := (*[][]byte)(p)
+			*s = append(*s, nil)
+		default:
+			s := (*[]unsafe.Pointer)(p)
+			*s = append(*s, nil)
+		}
+		return f.decodeValue(t, f.element(p, (*tableSlice)(p).len-1), dAtA, idx, unsafeDecode, sub)
+	case f.Case != nil:
+		vp := f.Case.get(p)
+		if vp == nil || f.Kind != TableKindMessage {
+			vp = f.Case.set(p)
+		}
+		return f.decodeValue(t, vp, dAtA, idx, unsafeDecode, sub)
+	case f.Flags&TableFlagPointer != 0:
+		var vp unsafe.Pointer
+		switch f.Kind {
+		case TableKindString:
+			vp = unsafe.Pointer(new(string))
+		case TableKindBool:
+			vp = unsafe.Pointer(new(bool))
+		default:
+			if f.width() == 4 {
+				vp = unsafe.Pointer(new(uint32))
+			} else {
+				vp = unsafe.Pointer(new(uint64))
+			}
+		}
+		idx, err := f.decodeValue(t, vp, dAtA, idx, unsafeDecode, sub)
+		if err != nil {
+			return 0, err
+		}
+		*(*unsafe.Pointer)(p) = vp
+		return idx, nil
+	case f.Kind == TableKindBytes && !unsafeDecode:
+		var err error
+		*(*[]byte)(p), idx, err = DecodeBytesAppend(*(*[]byte)(p), dAtA, idx)
+		return idx, err
+	default:
+		return f.decodeValue(t, p, dAtA, idx, unsafeDecode, sub)
+	}
+}
+
+// mapUnmarshal decodes one map entry at idx into the map at p.
+func (f *TableField) mapUnmarshal(t *Table, p unsafe.Pointer, dAtA []byte, idx int, unsafeDecode bool, sub func(p unsafe.Pointer, dAtA []byte) error) (int, error) {
+	start, end, err := DecodeLengthDelimited(dAtA, idx)
+	if err != nil {
+		return 0, err
+	}
+	mt := f.Map
+	err = mt.Access.insert(p, func(k, v unsafe.Pointer) error {
+		for idx := start; idx < end; {
+			wire, next, err := DecodeVarint(dAtA, idx)
+			if err != nil {
+				return err
+			}
+			switch int32(wire >> 3) { //nolint:gosec
+			case 1:
+				idx, err = mt.Key.decodeValue(t, k, dAtA, next, unsafeDecode, nil)
+			case 2:
+				idx, err = mt.Value.decodeValue(t, v, dAtA, next, unsafeDecode, sub)
+			default:
+				idx, err = SkipWithin(dAtA, idx, end)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+// field returns the index of the field with number num, checking hint first.
+func (t *Table) field(num int32, hint int) int {
+	if hint < len(t.Fields) && t.Fields[hint].Number == num {
+		return hint
+	}
+	lo, hi := 0, len(t.Fields)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1) //nolint:gosec
+		if t.Fields[mid].Number < num {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(t.Fields) && t.Fields[lo].Number == num {
+		return lo
+	}
+	return -1
+}
+
+// TableSize returns the encoded size of the message at p described by t.
+func TableSize(t *Table, p unsafe.Pointer) (n int) {
+	if p == nil {
+		return 0
+	}
+	for i := range t.Fields {
+		n += t.Fields[i].size(p)
+	}
+	return n + len(*(*[]byte)(unsafe.Add(p, t.Unknown)))
+}
+
+// TableMarshal marshals the message at p described by t backwards into dAtA.
+//
+// Oneof fields are written after the other fields to match MarshalToSizedBufferVT.
+func TableMarshal(t *Table, p unsafe.Pointer, dAtA []byte) (int, error) {
+	return tableMarshal(t, p, dAtA, false, nil)
+}
+
+// TableMarshalStrict marshals the message at p described by t backwards into
+// dAtA with all fields in field number order.
+//
+// Sub-messages are marshaled with the functions in strict.
+func TableMarshalStrict(t *Table, strict TableMarshalFuncs, p unsafe.Pointer, dAtA []byte) (int, error) {
+	return tableMarshal(t, p, dAtA, true, strict)
+}
+
+func tableMarshal(t *Table, p unsafe.Pointer, dAtA []byte, strict bool, subs TableMarshalFuncs) (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+	var err error
+	i := EncodeRawBytes(dAtA, len(dAtA), *(*[]byte)(unsafe.Add(p, t.Unknown)))
+	if !strict {
+		var done uint64
+		for j := len(t.Fields) - 1; j >= 0; j-- {
+			oneof := t.Fields[j].Oneof
+			if oneof == 0 || done&(1<<(oneof-1)) != 0 {
+				continue
+			}
+			done |= 1 << (oneof - 1)
+			for k := range t.Fields {
+				if t.Fields[k].Oneof != oneof {
+					continue
+				}
+				if i, err = t.Fields[k].marshal(p, dAtA, i, nil); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	for j := len(t.Fields) - 1; j >= 0; j-- {
+		f := &t.Fields[j]
+		if !strict && f.Oneof != 0 {
+			continue
+		}
+		var sub func(p unsafe.Pointer, dAtA []byte) (int, error)
+		if j < len(subs) {
+			sub = subs[j]
+		}
+		if i, err = f.marshal(p, dAtA, i, sub); err != nil {
+			return 0, err
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+// TableUnmarshal decodes dAtA into the message at p described by t.
+func TableUnmarshal(t *Table, p unsafe.Pointer, dAtA []byte) error {
+	return tableUnmarshal(t, p, dAtA, false, nil, nil)
+}
+
+// TableUnmarshalFields is like TableUnmarshal but only decodes the field
+// numbers in fields, skipping the rest. A nil fields decodes every field.
+func TableUnmarshalFields(t *Table, p unsafe.Pointer, dAtA []byte, fields []int32) error {
+	return tableUnmarshal(t, p, dAtA, false, nil, fields)
+}
+
+// TableUnmarshalUnsafe decodes dAtA into the message at p described by t,
+// aliasing dAtA for strings and bytes.
+//
+// Sub-messages are decoded with the functions in unsafeFuncs.
+func TableUnmarshalUnsafe(t *Table, unsafeFuncs TableUnmarshalFuncs, p unsafe.Pointer, dAtA []byte) error {
+	return tableUnmarshal(t, p, dAtA, true, unsafeFuncs, nil)
+}
+
+// TableUnmarshalFieldsUnsafe is like TableUnmarshalUnsafe but only decodes the
+// field numbers in fields, skipping the rest. A nil fields decodes every field.
+func TableUnmarshalFieldsUnsafe(t *Table, unsafeFuncs TableUnmarshalFuncs, p unsafe.Pointer, dAtA []byte, fields []int32) error {
+	return tableUnmarshal(t, p, dAtA, true, unsafeFuncs, fields)
+}
+
+func tableUnmarshal(t *Table, p unsafe.Pointer, dAtA []byte, unsafeDecode bool, subs TableUnmarshalFuncs, fields []int32) error {
+	var seen uint64
+	l := len(dAtA)
+	iNdEx := 0
+	hint := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		wire, next, err := DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		fieldNum := int32(wire >> 3) //nolint:gosec
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: %s: wiretype end group for non-group", t.Name)
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: %s: illegal tag %d (wire type %d)", t.Name, fieldNum, wire)
+		}
+		if fields != nil && !slices.Contains(fields, fieldNum) {
+			if iNdEx, err = SkipWithin(dAtA, preIndex, l); err != nil {
+				return err
+			}
+			continue
+		}
+		j := t.field(fieldNum, hint)
+		if j < 0 {
+			skippy, err := Skip(dAtA[preIndex:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (preIndex+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (preIndex + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			u := (*[]byte)(unsafe.Add(p, t.Unknown))
+			*u = append(*u, dAtA[preIndex:preIndex+skippy]...)
+			iNdEx = preIndex + skippy
+			continue
+		}
+		f := &t.Fields[j]
+		var sub func(p unsafe.Pointer, dAtA []byte) error
+		if j < len(subs) {
+			sub = subs[j]
+		}
+		if iNdEx, err = f.unmarshal(t, p, dAtA, iNdEx, wireType, unsafeDecode, sub); err != nil {
+			return err
+		}
+		if f.Flags&TableFlagRequired != 0 && j < 64 {
+			seen |= 1 << j
+		}
+		hint = j + 1
+	}
+	for j := range t.Fields {
+		f := &t.Fields[j]
+		if f.Flags&TableFlagRequired != 0 && j < 64 && seen&(1<<j) == 0 && (fields == nil || slices.Contains(fields, f.Number)) {
+			return fmt.Errorf("proto: required field %s not set", f.Name)
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
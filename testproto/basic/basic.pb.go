@@ -5,8 +5,13 @@
 package basic
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	maps "maps"
 	math "math"
 	slices "slices"
 	strconv "strconv"
@@ -85,6 +90,30 @@ func (x *BasicMsg) Reset() {
 
 func (*BasicMsg) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *BasicMsg) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *BasicMsg) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *BasicMsg) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.NestedMessage.DiscardUnknownVT()
+}
+
 func (x *BasicMsg) GetInt32Field() int32 {
 	if x != nil {
 		return x.Int32Field
@@ -267,6 +296,29 @@ func (x *BasicMsg_MapStringInt32FieldEntry) Reset() {
 
 func (*BasicMsg_MapStringInt32FieldEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *BasicMsg_MapStringInt32FieldEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *BasicMsg_MapStringInt32FieldEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *BasicMsg_MapStringInt32FieldEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *BasicMsg_MapStringInt32FieldEntry) GetKey() string {
 	if x != nil {
 		return x.Key
@@ -293,6 +345,29 @@ func (x *BasicMsg_NestedMsg) Reset() {
 
 func (*BasicMsg_NestedMsg) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *BasicMsg_NestedMsg) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *BasicMsg_NestedMsg) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *BasicMsg_NestedMsg) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *BasicMsg_NestedMsg) GetNestedInt32() int32 {
 	if x != nil {
 		return x.NestedInt32
@@ -387,6 +462,279 @@ func (m *BasicMsg_OneofInt32) CloneOneofVT() isBasicMsg_MyOneof {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *BasicMsg_NestedMsg) CompareVT(that *BasicMsg_NestedMsg) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.NestedInt32, that.NestedInt32); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.NestedString, that.NestedString); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *BasicMsg) CompareVT(that *BasicMsg) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Int32Field, that.Int32Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Int64Field, that.Int64Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Uint32Field, that.Uint32Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Uint64Field, that.Uint64Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Sint32Field, that.Sint32Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Sint64Field, that.Sint64Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Fixed32Field, that.Fixed32Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Fixed64Field, that.Fixed64Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Sfixed32Field, that.Sfixed32Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Sfixed64Field, that.Sfixed64Field); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.FloatField, that.FloatField); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.DoubleField, that.DoubleField); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBool(m.BoolField, that.BoolField); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.StringField, that.StringField); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(m.BytesField, that.BytesField); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.RepeatedInt32Field, that.RepeatedInt32Field); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.MapStringInt32Field, that.MapStringInt32Field, cmp.Compare[string], cmp.Compare[int32]); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.MyOneof.(*BasicMsg_OneofString)
+		b, bok := that.MyOneof.(*BasicMsg_OneofString)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.OneofString, b.OneofString); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.MyOneof.(*BasicMsg_OneofInt32)
+		b, bok := that.MyOneof.(*BasicMsg_OneofInt32)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.OneofInt32, b.OneofInt32); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := cmp.Compare(m.EnumField, that.EnumField); c != 0 {
+		return c
+	}
+	if c := m.NestedMessage.CompareVT(that.NestedMessage); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *BasicMsg_NestedMsg) CopyVT(dst *BasicMsg_NestedMsg) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.NestedInt32 = m.NestedInt32
+	dst.NestedString = m.NestedString
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *BasicMsg) CopyVT(dst *BasicMsg) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Int32Field = m.Int32Field
+	dst.Int64Field = m.Int64Field
+	dst.Uint32Field = m.Uint32Field
+	dst.Uint64Field = m.Uint64Field
+	dst.Sint32Field = m.Sint32Field
+	dst.Sint64Field = m.Sint64Field
+	dst.Fixed32Field = m.Fixed32Field
+	dst.Fixed64Field = m.Fixed64Field
+	dst.Sfixed32Field = m.Sfixed32Field
+	dst.Sfixed64Field = m.Sfixed64Field
+	dst.FloatField = m.FloatField
+	dst.DoubleField = m.DoubleField
+	dst.BoolField = m.BoolField
+	dst.StringField = m.StringField
+	dst.BytesField = protobuf_go_lite.CopyBytes(dst.BytesField, m.BytesField)
+	dst.RepeatedInt32Field = protobuf_go_lite.CopySlice(dst.RepeatedInt32Field, m.RepeatedInt32Field)
+	dst.MapStringInt32Field = protobuf_go_lite.CopyMap(dst.MapStringInt32Field, m.MapStringInt32Field)
+	switch v := m.MyOneof.(type) {
+	case nil:
+		dst.MyOneof = nil
+	case *BasicMsg_OneofString:
+		d, ok := dst.MyOneof.(*BasicMsg_OneofString)
+		if !ok {
+			d = &BasicMsg_OneofString{}
+			dst.MyOneof = d
+		}
+		d.OneofString = v.OneofString
+	case *BasicMsg_OneofInt32:
+		d, ok := dst.MyOneof.(*BasicMsg_OneofInt32)
+		if !ok {
+			d = &BasicMsg_OneofInt32{}
+			dst.MyOneof = d
+		}
+		d.OneofInt32 = v.OneofInt32
+	}
+	dst.EnumField = m.EnumField
+	dst.NestedMessage = protobuf_go_lite.CopyVTValue(dst.NestedMessage, m.NestedMessage, (*BasicMsg_NestedMsg).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *BasicMsg_NestedMsg) DiffVT(that *BasicMsg_NestedMsg) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *BasicMsg_NestedMsg) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *BasicMsg_NestedMsg) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &BasicMsg_NestedMsg{}
+	}
+	if that == nil {
+		that = &BasicMsg_NestedMsg{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "nested_int32", m.NestedInt32, that.NestedInt32)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "nested_string", m.NestedString, that.NestedString)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *BasicMsg) DiffVT(that *BasicMsg) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *BasicMsg) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *BasicMsg) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &BasicMsg{}
+	}
+	if that == nil {
+		that = &BasicMsg{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "int32_field", m.Int32Field, that.Int32Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "int64_field", m.Int64Field, that.Int64Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "uint32_field", m.Uint32Field, that.Uint32Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "uint64_field", m.Uint64Field, that.Uint64Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "sint32_field", m.Sint32Field, that.Sint32Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "sint64_field", m.Sint64Field, that.Sint64Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "fixed32_field", m.Fixed32Field, that.Fixed32Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "fixed64_field", m.Fixed64Field, that.Fixed64Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "sfixed32_field", m.Sfixed32Field, that.Sfixed32Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "sfixed64_field", m.Sfixed64Field, that.Sfixed64Field)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "float_field", m.FloatField, that.FloatField)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "double_field", m.DoubleField, that.DoubleField)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "bool_field", m.BoolField, that.BoolField)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "string_field", m.StringField, that.StringField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "bytes_field", m.BytesField, that.BytesField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_int32_field", m.RepeatedInt32Field, that.RepeatedInt32Field)
+	diffs = protobuf_go_lite.AppendDiffMap(diffs, prefix, "map_string_int32_field", m.MapStringInt32Field, that.MapStringInt32Field)
+	{
+		var a, b *string
+		if v, ok := m.MyOneof.(*BasicMsg_OneofString); ok {
+			a = &v.OneofString
+		}
+		if v, ok := that.MyOneof.(*BasicMsg_OneofString); ok {
+			b = &v.OneofString
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "oneof_string", a, b)
+	}
+	{
+		var a, b *int32
+		if v, ok := m.MyOneof.(*BasicMsg_OneofInt32); ok {
+			a = &v.OneofInt32
+		}
+		if v, ok := that.MyOneof.(*BasicMsg_OneofInt32); ok {
+			b = &v.OneofInt32
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "oneof_int32", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "enum_field", m.EnumField, that.EnumField)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "nested_message", m.NestedMessage, that.NestedMessage, (*BasicMsg_NestedMsg).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *BasicMsg_NestedMsg) EqualVT(that *BasicMsg_NestedMsg) bool {
 	if this == that {
 		return true
@@ -526,6 +874,281 @@ func (this *BasicMsg_OneofInt32) EqualVT(thatIface isBasicMsg_MyOneof) bool {
 	return true
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *BasicMsg_NestedMsg) EqualVTOpts(that *BasicMsg_NestedMsg, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *BasicMsg_NestedMsg) EqualVTOptsPrefix(that *BasicMsg_NestedMsg, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &BasicMsg_NestedMsg{}
+		}
+		if that == nil {
+			that = &BasicMsg_NestedMsg{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "nested_int32"); ok && this.NestedInt32 != that.NestedInt32 {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "nested_string"); ok && this.NestedString != that.NestedString {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *BasicMsg) EqualVTOpts(that *BasicMsg, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *BasicMsg) EqualVTOptsPrefix(that *BasicMsg, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &BasicMsg{}
+		}
+		if that == nil {
+			that = &BasicMsg{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "int32_field"); ok && this.Int32Field != that.Int32Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "int64_field"); ok && this.Int64Field != that.Int64Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "uint32_field"); ok && this.Uint32Field != that.Uint32Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "uint64_field"); ok && this.Uint64Field != that.Uint64Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sint32_field"); ok && this.Sint32Field != that.Sint32Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sint64_field"); ok && this.Sint64Field != that.Sint64Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "fixed32_field"); ok && this.Fixed32Field != that.Fixed32Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "fixed64_field"); ok && this.Fixed64Field != that.Fixed64Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sfixed32_field"); ok && this.Sfixed32Field != that.Sfixed32Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sfixed64_field"); ok && this.Sfixed64Field != that.Sfixed64Field {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "float_field"); ok && !protobuf_go_lite.EqualOptsFloat(opts, this.FloatField, that.FloatField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "double_field"); ok && !protobuf_go_lite.EqualOptsFloat(opts, this.DoubleField, that.DoubleField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bool_field"); ok && this.BoolField != that.BoolField {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "string_field"); ok && this.StringField != that.StringField {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bytes_field"); ok && (string(this.BytesField) != string(that.BytesField)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_int32_field"); ok && !slices.Equal(this.RepeatedInt32Field, that.RepeatedInt32Field) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "map_string_int32_field"); ok && !maps.Equal(this.MapStringInt32Field, that.MapStringInt32Field) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "oneof_string"); ok {
+		a, aok := this.MyOneof.(*BasicMsg_OneofString)
+		b, bok := that.MyOneof.(*BasicMsg_OneofString)
+		if aok != bok || aok && a.OneofString != b.OneofString {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "oneof_int32"); ok {
+		a, aok := this.MyOneof.(*BasicMsg_OneofInt32)
+		b, bok := that.MyOneof.(*BasicMsg_OneofInt32)
+		if aok != bok || aok && a.OneofInt32 != b.OneofInt32 {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "enum_field"); ok && this.EnumField != that.EnumField {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_message"); ok && !protobuf_go_lite.EqualVTOptsValue(this.NestedMessage, that.NestedMessage, opts, path, (*BasicMsg_NestedMsg).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *BasicMsg_NestedMsg) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *BasicMsg_NestedMsg) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *BasicMsg_NestedMsg) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.NestedInt32 != 0 {
+			w.Field(1)
+			w.Uint64(uint64(m.NestedInt32))
+		}
+		if m.NestedString != "" {
+			w.Field(2)
+			w.String(m.NestedString)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *BasicMsg) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *BasicMsg) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *BasicMsg) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Int32Field != 0 {
+			w.Field(1)
+			w.Uint64(uint64(m.Int32Field))
+		}
+		if m.Int64Field != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.Int64Field))
+		}
+		if m.Uint32Field != 0 {
+			w.Field(3)
+			w.Uint64(uint64(m.Uint32Field))
+		}
+		if m.Uint64Field != 0 {
+			w.Field(4)
+			w.Uint64(uint64(m.Uint64Field))
+		}
+		if m.Sint32Field != 0 {
+			w.Field(5)
+			w.Uint64(uint64(m.Sint32Field))
+		}
+		if m.Sint64Field != 0 {
+			w.Field(6)
+			w.Uint64(uint64(m.Sint64Field))
+		}
+		if m.Fixed32Field != 0 {
+			w.Field(7)
+			w.Uint64(uint64(m.Fixed32Field))
+		}
+		if m.Fixed64Field != 0 {
+			w.Field(8)
+			w.Uint64(uint64(m.Fixed64Field))
+		}
+		if m.Sfixed32Field != 0 {
+			w.Field(9)
+			w.Uint64(uint64(m.Sfixed32Field))
+		}
+		if m.Sfixed64Field != 0 {
+			w.Field(10)
+			w.Uint64(uint64(m.Sfixed64Field))
+		}
+		if m.FloatField != 0 {
+			w.Field(11)
+			w.Float64(float64(m.FloatField))
+		}
+		if m.DoubleField != 0 {
+			w.Field(12)
+			w.Float64(float64(m.DoubleField))
+		}
+		if m.BoolField {
+			w.Field(13)
+			w.Bool(m.BoolField)
+		}
+		if m.StringField != "" {
+			w.Field(14)
+			w.String(m.StringField)
+		}
+		if len(m.BytesField) != 0 {
+			w.Field(15)
+			w.Bytes(m.BytesField)
+		}
+		if len(m.RepeatedInt32Field) != 0 {
+			w.Field(16)
+			w.Uint64(uint64(len(m.RepeatedInt32Field)))
+			for _, v := range m.RepeatedInt32Field {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.MapStringInt32Field) != 0 {
+			w.Field(17)
+			protobuf_go_lite.HashMap(w, m.MapStringInt32Field, func(w *protobuf_go_lite.Hasher, k string, v int32) {
+				w.String(k)
+				w.Uint64(uint64(v))
+			})
+		}
+		switch v := m.MyOneof.(type) {
+		case *BasicMsg_OneofString:
+			w.Field(18)
+			w.String(v.OneofString)
+		case *BasicMsg_OneofInt32:
+			w.Field(19)
+			w.Uint64(uint64(v.OneofInt32))
+		}
+		if m.EnumField != 0 {
+			w.Field(20)
+			w.Uint64(uint64(m.EnumField))
+		}
+		if v := m.NestedMessage; v != nil {
+			w.Field(21)
+			v.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 // MarshalProtoJSON marshals the BasicMsg_MyEnum to JSON.
 func (x BasicMsg_MyEnum) MarshalProtoJSON(s *json.MarshalState) {
 	s.WriteEnum(int32(x), BasicMsg_MyEnum_name)
@@ -1359,28 +1982,159 @@ func (m *BasicMsg_OneofInt32) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 	dAtA[i] = 0x98
 	return len(dAtA) - i, nil
 }
-func (m *BasicMsg_NestedMsg) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *BasicMsg_NestedMsg) MergeVT(src *BasicMsg_NestedMsg) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.NestedInt32)
-	n += protobuf_go_lite.SizeStringNonEmpty(1, m.NestedString)
-	n += len(m.unknownFields)
-	return n
+	if src.NestedInt32 != 0 {
+		m.NestedInt32 = src.NestedInt32
+	}
+	if src.NestedString != "" {
+		m.NestedString = src.NestedString
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
 
-func (m *BasicMsg) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *BasicMsg_NestedMsg) MergeMessageVT(src any) bool {
+	s, ok := src.(*BasicMsg_NestedMsg)
+	if !ok {
+		return false
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.Int32Field)
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.Int64Field)
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.Uint32Field)
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.Uint64Field)
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *BasicMsg) MergeVT(src *BasicMsg) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Int32Field != 0 {
+		m.Int32Field = src.Int32Field
+	}
+	if src.Int64Field != 0 {
+		m.Int64Field = src.Int64Field
+	}
+	if src.Uint32Field != 0 {
+		m.Uint32Field = src.Uint32Field
+	}
+	if src.Uint64Field != 0 {
+		m.Uint64Field = src.Uint64Field
+	}
+	if src.Sint32Field != 0 {
+		m.Sint32Field = src.Sint32Field
+	}
+	if src.Sint64Field != 0 {
+		m.Sint64Field = src.Sint64Field
+	}
+	if src.Fixed32Field != 0 {
+		m.Fixed32Field = src.Fixed32Field
+	}
+	if src.Fixed64Field != 0 {
+		m.Fixed64Field = src.Fixed64Field
+	}
+	if src.Sfixed32Field != 0 {
+		m.Sfixed32Field = src.Sfixed32Field
+	}
+	if src.Sfixed64Field != 0 {
+		m.Sfixed64Field = src.Sfixed64Field
+	}
+	if src.FloatField != 0 {
+		m.FloatField = src.FloatField
+	}
+	if src.DoubleField != 0 {
+		m.DoubleField = src.DoubleField
+	}
+	if src.BoolField {
+		m.BoolField = true
+	}
+	if src.StringField != "" {
+		m.StringField = src.StringField
+	}
+	if len(src.BytesField) > 0 {
+		m.BytesField = slices.Clone(src.BytesField)
+	}
+	m.RepeatedInt32Field = append(m.RepeatedInt32Field, src.RepeatedInt32Field...)
+	if len(src.MapStringInt32Field) > 0 {
+		if m.MapStringInt32Field == nil {
+			m.MapStringInt32Field = make(map[string]int32, len(src.MapStringInt32Field))
+		}
+		for k, v := range src.MapStringInt32Field {
+			m.MapStringInt32Field[k] = v
+		}
+	}
+	switch v := src.MyOneof.(type) {
+	case *BasicMsg_OneofString:
+		m.MyOneof = &BasicMsg_OneofString{OneofString: v.OneofString}
+	case *BasicMsg_OneofInt32:
+		m.MyOneof = &BasicMsg_OneofInt32{OneofInt32: v.OneofInt32}
+	}
+	if src.EnumField != 0 {
+		m.EnumField = src.EnumField
+	}
+	if src.NestedMessage != nil {
+		if m.NestedMessage == nil {
+			m.NestedMessage = new(BasicMsg_NestedMsg)
+		}
+		m.NestedMessage.MergeVT(src.NestedMessage)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *BasicMsg) MergeMessageVT(src any) bool {
+	s, ok := src.(*BasicMsg)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *BasicMsg_NestedMsg) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *BasicMsg) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.NestedMessage.RedactVT()
+}
+
+func (m *BasicMsg_NestedMsg) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.NestedInt32)
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.NestedString)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *BasicMsg) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Int32Field)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Int64Field)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Uint32Field)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Uint64Field)
 	n += protobuf_go_lite.SizeZigzagNonZero(1, m.Sint32Field)
 	n += protobuf_go_lite.SizeZigzagNonZero(1, m.Sint64Field)
 	n += protobuf_go_lite.SizeFixed32NonZero(1, m.Fixed32Field)
@@ -1947,6 +2701,805 @@ func (m *BasicMsg) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BasicMsg_NestedMsg) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicMsg_NestedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicMsg_NestedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedInt32", wireType)
+			}
+			m.NestedInt32 = 0
+			m.NestedInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.NestedString = v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BasicMsg) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Field", wireType)
+			}
+			m.Int32Field = 0
+			m.Int32Field, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Field", wireType)
+			}
+			m.Int64Field = 0
+			m.Int64Field, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Field", wireType)
+			}
+			m.Uint32Field = 0
+			m.Uint32Field, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Field", wireType)
+			}
+			m.Uint64Field = 0
+			m.Uint64Field, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint32Field", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.Sint32Field = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint64Field", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Sint64Field = v
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Field", wireType)
+			}
+			m.Fixed32Field = 0
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Fixed32Field = uint32(_v32)
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Field", wireType)
+			}
+			m.Fixed64Field = 0
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Fixed64Field = uint64(_v64)
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Field", wireType)
+			}
+			m.Sfixed32Field = 0
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Sfixed32Field = int32(_v32)
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Field", wireType)
+			}
+			m.Sfixed64Field = 0
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Sfixed64Field = int64(_v64)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.FloatField = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.DoubleField = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BoolField = bool(v)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.StringField = v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesField", wireType)
+			}
+			m.BytesField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.BytesField, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 16:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32Field = append(m.RepeatedInt32Field, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedInt32Field) == 0 {
+					m.RepeatedInt32Field = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedInt32Field = append(m.RepeatedInt32Field, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32Field", wireType)
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringInt32Field", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.MapStringInt32Field == nil {
+				m.MapStringInt32Field = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.MapStringInt32Field[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.MyOneof = &BasicMsg_OneofString{OneofString: v}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.MyOneof = &BasicMsg_OneofInt32{OneofInt32: v}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumField", wireType)
+			}
+			m.EnumField = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.EnumField = BasicMsg_MyEnum(_v)
+			if err != nil {
+				return err
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.NestedMessage == nil {
+				m.NestedMessage = &BasicMsg_NestedMsg{}
+			}
+			if err := m.NestedMessage.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BasicMsg_NestedMsg) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicMsg_NestedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicMsg_NestedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedInt32", wireType)
+			}
+			m.NestedInt32 = 0
+			m.NestedInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.NestedString = v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BasicMsg) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Field", wireType)
+			}
+			m.Int32Field = 0
+			m.Int32Field, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Field", wireType)
+			}
+			m.Int64Field = 0
+			m.Int64Field, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Field", wireType)
+			}
+			m.Uint32Field = 0
+			m.Uint32Field, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Field", wireType)
+			}
+			m.Uint64Field = 0
+			m.Uint64Field, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint32Field", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.Sint32Field = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint64Field", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Sint64Field = v
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Field", wireType)
+			}
+			m.Fixed32Field = 0
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Fixed32Field = uint32(_v32)
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Field", wireType)
+			}
+			m.Fixed64Field = 0
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Fixed64Field = uint64(_v64)
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Field", wireType)
+			}
+			m.Sfixed32Field = 0
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Sfixed32Field = int32(_v32)
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Field", wireType)
+			}
+			m.Sfixed64Field = 0
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Sfixed64Field = int64(_v64)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.FloatField = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.DoubleField = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BoolField = bool(v)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.StringField = v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesField", wireType)
+			}
+			m.BytesField, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 16:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32Field = append(m.RepeatedInt32Field, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedInt32Field) == 0 {
+					m.RepeatedInt32Field = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedInt32Field = append(m.RepeatedInt32Field, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32Field", wireType)
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringInt32Field", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.MapStringInt32Field == nil {
+				m.MapStringInt32Field = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.MapStringInt32Field[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.MyOneof = &BasicMsg_OneofString{OneofString: v}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.MyOneof = &BasicMsg_OneofInt32{OneofInt32: v}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumField", wireType)
+			}
+			m.EnumField = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.EnumField = BasicMsg_MyEnum(_v)
+			if err != nil {
+				return err
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.NestedMessage == nil {
+				m.NestedMessage = &BasicMsg_NestedMsg{}
+			}
+			if err := m.NestedMessage.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *BasicMsg_NestedMsg) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -61,6 +61,58 @@ func TestBasicMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestBasicUnmarshalFields(t *testing.T) {
+	data, err := NewMockBasicMsg().MarshalVT()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for name, unmarshal := range map[string]func(*BasicMsg, []byte, ...int32) error{
+		"UnmarshalVTFields":       (*BasicMsg).UnmarshalVTFields,
+		"UnmarshalVTFieldsUnsafe": (*BasicMsg).UnmarshalVTFieldsUnsafe,
+	} {
+		out := &BasicMsg{}
+		if err := unmarshal(out, data, 1, 21); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		want := &BasicMsg{
+			Int32Field: 123,
+			NestedMessage: &BasicMsg_NestedMsg{
+				NestedInt32:  321,
+				NestedString: "nested test",
+			},
+		}
+		if !out.EqualVT(want) {
+			t.Fatalf("%s decoded unselected fields: %v", name, out)
+		}
+		// Skipped fields are not retained as unknown fields.
+		if size := out.SizeVT(); size != want.SizeVT() {
+			t.Fatalf("%s: SizeVT() = %d, want %d", name, size, want.SizeVT())
+		}
+
+		out = &BasicMsg{}
+		if err := unmarshal(out, data); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !out.EqualVT(&BasicMsg{}) {
+			t.Fatalf("%s with no fields decoded %v", name, out)
+		}
+	}
+}
+
+func TestBasicUnmarshalFieldsMalformed(t *testing.T) {
+	data, err := (&BasicMsg{Int32Field: 1, StringField: "test string"}).MarshalVT()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Truncate the string field, which is not selected.
+	out := &BasicMsg{}
+	if err := out.UnmarshalVTFields(data[:len(data)-1], 1); err == nil {
+		t.Fatal("expected truncated unselected field to fail")
+	}
+}
+
 func TestBasicEqualImplicitBytesTreatsNilAsEmpty(t *testing.T) {
 	if !(&BasicMsg{}).EqualVT(&BasicMsg{BytesField: []byte{}}) {
 		t.Fatal("implicit proto3 bytes nil and empty values should compare equal")
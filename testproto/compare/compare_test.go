@@ -0,0 +1,140 @@
+package compare
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+)
+
+// checkOrder checks that CompareVT orders want in its order, and that each
+// message compares equal to itself.
+func checkOrder(t *testing.T, want ...*Tree) {
+	t.Helper()
+	for i, a := range want {
+		for j, b := range want {
+			if got := a.CompareVT(b); got != cmpInt(i, j) {
+				t.Fatalf("CompareVT(%v, %v) is %d, want %d", a, b, got, cmpInt(i, j))
+			}
+		}
+	}
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func TestCompareVTFloats(t *testing.T) {
+	// NaN orders before every other value, like with cmp.Compare, and
+	// negative zero equals zero.
+	checkOrder(t,
+		&Tree{Weight: math.NaN()},
+		&Tree{Weight: math.Inf(-1)},
+		&Tree{Weight: -1},
+		&Tree{},
+		&Tree{Weight: 1},
+		&Tree{Weight: math.Inf(1)},
+	)
+	nan := &Tree{Weight: math.NaN()}
+	if c := nan.CompareVT(&Tree{Weight: math.NaN()}); c != 0 {
+		t.Fatalf("NaN compares %d to NaN, want 0", c)
+	}
+	if c := (&Tree{Weight: math.Copysign(0, -1)}).CompareVT(&Tree{}); c != 0 {
+		t.Fatalf("negative zero compares %d to zero, want 0", c)
+	}
+}
+
+func TestCompareVTNilAndEmpty(t *testing.T) {
+	var nilTree *Tree
+	checkOrder(t, nilTree, &Tree{}, &Tree{Leaf: &Leaf{}}, &Tree{Leaf: &Leaf{Count: 1}})
+
+	// Empty repeated fields and maps equal nil ones, and nil elements and map
+	// values equal empty messages.
+	for name, pair := range map[string][2]*Tree{
+		"empty lists": {{Data: []byte{}, Ids: []int32{}, Leaves: []*Leaf{}}, {}},
+		"empty maps":  {{ByName: map[string]*Leaf{}, Labels: map[int32]string{}}, {}},
+		"nil element": {{Leaves: []*Leaf{nil}}, {Leaves: []*Leaf{{}}}},
+		"nil value":   {{ByName: map[string]*Leaf{"a": nil}}, {ByName: map[string]*Leaf{"a": {}}}},
+		"nil node":    {{Choice: &Tree_Node{}}, {Choice: &Tree_Node{Node: &Leaf{}}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if c := pair[0].CompareVT(pair[1]); c != 0 {
+				t.Fatalf("CompareVT is %d, want 0", c)
+			}
+		})
+	}
+}
+
+func TestCompareVTOneofSwitch(t *testing.T) {
+	// Each case compares like an explicit field, so holding a later case
+	// orders before holding an earlier one.
+	checkOrder(t,
+		&Tree{},
+		&Tree{Choice: &Tree_Node{}},
+		&Tree{Choice: &Tree_Node{Node: &Leaf{Count: 1}}},
+		&Tree{Choice: &Tree_Text{}},
+		&Tree{Choice: &Tree_Text{Text: "a"}},
+	)
+}
+
+func TestCompareVTMapOrder(t *testing.T) {
+	checkOrder(t,
+		&Tree{},
+		&Tree{Labels: map[int32]string{-1: "z"}},
+		&Tree{Labels: map[int32]string{2: "a"}},
+		&Tree{Labels: map[int32]string{2: "a", 3: "a"}},
+		&Tree{Labels: map[int32]string{2: "b"}},
+		&Tree{Labels: map[int32]string{10: "a"}},
+	)
+	checkOrder(t,
+		&Tree{ByName: map[string]*Leaf{"a": nil}},
+		&Tree{ByName: map[string]*Leaf{"a": {Count: 1}}},
+		&Tree{ByName: map[string]*Leaf{"b": nil}},
+	)
+
+	// Maps filled in opposite orders compare equal.
+	a := &Tree{Labels: map[int32]string{}}
+	b := &Tree{Labels: map[int32]string{}}
+	for i := range int32(100) {
+		a.Labels[i] = "v"
+		b.Labels[99-i] = "v"
+	}
+	for range 20 {
+		if c := a.CompareVT(b); c != 0 {
+			t.Fatalf("maps with the same entries compare %d", c)
+		}
+	}
+}
+
+func TestCompareSort(t *testing.T) {
+	// An unset field orders before a set one, so messages setting only later
+	// fields order first.
+	want := []*Tree{
+		nil,
+		{Weight: math.NaN()},
+		{Weight: -1},
+		{Choice: &Tree_Text{}},
+		{Leaves: []*Leaf{{Name: "a"}}},
+		{Ids: []int32{1}},
+		{Ids: []int32{1, 2}},
+		{Name: "a"},
+	}
+	got := slices.Clone(want)
+	for i := range got {
+		j := (i * 5) % len(got)
+		got[i], got[j] = got[j], got[i]
+	}
+	slices.SortFunc(got, protobuf_go_lite.Compare[*Tree])
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sorted messages are %v, want %v", got, want)
+		}
+	}
+}
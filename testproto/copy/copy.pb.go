@@ -0,0 +1,3376 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/copy/copy.proto
+
+package copy
+
+import (
+	bytes "bytes"
+	cmp "cmp"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	iter "iter"
+	maps "maps"
+	math "math"
+	slices "slices"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+type Leaf struct {
+	unknownFields []byte
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Leaf) Reset() {
+	*x = Leaf{}
+}
+
+func (*Leaf) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Leaf) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Leaf) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Leaf) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *Leaf) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Leaf) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Tree struct {
+	unknownFields []byte
+	Name          string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Level         *int32           `protobuf:"varint,2,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	Data          []byte           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Weight        float64          `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Ids           []int32          `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Leaf          *Leaf            `protobuf:"bytes,6,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Leaves        []*Leaf          `protobuf:"bytes,7,rep,name=leaves,proto3" json:"leaves,omitempty"`
+	ByName        map[string]*Leaf `protobuf:"bytes,8,rep,name=by_name,json=byName,proto3" json:"byName,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels        map[int32]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//
+	//	*Tree_Text
+	//	*Tree_Node
+	Choice isTree_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+}
+
+func (*Tree) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Tree) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Tree) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Tree) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Leaf.DiscardUnknownVT()
+	for _, v := range x.Leaves {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.ByName {
+		v.DiscardUnknownVT()
+	}
+	if v, ok := x.Choice.(*Tree_Node); ok {
+		v.Node.DiscardUnknownVT()
+	}
+}
+
+func (x *Tree) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tree) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *Tree) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Tree) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Tree) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *Tree) GetLeaf() *Leaf {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *Tree) GetLeaves() []*Leaf {
+	if x != nil {
+		return x.Leaves
+	}
+	return nil
+}
+
+func (x *Tree) GetByName() map[string]*Leaf {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Tree) GetLabels() map[int32]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (m *Tree) GetChoice() isTree_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *Tree) GetText() string {
+	if x, ok := x.GetChoice().(*Tree_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Tree) GetNode() *Leaf {
+	if x, ok := x.GetChoice().(*Tree_Node); ok {
+		return x.Node
+	}
+	return nil
+}
+
+type isTree_Choice interface {
+	isTree_Choice()
+}
+
+type Tree_Text struct {
+	Text string `protobuf:"bytes,10,opt,name=text,proto3,oneof"`
+}
+
+type Tree_Node struct {
+	Node *Leaf `protobuf:"bytes,11,opt,name=node,proto3,oneof"`
+}
+
+func (*Tree_Text) isTree_Choice() {}
+
+func (*Tree_Node) isTree_Choice() {}
+
+type Tree_ByNameEntry struct {
+	unknownFields []byte
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Leaf  `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Tree_ByNameEntry) Reset() {
+	*x = Tree_ByNameEntry{}
+}
+
+func (*Tree_ByNameEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Tree_ByNameEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Tree_ByNameEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Tree_ByNameEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *Tree_ByNameEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Tree_ByNameEntry) GetValue() *Leaf {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Tree_LabelsEntry struct {
+	unknownFields []byte
+	Key           int32  `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Tree_LabelsEntry) Reset() {
+	*x = Tree_LabelsEntry{}
+}
+
+func (*Tree_LabelsEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Tree_LabelsEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Tree_LabelsEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Tree_LabelsEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *Tree_LabelsEntry) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *Tree_LabelsEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (m *Leaf) CloneVT() *Leaf {
+	if m == nil {
+		return (*Leaf)(nil)
+	}
+	r := new(Leaf)
+	r.Name = m.Name
+	r.Count = m.Count
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *Leaf) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *Tree) CloneVT() *Tree {
+	if m == nil {
+		return (*Tree)(nil)
+	}
+	r := new(Tree)
+	r.Name = m.Name
+	r.Weight = m.Weight
+	r.Level = protobuf_go_lite.ClonePtr(m.Level)
+	r.Data = protobuf_go_lite.CloneBytes(m.Data)
+	r.Ids = protobuf_go_lite.CloneSlice(m.Ids)
+	r.Leaf = protobuf_go_lite.CloneVTValue(m.Leaf)
+	r.Leaves = protobuf_go_lite.CloneVTSlice(m.Leaves)
+	r.ByName = protobuf_go_lite.CloneVTMap(m.ByName)
+	r.Labels = protobuf_go_lite.CloneMap(m.Labels)
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneOneofVT() isTree_Choice }).CloneOneofVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *Tree) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *Tree_Text) CloneVT() *Tree_Text {
+	if m == nil {
+		return (*Tree_Text)(nil)
+	}
+	r := new(Tree_Text)
+	r.Text = m.Text
+	return r
+}
+
+func (m *Tree_Text) CloneOneofVT() isTree_Choice {
+	return m.CloneVT()
+}
+
+func (m *Tree_Node) CloneVT() *Tree_Node {
+	if m == nil {
+		return (*Tree_Node)(nil)
+	}
+	r := new(Tree_Node)
+	r.Node = protobuf_go_lite.CloneVTValue(m.Node)
+	return r
+}
+
+func (m *Tree_Node) CloneOneofVT() isTree_Choice {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Leaf) CompareVT(that *Leaf) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Count, that.Count); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Tree) CompareVT(that *Tree) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Level, that.Level); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(m.Data, that.Data); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Weight, that.Weight); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.Ids, that.Ids); c != 0 {
+		return c
+	}
+	if c := m.Leaf.CompareVT(that.Leaf); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Leaves, that.Leaves, (*Leaf).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.ByName, that.ByName, cmp.Compare[string], (*Leaf).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.Labels, that.Labels, cmp.Compare[int32], cmp.Compare[string]); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*Tree_Text)
+		b, bok := that.Choice.(*Tree_Text)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.Text, b.Text); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*Tree_Node)
+		b, bok := that.Choice.(*Tree_Node)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Node, b.Node, (*Leaf).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Leaf) CopyVT(dst *Leaf) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Name = m.Name
+	dst.Count = m.Count
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Tree) CopyVT(dst *Tree) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Name = m.Name
+	dst.Level = protobuf_go_lite.CopyPtr(dst.Level, m.Level)
+	dst.Data = protobuf_go_lite.CopyBytes(dst.Data, m.Data)
+	dst.Weight = m.Weight
+	dst.Ids = protobuf_go_lite.CopySlice(dst.Ids, m.Ids)
+	dst.Leaf = protobuf_go_lite.CopyVTValue(dst.Leaf, m.Leaf, (*Leaf).CopyVT)
+	dst.Leaves = protobuf_go_lite.CopyVTSlice(dst.Leaves, m.Leaves, (*Leaf).CopyVT)
+	dst.ByName = protobuf_go_lite.CopyVTMap(dst.ByName, m.ByName, (*Leaf).CopyVT)
+	dst.Labels = protobuf_go_lite.CopyMap(dst.Labels, m.Labels)
+	switch v := m.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *Tree_Text:
+		d, ok := dst.Choice.(*Tree_Text)
+		if !ok {
+			d = &Tree_Text{}
+			dst.Choice = d
+		}
+		d.Text = v.Text
+	case *Tree_Node:
+		d, ok := dst.Choice.(*Tree_Node)
+		if !ok {
+			d = &Tree_Node{}
+			dst.Choice = d
+		}
+		d.Node = protobuf_go_lite.CopyVTValue(d.Node, v.Node, (*Leaf).CopyVT)
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Leaf) DiffVT(that *Leaf) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Leaf) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Leaf) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Leaf{}
+	}
+	if that == nil {
+		that = &Leaf{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "name", m.Name, that.Name)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "count", m.Count, that.Count)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Tree) DiffVT(that *Tree) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Tree) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Tree) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Tree{}
+	}
+	if that == nil {
+		that = &Tree{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "name", m.Name, that.Name)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "level", m.Level, that.Level)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "data", m.Data, that.Data)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "weight", m.Weight, that.Weight)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "ids", m.Ids, that.Ids)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "leaf", m.Leaf, that.Leaf, (*Leaf).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "leaves", m.Leaves, that.Leaves, (*Leaf).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "by_name", m.ByName, that.ByName, (*Leaf).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffMap(diffs, prefix, "labels", m.Labels, that.Labels)
+	{
+		var a, b *string
+		if v, ok := m.Choice.(*Tree_Text); ok {
+			a = &v.Text
+		}
+		if v, ok := that.Choice.(*Tree_Text); ok {
+			b = &v.Text
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "text", a, b)
+	}
+	{
+		var a, b *Leaf
+		if v, ok := m.Choice.(*Tree_Node); ok {
+			a = v.Node
+		}
+		if v, ok := that.Choice.(*Tree_Node); ok {
+			b = v.Node
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "node", a, b, (*Leaf).AppendDiffVT)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *Leaf) EqualVT(that *Leaf) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Name != that.Name {
+		return false
+	}
+	if this.Count != that.Count {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Leaf) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Leaf)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Tree) EqualVT(that *Tree) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface{ EqualVT(isTree_Choice) bool }).EqualVT(that.Choice) {
+			return false
+		}
+	}
+	if this.Name != that.Name {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Level, that.Level) {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytes(this.Data, that.Data) {
+		return false
+	}
+	if this.Weight != that.Weight {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Ids, that.Ids) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Leaf, that.Leaf) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.Leaves, that.Leaves, func() *Leaf { return &Leaf{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.ByName, that.ByName, func() *Leaf { return &Leaf{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualMap(this.Labels, that.Labels) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Tree) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Tree)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Tree_Text) EqualVT(thatIface isTree_Choice) bool {
+	that, ok := thatIface.(*Tree_Text)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.Text != that.Text {
+		return false
+	}
+	return true
+}
+
+func (this *Tree_Node) EqualVT(thatIface isTree_Choice) bool {
+	that, ok := thatIface.(*Tree_Node)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Node, that.Node, func() *Leaf { return &Leaf{} }) {
+		return false
+	}
+	return true
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Leaf) EqualVTOpts(that *Leaf, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Leaf) EqualVTOptsPrefix(that *Leaf, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Leaf{}
+		}
+		if that == nil {
+			that = &Leaf{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "name"); ok && this.Name != that.Name {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "count"); ok && this.Count != that.Count {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Tree) EqualVTOpts(that *Tree, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Tree) EqualVTOptsPrefix(that *Tree, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Tree{}
+		}
+		if that == nil {
+			that = &Tree{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "name"); ok && this.Name != that.Name {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "level"); ok && ((this.Level == nil) != (that.Level == nil) || this.Level != nil && *this.Level != *that.Level) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "data"); ok && (string(this.Data) != string(that.Data)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "weight"); ok && !protobuf_go_lite.EqualOptsFloat(opts, this.Weight, that.Weight) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "ids"); ok && !slices.Equal(this.Ids, that.Ids) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "leaf"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Leaf, that.Leaf, opts, path, (*Leaf).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "leaves"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Leaves, that.Leaves, opts, path, (*Leaf).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "by_name"); ok && !protobuf_go_lite.EqualVTOptsMap(this.ByName, that.ByName, opts, path, (*Leaf).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "labels"); ok && !maps.Equal(this.Labels, that.Labels) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "text"); ok {
+		a, aok := this.Choice.(*Tree_Text)
+		b, bok := that.Choice.(*Tree_Text)
+		if aok != bok || aok && a.Text != b.Text {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "node"); ok {
+		a, aok := this.Choice.(*Tree_Node)
+		b, bok := that.Choice.(*Tree_Node)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Node, b.Node, opts, path, (*Leaf).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Leaf) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Leaf) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Leaf) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Name != "" {
+			w.Field(1)
+			w.String(m.Name)
+		}
+		if m.Count != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.Count))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Tree) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Tree) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Tree) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Name != "" {
+			w.Field(1)
+			w.String(m.Name)
+		}
+		if m.Level != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.Level))
+		}
+		if len(m.Data) != 0 {
+			w.Field(3)
+			w.Bytes(m.Data)
+		}
+		if m.Weight != 0 {
+			w.Field(4)
+			w.Float64(float64(m.Weight))
+		}
+		if len(m.Ids) != 0 {
+			w.Field(5)
+			w.Uint64(uint64(len(m.Ids)))
+			for _, v := range m.Ids {
+				w.Uint64(uint64(v))
+			}
+		}
+		if v := m.Leaf; v != nil {
+			w.Field(6)
+			v.WriteHashVT(w)
+		}
+		if len(m.Leaves) != 0 {
+			w.Field(7)
+			w.Uint64(uint64(len(m.Leaves)))
+			for _, v := range m.Leaves {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.ByName) != 0 {
+			w.Field(8)
+			protobuf_go_lite.HashMap(w, m.ByName, func(w *protobuf_go_lite.Hasher, k string, v *Leaf) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		if len(m.Labels) != 0 {
+			w.Field(9)
+			protobuf_go_lite.HashMap(w, m.Labels, func(w *protobuf_go_lite.Hasher, k int32, v string) {
+				w.Uint64(uint64(k))
+				w.String(v)
+			})
+		}
+		switch v := m.Choice.(type) {
+		case *Tree_Text:
+			w.Field(10)
+			w.String(v.Text)
+		case *Tree_Node:
+			w.Field(11)
+			v.Node.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the Leaf message to JSON.
+func (x *Leaf) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != "" || s.HasField("name") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(x.Name)
+	}
+	if x.Count != 0 || s.HasField("count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("count")
+		s.WriteInt32(x.Count)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Leaf to JSON.
+func (x *Leaf) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Leaf message from JSON.
+func (x *Leaf) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			x.Name = s.ReadString()
+		case "count":
+			s.AddField("count")
+			x.Count = s.ReadInt32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Leaf from JSON.
+func (x *Leaf) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Tree_ByNameEntry message to JSON.
+func (x *Tree_ByNameEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Tree_ByNameEntry to JSON.
+func (x *Tree_ByNameEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Tree_ByNameEntry message from JSON.
+func (x *Tree_ByNameEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &Leaf{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Tree_ByNameEntry from JSON.
+func (x *Tree_ByNameEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Tree_LabelsEntry message to JSON.
+func (x *Tree_LabelsEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != 0 || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteInt32(x.Key)
+	}
+	if x.Value != "" || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteString(x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Tree_LabelsEntry to JSON.
+func (x *Tree_LabelsEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Tree_LabelsEntry message from JSON.
+func (x *Tree_LabelsEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadInt32()
+		case "value":
+			s.AddField("value")
+			x.Value = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Tree_LabelsEntry from JSON.
+func (x *Tree_LabelsEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Tree message to JSON.
+func (x *Tree) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != "" || s.HasField("name") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(x.Name)
+	}
+	if x.Level != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("level")
+		s.WriteInt32(*x.Level)
+	}
+	if len(x.Data) > 0 || s.HasField("data") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("data")
+		s.WriteBytes(x.Data)
+	}
+	if x.Weight != 0 || s.HasField("weight") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("weight")
+		s.WriteFloat64(x.Weight)
+	}
+	if len(x.Ids) > 0 || s.HasField("ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ids")
+		s.WriteInt32Array(x.Ids)
+	}
+	if x.Leaf != nil || s.HasField("leaf") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("leaf")
+		x.Leaf.MarshalProtoJSON(s.WithField("leaf"))
+	}
+	if len(x.Leaves) > 0 || s.HasField("leaves") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("leaves")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Leaves {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("leaves"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.ByName != nil || s.HasField("byName") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("byName")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.ByName {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			v.MarshalProtoJSON(s.WithField("byName"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Labels != nil || s.HasField("labels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("labels")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Labels {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectInt32Field(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Choice != nil {
+		switch ov := x.Choice.(type) {
+		case *Tree_Text:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("text")
+			s.WriteString(ov.Text)
+		case *Tree_Node:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("node")
+			ov.Node.MarshalProtoJSON(s.WithField("node"))
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Tree to JSON.
+func (x *Tree) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Tree message from JSON.
+func (x *Tree) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			x.Name = s.ReadString()
+		case "level":
+			s.AddField("level")
+			if s.ReadNil() {
+				x.Level = nil
+				return
+			}
+			t := s.ReadInt32()
+			x.Level = &t
+		case "data":
+			s.AddField("data")
+			x.Data = s.ReadBytes()
+		case "weight":
+			s.AddField("weight")
+			x.Weight = s.ReadFloat64()
+		case "ids":
+			s.AddField("ids")
+			if s.ReadNil() {
+				x.Ids = nil
+				return
+			}
+			x.Ids = s.ReadInt32Array()
+		case "leaf":
+			if s.ReadNil() {
+				x.Leaf = nil
+				return
+			}
+			x.Leaf = &Leaf{}
+			x.Leaf.UnmarshalProtoJSON(s.WithField("leaf", true))
+		case "leaves":
+			s.AddField("leaves")
+			if s.ReadNil() {
+				x.Leaves = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Leaves = append(x.Leaves, nil)
+					return
+				}
+				v := &Leaf{}
+				v.UnmarshalProtoJSON(s.WithField("leaves", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Leaves = append(x.Leaves, v)
+			})
+		case "by_name", "byName":
+			s.AddField("by_name")
+			if s.ReadNil() {
+				x.ByName = nil
+				return
+			}
+			x.ByName = make(map[string]*Leaf)
+			s.ReadStringMap(func(key string) {
+				var v Leaf
+				v.UnmarshalProtoJSON(s)
+				x.ByName[key] = &v
+			})
+		case "labels":
+			s.AddField("labels")
+			if s.ReadNil() {
+				x.Labels = nil
+				return
+			}
+			x.Labels = make(map[int32]string)
+			s.ReadInt32Map(func(key int32) {
+				x.Labels[key] = s.ReadString()
+			})
+		case "text":
+			s.AddField("text")
+			ov := &Tree_Text{}
+			x.Choice = ov
+			ov.Text = s.ReadString()
+		case "node":
+			ov := &Tree_Node{}
+			x.Choice = ov
+			if s.ReadNil() {
+				ov.Node = nil
+				return
+			}
+			ov.Node = &Leaf{}
+			ov.Node.UnmarshalProtoJSON(s.WithField("node", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Tree from JSON.
+func (x *Tree) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *Leaf) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Leaf) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Leaf) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.Count != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tree) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tree) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Tree) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeString(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ByName) > 0 {
+		for k := range m.ByName {
+			v := m.ByName[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Leaves) > 0 {
+		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Leaves[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Leaf != nil {
+		size, err := m.Leaf.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Ids) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ids)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Weight != 0 {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Data) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Data)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Level != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tree_Text) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Tree_Text) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Text)
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *Tree_Node) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Tree_Node) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Node != nil {
+		size, err := m.Node.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x5a
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Leaf) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Leaf) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Leaf) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.Count != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tree) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tree) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Tree) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if msg, ok := m.Choice.(*Tree_Node); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*Tree_Text); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeString(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ByName) > 0 {
+		for k := range m.ByName {
+			v := m.ByName[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Leaves) > 0 {
+		for iNdEx := len(m.Leaves) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Leaves[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Leaf != nil {
+		size, err := m.Leaf.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Ids) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ids)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Weight != 0 {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(m.Weight))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Data) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Data)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Level != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tree_Text) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Tree_Text) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Text)
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *Tree_Node) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Tree_Node) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Node != nil {
+		size, err := m.Node.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x5a
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Leaf) MergeVT(src *Leaf) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Count != 0 {
+		m.Count = src.Count
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Leaf) MergeMessageVT(src any) bool {
+	s, ok := src.(*Leaf)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Tree) MergeVT(src *Tree) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Name != "" {
+		m.Name = src.Name
+	}
+	if src.Level != nil {
+		v := *src.Level
+		m.Level = &v
+	}
+	if len(src.Data) > 0 {
+		m.Data = slices.Clone(src.Data)
+	}
+	if src.Weight != 0 {
+		m.Weight = src.Weight
+	}
+	m.Ids = append(m.Ids, src.Ids...)
+	if src.Leaf != nil {
+		if m.Leaf == nil {
+			m.Leaf = new(Leaf)
+		}
+		m.Leaf.MergeVT(src.Leaf)
+	}
+	for _, v := range src.Leaves {
+		var e *Leaf
+		if v != nil {
+			e = new(Leaf)
+			e.MergeVT(v)
+		}
+		m.Leaves = append(m.Leaves, e)
+	}
+	if len(src.ByName) > 0 {
+		if m.ByName == nil {
+			m.ByName = make(map[string]*Leaf, len(src.ByName))
+		}
+		for k, v := range src.ByName {
+			var e *Leaf
+			if v != nil {
+				e = new(Leaf)
+				e.MergeVT(v)
+			}
+			m.ByName[k] = e
+		}
+	}
+	if len(src.Labels) > 0 {
+		if m.Labels == nil {
+			m.Labels = make(map[int32]string, len(src.Labels))
+		}
+		for k, v := range src.Labels {
+			m.Labels[k] = v
+		}
+	}
+	switch v := src.Choice.(type) {
+	case *Tree_Text:
+		m.Choice = &Tree_Text{Text: v.Text}
+	case *Tree_Node:
+		if cur, ok := m.Choice.(*Tree_Node); ok && cur.Node != nil {
+			cur.Node.MergeVT(v.Node)
+		} else {
+			e := new(Leaf)
+			e.MergeVT(v.Node)
+			m.Choice = &Tree_Node{Node: e}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Tree) MergeMessageVT(src any) bool {
+	s, ok := src.(*Tree)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Leaf) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Tree) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.Leaf.RedactVT()
+	for _, v := range m.Leaves {
+		v.RedactVT()
+	}
+	for _, v := range m.ByName {
+		v.RedactVT()
+	}
+	switch v := m.Choice.(type) {
+	case *Tree_Node:
+		v.Node.RedactVT()
+	}
+}
+
+func (m *Leaf) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.Name)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Count)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Tree) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.Name)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Level)
+	n += protobuf_go_lite.SizeBytesNonEmpty(1, m.Data)
+	n += protobuf_go_lite.SizeFixed64NonZero(1, m.Weight)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.Ids)
+	if m.Leaf != nil {
+		l = m.Leaf.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.Leaves {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for k, v := range m.ByName {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	for k, v := range m.Labels {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeVarintValue(1, k) + protobuf_go_lite.SizeStringValue(1, v)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Tree_Text) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(1, m.Text)
+	return n
+}
+func (m *Tree_Node) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	} else {
+		n += 2
+	}
+	return n
+}
+func (x *Leaf) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Leaf")
+	if x.Name != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "name")
+		protobuf_go_lite.TextWriteString(&sb, x.Name)
+	}
+	if x.Count != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "count")
+		protobuf_go_lite.TextWriteInt(&sb, x.Count)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Leaf) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Tree_ByNameEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "ByNameEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Tree_ByNameEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Tree_LabelsEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "LabelsEntry")
+	if x.Key != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteInt(&sb, x.Key)
+	}
+	if x.Value != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteString(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Tree_LabelsEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Tree) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Tree")
+	if x.Name != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "name")
+		protobuf_go_lite.TextWriteString(&sb, x.Name)
+	}
+	if x.Level != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "level")
+		protobuf_go_lite.TextWriteInt(&sb, *x.Level)
+	}
+	if len(x.Data) != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "data")
+		protobuf_go_lite.TextWriteBytes(&sb, x.Data)
+	}
+	if x.Weight != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "weight")
+		protobuf_go_lite.TextWriteFloat64(&sb, x.Weight)
+	}
+	if len(x.Ids) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "ids")
+		for i, v := range x.Ids {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Leaf != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "leaf")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Leaf)
+	}
+	if len(x.Leaves) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "leaves")
+		for i, v := range x.Leaves {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &Leaf{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.ByName) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "by_name")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.ByName) {
+			v := x.ByName[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &Leaf{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.Labels) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "labels")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.Labels) {
+			v := x.Labels[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteInt(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteString(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	switch body := x.Choice.(type) {
+	case *Tree_Text:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "text")
+		protobuf_go_lite.TextWriteString(&sb, body.Text)
+	case *Tree_Node:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "node")
+		if body.Node == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &Leaf{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Node)
+		}
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Tree) String() string {
+	return x.MarshalProtoText()
+}
+func (m *Leaf) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Leaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Leaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tree) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Level = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			m.Data, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Data, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Weight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Leaf == nil {
+				m.Leaf = &Leaf{}
+			}
+			if err := m.Leaf.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Leaves = append(m.Leaves, &Leaf{})
+			if err := m.Leaves[len(m.Leaves)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ByName == nil {
+				m.ByName = make(map[string]*Leaf)
+			}
+			var mapkey string
+			var mapvalue *Leaf
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Leaf{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Labels == nil {
+				m.Labels = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Tree_Text{Text: v}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Tree_Node); ok {
+				if err := oneof.Node.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Leaf{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Tree_Node{Node: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// RangeTreeLeavesVT iterates over the Leaves elements encoded in dAtA, a serialized Tree,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeTreeLeavesVT(dAtA []byte) iter.Seq2[*Leaf, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 7, (*Leaf).UnmarshalVT)
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Leaf) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Leaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Leaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Tree) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Level = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			m.Data, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Data, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Weight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Leaf == nil {
+				m.Leaf = &Leaf{}
+			}
+			if err := m.Leaf.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Leaves = append(m.Leaves, &Leaf{})
+			if err := m.Leaves[len(m.Leaves)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ByName == nil {
+				m.ByName = make(map[string]*Leaf)
+			}
+			var mapkey string
+			var mapvalue *Leaf
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Leaf{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Labels == nil {
+				m.Labels = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Tree_Text{Text: v}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Tree_Node); ok {
+				if err := oneof.Node.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Leaf{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Tree_Node{Node: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Leaf) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Leaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Leaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Tree) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Level = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			m.Data, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Weight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Leaf == nil {
+				m.Leaf = &Leaf{}
+			}
+			if err := m.Leaf.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Leaves = append(m.Leaves, &Leaf{})
+			if err := m.Leaves[len(m.Leaves)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ByName == nil {
+				m.ByName = make(map[string]*Leaf)
+			}
+			var mapkey string
+			var mapvalue *Leaf
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Leaf{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Labels == nil {
+				m.Labels = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Tree_Text{Text: v}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Tree_Node); ok {
+				if err := oneof.Node.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Leaf{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Tree_Node{Node: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Leaf) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Leaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Leaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tree) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Level = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			m.Data, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Weight = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Leaf == nil {
+				m.Leaf = &Leaf{}
+			}
+			if err := m.Leaf.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Leaves = append(m.Leaves, &Leaf{})
+			if err := m.Leaves[len(m.Leaves)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ByName == nil {
+				m.ByName = make(map[string]*Leaf)
+			}
+			var mapkey string
+			var mapvalue *Leaf
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Leaf{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Labels == nil {
+				m.Labels = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Tree_Text{Text: v}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Tree_Node); ok {
+				if err := oneof.Node.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Leaf{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Tree_Node{Node: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
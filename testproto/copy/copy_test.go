@@ -0,0 +1,116 @@
+package copy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTree() *Tree {
+	level := int32(2)
+	return &Tree{
+		Name:   "src",
+		Level:  &level,
+		Data:   []byte("src"),
+		Weight: 1.5,
+		Ids:    []int32{1, 2},
+		Leaf:   &Leaf{Name: "leaf", Count: 1},
+		Leaves: []*Leaf{{Name: "a"}, nil},
+		ByName: map[string]*Leaf{"a": {Name: "a", Count: 1}, "b": nil},
+		Labels: map[int32]string{1: "one"},
+		Choice: &Tree_Node{Node: &Leaf{Name: "node", Count: 3}},
+	}
+}
+
+// checkCopy copies src into dst and checks that the copy is deeply equal to
+// src, keeping nil and empty values apart.
+func checkCopy(t *testing.T, src, dst *Tree) {
+	t.Helper()
+	src.CopyVT(dst)
+	if !reflect.DeepEqual(dst, src) {
+		t.Fatalf("CopyVT gave %v, want %v", dst, src)
+	}
+}
+
+func TestCopyVTNilAndEmpty(t *testing.T) {
+	empty := &Tree{
+		Data:   []byte{},
+		Ids:    []int32{},
+		Leaf:   &Leaf{},
+		Leaves: []*Leaf{},
+		ByName: map[string]*Leaf{},
+		Labels: map[int32]string{},
+		Choice: &Tree_Node{},
+	}
+	checkCopy(t, empty, &Tree{})
+	checkCopy(t, empty, newTree())
+
+	// Copying a message with nil fields clears the fields of dst, including
+	// the ones it allocated before.
+	checkCopy(t, &Tree{}, newTree())
+	checkCopy(t, &Tree{}, empty)
+	checkCopy(t, newTree(), empty)
+}
+
+func TestCopyVTNilMessages(t *testing.T) {
+	dst := newTree()
+	var nilTree *Tree
+	nilTree.CopyVT(dst)
+	if !reflect.DeepEqual(dst, &Tree{}) {
+		t.Fatalf("copying a nil message gave %v, want an empty message", dst)
+	}
+
+	src := newTree()
+	src.CopyVT(nil)
+	src.CopyVT(src)
+	if !src.EqualVT(newTree()) {
+		t.Fatalf("copying into nil or itself changed the message to %v", src)
+	}
+}
+
+func TestCopyVTOneofSwitch(t *testing.T) {
+	dst := &Tree{Choice: &Tree_Text{Text: "text"}}
+	checkCopy(t, &Tree{Choice: &Tree_Node{Node: &Leaf{Name: "node"}}}, dst)
+	checkCopy(t, &Tree{Choice: &Tree_Text{Text: "back"}}, dst)
+	checkCopy(t, &Tree{}, dst)
+	if dst.Choice != nil {
+		t.Fatalf("copying an unset oneof left %v", dst.Choice)
+	}
+}
+
+func TestCopyVTReusesDst(t *testing.T) {
+	dst := newTree()
+	leaf, node, byName := dst.Leaf, dst.GetNode(), dst.ByName["a"]
+	dst.ByName["stale"] = &Leaf{Name: "stale"}
+	dst.Labels[9] = "stale"
+
+	src := newTree()
+	src.Leaf.Count = 10
+	src.GetNode().Count = 20
+	src.ByName["a"].Count = 30
+	checkCopy(t, src, dst)
+
+	if dst.Leaf != leaf || dst.GetNode() != node || dst.ByName["a"] != byName {
+		t.Fatal("CopyVT did not reuse the sub-messages of dst")
+	}
+	if _, ok := dst.ByName["stale"]; ok {
+		t.Fatal("CopyVT kept a map key missing from src")
+	}
+}
+
+func TestCopyVTDoesNotAlias(t *testing.T) {
+	src := newTree()
+	dst := &Tree{}
+	src.CopyVT(dst)
+
+	*src.Level = 7
+	src.Data[0] = 'x'
+	src.Ids[0] = 9
+	src.Leaf.Name = "changed"
+	src.Leaves[0].Name = "changed"
+	src.ByName["a"].Name = "changed"
+	src.Labels[1] = "changed"
+	src.GetNode().Name = "changed"
+	if !reflect.DeepEqual(dst, newTree()) {
+		t.Fatalf("changing src changed the copy to %v", dst)
+	}
+}
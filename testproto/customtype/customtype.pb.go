@@ -0,0 +1,4038 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/customtype/customtype.proto
+
+package customtype
+
+import (
+	bytes "bytes"
+	cmp "cmp"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	slices "slices"
+	time "time"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+// CustomTypes holds bytes and string fields generated as custom Go types and
+// integer fields generated as named Go types.
+type CustomTypes struct {
+	unknownFields []byte
+	// protobuf-go-lite:customtype=UUID
+	Id *UUID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// protobuf-go-lite:customtype=UUID
+	Parent *UUID `protobuf:"bytes,2,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	// protobuf-go-lite:customtype=UUID
+	Members []UUID `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// protobuf-go-lite:customtype=Hostname
+	Host *Hostname `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	// protobuf-go-lite:customtype=Hostname
+	Aliases []Hostname `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// protobuf-go-lite:casttype=Cents
+	Price Cents `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	// protobuf-go-lite:casttype=Cents
+	Discount *Cents `protobuf:"varint,7,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	// protobuf-go-lite:casttype=Port
+	Ports []Port `protobuf:"varint,8,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	// protobuf-go-lite:casttype=time.Duration
+	Timeout time.Duration `protobuf:"zigzag64,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Types that are assignable to Target:
+	//
+	//	*CustomTypes_TargetId
+	//	*CustomTypes_TargetPort
+	Target isCustomTypes_Target `protobuf_oneof:"target"`
+}
+
+func (x *CustomTypes) Reset() {
+	*x = CustomTypes{}
+}
+
+func (*CustomTypes) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CustomTypes) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CustomTypes) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CustomTypes) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *CustomTypes) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetParent() *UUID {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetMembers() []UUID {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetHost() *Hostname {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetAliases() []Hostname {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetPrice() Cents {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CustomTypes) GetDiscount() Cents {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *CustomTypes) GetPorts() []Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetTimeout() time.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (m *CustomTypes) GetTarget() isCustomTypes_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetTargetId() *UUID {
+	if x, ok := x.GetTarget().(*CustomTypes_TargetId); ok {
+		return x.TargetId
+	}
+	return nil
+}
+
+func (x *CustomTypes) GetTargetPort() Port {
+	if x, ok := x.GetTarget().(*CustomTypes_TargetPort); ok {
+		return x.TargetPort
+	}
+	return 0
+}
+
+type isCustomTypes_Target interface {
+	isCustomTypes_Target()
+}
+
+type CustomTypes_TargetId struct {
+	// protobuf-go-lite:customtype=UUID
+	TargetId *UUID `protobuf:"bytes,10,opt,name=target_id,json=targetId,proto3,oneof"`
+}
+
+type CustomTypes_TargetPort struct {
+	// protobuf-go-lite:casttype=Port
+	TargetPort Port `protobuf:"fixed32,11,opt,name=target_port,json=targetPort,proto3,oneof"`
+}
+
+func (*CustomTypes_TargetId) isCustomTypes_Target() {}
+
+func (*CustomTypes_TargetPort) isCustomTypes_Target() {}
+
+// CustomTypesPlain has the fields of CustomTypes with their plain Go types.
+type CustomTypesPlain struct {
+	unknownFields []byte
+	Id            []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Parent        []byte   `protobuf:"bytes,2,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	Members       [][]byte `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Host          string   `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Aliases       []string `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Price         int64    `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Discount      *int64   `protobuf:"varint,7,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Ports         []uint32 `protobuf:"varint,8,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	Timeout       int64    `protobuf:"zigzag64,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Types that are assignable to Target:
+	//
+	//	*CustomTypesPlain_TargetId
+	//	*CustomTypesPlain_TargetPort
+	Target isCustomTypesPlain_Target `protobuf_oneof:"target"`
+}
+
+func (x *CustomTypesPlain) Reset() {
+	*x = CustomTypesPlain{}
+}
+
+func (*CustomTypesPlain) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CustomTypesPlain) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CustomTypesPlain) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CustomTypesPlain) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *CustomTypesPlain) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetParent() []byte {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetMembers() [][]byte {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CustomTypesPlain) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CustomTypesPlain) GetDiscount() int64 {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *CustomTypesPlain) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (m *CustomTypesPlain) GetTarget() isCustomTypesPlain_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetTargetId() []byte {
+	if x, ok := x.GetTarget().(*CustomTypesPlain_TargetId); ok {
+		return x.TargetId
+	}
+	return nil
+}
+
+func (x *CustomTypesPlain) GetTargetPort() uint32 {
+	if x, ok := x.GetTarget().(*CustomTypesPlain_TargetPort); ok {
+		return x.TargetPort
+	}
+	return 0
+}
+
+type isCustomTypesPlain_Target interface {
+	isCustomTypesPlain_Target()
+}
+
+type CustomTypesPlain_TargetId struct {
+	TargetId []byte `protobuf:"bytes,10,opt,name=target_id,json=targetId,proto3,oneof"`
+}
+
+type CustomTypesPlain_TargetPort struct {
+	TargetPort uint32 `protobuf:"fixed32,11,opt,name=target_port,json=targetPort,proto3,oneof"`
+}
+
+func (*CustomTypesPlain_TargetId) isCustomTypesPlain_Target() {}
+
+func (*CustomTypesPlain_TargetPort) isCustomTypesPlain_Target() {}
+
+func (m *CustomTypes) CloneVT() *CustomTypes {
+	if m == nil {
+		return (*CustomTypes)(nil)
+	}
+	r := new(CustomTypes)
+	r.Price = m.Price
+	r.Timeout = m.Timeout
+	r.Id = protobuf_go_lite.CloneCustom(m.Id)
+	r.Parent = protobuf_go_lite.CloneCustom(m.Parent)
+	r.Members = protobuf_go_lite.CloneCustomSlice(m.Members)
+	r.Host = protobuf_go_lite.CloneCustom(m.Host)
+	r.Aliases = protobuf_go_lite.CloneCustomSlice(m.Aliases)
+	r.Discount = protobuf_go_lite.ClonePtr(m.Discount)
+	r.Ports = protobuf_go_lite.CloneSlice(m.Ports)
+	if m.Target != nil {
+		r.Target = m.Target.(interface{ CloneOneofVT() isCustomTypes_Target }).CloneOneofVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *CustomTypes) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *CustomTypes_TargetId) CloneVT() *CustomTypes_TargetId {
+	if m == nil {
+		return (*CustomTypes_TargetId)(nil)
+	}
+	r := new(CustomTypes_TargetId)
+	r.TargetId = protobuf_go_lite.CloneCustom(m.TargetId)
+	return r
+}
+
+func (m *CustomTypes_TargetId) CloneOneofVT() isCustomTypes_Target {
+	return m.CloneVT()
+}
+
+func (m *CustomTypes_TargetPort) CloneVT() *CustomTypes_TargetPort {
+	if m == nil {
+		return (*CustomTypes_TargetPort)(nil)
+	}
+	r := new(CustomTypes_TargetPort)
+	r.TargetPort = m.TargetPort
+	return r
+}
+
+func (m *CustomTypes_TargetPort) CloneOneofVT() isCustomTypes_Target {
+	return m.CloneVT()
+}
+
+func (m *CustomTypesPlain) CloneVT() *CustomTypesPlain {
+	if m == nil {
+		return (*CustomTypesPlain)(nil)
+	}
+	r := new(CustomTypesPlain)
+	r.Host = m.Host
+	r.Price = m.Price
+	r.Timeout = m.Timeout
+	r.Id = protobuf_go_lite.CloneBytes(m.Id)
+	r.Parent = protobuf_go_lite.CloneBytes(m.Parent)
+	r.Members = protobuf_go_lite.CloneBytesSlice(m.Members)
+	r.Aliases = protobuf_go_lite.CloneSlice(m.Aliases)
+	r.Discount = protobuf_go_lite.ClonePtr(m.Discount)
+	r.Ports = protobuf_go_lite.CloneSlice(m.Ports)
+	if m.Target != nil {
+		r.Target = m.Target.(interface {
+			CloneOneofVT() isCustomTypesPlain_Target
+		}).CloneOneofVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *CustomTypesPlain) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *CustomTypesPlain_TargetId) CloneVT() *CustomTypesPlain_TargetId {
+	if m == nil {
+		return (*CustomTypesPlain_TargetId)(nil)
+	}
+	r := new(CustomTypesPlain_TargetId)
+	r.TargetId = protobuf_go_lite.CloneBytes(m.TargetId)
+	return r
+}
+
+func (m *CustomTypesPlain_TargetId) CloneOneofVT() isCustomTypesPlain_Target {
+	return m.CloneVT()
+}
+
+func (m *CustomTypesPlain_TargetPort) CloneVT() *CustomTypesPlain_TargetPort {
+	if m == nil {
+		return (*CustomTypesPlain_TargetPort)(nil)
+	}
+	r := new(CustomTypesPlain_TargetPort)
+	r.TargetPort = m.TargetPort
+	return r
+}
+
+func (m *CustomTypesPlain_TargetPort) CloneOneofVT() isCustomTypesPlain_Target {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *CustomTypes) CompareVT(that *CustomTypes) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.CompareCustom(m.Id, that.Id); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareCustom(m.Parent, that.Parent); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareCustomSlice(m.Members, that.Members); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareCustom(m.Host, that.Host); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareCustomSlice(m.Aliases, that.Aliases); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Price, that.Price); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Discount, that.Discount); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.Ports, that.Ports); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Timeout, that.Timeout); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Target.(*CustomTypes_TargetId)
+		b, bok := that.Target.(*CustomTypes_TargetId)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareCustom(a.TargetId, b.TargetId); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Target.(*CustomTypes_TargetPort)
+		b, bok := that.Target.(*CustomTypes_TargetPort)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.TargetPort, b.TargetPort); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *CustomTypesPlain) CompareVT(that *CustomTypesPlain) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := bytes.Compare(m.Id, that.Id); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBytesPresent(m.Parent, that.Parent); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.Members, that.Members, bytes.Compare); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Host, that.Host); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.Aliases, that.Aliases); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Price, that.Price); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Discount, that.Discount); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.Ports, that.Ports); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Timeout, that.Timeout); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Target.(*CustomTypesPlain_TargetId)
+		b, bok := that.Target.(*CustomTypesPlain_TargetId)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := bytes.Compare(a.TargetId, b.TargetId); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Target.(*CustomTypesPlain_TargetPort)
+		b, bok := that.Target.(*CustomTypesPlain_TargetPort)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.TargetPort, b.TargetPort); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *CustomTypes) CopyVT(dst *CustomTypes) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Id = protobuf_go_lite.CopyCustom(dst.Id, m.Id)
+	dst.Parent = protobuf_go_lite.CopyCustom(dst.Parent, m.Parent)
+	dst.Members = protobuf_go_lite.CopyCustomSlice(dst.Members, m.Members)
+	dst.Host = protobuf_go_lite.CopyCustom(dst.Host, m.Host)
+	dst.Aliases = protobuf_go_lite.CopyCustomSlice(dst.Aliases, m.Aliases)
+	dst.Price = m.Price
+	dst.Discount = protobuf_go_lite.CopyPtr(dst.Discount, m.Discount)
+	dst.Ports = protobuf_go_lite.CopySlice(dst.Ports, m.Ports)
+	dst.Timeout = m.Timeout
+	switch v := m.Target.(type) {
+	case nil:
+		dst.Target = nil
+	case *CustomTypes_TargetId:
+		d, ok := dst.Target.(*CustomTypes_TargetId)
+		if !ok {
+			d = &CustomTypes_TargetId{}
+			dst.Target = d
+		}
+		d.TargetId = protobuf_go_lite.CopyCustom(d.TargetId, v.TargetId)
+	case *CustomTypes_TargetPort:
+		d, ok := dst.Target.(*CustomTypes_TargetPort)
+		if !ok {
+			d = &CustomTypes_TargetPort{}
+			dst.Target = d
+		}
+		d.TargetPort = v.TargetPort
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *CustomTypesPlain) CopyVT(dst *CustomTypesPlain) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Id = protobuf_go_lite.CopyBytes(dst.Id, m.Id)
+	dst.Parent = protobuf_go_lite.CopyBytes(dst.Parent, m.Parent)
+	dst.Members = protobuf_go_lite.CopyBytesSlice(dst.Members, m.Members)
+	dst.Host = m.Host
+	dst.Aliases = protobuf_go_lite.CopySlice(dst.Aliases, m.Aliases)
+	dst.Price = m.Price
+	dst.Discount = protobuf_go_lite.CopyPtr(dst.Discount, m.Discount)
+	dst.Ports = protobuf_go_lite.CopySlice(dst.Ports, m.Ports)
+	dst.Timeout = m.Timeout
+	switch v := m.Target.(type) {
+	case nil:
+		dst.Target = nil
+	case *CustomTypesPlain_TargetId:
+		d, ok := dst.Target.(*CustomTypesPlain_TargetId)
+		if !ok {
+			d = &CustomTypesPlain_TargetId{}
+			dst.Target = d
+		}
+		d.TargetId = protobuf_go_lite.CopyBytes(d.TargetId, v.TargetId)
+	case *CustomTypesPlain_TargetPort:
+		d, ok := dst.Target.(*CustomTypesPlain_TargetPort)
+		if !ok {
+			d = &CustomTypesPlain_TargetPort{}
+			dst.Target = d
+		}
+		d.TargetPort = v.TargetPort
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *CustomTypes) DiffVT(that *CustomTypes) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *CustomTypes) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *CustomTypes) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &CustomTypes{}
+	}
+	if that == nil {
+		that = &CustomTypes{}
+	}
+	diffs = protobuf_go_lite.AppendDiffCustom(diffs, prefix, "id", m.Id, that.Id)
+	diffs = protobuf_go_lite.AppendDiffCustom(diffs, prefix, "parent", m.Parent, that.Parent)
+	diffs = protobuf_go_lite.AppendDiffCustomSlice(diffs, prefix, "members", m.Members, that.Members)
+	diffs = protobuf_go_lite.AppendDiffCustom(diffs, prefix, "host", m.Host, that.Host)
+	diffs = protobuf_go_lite.AppendDiffCustomSlice(diffs, prefix, "aliases", m.Aliases, that.Aliases)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "price", m.Price, that.Price)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "discount", m.Discount, that.Discount)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "ports", m.Ports, that.Ports)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "timeout", m.Timeout, that.Timeout)
+	{
+		var a, b *UUID
+		if v, ok := m.Target.(*CustomTypes_TargetId); ok {
+			a = v.TargetId
+		}
+		if v, ok := that.Target.(*CustomTypes_TargetId); ok {
+			b = v.TargetId
+		}
+		diffs = protobuf_go_lite.AppendDiffCustom(diffs, prefix, "target_id", a, b)
+	}
+	{
+		var a, b *Port
+		if v, ok := m.Target.(*CustomTypes_TargetPort); ok {
+			a = &v.TargetPort
+		}
+		if v, ok := that.Target.(*CustomTypes_TargetPort); ok {
+			b = &v.TargetPort
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "target_port", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *CustomTypesPlain) DiffVT(that *CustomTypesPlain) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *CustomTypesPlain) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *CustomTypesPlain) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &CustomTypesPlain{}
+	}
+	if that == nil {
+		that = &CustomTypesPlain{}
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "id", m.Id, that.Id)
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "parent", m.Parent, that.Parent)
+	diffs = protobuf_go_lite.AppendDiffBytesSlice(diffs, prefix, "members", m.Members, that.Members)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "host", m.Host, that.Host)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "aliases", m.Aliases, that.Aliases)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "price", m.Price, that.Price)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "discount", m.Discount, that.Discount)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "ports", m.Ports, that.Ports)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "timeout", m.Timeout, that.Timeout)
+	{
+		var a, b []byte
+		if v, ok := m.Target.(*CustomTypesPlain_TargetId); ok {
+			a = v.TargetId
+			if a == nil {
+				a = []byte{}
+			}
+		}
+		if v, ok := that.Target.(*CustomTypesPlain_TargetId); ok {
+			b = v.TargetId
+			if b == nil {
+				b = []byte{}
+			}
+		}
+		diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "target_id", a, b)
+	}
+	{
+		var a, b *uint32
+		if v, ok := m.Target.(*CustomTypesPlain_TargetPort); ok {
+			a = &v.TargetPort
+		}
+		if v, ok := that.Target.(*CustomTypesPlain_TargetPort); ok {
+			b = &v.TargetPort
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "target_port", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *CustomTypes) EqualVT(that *CustomTypes) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Target == nil && that.Target != nil {
+		return false
+	} else if this.Target != nil {
+		if that.Target == nil {
+			return false
+		}
+		if !this.Target.(interface {
+			EqualVT(isCustomTypes_Target) bool
+		}).EqualVT(that.Target) {
+			return false
+		}
+	}
+	if !protobuf_go_lite.EqualCustom(this.Id, that.Id) {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustom(this.Parent, that.Parent) {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustomSlice(this.Members, that.Members) {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustom(this.Host, that.Host) {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustomSlice(this.Aliases, that.Aliases) {
+		return false
+	}
+	if this.Price != that.Price {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Discount, that.Discount) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Ports, that.Ports) {
+		return false
+	}
+	if this.Timeout != that.Timeout {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *CustomTypes) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*CustomTypes)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *CustomTypes_TargetId) EqualVT(thatIface isCustomTypes_Target) bool {
+	that, ok := thatIface.(*CustomTypes_TargetId)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustom(this.TargetId, that.TargetId) {
+		return false
+	}
+	return true
+}
+
+func (this *CustomTypes_TargetPort) EqualVT(thatIface isCustomTypes_Target) bool {
+	that, ok := thatIface.(*CustomTypes_TargetPort)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.TargetPort != that.TargetPort {
+		return false
+	}
+	return true
+}
+
+func (this *CustomTypesPlain) EqualVT(that *CustomTypesPlain) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Target == nil && that.Target != nil {
+		return false
+	} else if this.Target != nil {
+		if that.Target == nil {
+			return false
+		}
+		if !this.Target.(interface {
+			EqualVT(isCustomTypesPlain_Target) bool
+		}).EqualVT(that.Target) {
+			return false
+		}
+	}
+	if !protobuf_go_lite.EqualBytes(this.Id, that.Id) {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytesPresent(this.Parent, that.Parent) {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytesSlice(this.Members, that.Members) {
+		return false
+	}
+	if this.Host != that.Host {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Aliases, that.Aliases) {
+		return false
+	}
+	if this.Price != that.Price {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Discount, that.Discount) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Ports, that.Ports) {
+		return false
+	}
+	if this.Timeout != that.Timeout {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *CustomTypesPlain) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*CustomTypesPlain)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *CustomTypesPlain_TargetId) EqualVT(thatIface isCustomTypesPlain_Target) bool {
+	that, ok := thatIface.(*CustomTypesPlain_TargetId)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytes(this.TargetId, that.TargetId) {
+		return false
+	}
+	return true
+}
+
+func (this *CustomTypesPlain_TargetPort) EqualVT(thatIface isCustomTypesPlain_Target) bool {
+	that, ok := thatIface.(*CustomTypesPlain_TargetPort)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.TargetPort != that.TargetPort {
+		return false
+	}
+	return true
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *CustomTypes) EqualVTOpts(that *CustomTypes, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *CustomTypes) EqualVTOptsPrefix(that *CustomTypes, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &CustomTypes{}
+		}
+		if that == nil {
+			that = &CustomTypes{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "id"); ok && !protobuf_go_lite.EqualCustom(this.Id, that.Id) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "parent"); ok && !protobuf_go_lite.EqualCustom(this.Parent, that.Parent) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "members"); ok && !protobuf_go_lite.EqualCustomSlice(this.Members, that.Members) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "host"); ok && !protobuf_go_lite.EqualCustom(this.Host, that.Host) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "aliases"); ok && !protobuf_go_lite.EqualCustomSlice(this.Aliases, that.Aliases) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "price"); ok && this.Price != that.Price {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "discount"); ok && ((this.Discount == nil) != (that.Discount == nil) || this.Discount != nil && *this.Discount != *that.Discount) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "ports"); ok && !slices.Equal(this.Ports, that.Ports) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "timeout"); ok && this.Timeout != that.Timeout {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "target_id"); ok {
+		a, aok := this.Target.(*CustomTypes_TargetId)
+		b, bok := that.Target.(*CustomTypes_TargetId)
+		if aok != bok || aok && !protobuf_go_lite.EqualCustom(a.TargetId, b.TargetId) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "target_port"); ok {
+		a, aok := this.Target.(*CustomTypes_TargetPort)
+		b, bok := that.Target.(*CustomTypes_TargetPort)
+		if aok != bok || aok && a.TargetPort != b.TargetPort {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *CustomTypesPlain) EqualVTOpts(that *CustomTypesPlain, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *CustomTypesPlain) EqualVTOptsPrefix(that *CustomTypesPlain, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &CustomTypesPlain{}
+		}
+		if that == nil {
+			that = &CustomTypesPlain{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "id"); ok && (string(this.Id) != string(that.Id)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "parent"); ok && ((this.Parent == nil) != (that.Parent == nil) || string(this.Parent) != string(that.Parent)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "members"); ok && !protobuf_go_lite.EqualBytesSlice(this.Members, that.Members) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "host"); ok && this.Host != that.Host {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "aliases"); ok && !slices.Equal(this.Aliases, that.Aliases) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "price"); ok && this.Price != that.Price {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "discount"); ok && ((this.Discount == nil) != (that.Discount == nil) || this.Discount != nil && *this.Discount != *that.Discount) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "ports"); ok && !slices.Equal(this.Ports, that.Ports) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "timeout"); ok && this.Timeout != that.Timeout {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "target_id"); ok {
+		a, aok := this.Target.(*CustomTypesPlain_TargetId)
+		b, bok := that.Target.(*CustomTypesPlain_TargetId)
+		if aok != bok || aok && string(a.TargetId) != string(b.TargetId) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "target_port"); ok {
+		a, aok := this.Target.(*CustomTypesPlain_TargetPort)
+		b, bok := that.Target.(*CustomTypesPlain_TargetPort)
+		if aok != bok || aok && a.TargetPort != b.TargetPort {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *CustomTypes) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *CustomTypes) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *CustomTypes) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if v := m.Id; v != nil {
+			w.Field(1)
+			protobuf_go_lite.HashCustom(w, v)
+		}
+		if v := m.Parent; v != nil {
+			w.Field(2)
+			protobuf_go_lite.HashCustom(w, v)
+		}
+		if len(m.Members) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.Members)))
+			for _, v := range m.Members {
+				protobuf_go_lite.HashCustom(w, &v)
+			}
+		}
+		if v := m.Host; v != nil {
+			w.Field(4)
+			protobuf_go_lite.HashCustom(w, v)
+		}
+		if len(m.Aliases) != 0 {
+			w.Field(5)
+			w.Uint64(uint64(len(m.Aliases)))
+			for _, v := range m.Aliases {
+				protobuf_go_lite.HashCustom(w, &v)
+			}
+		}
+		if m.Price != 0 {
+			w.Field(6)
+			w.Uint64(uint64(m.Price))
+		}
+		if m.Discount != nil {
+			w.Field(7)
+			w.Uint64(uint64(*m.Discount))
+		}
+		if len(m.Ports) != 0 {
+			w.Field(8)
+			w.Uint64(uint64(len(m.Ports)))
+			for _, v := range m.Ports {
+				w.Uint64(uint64(v))
+			}
+		}
+		if m.Timeout != 0 {
+			w.Field(9)
+			w.Uint64(uint64(m.Timeout))
+		}
+		switch v := m.Target.(type) {
+		case *CustomTypes_TargetId:
+			w.Field(10)
+			protobuf_go_lite.HashCustom(w, v.TargetId)
+		case *CustomTypes_TargetPort:
+			w.Field(11)
+			w.Uint64(uint64(v.TargetPort))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *CustomTypesPlain) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *CustomTypesPlain) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *CustomTypesPlain) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.Id) != 0 {
+			w.Field(1)
+			w.Bytes(m.Id)
+		}
+		if m.Parent != nil {
+			w.Field(2)
+			w.Bytes(m.Parent)
+		}
+		if len(m.Members) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.Members)))
+			for _, v := range m.Members {
+				w.Bytes(v)
+			}
+		}
+		if m.Host != "" {
+			w.Field(4)
+			w.String(m.Host)
+		}
+		if len(m.Aliases) != 0 {
+			w.Field(5)
+			w.Uint64(uint64(len(m.Aliases)))
+			for _, v := range m.Aliases {
+				w.String(v)
+			}
+		}
+		if m.Price != 0 {
+			w.Field(6)
+			w.Uint64(uint64(m.Price))
+		}
+		if m.Discount != nil {
+			w.Field(7)
+			w.Uint64(uint64(*m.Discount))
+		}
+		if len(m.Ports) != 0 {
+			w.Field(8)
+			w.Uint64(uint64(len(m.Ports)))
+			for _, v := range m.Ports {
+				w.Uint64(uint64(v))
+			}
+		}
+		if m.Timeout != 0 {
+			w.Field(9)
+			w.Uint64(uint64(m.Timeout))
+		}
+		switch v := m.Target.(type) {
+		case *CustomTypesPlain_TargetId:
+			w.Field(10)
+			w.Bytes(v.TargetId)
+		case *CustomTypesPlain_TargetPort:
+			w.Field(11)
+			w.Uint64(uint64(v.TargetPort))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the CustomTypes message to JSON.
+func (x *CustomTypes) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		if b, err := protobuf_go_lite.CustomBytes(x.Id); err != nil {
+			s.SetError(err)
+		} else {
+			s.WriteBytes(b)
+		}
+	}
+	if x.Parent != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("parent")
+		if b, err := protobuf_go_lite.CustomBytes(x.Parent); err != nil {
+			s.SetError(err)
+		} else {
+			s.WriteBytes(b)
+		}
+	}
+	if len(x.Members) > 0 || s.HasField("members") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("members")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Members {
+			s.WriteMoreIf(&wroteElement)
+			if b, err := protobuf_go_lite.CustomBytes(&element); err != nil {
+				s.SetError(err)
+			} else {
+				s.WriteBytes(b)
+			}
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Host != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("host")
+		if b, err := protobuf_go_lite.CustomBytes(x.Host); err != nil {
+			s.SetError(err)
+		} else {
+			s.WriteString(string(b))
+		}
+	}
+	if len(x.Aliases) > 0 || s.HasField("aliases") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("aliases")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Aliases {
+			s.WriteMoreIf(&wroteElement)
+			if b, err := protobuf_go_lite.CustomBytes(&element); err != nil {
+				s.SetError(err)
+			} else {
+				s.WriteString(string(b))
+			}
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Price != 0 || s.HasField("price") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("price")
+		s.WriteInt64(int64(x.Price))
+	}
+	if x.Discount != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("discount")
+		s.WriteInt64(int64(*x.Discount))
+	}
+	if len(x.Ports) > 0 || s.HasField("ports") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ports")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Ports {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteUint32(uint32(element))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Timeout != 0 || s.HasField("timeout") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timeout")
+		s.WriteInt64(int64(x.Timeout))
+	}
+	if x.Target != nil {
+		switch ov := x.Target.(type) {
+		case *CustomTypes_TargetId:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("targetId")
+			if b, err := protobuf_go_lite.CustomBytes(ov.TargetId); err != nil {
+				s.SetError(err)
+			} else {
+				s.WriteBytes(b)
+			}
+		case *CustomTypes_TargetPort:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("targetPort")
+			s.WriteUint32(uint32(ov.TargetPort))
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CustomTypes to JSON.
+func (x *CustomTypes) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CustomTypes message from JSON.
+func (x *CustomTypes) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			if s.ReadNil() {
+				x.Id = nil
+				return
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(s.ReadBytes()); err != nil {
+				s.SetError(err)
+				return
+			}
+			x.Id = v
+		case "parent":
+			s.AddField("parent")
+			if s.ReadNil() {
+				x.Parent = nil
+				return
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(s.ReadBytes()); err != nil {
+				s.SetError(err)
+				return
+			}
+			x.Parent = v
+		case "members":
+			s.AddField("members")
+			if s.ReadNil() {
+				x.Members = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v UUID
+				if err := v.UnmarshalVT(s.ReadBytes()); err != nil {
+					s.SetError(err)
+					return
+				}
+				x.Members = append(x.Members, v)
+			})
+		case "host":
+			s.AddField("host")
+			if s.ReadNil() {
+				x.Host = nil
+				return
+			}
+			v := new(Hostname)
+			if err := v.UnmarshalVT([]byte(s.ReadString())); err != nil {
+				s.SetError(err)
+				return
+			}
+			x.Host = v
+		case "aliases":
+			s.AddField("aliases")
+			if s.ReadNil() {
+				x.Aliases = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v Hostname
+				if err := v.UnmarshalVT([]byte(s.ReadString())); err != nil {
+					s.SetError(err)
+					return
+				}
+				x.Aliases = append(x.Aliases, v)
+			})
+		case "price":
+			s.AddField("price")
+			x.Price = Cents(s.ReadInt64())
+		case "discount":
+			s.AddField("discount")
+			if s.ReadNil() {
+				x.Discount = nil
+				return
+			}
+			t := Cents(s.ReadInt64())
+			x.Discount = &t
+		case "ports":
+			s.AddField("ports")
+			if s.ReadNil() {
+				x.Ports = nil
+				return
+			}
+			s.ReadArray(func() {
+				x.Ports = append(x.Ports, Port(s.ReadUint32()))
+			})
+		case "timeout":
+			s.AddField("timeout")
+			x.Timeout = time.Duration(s.ReadInt64())
+		case "target_id", "targetId":
+			s.AddField("target_id")
+			ov := &CustomTypes_TargetId{}
+			x.Target = ov
+			if s.ReadNil() {
+				ov.TargetId = nil
+				return
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(s.ReadBytes()); err != nil {
+				s.SetError(err)
+				return
+			}
+			ov.TargetId = v
+		case "target_port", "targetPort":
+			s.AddField("target_port")
+			ov := &CustomTypes_TargetPort{}
+			x.Target = ov
+			ov.TargetPort = Port(s.ReadUint32())
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CustomTypes from JSON.
+func (x *CustomTypes) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CustomTypesPlain message to JSON.
+func (x *CustomTypesPlain) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Id) > 0 || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteBytes(x.Id)
+	}
+	if x.Parent != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("parent")
+		s.WriteBytes(x.Parent)
+	}
+	if len(x.Members) > 0 || s.HasField("members") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("members")
+		s.WriteBytesArray(x.Members)
+	}
+	if x.Host != "" || s.HasField("host") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("host")
+		s.WriteString(x.Host)
+	}
+	if len(x.Aliases) > 0 || s.HasField("aliases") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("aliases")
+		s.WriteStringArray(x.Aliases)
+	}
+	if x.Price != 0 || s.HasField("price") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("price")
+		s.WriteInt64(x.Price)
+	}
+	if x.Discount != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("discount")
+		s.WriteInt64(*x.Discount)
+	}
+	if len(x.Ports) > 0 || s.HasField("ports") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ports")
+		s.WriteUint32Array(x.Ports)
+	}
+	if x.Timeout != 0 || s.HasField("timeout") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timeout")
+		s.WriteInt64(x.Timeout)
+	}
+	if x.Target != nil {
+		switch ov := x.Target.(type) {
+		case *CustomTypesPlain_TargetId:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("targetId")
+			s.WriteBytes(ov.TargetId)
+		case *CustomTypesPlain_TargetPort:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("targetPort")
+			s.WriteUint32(ov.TargetPort)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CustomTypesPlain to JSON.
+func (x *CustomTypesPlain) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CustomTypesPlain message from JSON.
+func (x *CustomTypesPlain) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadBytes()
+		case "parent":
+			s.AddField("parent")
+			if s.ReadNil() {
+				x.Parent = nil
+				return
+			}
+			x.Parent = s.ReadBytes()
+		case "members":
+			s.AddField("members")
+			if s.ReadNil() {
+				x.Members = nil
+				return
+			}
+			x.Members = s.ReadBytesArray()
+		case "host":
+			s.AddField("host")
+			x.Host = s.ReadString()
+		case "aliases":
+			s.AddField("aliases")
+			if s.ReadNil() {
+				x.Aliases = nil
+				return
+			}
+			x.Aliases = s.ReadStringArray()
+		case "price":
+			s.AddField("price")
+			x.Price = s.ReadInt64()
+		case "discount":
+			s.AddField("discount")
+			if s.ReadNil() {
+				x.Discount = nil
+				return
+			}
+			t := s.ReadInt64()
+			x.Discount = &t
+		case "ports":
+			s.AddField("ports")
+			if s.ReadNil() {
+				x.Ports = nil
+				return
+			}
+			x.Ports = s.ReadUint32Array()
+		case "timeout":
+			s.AddField("timeout")
+			x.Timeout = s.ReadInt64()
+		case "target_id", "targetId":
+			s.AddField("target_id")
+			ov := &CustomTypesPlain_TargetId{}
+			x.Target = ov
+			ov.TargetId = s.ReadBytes()
+		case "target_port", "targetPort":
+			s.AddField("target_port")
+			ov := &CustomTypesPlain_TargetPort{}
+			x.Target = ov
+			ov.TargetPort = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CustomTypesPlain from JSON.
+func (x *CustomTypesPlain) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *CustomTypes) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomTypes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Target.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Timeout != 0 {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.Timeout)
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Ports) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ports)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Discount != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Discount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Price != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x30
+	}
+	for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := m.Aliases[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Host != nil {
+		size, err := m.Host.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := m.Members[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Parent != nil {
+		size, err := m.Parent.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		size, err := m.Id.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomTypes_TargetId) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypes_TargetId) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TargetId != nil {
+		size, err := m.TargetId.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypes_TargetPort) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypes_TargetPort) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.TargetPort))
+	i--
+	dAtA[i] = 0x5d
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypesPlain) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomTypesPlain) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypesPlain) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Target.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Timeout != 0 {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.Timeout)
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Ports) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ports)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Discount != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Discount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Price != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.Aliases[iNdEx])
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Host) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Host)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Members[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Parent != nil {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Parent)
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Id)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomTypesPlain_TargetId) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypesPlain_TargetId) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeBytes(dAtA, i, m.TargetId)
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypesPlain_TargetPort) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CustomTypesPlain_TargetPort) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.TargetPort))
+	i--
+	dAtA[i] = 0x5d
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypes) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomTypes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if msg, ok := m.Target.(*CustomTypes_TargetPort); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Target.(*CustomTypes_TargetId); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Timeout != 0 {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.Timeout)
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Ports) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ports)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Discount != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Discount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Price != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x30
+	}
+	for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := m.Aliases[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Host != nil {
+		size, err := m.Host.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+		size, err := m.Members[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Parent != nil {
+		size, err := m.Parent.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		size, err := m.Id.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomTypes_TargetId) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypes_TargetId) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TargetId != nil {
+		size, err := m.TargetId.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypes_TargetPort) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypes_TargetPort) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.TargetPort))
+	i--
+	dAtA[i] = 0x5d
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypesPlain) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomTypesPlain) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypesPlain) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if msg, ok := m.Target.(*CustomTypesPlain_TargetPort); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Target.(*CustomTypesPlain_TargetId); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Timeout != 0 {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.Timeout)
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Ports) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Ports)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Discount != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Discount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Price != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.Aliases[iNdEx])
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Host) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Host)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Members[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Parent != nil {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Parent)
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Id)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomTypesPlain_TargetId) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypesPlain_TargetId) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeBytes(dAtA, i, m.TargetId)
+	i--
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *CustomTypesPlain_TargetPort) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CustomTypesPlain_TargetPort) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.TargetPort))
+	i--
+	dAtA[i] = 0x5d
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *CustomTypes) MergeVT(src *CustomTypes) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Id != nil {
+		m.Id = protobuf_go_lite.CloneCustom(src.Id)
+	}
+	if src.Parent != nil {
+		m.Parent = protobuf_go_lite.CloneCustom(src.Parent)
+	}
+	m.Members = append(m.Members, protobuf_go_lite.CloneCustomSlice(src.Members)...)
+	if src.Host != nil {
+		m.Host = protobuf_go_lite.CloneCustom(src.Host)
+	}
+	m.Aliases = append(m.Aliases, protobuf_go_lite.CloneCustomSlice(src.Aliases)...)
+	if src.Price != 0 {
+		m.Price = src.Price
+	}
+	if src.Discount != nil {
+		v := *src.Discount
+		m.Discount = &v
+	}
+	m.Ports = append(m.Ports, src.Ports...)
+	if src.Timeout != 0 {
+		m.Timeout = src.Timeout
+	}
+	switch v := src.Target.(type) {
+	case *CustomTypes_TargetId:
+		m.Target = &CustomTypes_TargetId{TargetId: protobuf_go_lite.CloneCustom(v.TargetId)}
+	case *CustomTypes_TargetPort:
+		m.Target = &CustomTypes_TargetPort{TargetPort: v.TargetPort}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *CustomTypes) MergeMessageVT(src any) bool {
+	s, ok := src.(*CustomTypes)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *CustomTypesPlain) MergeVT(src *CustomTypesPlain) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.Id) > 0 {
+		m.Id = slices.Clone(src.Id)
+	}
+	if src.Parent != nil {
+		m.Parent = slices.Clone(src.Parent)
+	}
+	for _, v := range src.Members {
+		m.Members = append(m.Members, slices.Clone(v))
+	}
+	if src.Host != "" {
+		m.Host = src.Host
+	}
+	m.Aliases = append(m.Aliases, src.Aliases...)
+	if src.Price != 0 {
+		m.Price = src.Price
+	}
+	if src.Discount != nil {
+		v := *src.Discount
+		m.Discount = &v
+	}
+	m.Ports = append(m.Ports, src.Ports...)
+	if src.Timeout != 0 {
+		m.Timeout = src.Timeout
+	}
+	switch v := src.Target.(type) {
+	case *CustomTypesPlain_TargetId:
+		m.Target = &CustomTypesPlain_TargetId{TargetId: slices.Clone(v.TargetId)}
+	case *CustomTypesPlain_TargetPort:
+		m.Target = &CustomTypesPlain_TargetPort{TargetPort: v.TargetPort}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *CustomTypesPlain) MergeMessageVT(src any) bool {
+	s, ok := src.(*CustomTypesPlain)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *CustomTypes) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *CustomTypesPlain) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+func (m *CustomTypes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Parent != nil {
+		l = m.Parent.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for iNdEx := range m.Members {
+		l = m.Members[iNdEx].SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Host != nil {
+		l = m.Host.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for iNdEx := range m.Aliases {
+		l = m.Aliases[iNdEx].SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Price)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Discount)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.Ports)
+	n += protobuf_go_lite.SizeZigzagNonZero(1, m.Timeout)
+	if vtmsg, ok := m.Target.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *CustomTypes_TargetId) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetId != nil {
+		l = m.TargetId.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	} else {
+		n += 2
+	}
+	return n
+}
+func (m *CustomTypes_TargetPort) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed32Value(1)
+	return n
+}
+func (m *CustomTypesPlain) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeBytesNonEmpty(1, m.Id)
+	n += protobuf_go_lite.SizeBytesPresent(1, m.Parent)
+	n += protobuf_go_lite.SizeBytesSlice(1, m.Members)
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.Host)
+	n += protobuf_go_lite.SizeStringSlice(1, m.Aliases)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Price)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Discount)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.Ports)
+	n += protobuf_go_lite.SizeZigzagNonZero(1, m.Timeout)
+	if vtmsg, ok := m.Target.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *CustomTypesPlain_TargetId) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeBytesValue(1, len(m.TargetId))
+	return n
+}
+func (m *CustomTypesPlain_TargetPort) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed32Value(1)
+	return n
+}
+func (x *CustomTypes) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "CustomTypes")
+	if x.Id != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "id")
+		protobuf_go_lite.TextWriteCustom(&sb, x.Id, false)
+	}
+	if x.Parent != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "parent")
+		protobuf_go_lite.TextWriteCustom(&sb, x.Parent, false)
+	}
+	if len(x.Members) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "members")
+		for i, v := range x.Members {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteCustom(&sb, &v, false)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Host != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "host")
+		protobuf_go_lite.TextWriteCustom(&sb, x.Host, true)
+	}
+	if len(x.Aliases) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "aliases")
+		for i, v := range x.Aliases {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteCustom(&sb, &v, true)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Price != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "price")
+		protobuf_go_lite.TextWriteInt(&sb, x.Price)
+	}
+	if x.Discount != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "discount")
+		protobuf_go_lite.TextWriteInt(&sb, *x.Discount)
+	}
+	if len(x.Ports) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "ports")
+		for i, v := range x.Ports {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Timeout != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "timeout")
+		protobuf_go_lite.TextWriteInt(&sb, x.Timeout)
+	}
+	switch body := x.Target.(type) {
+	case *CustomTypes_TargetId:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "target_id")
+		protobuf_go_lite.TextWriteCustom(&sb, body.TargetId, false)
+	case *CustomTypes_TargetPort:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "target_port")
+		protobuf_go_lite.TextWriteUint(&sb, body.TargetPort)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *CustomTypes) String() string {
+	return x.MarshalProtoText()
+}
+func (x *CustomTypesPlain) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "CustomTypesPlain")
+	if len(x.Id) != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "id")
+		protobuf_go_lite.TextWriteBytes(&sb, x.Id)
+	}
+	if x.Parent != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "parent")
+		protobuf_go_lite.TextWriteBytes(&sb, x.Parent)
+	}
+	if len(x.Members) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "members")
+		for i, v := range x.Members {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteBytes(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Host != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "host")
+		protobuf_go_lite.TextWriteString(&sb, x.Host)
+	}
+	if len(x.Aliases) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "aliases")
+		for i, v := range x.Aliases {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteString(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Price != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "price")
+		protobuf_go_lite.TextWriteInt(&sb, x.Price)
+	}
+	if x.Discount != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "discount")
+		protobuf_go_lite.TextWriteInt(&sb, *x.Discount)
+	}
+	if len(x.Ports) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "ports")
+		for i, v := range x.Ports {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.Timeout != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "timeout")
+		protobuf_go_lite.TextWriteInt(&sb, x.Timeout)
+	}
+	switch body := x.Target.(type) {
+	case *CustomTypesPlain_TargetId:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "target_id")
+		protobuf_go_lite.TextWriteBytes(&sb, body.TargetId)
+	case *CustomTypesPlain_TargetPort:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "target_port")
+		protobuf_go_lite.TextWriteUint(&sb, body.TargetPort)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *CustomTypesPlain) String() string {
+	return x.MarshalProtoText()
+}
+func (m *CustomTypes) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Parent = v
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, UUID{})
+			if err := m.Members[len(m.Members)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(Hostname)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Host = v
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, Hostname{})
+			if err := m.Aliases[len(m.Aliases)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Price = Cents(_v)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v Port
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Port(_v)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]Port, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Port
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Port(_v)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v time.Duration
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = time.Duration(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &CustomTypes_TargetId{TargetId: v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v Port
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = Port(_v32)
+			m.Target = &CustomTypes_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomTypesPlain) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypesPlain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypesPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Id, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Parent, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, v)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Host = v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			m.Price, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Target = &CustomTypesPlain_TargetId{TargetId: v}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Target = &CustomTypesPlain_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CustomTypes) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Parent = v
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, UUID{})
+			if err := m.Members[len(m.Members)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(Hostname)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Host = v
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, Hostname{})
+			if err := m.Aliases[len(m.Aliases)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Price = Cents(_v)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v Port
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Port(_v)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]Port, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Port
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Port(_v)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v time.Duration
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = time.Duration(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &CustomTypes_TargetId{TargetId: v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v Port
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = Port(_v32)
+			m.Target = &CustomTypes_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CustomTypesPlain) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypesPlain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypesPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Id, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Parent, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, v)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Host = v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			m.Price, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Target = &CustomTypesPlain_TargetId{TargetId: v}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Target = &CustomTypesPlain_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CustomTypes) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Parent = v
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, UUID{})
+			if err := m.Members[len(m.Members)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(Hostname)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Host = v
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, Hostname{})
+			if err := m.Aliases[len(m.Aliases)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Price = Cents(_v)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v Port
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Port(_v)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]Port, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Port
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Port(_v)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v time.Duration
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = time.Duration(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &CustomTypes_TargetId{TargetId: v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v Port
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = Port(_v32)
+			m.Target = &CustomTypes_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CustomTypesPlain) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypesPlain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypesPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, v)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Host = v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			m.Price, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+			m.Target = &CustomTypesPlain_TargetId{TargetId: v}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Target = &CustomTypesPlain_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CustomTypes) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Parent = v
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, UUID{})
+			if err := m.Members[len(m.Members)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(Hostname)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Host = v
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, Hostname{})
+			if err := m.Aliases[len(m.Aliases)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Price = Cents(_v)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v Port
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Port(_v)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]Port, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Port
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Port(_v)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v time.Duration
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = time.Duration(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &CustomTypes_TargetId{TargetId: v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v Port
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = Port(_v32)
+			m.Target = &CustomTypes_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomTypesPlain) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTypesPlain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTypesPlain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+			m.Members = append(m.Members, v)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Host = v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Aliases = append(m.Aliases, v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			m.Price, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Discount = &v
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Timeout = v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+			m.Target = &CustomTypesPlain_TargetId{TargetId: v}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Target = &CustomTypesPlain_TargetPort{TargetPort: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/customtype/customtype2.proto
+
+package customtype
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	slices "slices"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+)
+
+// CastDefaults holds named Go type fields with explicit defaults.
+type CastDefaults struct {
+	unknownFields []byte
+	// protobuf-go-lite:casttype=Cents
+	Price *Cents `protobuf:"varint,1,opt,name=price,def=250" json:"price,omitempty"`
+	// protobuf-go-lite:casttype=Port
+	Port *Port `protobuf:"varint,2,opt,name=port,def=8080" json:"port,omitempty"`
+	// protobuf-go-lite:customtype=UUID
+	Id *UUID `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+}
+
+// Default values for CastDefaults fields.
+const (
+	Default_CastDefaults_Price = Cents(250)
+	Default_CastDefaults_Port  = Port(8080)
+)
+
+func (x *CastDefaults) Reset() {
+	*x = CastDefaults{}
+}
+
+func (*CastDefaults) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CastDefaults) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CastDefaults) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CastDefaults) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *CastDefaults) GetPrice() Cents {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return Default_CastDefaults_Price
+}
+
+func (x *CastDefaults) GetPort() Port {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return Default_CastDefaults_Port
+}
+
+func (x *CastDefaults) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (m *CastDefaults) CloneVT() *CastDefaults {
+	if m == nil {
+		return (*CastDefaults)(nil)
+	}
+	r := new(CastDefaults)
+	r.Price = protobuf_go_lite.ClonePtr(m.Price)
+	r.Port = protobuf_go_lite.ClonePtr(m.Port)
+	r.Id = protobuf_go_lite.CloneCustom(m.Id)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *CastDefaults) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *CastDefaults) CompareVT(that *CastDefaults) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Price, that.Price); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Port, that.Port); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareCustom(m.Id, that.Id); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *CastDefaults) CopyVT(dst *CastDefaults) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Price = protobuf_go_lite.CopyPtr(dst.Price, m.Price)
+	dst.Port = protobuf_go_lite.CopyPtr(dst.Port, m.Port)
+	dst.Id = protobuf_go_lite.CopyCustom(dst.Id, m.Id)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *CastDefaults) DiffVT(that *CastDefaults) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *CastDefaults) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *CastDefaults) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &CastDefaults{}
+	}
+	if that == nil {
+		that = &CastDefaults{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "price", m.Price, that.Price)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "port", m.Port, that.Port)
+	diffs = protobuf_go_lite.AppendDiffCustom(diffs, prefix, "id", m.Id, that.Id)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *CastDefaults) EqualVT(that *CastDefaults) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Price, that.Price) {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Port, that.Port) {
+		return false
+	}
+	if !protobuf_go_lite.EqualCustom(this.Id, that.Id) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *CastDefaults) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*CastDefaults)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *CastDefaults) EqualVTOpts(that *CastDefaults, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *CastDefaults) EqualVTOptsPrefix(that *CastDefaults, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &CastDefaults{}
+		}
+		if that == nil {
+			that = &CastDefaults{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "price"); ok && ((this.Price == nil) != (that.Price == nil) || this.Price != nil && *this.Price != *that.Price) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "port"); ok && ((this.Port == nil) != (that.Port == nil) || this.Port != nil && *this.Port != *that.Port) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "id"); ok && !protobuf_go_lite.EqualCustom(this.Id, that.Id) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *CastDefaults) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *CastDefaults) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *CastDefaults) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Price != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.Price))
+		}
+		if m.Port != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.Port))
+		}
+		if v := m.Id; v != nil {
+			w.Field(3)
+			protobuf_go_lite.HashCustom(w, v)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// NOTE: protobuf-go-lite json only supports proto3 and editions: proto2 is not supported.
+
+func (m *CastDefaults) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CastDefaults) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *CastDefaults) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.Id != nil {
+		size, err := m.Id.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Port != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CastDefaults) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CastDefaults) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *CastDefaults) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.Id != nil {
+		size, err := m.Id.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Port != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Price))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *CastDefaults) MergeVT(src *CastDefaults) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Price != nil {
+		v := *src.Price
+		m.Price = &v
+	}
+	if src.Port != nil {
+		v := *src.Port
+		m.Port = &v
+	}
+	if src.Id != nil {
+		m.Id = protobuf_go_lite.CloneCustom(src.Id)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *CastDefaults) MergeMessageVT(src any) bool {
+	s, ok := src.(*CastDefaults)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *CastDefaults) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+func (m *CastDefaults) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Price)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Port)
+	if m.Id != nil {
+		l = m.Id.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (x *CastDefaults) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "CastDefaults")
+	if x.Price != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "price")
+		protobuf_go_lite.TextWriteInt(&sb, *x.Price)
+	}
+	if x.Port != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "port")
+		protobuf_go_lite.TextWriteUint(&sb, *x.Port)
+	}
+	if x.Id != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "id")
+		protobuf_go_lite.TextWriteCustom(&sb, x.Id, false)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *CastDefaults) String() string {
+	return x.MarshalProtoText()
+}
+func (m *CastDefaults) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CastDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CastDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Price = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var v Port
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Port(_v)
+			if err != nil {
+				return err
+			}
+			m.Port = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CastDefaults) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CastDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CastDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Price = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var v Port
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Port(_v)
+			if err != nil {
+				return err
+			}
+			m.Port = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *CastDefaults) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CastDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CastDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Price = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var v Port
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Port(_v)
+			if err != nil {
+				return err
+			}
+			m.Port = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CastDefaults) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CastDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CastDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v Cents
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Cents(_v)
+			if err != nil {
+				return err
+			}
+			m.Price = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var v Port
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Port(_v)
+			if err != nil {
+				return err
+			}
+			m.Port = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v := new(UUID)
+			if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			m.Id = v
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package customtype
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// newCustomTypes returns a CustomTypes with every field set and its twin
+// using the plain Go types.
+func newCustomTypes() (*CustomTypes, *CustomTypesPlain) {
+	id := UUID{0: 1, 15: 2}
+	parent := UUID{3: 4}
+	custom := &CustomTypes{
+		Id:       &id,
+		Parent:   &parent,
+		Members:  []UUID{{}, {1}},
+		Host:     &Hostname{Labels: []string{"example", "com"}},
+		Aliases:  []Hostname{{Labels: []string{"a"}}, {}},
+		Price:    Cents(1999),
+		Discount: ptr(Cents(0)),
+		Ports:    []Port{80, 443},
+		Timeout:  -time.Second,
+		Target:   &CustomTypes_TargetId{TargetId: &UUID{9: 9}},
+	}
+	plain := &CustomTypesPlain{
+		Id:       id[:],
+		Parent:   parent[:],
+		Members:  [][]byte{make([]byte, 16), append([]byte{1}, make([]byte, 15)...)},
+		Host:     "example.com",
+		Aliases:  []string{"a", ""},
+		Price:    1999,
+		Discount: ptr(int64(0)),
+		Ports:    []uint32{80, 443},
+		Timeout:  int64(-time.Second),
+		Target:   &CustomTypesPlain_TargetId{TargetId: UUID{9: 9}.bytes()},
+	}
+	return custom, plain
+}
+
+func (u UUID) bytes() []byte {
+	return u[:]
+}
+
+func TestCustomTypesWire(t *testing.T) {
+	custom, plain := newCustomTypes()
+
+	out, err := custom.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := plain.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+	if got := custom.SizeVT(); got != len(out) {
+		t.Fatalf("SizeVT() = %d, want %d", got, len(out))
+	}
+
+	var decoded CustomTypes
+	if err := decoded.UnmarshalVT(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(custom) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, custom)
+	}
+
+	// The custom type rejects bytes it cannot decode.
+	bad, _ := (&CustomTypesPlain{Id: []byte{1, 2, 3}}).MarshalVT()
+	if err := decoded.UnmarshalVT(bad); err == nil {
+		t.Fatal("UnmarshalVT() accepted a 3 byte UUID")
+	}
+}
+
+func TestCustomTypesOneof(t *testing.T) {
+	for _, tc := range []struct {
+		custom isCustomTypes_Target
+		plain  isCustomTypesPlain_Target
+	}{
+		{&CustomTypes_TargetId{TargetId: &UUID{1: 1}}, &CustomTypesPlain_TargetId{TargetId: UUID{1: 1}.bytes()}},
+		{&CustomTypes_TargetPort{TargetPort: 8080}, &CustomTypesPlain_TargetPort{TargetPort: 8080}},
+	} {
+		custom, plain := &CustomTypes{Target: tc.custom}, &CustomTypesPlain{Target: tc.plain}
+		out, err := custom.MarshalVT()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := plain.MarshalVT()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, want) {
+			t.Fatalf("MarshalVT(%T) = %x, want %x", tc.custom, out, want)
+		}
+		var decoded CustomTypes
+		if err := decoded.UnmarshalVT(want); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.EqualVT(custom) {
+			t.Fatalf("UnmarshalVT(%T) = %v, want %v", tc.custom, &decoded, custom)
+		}
+	}
+}
+
+func TestCustomTypesCloneEqual(t *testing.T) {
+	custom, _ := newCustomTypes()
+
+	clone := custom.CloneVT()
+	if !clone.EqualVT(custom) {
+		t.Fatalf("CloneVT() = %v, want %v", clone, custom)
+	}
+	clone.Id[0] = 7
+	clone.Host.Labels[0] = "other"
+	clone.Aliases[0].Labels[0] = "b"
+	clone.Target.(*CustomTypes_TargetId).TargetId[0] = 1
+	if custom.Id[0] != 1 || custom.Host.String() != "example.com" || custom.Aliases[0].Labels[0] != "a" {
+		t.Fatal("CloneVT() shares custom type values")
+	}
+	if custom.Target.(*CustomTypes_TargetId).TargetId[0] != 0 {
+		t.Fatal("CloneVT() shares the oneof custom type value")
+	}
+	if clone.EqualVT(custom) {
+		t.Fatal("EqualVT() = true after changing the clone")
+	}
+	if c := clone.CompareVT(custom); c == 0 {
+		t.Fatal("CompareVT() = 0 after changing the clone")
+	}
+	if len(clone.DiffVT(custom)) != 4 {
+		t.Fatalf("DiffVT() = %v, want 4 differences", clone.DiffVT(custom))
+	}
+}
+
+func TestCustomTypesMerge(t *testing.T) {
+	dst := &CustomTypes{
+		Id:      &UUID{1},
+		Host:    &Hostname{Labels: []string{"old"}},
+		Members: []UUID{{1}},
+		Price:   5,
+	}
+	src := &CustomTypes{
+		Host:     &Hostname{Labels: []string{"new"}},
+		Members:  []UUID{{2}},
+		Discount: ptr(Cents(3)),
+	}
+	dst.MergeVT(src)
+
+	if *dst.Id != (UUID{1}) || dst.Host.String() != "new" || dst.Price != 5 || *dst.Discount != 3 {
+		t.Fatalf("MergeVT() = %v", dst)
+	}
+	if len(dst.Members) != 2 || dst.Members[1] != (UUID{2}) {
+		t.Fatalf("Members = %v, want two UUIDs", dst.Members)
+	}
+	src.Host.Labels[0] = "changed"
+	if dst.Host.String() != "new" {
+		t.Fatal("MergeVT() shares the custom type value of src")
+	}
+}
+
+func TestCustomTypesJSON(t *testing.T) {
+	custom, plain := newCustomTypes()
+
+	out, err := custom.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := plain.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(want) {
+		t.Fatalf("MarshalJSON() = %s, want %s", out, want)
+	}
+
+	var decoded CustomTypes
+	if err := decoded.UnmarshalJSON(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(custom) {
+		t.Fatalf("UnmarshalJSON(%s) = %v, want %v", want, &decoded, custom)
+	}
+
+	if err := decoded.UnmarshalJSON([]byte(`{"id":"AQID"}`)); err == nil || !strings.Contains(err.Error(), "UUID") {
+		t.Fatalf("UnmarshalJSON() error = %v, want the UUID error", err)
+	}
+}
+
+func TestCustomTypesText(t *testing.T) {
+	custom, plain := newCustomTypes()
+
+	got := strings.TrimPrefix(custom.MarshalProtoText(), "CustomTypes ")
+	want := strings.TrimPrefix(plain.MarshalProtoText(), "CustomTypesPlain ")
+	if got != want {
+		t.Fatalf("MarshalProtoText() = %s, want %s", got, want)
+	}
+}
+
+func TestCastDefaults(t *testing.T) {
+	var m CastDefaults
+	if m.GetPrice() != 250 || m.GetPort() != 8080 || m.GetId() != nil {
+		t.Fatalf("getters = %v, %v, %v, want the defaults", m.GetPrice(), m.GetPort(), m.GetId())
+	}
+}
@@ -0,0 +1,86 @@
+package customtype
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// UUID is a custom type encoded as 16 bytes.
+type UUID [16]byte
+
+// SizeVT returns the size of the encoded UUID.
+func (u *UUID) SizeVT() int {
+	return len(u)
+}
+
+// MarshalToSizedBufferVT writes the UUID to the end of dAtA.
+func (u *UUID) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	return copy(dAtA[len(dAtA)-len(u):], u[:]), nil
+}
+
+// UnmarshalVT decodes the UUID from dAtA.
+func (u *UUID) UnmarshalVT(dAtA []byte) error {
+	if len(dAtA) != len(u) {
+		return errors.New("invalid UUID length")
+	}
+	copy(u[:], dAtA)
+	return nil
+}
+
+// EqualVT reports whether u and other are equal.
+func (u *UUID) EqualVT(other *UUID) bool {
+	return *u == *other
+}
+
+// CloneVT returns a copy of u.
+func (u *UUID) CloneVT() *UUID {
+	c := *u
+	return &c
+}
+
+// Hostname is a custom type encoded as its dot separated labels.
+type Hostname struct {
+	Labels []string
+}
+
+// SizeVT returns the size of the encoded Hostname.
+func (h *Hostname) SizeVT() int {
+	return len(h.String())
+}
+
+// MarshalToSizedBufferVT writes the Hostname to the end of dAtA.
+func (h *Hostname) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	s := h.String()
+	return copy(dAtA[len(dAtA)-len(s):], s), nil
+}
+
+// UnmarshalVT decodes the Hostname from dAtA.
+func (h *Hostname) UnmarshalVT(dAtA []byte) error {
+	h.Labels = nil
+	if len(dAtA) != 0 {
+		h.Labels = strings.Split(string(dAtA), ".")
+	}
+	return nil
+}
+
+// EqualVT reports whether h and other are equal.
+func (h *Hostname) EqualVT(other *Hostname) bool {
+	return slices.Equal(h.Labels, other.Labels)
+}
+
+// CloneVT returns a deep copy of h.
+func (h *Hostname) CloneVT() *Hostname {
+	return &Hostname{Labels: slices.Clone(h.Labels)}
+}
+
+// String returns the dot separated labels of h.
+func (h *Hostname) String() string {
+	return strings.Join(h.Labels, ".")
+}
+
+// Cents is an amount of money used as a named Go type.
+type Cents int64
+
+// Port is a network port used as a named Go type.
+type Port uint32
@@ -0,0 +1,122 @@
+package diff
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+)
+
+// diffPaths returns the paths of diffs.
+func diffPaths(diffs []protobuf_go_lite.FieldDiff) []string {
+	paths := make([]string, len(diffs))
+	for i, d := range diffs {
+		paths[i] = d.Path
+	}
+	return paths
+}
+
+func checkPaths(t *testing.T, a, b *Tree, want ...string) []protobuf_go_lite.FieldDiff {
+	t.Helper()
+	diffs := a.DiffVT(b)
+	if got := diffPaths(diffs); !slices.Equal(got, want) {
+		t.Fatalf("DiffVT paths are %q, want %q", got, want)
+	}
+	return diffs
+}
+
+func TestDiffVTNilAndEmpty(t *testing.T) {
+	var nilTree *Tree
+	checkPaths(t, nilTree, &Tree{})
+	checkPaths(t, &Tree{}, nilTree)
+	checkPaths(t, nilTree, nilTree)
+
+	// Empty repeated fields, maps and bytes equal nil ones.
+	checkPaths(t, &Tree{}, &Tree{
+		Data:   []byte{},
+		Ids:    []int32{},
+		Leaves: []*Leaf{},
+		ByName: map[string]*Leaf{},
+		Labels: map[int32]string{},
+	})
+
+	// An empty sub-message is set, unlike a nil one.
+	diffs := checkPaths(t, &Tree{}, &Tree{Leaf: &Leaf{}}, "leaf")
+	if diffs[0].Old != nil || diffs[0].New == nil {
+		t.Fatalf("diff of an added sub-message is %v", diffs[0])
+	}
+	level := int32(0)
+	diffs = checkPaths(t, &Tree{Level: &level}, &Tree{}, "level")
+	if got := diffs[0].String(); got != "level: 0 -> <unset>" {
+		t.Fatalf("diff of a cleared optional field is %q", got)
+	}
+}
+
+func TestDiffVTOneofSwitch(t *testing.T) {
+	text := &Tree{Choice: &Tree_Text{Text: "a"}}
+	node := &Tree{Choice: &Tree_Node{Node: &Leaf{Name: "n"}}}
+	diffs := checkPaths(t, text, node, "text", "node")
+	if got := diffs[0].String(); got != `text: "a" -> <unset>` {
+		t.Fatalf("diff of the old oneof case is %q", got)
+	}
+	if diffs[1].Old != nil || diffs[1].New == nil {
+		t.Fatalf("diff of the new oneof case is %v", diffs[1])
+	}
+
+	// The same case compares the values, an empty text being set.
+	checkPaths(t, node, &Tree{Choice: &Tree_Node{Node: &Leaf{Name: "m"}}}, "node.name")
+	checkPaths(t, &Tree{Choice: &Tree_Text{}}, &Tree{}, "text")
+}
+
+func TestDiffVTMapOrder(t *testing.T) {
+	a := &Tree{
+		ByName: map[string]*Leaf{"b": {Count: 1}, "a": {}, "c": {}},
+		Labels: map[int32]string{10: "ten", 2: "two", -1: "minus"},
+	}
+	b := &Tree{
+		ByName: map[string]*Leaf{"b": {Count: 2}, "d": {}},
+		Labels: map[int32]string{10: "TEN", 2: "TWO", -1: "MINUS", 3: "three"},
+	}
+	want := []string{
+		`by_name["a"]`, `by_name["b"].count`, `by_name["c"]`, `by_name["d"]`,
+		"labels[-1]", "labels[2]", "labels[3]", "labels[10]",
+	}
+	// Map iteration order is random, so the order of the paths must not
+	// depend on it.
+	for range 20 {
+		checkPaths(t, a, b, want...)
+	}
+}
+
+func TestDiffVTRepeated(t *testing.T) {
+	a := &Tree{Ids: []int32{1, 2}, Leaves: []*Leaf{{Name: "x"}, nil}}
+	b := &Tree{Ids: []int32{1, 3, 4}, Leaves: []*Leaf{{Name: "y"}}}
+	diffs := checkPaths(t, a, b, "ids[1]", "ids[2]", "leaves[0].name", "leaves[1]")
+	if got := diffs[1].String(); got != "ids[2]: <unset> -> 4" {
+		t.Fatalf("diff of an added element is %q", got)
+	}
+	// A nil element is reported like an unset sub-message.
+	checkPaths(t, &Tree{Leaves: []*Leaf{nil}}, &Tree{Leaves: []*Leaf{{}}}, "leaves[0]")
+}
+
+func TestDiffVTMatchesEqualVT(t *testing.T) {
+	nan := math.NaN()
+	for name, pair := range map[string][2]*Tree{
+		"equal":     {{Name: "a", Weight: 1}, {Name: "a", Weight: 1}},
+		"zero sign": {{Weight: math.Copysign(0, -1)}, {}},
+		"nan":       {{Weight: nan}, {Weight: nan}},
+		"nan value": {{Weight: nan}, {Weight: 1}},
+		"leaf":      {{Leaf: &Leaf{}}, {}},
+		"oneof":     {{Choice: &Tree_Text{}}, {Choice: &Tree_Node{}}},
+		"map":       {{Labels: map[int32]string{1: ""}}, {}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b := pair[0], pair[1]
+			diffs := a.DiffVT(b)
+			if (len(diffs) == 0) != a.EqualVT(b) {
+				t.Fatalf("DiffVT gave %v while EqualVT is %v", diffs, a.EqualVT(b))
+			}
+		})
+	}
+}
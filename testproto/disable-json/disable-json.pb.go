@@ -5,7 +5,11 @@
 package disable_json
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	slices "slices"
 
@@ -28,6 +32,29 @@ func (x *MessageDisableJson) Reset() {
 
 func (*MessageDisableJson) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *MessageDisableJson) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *MessageDisableJson) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *MessageDisableJson) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (m *MessageDisableJson) GetBody() isMessageDisableJson_Body {
 	if m != nil {
 		return m.Body
@@ -111,6 +138,121 @@ func (m *MessageDisableJson_World) CloneOneofVT() isMessageDisableJson_Body {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *MessageDisableJson) CompareVT(that *MessageDisableJson) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	{
+		a, aok := m.Body.(*MessageDisableJson_Hello)
+		b, bok := that.Body.(*MessageDisableJson_Hello)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareBool(a.Hello, b.Hello); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Body.(*MessageDisableJson_World)
+		b, bok := that.Body.(*MessageDisableJson_World)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.World, b.World); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *MessageDisableJson) CopyVT(dst *MessageDisableJson) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch v := m.Body.(type) {
+	case nil:
+		dst.Body = nil
+	case *MessageDisableJson_Hello:
+		d, ok := dst.Body.(*MessageDisableJson_Hello)
+		if !ok {
+			d = &MessageDisableJson_Hello{}
+			dst.Body = d
+		}
+		d.Hello = v.Hello
+	case *MessageDisableJson_World:
+		d, ok := dst.Body.(*MessageDisableJson_World)
+		if !ok {
+			d = &MessageDisableJson_World{}
+			dst.Body = d
+		}
+		d.World = v.World
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *MessageDisableJson) DiffVT(that *MessageDisableJson) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *MessageDisableJson) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *MessageDisableJson) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &MessageDisableJson{}
+	}
+	if that == nil {
+		that = &MessageDisableJson{}
+	}
+	{
+		var a, b *bool
+		if v, ok := m.Body.(*MessageDisableJson_Hello); ok {
+			a = &v.Hello
+		}
+		if v, ok := that.Body.(*MessageDisableJson_Hello); ok {
+			b = &v.Hello
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "hello", a, b)
+	}
+	{
+		var a, b *string
+		if v, ok := m.Body.(*MessageDisableJson_World); ok {
+			a = &v.World
+		}
+		if v, ok := that.Body.(*MessageDisableJson_World); ok {
+			b = &v.World
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "world", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *MessageDisableJson) EqualVT(that *MessageDisableJson) bool {
 	if this == that {
 		return true
@@ -173,6 +315,78 @@ func (this *MessageDisableJson_World) EqualVT(thatIface isMessageDisableJson_Bod
 	return true
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *MessageDisableJson) EqualVTOpts(that *MessageDisableJson, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *MessageDisableJson) EqualVTOptsPrefix(that *MessageDisableJson, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &MessageDisableJson{}
+		}
+		if that == nil {
+			that = &MessageDisableJson{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "hello"); ok {
+		a, aok := this.Body.(*MessageDisableJson_Hello)
+		b, bok := that.Body.(*MessageDisableJson_Hello)
+		if aok != bok || aok && a.Hello != b.Hello {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "world"); ok {
+		a, aok := this.Body.(*MessageDisableJson_World)
+		b, bok := that.Body.(*MessageDisableJson_World)
+		if aok != bok || aok && a.World != b.World {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *MessageDisableJson) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *MessageDisableJson) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *MessageDisableJson) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		switch v := m.Body.(type) {
+		case *MessageDisableJson_Hello:
+			w.Field(1)
+			w.Bool(v.Hello)
+		case *MessageDisableJson_World:
+			w.Field(2)
+			w.String(v.World)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 func (m *MessageDisableJson) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -308,6 +522,40 @@ func (m *MessageDisableJson_World) MarshalToSizedBufferVTStrict(dAtA []byte) (in
 	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *MessageDisableJson) MergeVT(src *MessageDisableJson) {
+	if m == nil || src == nil {
+		return
+	}
+	switch v := src.Body.(type) {
+	case *MessageDisableJson_Hello:
+		m.Body = &MessageDisableJson_Hello{Hello: v.Hello}
+	case *MessageDisableJson_World:
+		m.Body = &MessageDisableJson_World{World: v.World}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MessageDisableJson) MergeMessageVT(src any) bool {
+	s, ok := src.(*MessageDisableJson)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *MessageDisableJson) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *MessageDisableJson) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -419,6 +667,155 @@ func (m *MessageDisableJson) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *MessageDisableJson) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageDisableJson: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageDisableJson: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hello", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.Body = &MessageDisableJson_Hello{Hello: b}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field World", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Body = &MessageDisableJson_World{World: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *MessageDisableJson) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageDisableJson: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageDisableJson: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hello", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.Body = &MessageDisableJson_Hello{Hello: b}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field World", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Body = &MessageDisableJson_World{World: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *MessageDisableJson) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,8 +5,13 @@
 package echo
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	iter "iter"
 	slices "slices"
 	strconv "strconv"
 
@@ -72,6 +77,33 @@ func (x *EchoMsg) Reset() {
 
 func (*EchoMsg) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EchoMsg) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EchoMsg) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EchoMsg) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Ts.DiscardUnknownVT()
+	for _, v := range x.Timestamps {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *EchoMsg) GetBody() string {
 	if x != nil {
 		return x.Body
@@ -177,6 +209,136 @@ func (m *EchoMsg_ExampleString) CloneOneofVT() isEchoMsg_Demo {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *EchoMsg) CompareVT(that *EchoMsg) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Body, that.Body); c != 0 {
+		return c
+	}
+	if c := m.Ts.CompareVT(that.Ts); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Demo.(*EchoMsg_ExampleEnum)
+		b, bok := that.Demo.(*EchoMsg_ExampleEnum)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ExampleEnum, b.ExampleEnum); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Demo.(*EchoMsg_ExampleString)
+		b, bok := that.Demo.(*EchoMsg_ExampleString)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ExampleString, b.ExampleString); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Timestamps, that.Timestamps, (*timestamppb.Timestamp).CompareVT); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *EchoMsg) CopyVT(dst *EchoMsg) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Body = m.Body
+	dst.Ts = protobuf_go_lite.CopyVTValue(dst.Ts, m.Ts, (*timestamppb.Timestamp).CopyVT)
+	switch v := m.Demo.(type) {
+	case nil:
+		dst.Demo = nil
+	case *EchoMsg_ExampleEnum:
+		d, ok := dst.Demo.(*EchoMsg_ExampleEnum)
+		if !ok {
+			d = &EchoMsg_ExampleEnum{}
+			dst.Demo = d
+		}
+		d.ExampleEnum = v.ExampleEnum
+	case *EchoMsg_ExampleString:
+		d, ok := dst.Demo.(*EchoMsg_ExampleString)
+		if !ok {
+			d = &EchoMsg_ExampleString{}
+			dst.Demo = d
+		}
+		d.ExampleString = v.ExampleString
+	}
+	dst.Timestamps = protobuf_go_lite.CopyVTSlice(dst.Timestamps, m.Timestamps, (*timestamppb.Timestamp).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *EchoMsg) DiffVT(that *EchoMsg) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *EchoMsg) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *EchoMsg) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &EchoMsg{}
+	}
+	if that == nil {
+		that = &EchoMsg{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "body", m.Body, that.Body)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "ts", m.Ts, that.Ts, (*timestamppb.Timestamp).AppendDiffVT)
+	{
+		var a, b *ExampleEnum
+		if v, ok := m.Demo.(*EchoMsg_ExampleEnum); ok {
+			a = &v.ExampleEnum
+		}
+		if v, ok := that.Demo.(*EchoMsg_ExampleEnum); ok {
+			b = &v.ExampleEnum
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "example_enum", a, b)
+	}
+	{
+		var a, b *string
+		if v, ok := m.Demo.(*EchoMsg_ExampleString); ok {
+			a = &v.ExampleString
+		}
+		if v, ok := that.Demo.(*EchoMsg_ExampleString); ok {
+			b = &v.ExampleString
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "example_string", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "timestamps", m.Timestamps, that.Timestamps, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *EchoMsg) EqualVT(that *EchoMsg) bool {
 	if this == that {
 		return true
@@ -246,6 +408,102 @@ func (this *EchoMsg_ExampleString) EqualVT(thatIface isEchoMsg_Demo) bool {
 	return true
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *EchoMsg) EqualVTOpts(that *EchoMsg, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *EchoMsg) EqualVTOptsPrefix(that *EchoMsg, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &EchoMsg{}
+		}
+		if that == nil {
+			that = &EchoMsg{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "body"); ok && this.Body != that.Body {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "ts"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Ts, that.Ts, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "example_enum"); ok {
+		a, aok := this.Demo.(*EchoMsg_ExampleEnum)
+		b, bok := that.Demo.(*EchoMsg_ExampleEnum)
+		if aok != bok || aok && a.ExampleEnum != b.ExampleEnum {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "example_string"); ok {
+		a, aok := this.Demo.(*EchoMsg_ExampleString)
+		b, bok := that.Demo.(*EchoMsg_ExampleString)
+		if aok != bok || aok && a.ExampleString != b.ExampleString {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamps"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Timestamps, that.Timestamps, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *EchoMsg) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *EchoMsg) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *EchoMsg) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Body != "" {
+			w.Field(1)
+			w.String(m.Body)
+		}
+		if v := m.Ts; v != nil {
+			w.Field(2)
+			v.WriteHashVT(w)
+		}
+		switch v := m.Demo.(type) {
+		case *EchoMsg_ExampleEnum:
+			w.Field(3)
+			w.Uint64(uint64(v.ExampleEnum))
+		case *EchoMsg_ExampleString:
+			w.Field(4)
+			w.String(v.ExampleString)
+		}
+		if len(m.Timestamps) != 0 {
+			w.Field(5)
+			w.Uint64(uint64(len(m.Timestamps)))
+			for _, v := range m.Timestamps {
+				v.WriteHashVT(w)
+			}
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 // MarshalProtoJSON marshals the ExampleEnum to JSON.
 func (x ExampleEnum) MarshalProtoJSON(s *json.MarshalState) {
 	s.WriteEnum(int32(x), ExampleEnum_name)
@@ -580,6 +838,61 @@ func (m *EchoMsg_ExampleString) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *EchoMsg) MergeVT(src *EchoMsg) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Body != "" {
+		m.Body = src.Body
+	}
+	if src.Ts != nil {
+		if m.Ts == nil {
+			m.Ts = new(timestamppb.Timestamp)
+		}
+		m.Ts.MergeVT(src.Ts)
+	}
+	switch v := src.Demo.(type) {
+	case *EchoMsg_ExampleEnum:
+		m.Demo = &EchoMsg_ExampleEnum{ExampleEnum: v.ExampleEnum}
+	case *EchoMsg_ExampleString:
+		m.Demo = &EchoMsg_ExampleString{ExampleString: v.ExampleString}
+	}
+	for _, v := range src.Timestamps {
+		var e *timestamppb.Timestamp
+		if v != nil {
+			e = new(timestamppb.Timestamp)
+			e.MergeVT(v)
+		}
+		m.Timestamps = append(m.Timestamps, e)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EchoMsg) MergeMessageVT(src any) bool {
+	s, ok := src.(*EchoMsg)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *EchoMsg) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.Ts.RedactVT()
+	for _, v := range m.Timestamps {
+		v.RedactVT()
+	}
+}
+
 func (m *EchoMsg) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -762,6 +1075,241 @@ func (m *EchoMsg) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeEchoMsgTimestampsVT iterates over the Timestamps elements encoded in dAtA, a serialized EchoMsg,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEchoMsgTimestampsVT(dAtA []byte) iter.Seq2[*timestamppb.Timestamp, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 5, (*timestamppb.Timestamp).UnmarshalVT)
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *EchoMsg) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EchoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EchoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Body = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Ts == nil {
+				m.Ts = &timestamppb.Timestamp{}
+			}
+			if err := m.Ts.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleEnum", wireType)
+			}
+			var v ExampleEnum
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = ExampleEnum(_v)
+			if err != nil {
+				return err
+			}
+			m.Demo = &EchoMsg_ExampleEnum{ExampleEnum: v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Demo = &EchoMsg_ExampleString{ExampleString: v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *EchoMsg) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EchoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EchoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Body = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Ts == nil {
+				m.Ts = &timestamppb.Timestamp{}
+			}
+			if err := m.Ts.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleEnum", wireType)
+			}
+			var v ExampleEnum
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = ExampleEnum(_v)
+			if err != nil {
+				return err
+			}
+			m.Demo = &EchoMsg_ExampleEnum{ExampleEnum: v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Demo = &EchoMsg_ExampleString{ExampleString: v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *EchoMsg) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,11 @@
 package editions2024
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	slices "slices"
 	strconv "strconv"
@@ -82,6 +86,34 @@ func (x *Edition2024Fixture) Reset() {
 
 func (*Edition2024Fixture) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Edition2024Fixture) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Edition2024Fixture) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Edition2024Fixture) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.NestedMessage.DiscardUnknownVT()
+	for _, v := range x.NestedMap {
+		v.DiscardUnknownVT()
+	}
+	x.DelimitedGroup.DiscardUnknownVT()
+}
+
 func (x *Edition2024Fixture) GetExplicitInt32() int32 {
 	if x != nil && x.ExplicitInt32 != nil {
 		return *x.ExplicitInt32
@@ -222,6 +254,29 @@ func (x *Edition2024Fixture_Nested) Reset() {
 
 func (*Edition2024Fixture_Nested) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Edition2024Fixture_Nested) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Edition2024Fixture_Nested) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Edition2024Fixture_Nested) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Edition2024Fixture_Nested) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -247,6 +302,29 @@ func (x *Edition2024Fixture_DelimitedGroup) Reset() {
 
 func (*Edition2024Fixture_DelimitedGroup) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Edition2024Fixture_DelimitedGroup) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Edition2024Fixture_DelimitedGroup) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Edition2024Fixture_DelimitedGroup) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Edition2024Fixture_DelimitedGroup) GetLabel() string {
 	if x != nil && x.Label != nil {
 		return *x.Label
@@ -266,6 +344,30 @@ func (x *Edition2024Fixture_NestedMapEntry) Reset() {
 
 func (*Edition2024Fixture_NestedMapEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Edition2024Fixture_NestedMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Edition2024Fixture_NestedMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Edition2024Fixture_NestedMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
 func (x *Edition2024Fixture_NestedMapEntry) GetKey() string {
 	if x != nil && x.Key != nil {
 		return *x.Key
@@ -372,6 +474,307 @@ func (m *Edition2024Fixture_ChoiceInt32) CloneOneofVT() isEdition2024Fixture_Cho
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Edition2024Fixture_Nested) CompareVT(that *Edition2024Fixture_Nested) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Value, that.Value); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Edition2024Fixture_DelimitedGroup) CompareVT(that *Edition2024Fixture_DelimitedGroup) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Label, that.Label); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Edition2024Fixture) CompareVT(that *Edition2024Fixture) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitInt32, that.ExplicitInt32); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.ImplicitInt32, that.ImplicitInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.RequiredInt32, that.RequiredInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitString, that.ExplicitString); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBytesPresent(m.ExplicitBytes, that.ExplicitBytes); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitState, that.ExplicitState); c != 0 {
+		return c
+	}
+	if c := m.NestedMessage.CompareVT(that.NestedMessage); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.PackedInt32, that.PackedInt32); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.ExpandedInt32, that.ExpandedInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.NestedMap, that.NestedMap, cmp.Compare[string], (*Edition2024Fixture_Nested).CompareVT); c != 0 {
+		return c
+	}
+	if c := m.DelimitedGroup.CompareVT(that.DelimitedGroup); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*Edition2024Fixture_ChoiceString)
+		b, bok := that.Choice.(*Edition2024Fixture_ChoiceString)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ChoiceString, b.ChoiceString); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*Edition2024Fixture_ChoiceInt32)
+		b, bok := that.Choice.(*Edition2024Fixture_ChoiceInt32)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ChoiceInt32, b.ChoiceInt32); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitDefaultInt32, that.ExplicitDefaultInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitDefaultString, that.ExplicitDefaultString); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Edition2024Fixture_Nested) CopyVT(dst *Edition2024Fixture_Nested) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Name = protobuf_go_lite.CopyPtr(dst.Name, m.Name)
+	dst.Value = m.Value
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Edition2024Fixture_DelimitedGroup) CopyVT(dst *Edition2024Fixture_DelimitedGroup) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Label = protobuf_go_lite.CopyPtr(dst.Label, m.Label)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Edition2024Fixture) CopyVT(dst *Edition2024Fixture) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.ExplicitInt32 = protobuf_go_lite.CopyPtr(dst.ExplicitInt32, m.ExplicitInt32)
+	dst.ImplicitInt32 = m.ImplicitInt32
+	dst.RequiredInt32 = protobuf_go_lite.CopyPtr(dst.RequiredInt32, m.RequiredInt32)
+	dst.ExplicitString = protobuf_go_lite.CopyPtr(dst.ExplicitString, m.ExplicitString)
+	dst.ExplicitBytes = protobuf_go_lite.CopyBytes(dst.ExplicitBytes, m.ExplicitBytes)
+	dst.ExplicitState = protobuf_go_lite.CopyPtr(dst.ExplicitState, m.ExplicitState)
+	dst.NestedMessage = protobuf_go_lite.CopyVTValue(dst.NestedMessage, m.NestedMessage, (*Edition2024Fixture_Nested).CopyVT)
+	dst.PackedInt32 = protobuf_go_lite.CopySlice(dst.PackedInt32, m.PackedInt32)
+	dst.ExpandedInt32 = protobuf_go_lite.CopySlice(dst.ExpandedInt32, m.ExpandedInt32)
+	dst.NestedMap = protobuf_go_lite.CopyVTMap(dst.NestedMap, m.NestedMap, (*Edition2024Fixture_Nested).CopyVT)
+	dst.DelimitedGroup = protobuf_go_lite.CopyVTValue(dst.DelimitedGroup, m.DelimitedGroup, (*Edition2024Fixture_DelimitedGroup).CopyVT)
+	dst.ExplicitDefaultInt32 = protobuf_go_lite.CopyPtr(dst.ExplicitDefaultInt32, m.ExplicitDefaultInt32)
+	dst.ExplicitDefaultString = protobuf_go_lite.CopyPtr(dst.ExplicitDefaultString, m.ExplicitDefaultString)
+	switch v := m.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *Edition2024Fixture_ChoiceString:
+		d, ok := dst.Choice.(*Edition2024Fixture_ChoiceString)
+		if !ok {
+			d = &Edition2024Fixture_ChoiceString{}
+			dst.Choice = d
+		}
+		d.ChoiceString = v.ChoiceString
+	case *Edition2024Fixture_ChoiceInt32:
+		d, ok := dst.Choice.(*Edition2024Fixture_ChoiceInt32)
+		if !ok {
+			d = &Edition2024Fixture_ChoiceInt32{}
+			dst.Choice = d
+		}
+		d.ChoiceInt32 = v.ChoiceInt32
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Edition2024Fixture_Nested) DiffVT(that *Edition2024Fixture_Nested) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Edition2024Fixture_Nested) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Edition2024Fixture_Nested) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Edition2024Fixture_Nested{}
+	}
+	if that == nil {
+		that = &Edition2024Fixture_Nested{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "name", m.Name, that.Name)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "value", m.Value, that.Value)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Edition2024Fixture_DelimitedGroup) DiffVT(that *Edition2024Fixture_DelimitedGroup) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Edition2024Fixture_DelimitedGroup) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Edition2024Fixture_DelimitedGroup) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Edition2024Fixture_DelimitedGroup{}
+	}
+	if that == nil {
+		that = &Edition2024Fixture_DelimitedGroup{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "label", m.Label, that.Label)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Edition2024Fixture) DiffVT(that *Edition2024Fixture) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Edition2024Fixture) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Edition2024Fixture) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Edition2024Fixture{}
+	}
+	if that == nil {
+		that = &Edition2024Fixture{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_int32", m.ExplicitInt32, that.ExplicitInt32)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "implicit_int32", m.ImplicitInt32, that.ImplicitInt32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_int32", m.RequiredInt32, that.RequiredInt32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_string", m.ExplicitString, that.ExplicitString)
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "explicit_bytes", m.ExplicitBytes, that.ExplicitBytes)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_state", m.ExplicitState, that.ExplicitState)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "nested_message", m.NestedMessage, that.NestedMessage, (*Edition2024Fixture_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_int32", m.PackedInt32, that.PackedInt32)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "expanded_int32", m.ExpandedInt32, that.ExpandedInt32)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "nested_map", m.NestedMap, that.NestedMap, (*Edition2024Fixture_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "delimited_group", m.DelimitedGroup, that.DelimitedGroup, (*Edition2024Fixture_DelimitedGroup).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_default_int32", m.ExplicitDefaultInt32, that.ExplicitDefaultInt32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_default_string", m.ExplicitDefaultString, that.ExplicitDefaultString)
+	{
+		var a, b *string
+		if v, ok := m.Choice.(*Edition2024Fixture_ChoiceString); ok {
+			a = &v.ChoiceString
+		}
+		if v, ok := that.Choice.(*Edition2024Fixture_ChoiceString); ok {
+			b = &v.ChoiceString
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "choice_string", a, b)
+	}
+	{
+		var a, b *int32
+		if v, ok := m.Choice.(*Edition2024Fixture_ChoiceInt32); ok {
+			a = &v.ChoiceInt32
+		}
+		if v, ok := that.Choice.(*Edition2024Fixture_ChoiceInt32); ok {
+			b = &v.ChoiceInt32
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "choice_int32", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *Edition2024Fixture_Nested) EqualVT(that *Edition2024Fixture_Nested) bool {
 	if this == that {
 		return true
@@ -514,76 +917,369 @@ func (this *Edition2024Fixture_ChoiceInt32) EqualVT(thatIface isEdition2024Fixtu
 	return true
 }
 
-// MarshalProtoJSON marshals the Edition2024Fixture_State to JSON.
-func (x Edition2024Fixture_State) MarshalProtoJSON(s *json.MarshalState) {
-	s.WriteEnum(int32(x), Edition2024Fixture_State_name)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Edition2024Fixture_Nested) EqualVTOpts(that *Edition2024Fixture_Nested, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// MarshalText marshals the Edition2024Fixture_State to text.
-func (x Edition2024Fixture_State) MarshalText() ([]byte, error) {
-	return []byte(json.GetEnumString(int32(x), Edition2024Fixture_State_name)), nil
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Edition2024Fixture_Nested) EqualVTOptsPrefix(that *Edition2024Fixture_Nested, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Edition2024Fixture_Nested{}
+		}
+		if that == nil {
+			that = &Edition2024Fixture_Nested{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "name"); ok && ((this.Name == nil) != (that.Name == nil) || this.Name != nil && *this.Name != *that.Name) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "value"); ok && this.Value != that.Value {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// MarshalJSON marshals the Edition2024Fixture_State to JSON.
-func (x Edition2024Fixture_State) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Edition2024Fixture_DelimitedGroup) EqualVTOpts(that *Edition2024Fixture_DelimitedGroup, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// UnmarshalProtoJSON unmarshals the Edition2024Fixture_State from JSON.
-func (x *Edition2024Fixture_State) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	v := s.ReadEnum(Edition2024Fixture_State_value)
-	if err := s.Err(); err != nil {
-		s.SetErrorf("could not read State enum: %v", err)
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Edition2024Fixture_DelimitedGroup) EqualVTOptsPrefix(that *Edition2024Fixture_DelimitedGroup, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	*x = Edition2024Fixture_State(v)
-}
-
-// UnmarshalText unmarshals the Edition2024Fixture_State from text.
-func (x *Edition2024Fixture_State) UnmarshalText(b []byte) error {
-	i, err := json.ParseEnumString(string(b), Edition2024Fixture_State_value)
-	if err != nil {
-		return err
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Edition2024Fixture_DelimitedGroup{}
+		}
+		if that == nil {
+			that = &Edition2024Fixture_DelimitedGroup{}
+		}
 	}
-	*x = Edition2024Fixture_State(i)
-	return nil
+	if _, ok := opts.FieldPath(prefix, "label"); ok && ((this.Label == nil) != (that.Label == nil) || this.Label != nil && *this.Label != *that.Label) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// UnmarshalJSON unmarshals the Edition2024Fixture_State from JSON.
-func (x *Edition2024Fixture_State) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Edition2024Fixture) EqualVTOpts(that *Edition2024Fixture, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// MarshalProtoJSON marshals the Edition2024Fixture_Nested message to JSON.
-func (x *Edition2024Fixture_Nested) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Edition2024Fixture) EqualVTOptsPrefix(that *Edition2024Fixture, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.Name != nil {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("name")
-		s.WriteString(*x.Name)
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Edition2024Fixture{}
+		}
+		if that == nil {
+			that = &Edition2024Fixture{}
+		}
 	}
-	if x.Value != 0 || s.HasField("value") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("value")
-		s.WriteInt32(x.Value)
+	if _, ok := opts.FieldPath(prefix, "explicit_int32"); ok && ((this.ExplicitInt32 == nil) != (that.ExplicitInt32 == nil) || this.ExplicitInt32 != nil && *this.ExplicitInt32 != *that.ExplicitInt32) {
+		return false
 	}
-	s.WriteObjectEnd()
+	if _, ok := opts.FieldPath(prefix, "implicit_int32"); ok && this.ImplicitInt32 != that.ImplicitInt32 {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "required_int32"); ok && ((this.RequiredInt32 == nil) != (that.RequiredInt32 == nil) || this.RequiredInt32 != nil && *this.RequiredInt32 != *that.RequiredInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_string"); ok && ((this.ExplicitString == nil) != (that.ExplicitString == nil) || this.ExplicitString != nil && *this.ExplicitString != *that.ExplicitString) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_bytes"); ok && ((this.ExplicitBytes == nil) != (that.ExplicitBytes == nil) || string(this.ExplicitBytes) != string(that.ExplicitBytes)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_state"); ok && ((this.ExplicitState == nil) != (that.ExplicitState == nil) || this.ExplicitState != nil && *this.ExplicitState != *that.ExplicitState) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_message"); ok && !protobuf_go_lite.EqualVTOptsValue(this.NestedMessage, that.NestedMessage, opts, path, (*Edition2024Fixture_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "packed_int32"); ok && !slices.Equal(this.PackedInt32, that.PackedInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "expanded_int32"); ok && !slices.Equal(this.ExpandedInt32, that.ExpandedInt32) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_map"); ok && !protobuf_go_lite.EqualVTOptsMap(this.NestedMap, that.NestedMap, opts, path, (*Edition2024Fixture_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "delimited_group"); ok && !protobuf_go_lite.EqualVTOptsValue(this.DelimitedGroup, that.DelimitedGroup, opts, path, (*Edition2024Fixture_DelimitedGroup).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_string"); ok {
+		a, aok := this.Choice.(*Edition2024Fixture_ChoiceString)
+		b, bok := that.Choice.(*Edition2024Fixture_ChoiceString)
+		if aok != bok || aok && a.ChoiceString != b.ChoiceString {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_int32"); ok {
+		a, aok := this.Choice.(*Edition2024Fixture_ChoiceInt32)
+		b, bok := that.Choice.(*Edition2024Fixture_ChoiceInt32)
+		if aok != bok || aok && a.ChoiceInt32 != b.ChoiceInt32 {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_default_int32"); ok && ((this.ExplicitDefaultInt32 == nil) != (that.ExplicitDefaultInt32 == nil) || this.ExplicitDefaultInt32 != nil && *this.ExplicitDefaultInt32 != *that.ExplicitDefaultInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_default_string"); ok && ((this.ExplicitDefaultString == nil) != (that.ExplicitDefaultString == nil) || this.ExplicitDefaultString != nil && *this.ExplicitDefaultString != *that.ExplicitDefaultString) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// MarshalJSON marshals the Edition2024Fixture_Nested to JSON.
-func (x *Edition2024Fixture_Nested) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Edition2024Fixture_Nested) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
 
-// UnmarshalProtoJSON unmarshals the Edition2024Fixture_Nested message from JSON.
-func (x *Edition2024Fixture_Nested) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Edition2024Fixture_Nested) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Edition2024Fixture_Nested) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Name != nil {
+			w.Field(1)
+			w.String(*m.Name)
+		}
+		if m.Value != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.Value))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Edition2024Fixture_DelimitedGroup) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Edition2024Fixture_DelimitedGroup) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Edition2024Fixture_DelimitedGroup) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Label != nil {
+			w.Field(1)
+			w.String(*m.Label)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Edition2024Fixture) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Edition2024Fixture) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Edition2024Fixture) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.ExplicitInt32 != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.ExplicitInt32))
+		}
+		if m.ImplicitInt32 != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.ImplicitInt32))
+		}
+		if m.RequiredInt32 != nil {
+			w.Field(3)
+			w.Uint64(uint64(*m.RequiredInt32))
+		}
+		if m.ExplicitString != nil {
+			w.Field(4)
+			w.String(*m.ExplicitString)
+		}
+		if m.ExplicitBytes != nil {
+			w.Field(5)
+			w.Bytes(m.ExplicitBytes)
+		}
+		if m.ExplicitState != nil {
+			w.Field(6)
+			w.Uint64(uint64(*m.ExplicitState))
+		}
+		if v := m.NestedMessage; v != nil {
+			w.Field(7)
+			v.WriteHashVT(w)
+		}
+		if len(m.PackedInt32) != 0 {
+			w.Field(8)
+			w.Uint64(uint64(len(m.PackedInt32)))
+			for _, v := range m.PackedInt32 {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.ExpandedInt32) != 0 {
+			w.Field(9)
+			w.Uint64(uint64(len(m.ExpandedInt32)))
+			for _, v := range m.ExpandedInt32 {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.NestedMap) != 0 {
+			w.Field(10)
+			protobuf_go_lite.HashMap(w, m.NestedMap, func(w *protobuf_go_lite.Hasher, k string, v *Edition2024Fixture_Nested) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		if v := m.DelimitedGroup; v != nil {
+			w.Field(11)
+			v.WriteHashVT(w)
+		}
+		switch v := m.Choice.(type) {
+		case *Edition2024Fixture_ChoiceString:
+			w.Field(12)
+			w.String(v.ChoiceString)
+		case *Edition2024Fixture_ChoiceInt32:
+			w.Field(13)
+			w.Uint64(uint64(v.ChoiceInt32))
+		}
+		if m.ExplicitDefaultInt32 != nil {
+			w.Field(14)
+			w.Uint64(uint64(*m.ExplicitDefaultInt32))
+		}
+		if m.ExplicitDefaultString != nil {
+			w.Field(15)
+			w.String(*m.ExplicitDefaultString)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the Edition2024Fixture_State to JSON.
+func (x Edition2024Fixture_State) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Edition2024Fixture_State_name)
+}
+
+// MarshalText marshals the Edition2024Fixture_State to text.
+func (x Edition2024Fixture_State) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Edition2024Fixture_State_name)), nil
+}
+
+// MarshalJSON marshals the Edition2024Fixture_State to JSON.
+func (x Edition2024Fixture_State) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Edition2024Fixture_State from JSON.
+func (x *Edition2024Fixture_State) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Edition2024Fixture_State_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read State enum: %v", err)
+		return
+	}
+	*x = Edition2024Fixture_State(v)
+}
+
+// UnmarshalText unmarshals the Edition2024Fixture_State from text.
+func (x *Edition2024Fixture_State) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Edition2024Fixture_State_value)
+	if err != nil {
+		return err
+	}
+	*x = Edition2024Fixture_State(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Edition2024Fixture_State from JSON.
+func (x *Edition2024Fixture_State) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Edition2024Fixture_Nested message to JSON.
+func (x *Edition2024Fixture_Nested) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(*x.Name)
+	}
+	if x.Value != 0 || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteInt32(x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Edition2024Fixture_Nested to JSON.
+func (x *Edition2024Fixture_Nested) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Edition2024Fixture_Nested message from JSON.
+func (x *Edition2024Fixture_Nested) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
 	}
 	s.ReadObject(func(key string) {
 		switch key {
@@ -1425,6 +2121,167 @@ func (m *Edition2024Fixture_ChoiceInt32) MarshalToSizedBufferVTStrict(dAtA []byt
 	dAtA[i] = 0x68
 	return len(dAtA) - i, nil
 }
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Edition2024Fixture_Nested) MergeVT(src *Edition2024Fixture_Nested) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Name != nil {
+		v := *src.Name
+		m.Name = &v
+	}
+	if src.Value != 0 {
+		m.Value = src.Value
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Edition2024Fixture_Nested) MergeMessageVT(src any) bool {
+	s, ok := src.(*Edition2024Fixture_Nested)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Edition2024Fixture_DelimitedGroup) MergeVT(src *Edition2024Fixture_DelimitedGroup) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Label != nil {
+		v := *src.Label
+		m.Label = &v
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Edition2024Fixture_DelimitedGroup) MergeMessageVT(src any) bool {
+	s, ok := src.(*Edition2024Fixture_DelimitedGroup)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Edition2024Fixture) MergeVT(src *Edition2024Fixture) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.ExplicitInt32 != nil {
+		v := *src.ExplicitInt32
+		m.ExplicitInt32 = &v
+	}
+	if src.ImplicitInt32 != 0 {
+		m.ImplicitInt32 = src.ImplicitInt32
+	}
+	if src.RequiredInt32 != nil {
+		v := *src.RequiredInt32
+		m.RequiredInt32 = &v
+	}
+	if src.ExplicitString != nil {
+		v := *src.ExplicitString
+		m.ExplicitString = &v
+	}
+	if src.ExplicitBytes != nil {
+		m.ExplicitBytes = slices.Clone(src.ExplicitBytes)
+	}
+	if src.ExplicitState != nil {
+		v := *src.ExplicitState
+		m.ExplicitState = &v
+	}
+	if src.NestedMessage != nil {
+		if m.NestedMessage == nil {
+			m.NestedMessage = new(Edition2024Fixture_Nested)
+		}
+		m.NestedMessage.MergeVT(src.NestedMessage)
+	}
+	m.PackedInt32 = append(m.PackedInt32, src.PackedInt32...)
+	m.ExpandedInt32 = append(m.ExpandedInt32, src.ExpandedInt32...)
+	if len(src.NestedMap) > 0 {
+		if m.NestedMap == nil {
+			m.NestedMap = make(map[string]*Edition2024Fixture_Nested, len(src.NestedMap))
+		}
+		for k, v := range src.NestedMap {
+			var e *Edition2024Fixture_Nested
+			if v != nil {
+				e = new(Edition2024Fixture_Nested)
+				e.MergeVT(v)
+			}
+			m.NestedMap[k] = e
+		}
+	}
+	if src.DelimitedGroup != nil {
+		if m.DelimitedGroup == nil {
+			m.DelimitedGroup = new(Edition2024Fixture_DelimitedGroup)
+		}
+		m.DelimitedGroup.MergeVT(src.DelimitedGroup)
+	}
+	switch v := src.Choice.(type) {
+	case *Edition2024Fixture_ChoiceString:
+		m.Choice = &Edition2024Fixture_ChoiceString{ChoiceString: v.ChoiceString}
+	case *Edition2024Fixture_ChoiceInt32:
+		m.Choice = &Edition2024Fixture_ChoiceInt32{ChoiceInt32: v.ChoiceInt32}
+	}
+	if src.ExplicitDefaultInt32 != nil {
+		v := *src.ExplicitDefaultInt32
+		m.ExplicitDefaultInt32 = &v
+	}
+	if src.ExplicitDefaultString != nil {
+		v := *src.ExplicitDefaultString
+		m.ExplicitDefaultString = &v
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Edition2024Fixture) MergeMessageVT(src any) bool {
+	s, ok := src.(*Edition2024Fixture)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Edition2024Fixture_Nested) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Edition2024Fixture_DelimitedGroup) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Edition2024Fixture) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.NestedMessage.RedactVT()
+	for _, v := range m.NestedMap {
+		v.RedactVT()
+	}
+	m.DelimitedGroup.RedactVT()
+}
+
 func (m *Edition2024Fixture_Nested) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -2076,6 +2933,935 @@ func (m *Edition2024Fixture) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture_Nested) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture_Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture_Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.Nested.name contains invalid UTF-8")
+			}
+			m.Name = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			m.Value, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture_DelimitedGroup) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture_DelimitedGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture_DelimitedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.DelimitedGroup.label contains invalid UTF-8")
+			}
+			m.Label = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			m.ImplicitInt32 = 0
+			m.ImplicitInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredInt32 = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.explicit_string contains invalid UTF-8")
+			}
+			m.ExplicitString = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitBytes", wireType)
+			}
+			m.ExplicitBytes, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.ExplicitBytes, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitState", wireType)
+			}
+			var v Edition2024Fixture_State
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Edition2024Fixture_State(_v)
+			if err != nil {
+				return err
+			}
+			m.ExplicitState = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.NestedMessage == nil {
+				m.NestedMessage = &Edition2024Fixture_Nested{}
+			}
+			if err := m.NestedMessage.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.ExpandedInt32 = append(m.ExpandedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.ExpandedInt32) == 0 {
+					m.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.ExpandedInt32 = append(m.ExpandedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedMap == nil {
+				m.NestedMap = make(map[string]*Edition2024Fixture_Nested)
+			}
+			var mapkey string
+			var mapvalue *Edition2024Fixture_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if !utf8.ValidString(mapkey) {
+						return fmt.Errorf("proto: field editions2024.Edition2024Fixture.NestedMapEntry.key contains invalid UTF-8")
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Edition2024Fixture_Nested{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelimitedGroup", wireType)
+			}
+			groupStart := iNdEx
+			for {
+				maybeGroupEnd := iNdEx
+				var groupFieldWire uint64
+				groupFieldWire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				groupWireType := int(groupFieldWire & 0x7)
+				if groupWireType == 4 {
+					if m.DelimitedGroup == nil {
+						m.DelimitedGroup = &Edition2024Fixture_DelimitedGroup{}
+					}
+					if err := m.DelimitedGroup.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
+						return err
+					}
+					break
+				}
+				iNdEx = maybeGroupEnd
+				skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protobuf_go_lite.ErrInvalidLength
+				}
+				iNdEx += skippy
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.choice_string contains invalid UTF-8")
+			}
+			m.Choice = &Edition2024Fixture_ChoiceString{ChoiceString: v}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Edition2024Fixture_ChoiceInt32{ChoiceInt32: v}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDefaultInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitDefaultInt32 = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDefaultString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.explicit_default_string contains invalid UTF-8")
+			}
+			m.ExplicitDefaultString = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 3) {
+		return fmt.Errorf("proto: required field required_int32 not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture_Nested) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture_Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture_Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.Nested.name contains invalid UTF-8")
+			}
+			m.Name = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			m.Value, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture_DelimitedGroup) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture_DelimitedGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture_DelimitedGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.DelimitedGroup.label contains invalid UTF-8")
+			}
+			m.Label = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Edition2024Fixture) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Edition2024Fixture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Edition2024Fixture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			m.ImplicitInt32 = 0
+			m.ImplicitInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredInt32 = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.explicit_string contains invalid UTF-8")
+			}
+			m.ExplicitString = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitBytes", wireType)
+			}
+			m.ExplicitBytes, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitState", wireType)
+			}
+			var v Edition2024Fixture_State
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Edition2024Fixture_State(_v)
+			if err != nil {
+				return err
+			}
+			m.ExplicitState = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.NestedMessage == nil {
+				m.NestedMessage = &Edition2024Fixture_Nested{}
+			}
+			if err := m.NestedMessage.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.ExpandedInt32 = append(m.ExpandedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.ExpandedInt32) == 0 {
+					m.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.ExpandedInt32 = append(m.ExpandedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedMap == nil {
+				m.NestedMap = make(map[string]*Edition2024Fixture_Nested)
+			}
+			var mapkey string
+			var mapvalue *Edition2024Fixture_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if !utf8.ValidString(mapkey) {
+						return fmt.Errorf("proto: field editions2024.Edition2024Fixture.NestedMapEntry.key contains invalid UTF-8")
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Edition2024Fixture_Nested{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelimitedGroup", wireType)
+			}
+			groupStart := iNdEx
+			for {
+				maybeGroupEnd := iNdEx
+				var groupFieldWire uint64
+				groupFieldWire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				groupWireType := int(groupFieldWire & 0x7)
+				if groupWireType == 4 {
+					if m.DelimitedGroup == nil {
+						m.DelimitedGroup = &Edition2024Fixture_DelimitedGroup{}
+					}
+					if err := m.DelimitedGroup.UnmarshalVTUnsafe(dAtA[groupStart:maybeGroupEnd]); err != nil {
+						return err
+					}
+					break
+				}
+				iNdEx = maybeGroupEnd
+				skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protobuf_go_lite.ErrInvalidLength
+				}
+				iNdEx += skippy
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.choice_string contains invalid UTF-8")
+			}
+			m.Choice = &Edition2024Fixture_ChoiceString{ChoiceString: v}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Edition2024Fixture_ChoiceInt32{ChoiceInt32: v}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDefaultInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitDefaultInt32 = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDefaultString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field editions2024.Edition2024Fixture.explicit_default_string contains invalid UTF-8")
+			}
+			m.ExplicitDefaultString = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 3) {
+		return fmt.Errorf("proto: required field required_int32 not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *Edition2024Fixture_Nested) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
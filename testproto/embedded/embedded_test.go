@@ -0,0 +1,227 @@
+package embedded
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// newSample returns a Sample with every field set and its twin using
+// sub-message pointers.
+func newSample() (*Sample, *SamplePointers) {
+	embedded := &Sample{
+		Position: Point{X: 1, Y: 2, Tags: []string{"a"}},
+		Origin:   &Point{X: 3},
+		Path:     []*Point{{X: 4}, {Y: 5}},
+	}
+	embedded.SetVelocity(Point{Y: -1})
+	pointers := &SamplePointers{
+		Position: &Point{X: 1, Y: 2, Tags: []string{"a"}},
+		Velocity: &Point{Y: -1},
+		Origin:   &Point{X: 3},
+		Path:     []*Point{{X: 4}, {Y: 5}},
+		Target:   &Point{},
+	}
+	return embedded, pointers
+}
+
+func TestEmbeddedWire(t *testing.T) {
+	embedded, pointers := newSample()
+
+	out, err := embedded.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := pointers.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+	if got := embedded.SizeVT(); got != len(out) {
+		t.Fatalf("SizeVT() = %d, want %d", got, len(out))
+	}
+
+	var decoded Sample
+	if err := decoded.UnmarshalVT(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(embedded) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, embedded)
+	}
+	if !decoded.HasVelocity() {
+		t.Fatal("HasVelocity() = false after decoding the field")
+	}
+}
+
+func TestEmbeddedPresence(t *testing.T) {
+	var m Sample
+	if m.HasVelocity() {
+		t.Fatal("HasVelocity() = true for an empty message")
+	}
+
+	// Fields without tracked presence are always encoded, the unset velocity
+	// is not.
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := (&SamplePointers{Position: &Point{}, Target: &Point{}}).MarshalVT()
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+
+	// An empty velocity that is set is encoded.
+	m.SetVelocity(Point{})
+	out, _ = m.MarshalVT()
+	want, _ = (&SamplePointers{Position: &Point{}, Velocity: &Point{}, Target: &Point{}}).MarshalVT()
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+
+	var unset Sample
+	if unset.EqualVT(&m) || unset.CompareVT(&m) >= 0 || unset.Hash64VT() == m.Hash64VT() {
+		t.Fatal("an unset velocity compares like a set empty one")
+	}
+	if len(unset.DiffVT(&m)) != 1 {
+		t.Fatalf("DiffVT() = %v, want 1 difference", unset.DiffVT(&m))
+	}
+
+	m.ClearVelocity()
+	if m.HasVelocity() || !unset.EqualVT(&m) {
+		t.Fatal("ClearVelocity() did not unset the field")
+	}
+}
+
+func TestEmbeddedPresenceAssigned(t *testing.T) {
+	// Assigning the field directly does not set its presence, so the value is
+	// not encoded and the message compares like an empty one.
+	var m Sample
+	m.Velocity = Point{Y: 3}
+	if m.HasVelocity() {
+		t.Fatal("HasVelocity() = true after assigning the field")
+	}
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := (&Sample{}).MarshalVT()
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x without the assigned velocity", out, want)
+	}
+	if !m.EqualVT(&Sample{}) || m.Hash64VT() != (&Sample{}).Hash64VT() {
+		t.Fatal("an assigned velocity without presence compares unlike an unset one")
+	}
+
+	// SetVelocity sets the presence, so the value is encoded.
+	m.SetVelocity(m.Velocity)
+	out, _ = m.MarshalVT()
+	want, _ = (&SamplePointers{Position: &Point{}, Velocity: &Point{Y: 3}, Target: &Point{}}).MarshalVT()
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+}
+
+func TestEmbeddedGetter(t *testing.T) {
+	embedded, _ := newSample()
+	embedded.GetPosition().X = 7
+	if embedded.Position.X != 7 {
+		t.Fatal("GetPosition() does not point to the embedded field")
+	}
+	var nilSample *Sample
+	if nilSample.GetPosition() != nil || nilSample.GetPosition().GetX() != 0 {
+		t.Fatal("GetPosition() of a nil message is not nil")
+	}
+}
+
+func TestEmbeddedCloneEqual(t *testing.T) {
+	embedded, _ := newSample()
+
+	clone := embedded.CloneVT()
+	if !clone.EqualVT(embedded) || !clone.HasVelocity() {
+		t.Fatalf("CloneVT() = %v, want %v", clone, embedded)
+	}
+	clone.Position.Tags[0] = "b"
+	if embedded.Position.Tags[0] != "a" {
+		t.Fatal("CloneVT() shares the embedded sub-message")
+	}
+	if clone.EqualVT(embedded) {
+		t.Fatal("EqualVT() = true after changing the clone")
+	}
+	if clone.CompareVT(embedded) == 0 {
+		t.Fatal("CompareVT() = 0 after changing the clone")
+	}
+	if clone.Hash64VT() == embedded.Hash64VT() {
+		t.Fatal("Hash64VT() unchanged after changing the clone")
+	}
+	if len(clone.DiffVT(embedded)) != 1 {
+		t.Fatalf("DiffVT() = %v, want 1 difference", clone.DiffVT(embedded))
+	}
+
+	var dst Sample
+	embedded.CopyVT(&dst)
+	if !dst.EqualVT(embedded) || !dst.HasVelocity() {
+		t.Fatalf("CopyVT() = %v, want %v", &dst, embedded)
+	}
+}
+
+func TestEmbeddedMerge(t *testing.T) {
+	dst := &Sample{Position: Point{X: 1, Tags: []string{"a"}}}
+	src := &Sample{Position: Point{Y: 2, Tags: []string{"b"}}}
+	src.SetVelocity(Point{X: 3})
+	dst.MergeVT(src)
+
+	if dst.Position.X != 1 || dst.Position.Y != 2 || len(dst.Position.Tags) != 2 {
+		t.Fatalf("MergeVT() position = %v", &dst.Position)
+	}
+	if !dst.HasVelocity() || dst.Velocity.X != 3 {
+		t.Fatalf("MergeVT() velocity = %v, set %v", &dst.Velocity, dst.HasVelocity())
+	}
+
+	dst.MergeVT(&Sample{})
+	if !dst.HasVelocity() {
+		t.Fatal("MergeVT() of an unset velocity cleared the field")
+	}
+}
+
+func TestEmbeddedJSON(t *testing.T) {
+	embedded, pointers := newSample()
+
+	out, err := embedded.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := pointers.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(want) {
+		t.Fatalf("MarshalJSON() = %s, want %s", out, want)
+	}
+
+	var decoded Sample
+	if err := decoded.UnmarshalJSON(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(embedded) {
+		t.Fatalf("UnmarshalJSON(%s) = %v, want %v", want, &decoded, embedded)
+	}
+
+	if err := decoded.UnmarshalJSON([]byte(`{"velocity":null}`)); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.HasVelocity() {
+		t.Fatal("UnmarshalJSON() of null left the velocity set")
+	}
+}
+
+func TestEmbeddedText(t *testing.T) {
+	embedded, pointers := newSample()
+
+	got := strings.TrimPrefix(embedded.MarshalProtoText(), "Sample ")
+	want := strings.TrimPrefix(pointers.MarshalProtoText(), "SamplePointers ")
+	if got != want {
+		t.Fatalf("MarshalProtoText() = %s, want %s", got, want)
+	}
+}
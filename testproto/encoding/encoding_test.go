@@ -0,0 +1,58 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*Record)(nil)
+	_ encoding.BinaryUnmarshaler = (*Record)(nil)
+	_ interface {
+		AppendBinary(b []byte) ([]byte, error)
+	} = (*Record)(nil)
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	msg := &Record{Key: "k", Version: 3, Tags: []string{"a"}, Parent: &Record{Key: "p"}}
+	data, err := msg.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := msg.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBinary() = %x, want %x", data, want)
+	}
+
+	prefix := []byte("prefix")
+	appended, err := msg.AppendBinary(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(appended, append([]byte("prefix"), want...)) {
+		t.Fatalf("AppendBinary() = %x", appended)
+	}
+	if got, err := (*Record)(nil).AppendBinary(prefix); err != nil || !bytes.Equal(got, prefix) {
+		t.Fatalf("nil AppendBinary() = %x, %v", got, err)
+	}
+
+	got := &Record{Key: "stale", Tags: []string{"stale"}}
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.EqualVT(msg) {
+		t.Fatalf("UnmarshalBinary() = %v, want %v", got, msg)
+	}
+}
+
+func TestNoTextMarshaler(t *testing.T) {
+	// There is no text format parser to implement encoding.TextUnmarshaler, so
+	// encoding.TextMarshaler is not implemented either.
+	if _, ok := any((*Record)(nil)).(encoding.TextMarshaler); ok {
+		t.Fatal("a message implements encoding.TextMarshaler")
+	}
+}
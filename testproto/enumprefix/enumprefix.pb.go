@@ -0,0 +1,1422 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/enumprefix/enumprefix.proto
+
+package enumprefix
+
+import (
+	bytes "bytes"
+	cmp "cmp"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	slices "slices"
+	strconv "strconv"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	_ "github.com/aperturerobotics/protobuf-go-lite/golite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+//protobuf-go-lite:stripvalueprefix
+
+// Status drops the prefix shared by its values, generating Status_ACTIVE.
+type Status int32
+
+const (
+	Status_UNSPECIFIED Status = 0
+	Status_ACTIVE      Status = 1
+	Status_SUSPENDED   Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+		2: "STATUS_SUSPENDED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+		"STATUS_SUSPENDED":   2,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	name, valid := Status_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// Color also drops the type prefix, generating RED.
+// protobuf-go-lite:stripenumprefix
+type Color int32
+
+const (
+	UNSPECIFIED Color = 0
+	RED         Color = 1
+	GREEN       Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "COLOR_RED",
+		2: "COLOR_GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"COLOR_RED":         1,
+		"COLOR_GREEN":       2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	name, valid := Color_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// Kind keeps the names of its values, generating Kind_KIND_A.
+type Kind int32
+
+const (
+	Kind_KIND_A Kind = 0
+	Kind_KIND_B Kind = 1
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_A",
+		1: "KIND_B",
+	}
+	Kind_value = map[string]int32{
+		"KIND_A": 0,
+		"KIND_B": 1,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	name, valid := Kind_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// State drops the parent message and shared prefixes, generating OPEN.
+type Account_State int32
+
+const (
+	OPEN   Account_State = 0
+	CLOSED Account_State = 1
+)
+
+// Enum value maps for Account_State.
+var (
+	Account_State_name = map[int32]string{
+		0: "STATE_OPEN",
+		1: "STATE_CLOSED",
+	}
+	Account_State_value = map[string]int32{
+		"STATE_OPEN":   0,
+		"STATE_CLOSED": 1,
+	}
+)
+
+func (x Account_State) Enum() *Account_State {
+	p := new(Account_State)
+	*p = x
+	return p
+}
+
+func (x Account_State) String() string {
+	name, valid := Account_State_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+type Account struct {
+	unknownFields []byte
+	Status        Status        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Color         Color         `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          Kind          `protobuf:"varint,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	State         Account_State `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	History       []Status      `protobuf:"varint,5,rep,packed,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+}
+
+func (*Account) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Account) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Account) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Account) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *Account) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNSPECIFIED
+}
+
+func (x *Account) GetColor() Color {
+	if x != nil {
+		return x.Color
+	}
+	return UNSPECIFIED
+}
+
+func (x *Account) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_A
+}
+
+func (x *Account) GetState() Account_State {
+	if x != nil {
+		return x.State
+	}
+	return OPEN
+}
+
+func (x *Account) GetHistory() []Status {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (m *Account) CloneVT() *Account {
+	if m == nil {
+		return (*Account)(nil)
+	}
+	r := new(Account)
+	r.Status = m.Status
+	r.Color = m.Color
+	r.Kind = m.Kind
+	r.State = m.State
+	r.History = protobuf_go_lite.CloneSlice(m.History)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *Account) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Account) CompareVT(that *Account) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Status, that.Status); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Color, that.Color); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Kind, that.Kind); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.State, that.State); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.History, that.History); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Account) CopyVT(dst *Account) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Status = m.Status
+	dst.Color = m.Color
+	dst.Kind = m.Kind
+	dst.State = m.State
+	dst.History = protobuf_go_lite.CopySlice(dst.History, m.History)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Account) DiffVT(that *Account) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Account) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Account) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Account{}
+	}
+	if that == nil {
+		that = &Account{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "status", m.Status, that.Status)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "color", m.Color, that.Color)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "kind", m.Kind, that.Kind)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "state", m.State, that.State)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "history", m.History, that.History)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *Account) EqualVT(that *Account) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Status != that.Status {
+		return false
+	}
+	if this.Color != that.Color {
+		return false
+	}
+	if this.Kind != that.Kind {
+		return false
+	}
+	if this.State != that.State {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.History, that.History) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Account) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Account)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Account) EqualVTOpts(that *Account, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Account) EqualVTOptsPrefix(that *Account, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Account{}
+		}
+		if that == nil {
+			that = &Account{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "status"); ok && this.Status != that.Status {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "color"); ok && this.Color != that.Color {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "kind"); ok && this.Kind != that.Kind {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "state"); ok && this.State != that.State {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "history"); ok && !slices.Equal(this.History, that.History) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Account) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Account) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Account) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Status != 0 {
+			w.Field(1)
+			w.Uint64(uint64(m.Status))
+		}
+		if m.Color != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.Color))
+		}
+		if m.Kind != 0 {
+			w.Field(3)
+			w.Uint64(uint64(m.Kind))
+		}
+		if m.State != 0 {
+			w.Field(4)
+			w.Uint64(uint64(m.State))
+		}
+		if len(m.History) != 0 {
+			w.Field(5)
+			w.Uint64(uint64(len(m.History)))
+			for _, v := range m.History {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the Status to JSON.
+func (x Status) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Status_name)
+}
+
+// MarshalText marshals the Status to text.
+func (x Status) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Status_name)), nil
+}
+
+// MarshalJSON marshals the Status to JSON.
+func (x Status) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Status from JSON.
+func (x *Status) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Status_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Status enum: %v", err)
+		return
+	}
+	*x = Status(v)
+}
+
+// UnmarshalText unmarshals the Status from text.
+func (x *Status) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Status_value)
+	if err != nil {
+		return err
+	}
+	*x = Status(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Status from JSON.
+func (x *Status) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Color to JSON.
+func (x Color) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Color_name)
+}
+
+// MarshalText marshals the Color to text.
+func (x Color) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Color_name)), nil
+}
+
+// MarshalJSON marshals the Color to JSON.
+func (x Color) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Color from JSON.
+func (x *Color) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Color_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Color enum: %v", err)
+		return
+	}
+	*x = Color(v)
+}
+
+// UnmarshalText unmarshals the Color from text.
+func (x *Color) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Color_value)
+	if err != nil {
+		return err
+	}
+	*x = Color(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Color from JSON.
+func (x *Color) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Kind to JSON.
+func (x Kind) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Kind_name)
+}
+
+// MarshalText marshals the Kind to text.
+func (x Kind) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Kind_name)), nil
+}
+
+// MarshalJSON marshals the Kind to JSON.
+func (x Kind) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Kind from JSON.
+func (x *Kind) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Kind_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Kind enum: %v", err)
+		return
+	}
+	*x = Kind(v)
+}
+
+// UnmarshalText unmarshals the Kind from text.
+func (x *Kind) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Kind_value)
+	if err != nil {
+		return err
+	}
+	*x = Kind(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Kind from JSON.
+func (x *Kind) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Account_State to JSON.
+func (x Account_State) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Account_State_name)
+}
+
+// MarshalText marshals the Account_State to text.
+func (x Account_State) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Account_State_name)), nil
+}
+
+// MarshalJSON marshals the Account_State to JSON.
+func (x Account_State) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Account_State from JSON.
+func (x *Account_State) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Account_State_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read State enum: %v", err)
+		return
+	}
+	*x = Account_State(v)
+}
+
+// UnmarshalText unmarshals the Account_State from text.
+func (x *Account_State) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Account_State_value)
+	if err != nil {
+		return err
+	}
+	*x = Account_State(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Account_State from JSON.
+func (x *Account_State) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Account message to JSON.
+func (x *Account) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Status != 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		x.Status.MarshalProtoJSON(s)
+	}
+	if x.Color != 0 || s.HasField("color") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("color")
+		x.Color.MarshalProtoJSON(s)
+	}
+	if x.Kind != 0 || s.HasField("kind") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("kind")
+		x.Kind.MarshalProtoJSON(s)
+	}
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s)
+	}
+	if len(x.History) > 0 || s.HasField("history") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("history")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.History {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Account to JSON.
+func (x *Account) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Account message from JSON.
+func (x *Account) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "status":
+			s.AddField("status")
+			x.Status.UnmarshalProtoJSON(s)
+		case "color":
+			s.AddField("color")
+			x.Color.UnmarshalProtoJSON(s)
+		case "kind":
+			s.AddField("kind")
+			x.Kind.UnmarshalProtoJSON(s)
+		case "state":
+			s.AddField("state")
+			x.State.UnmarshalProtoJSON(s)
+		case "history":
+			s.AddField("history")
+			if s.ReadNil() {
+				x.History = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v Status
+				v.UnmarshalProtoJSON(s)
+				x.History = append(x.History, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Account from JSON.
+func (x *Account) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *Account) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.History) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.History)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Color != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Color))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Account) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.History) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.History)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Color != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Color))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Account) MergeVT(src *Account) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Status != 0 {
+		m.Status = src.Status
+	}
+	if src.Color != 0 {
+		m.Color = src.Color
+	}
+	if src.Kind != 0 {
+		m.Kind = src.Kind
+	}
+	if src.State != 0 {
+		m.State = src.State
+	}
+	m.History = append(m.History, src.History...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Account) MergeMessageVT(src any) bool {
+	s, ok := src.(*Account)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Account) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+func (m *Account) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Status)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Color)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Kind)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.State)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.History)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (x Status) MarshalProtoText() string {
+	return x.String()
+}
+func (x Color) MarshalProtoText() string {
+	return x.String()
+}
+func (x Kind) MarshalProtoText() string {
+	return x.String()
+}
+func (x Account_State) MarshalProtoText() string {
+	return x.String()
+}
+func (x *Account) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Account")
+	if x.Status != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "status")
+		protobuf_go_lite.TextWriteStringer(&sb, Status(x.Status))
+	}
+	if x.Color != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "color")
+		protobuf_go_lite.TextWriteStringer(&sb, Color(x.Color))
+	}
+	if x.Kind != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "kind")
+		protobuf_go_lite.TextWriteStringer(&sb, Kind(x.Kind))
+	}
+	if x.State != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "state")
+		protobuf_go_lite.TextWriteStringer(&sb, Account_State(x.State))
+	}
+	if len(x.History) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "history")
+		for i, v := range x.History {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStringer(&sb, Status(v))
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Account) String() string {
+	return x.MarshalProtoText()
+}
+func (m *Account) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Status = Status(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			m.Color = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Color = Color(_v)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Kind = Kind(_v)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.State = Account_State(_v)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType == 0 {
+				var v Status
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Status(_v)
+				if err != nil {
+					return err
+				}
+				m.History = append(m.History, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.History) == 0 {
+					m.History = make([]Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Status
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Status(_v)
+					if err != nil {
+						return err
+					}
+					m.History = append(m.History, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Account) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Status = Status(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			m.Color = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Color = Color(_v)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Kind = Kind(_v)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.State = Account_State(_v)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType == 0 {
+				var v Status
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Status(_v)
+				if err != nil {
+					return err
+				}
+				m.History = append(m.History, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.History) == 0 {
+					m.History = make([]Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Status
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Status(_v)
+					if err != nil {
+						return err
+					}
+					m.History = append(m.History, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Account) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Status = Status(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			m.Color = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Color = Color(_v)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Kind = Kind(_v)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.State = Account_State(_v)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType == 0 {
+				var v Status
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Status(_v)
+				if err != nil {
+					return err
+				}
+				m.History = append(m.History, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.History) == 0 {
+					m.History = make([]Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Status
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Status(_v)
+					if err != nil {
+						return err
+					}
+					m.History = append(m.History, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Account) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Status = Status(_v)
+			if err != nil {
+				return err
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			m.Color = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Color = Color(_v)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Kind = Kind(_v)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.State = Account_State(_v)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType == 0 {
+				var v Status
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Status(_v)
+				if err != nil {
+					return err
+				}
+				m.History = append(m.History, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.History) == 0 {
+					m.History = make([]Status, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Status
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Status(_v)
+					if err != nil {
+						return err
+					}
+					m.History = append(m.History, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package enumprefix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+func TestEnumPrefixNames(t *testing.T) {
+	for _, tc := range []struct {
+		value fmt.Stringer
+		want  string
+	}{
+		{Status_ACTIVE, "STATUS_ACTIVE"},
+		{RED, "COLOR_RED"},
+		{Kind_KIND_B, "KIND_B"},
+		{CLOSED, "STATE_CLOSED"},
+		{LEVEL_HIGH, "LEVEL_HIGH"},
+	} {
+		if got := tc.value.String(); got != tc.want {
+			t.Errorf("String() = %q, want %q", got, tc.want)
+		}
+	}
+	if Status_value["STATUS_SUSPENDED"] != int32(Status_SUSPENDED) || Status_name[int32(Status_ACTIVE)] != "STATUS_ACTIVE" {
+		t.Fatal("the value maps do not use the proto value names")
+	}
+	if Color_value["COLOR_GREEN"] != int32(GREEN) || Account_State_name[int32(OPEN)] != "STATE_OPEN" {
+		t.Fatal("the value maps do not use the proto value names")
+	}
+}
+
+func TestEnumPrefixEncoding(t *testing.T) {
+	m := &Account{
+		Status:  Status_SUSPENDED,
+		Color:   GREEN,
+		Kind:    Kind_KIND_B,
+		State:   CLOSED,
+		History: []Status{Status_UNSPECIFIED, Status_ACTIVE},
+	}
+
+	b, err := json.MarshalerConfig{}.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"status":"STATUS_SUSPENDED","color":"COLOR_GREEN","kind":"KIND_B","state":"STATE_CLOSED","history":["STATUS_UNSPECIFIED","STATUS_ACTIVE"]}`
+	if string(b) != want {
+		t.Fatalf("Marshal() = %s, want %s", b, want)
+	}
+	var decoded Account
+	if err := decoded.UnmarshalJSON(b); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(m) {
+		t.Fatalf("UnmarshalJSON(%s) = %v, want %v", b, &decoded, m)
+	}
+
+	if text := m.MarshalProtoText(); !strings.Contains(text, "STATE_CLOSED") || !strings.Contains(text, "COLOR_GREEN") {
+		t.Fatalf("MarshalProtoText() = %s", text)
+	}
+
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded = Account{}
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(m) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, m)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/enumprefix/level.proto
+
+package enumprefix
+
+import (
+	strconv "strconv"
+
+	_ "github.com/aperturerobotics/protobuf-go-lite/golite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+// Level drops the type prefix set for the file, generating LEVEL_LOW.
+type Level int32
+
+const (
+	LEVEL_LOW  Level = 0
+	LEVEL_HIGH Level = 1
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_LOW",
+		1: "LEVEL_HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_LOW":  0,
+		"LEVEL_HIGH": 1,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	name, valid := Level_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+// MarshalProtoJSON marshals the Level to JSON.
+func (x Level) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Level_name)
+}
+
+// MarshalText marshals the Level to text.
+func (x Level) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Level_name)), nil
+}
+
+// MarshalJSON marshals the Level to JSON.
+func (x Level) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Level from JSON.
+func (x *Level) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Level_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Level enum: %v", err)
+		return
+	}
+	*x = Level(v)
+}
+
+// UnmarshalText unmarshals the Level from text.
+func (x *Level) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Level_value)
+	if err != nil {
+		return err
+	}
+	*x = Level(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Level from JSON.
+func (x *Level) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (x Level) MarshalProtoText() string {
+	return x.String()
+}
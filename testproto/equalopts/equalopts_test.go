@@ -0,0 +1,146 @@
+package equalopts
+
+import (
+	"math"
+	"testing"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+)
+
+func TestEqualVTOptsZeroMatchesEqualVT(t *testing.T) {
+	nan := math.NaN()
+	for name, pair := range map[string][2]*Tree{
+		"equal":       {{Name: "a", Leaf: &Leaf{Count: 1}}, {Name: "a", Leaf: &Leaf{Count: 1}}},
+		"nil tree":    {nil, {}},
+		"nil leaf":    {{Leaf: &Leaf{}}, {}},
+		"nil element": {{Leaves: []*Leaf{nil}}, {Leaves: []*Leaf{{}}}},
+		"nil value":   {{ByName: map[string]*Leaf{"a": nil}}, {ByName: map[string]*Leaf{"a": {}}}},
+		"nil node":    {{Choice: &Tree_Node{}}, {Choice: &Tree_Node{Node: &Leaf{}}}},
+		"nan":         {{Weight: nan}, {Weight: nan}},
+		"zero sign":   {{Weight: math.Copysign(0, -1)}, {}},
+		"oneof":       {{Choice: &Tree_Text{}}, {}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b := pair[0], pair[1]
+			if got, want := a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}), a.EqualVT(b); got != want {
+				t.Fatalf("EqualVTOpts with no options is %v, EqualVT is %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEqualVTOptsEmptyMessages(t *testing.T) {
+	opts := protobuf_go_lite.EqualOptions{EmptyMessages: protobuf_go_lite.NilEqualsEmpty}
+	var nilTree *Tree
+	for name, pair := range map[string][2]*Tree{
+		"nil tree":    {nilTree, {}},
+		"tree nil":    {{}, nilTree},
+		"nil leaf":    {{Leaf: &Leaf{}}, {}},
+		"nested leaf": {{Choice: &Tree_Node{Node: &Leaf{}}}, {Choice: &Tree_Node{}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b := pair[0], pair[1]
+			if !a.EqualVTOpts(b, opts) {
+				t.Fatal("nil and empty messages differ under NilEqualsEmpty")
+			}
+			if name != "nested leaf" && a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}) {
+				t.Fatal("nil and empty messages are equal under NilDiffersFromEmpty")
+			}
+		})
+	}
+
+	// A nil message still differs from a non-empty one, and an unset oneof
+	// from a set one.
+	if (&Tree{Leaf: &Leaf{Count: 1}}).EqualVTOpts(&Tree{}, opts) {
+		t.Fatal("a nil sub-message equals a non-empty one")
+	}
+	if nilTree.EqualVTOpts(&Tree{Name: "a"}, opts) {
+		t.Fatal("a nil message equals a non-empty one")
+	}
+	if (&Tree{Choice: &Tree_Node{}}).EqualVTOpts(&Tree{}, opts) {
+		t.Fatal("a set oneof equals an unset one")
+	}
+}
+
+func TestEqualVTOptsIgnoreFields(t *testing.T) {
+	a := &Tree{
+		Name:   "a",
+		Leaf:   &Leaf{Name: "leaf", Count: 1},
+		Leaves: []*Leaf{{Count: 1}},
+		ByName: map[string]*Leaf{"x": {Name: "x", Count: 1}, "y": {Count: 2}},
+		Choice: &Tree_Node{Node: &Leaf{Name: "a"}},
+	}
+	b := &Tree{
+		Name:   "b",
+		Leaf:   &Leaf{Name: "leaf", Count: 2},
+		Leaves: []*Leaf{{Count: 2}},
+		ByName: map[string]*Leaf{"x": {Name: "x", Count: 3}, "y": {Count: 4}},
+		Choice: &Tree_Node{Node: &Leaf{Name: "b"}},
+	}
+	ignore := []string{"name", "leaf.count", "leaves.count", "by_name.count", "node.name"}
+	if !a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{IgnoreFields: ignore}) {
+		t.Fatal("messages differing in ignored fields are not equal")
+	}
+	for i := range ignore {
+		partial := append(append([]string(nil), ignore[:i]...), ignore[i+1:]...)
+		if a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{IgnoreFields: partial}) {
+			t.Fatalf("messages are equal without ignoring %s", ignore[i])
+		}
+	}
+
+	// The map keys are still compared when the values are ignored.
+	b.ByName["z"] = &Leaf{}
+	if a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{IgnoreFields: ignore}) {
+		t.Fatal("maps with different keys are equal")
+	}
+
+	// Ignoring one oneof case does not hide a switch to another one.
+	text := &Tree{Choice: &Tree_Text{Text: "a"}}
+	opts := protobuf_go_lite.EqualOptions{IgnoreFields: []string{"text"}}
+	if !text.EqualVTOpts(&Tree{Choice: &Tree_Text{Text: "b"}}, opts) {
+		t.Fatal("messages differing in an ignored oneof case are not equal")
+	}
+	if text.EqualVTOpts(&Tree{Choice: &Tree_Node{}}, opts) {
+		t.Fatal("a switch to another oneof case is ignored")
+	}
+}
+
+func TestEqualVTOptsFloats(t *testing.T) {
+	nan := math.NaN()
+	for _, tc := range []struct {
+		name string
+		a, b float64
+		opts protobuf_go_lite.EqualOptions
+		want bool
+	}{
+		{"nan", nan, nan, protobuf_go_lite.EqualOptions{}, false},
+		{"nan equal", nan, nan, protobuf_go_lite.EqualOptions{NaNEqual: true}, true},
+		{"nan value", nan, 1, protobuf_go_lite.EqualOptions{NaNEqual: true, FloatEpsilon: math.Inf(1)}, false},
+		{"zero sign", math.Copysign(0, -1), 0, protobuf_go_lite.EqualOptions{}, true},
+		{"within epsilon", 1, 1.005, protobuf_go_lite.EqualOptions{FloatEpsilon: 0.01}, true},
+		{"outside epsilon", 1, 1.02, protobuf_go_lite.EqualOptions{FloatEpsilon: 0.01}, false},
+		{"infinity", math.Inf(1), math.Inf(1), protobuf_go_lite.EqualOptions{}, true},
+		{"infinities", math.Inf(1), math.Inf(-1), protobuf_go_lite.EqualOptions{FloatEpsilon: 1}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := &Tree{Weight: tc.a}, &Tree{Weight: tc.b}
+			if got := a.EqualVTOpts(b, tc.opts); got != tc.want {
+				t.Fatalf("EqualVTOpts(%v, %v) is %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEqualVTOptsIgnoreUnknown(t *testing.T) {
+	// Field 99 is not declared by Tree, so it is kept as an unknown field.
+	a := &Tree{}
+	if err := a.UnmarshalVT([]byte{0x98, 0x06, 0x01}); err != nil {
+		t.Fatal(err)
+	}
+	if a.EqualVTOpts(&Tree{}, protobuf_go_lite.EqualOptions{}) {
+		t.Fatal("messages with different unknown fields are equal")
+	}
+	if !a.EqualVTOpts(&Tree{}, protobuf_go_lite.EqualOptions{IgnoreUnknown: true}) {
+		t.Fatal("unknown fields are compared under IgnoreUnknown")
+	}
+}
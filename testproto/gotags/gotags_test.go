@@ -0,0 +1,83 @@
+package gotags
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGoTags(t *testing.T) {
+	for _, tc := range []struct {
+		typ   reflect.Type
+		field string
+		key   string
+		want  string
+	}{
+		{reflect.TypeFor[User](), "ID", "db", "id"},
+		{reflect.TypeFor[User](), "ID", "yaml", "id"},
+		{reflect.TypeFor[User](), "ID", "json", "userId,omitempty"},
+		{reflect.TypeFor[User](), "DisplayName", "db", "display_name"},
+		{reflect.TypeFor[User](), "DisplayName", "yaml", "displayName,omitempty"},
+		{reflect.TypeFor[User](), "PasswordHash", "json", "-"},
+		{reflect.TypeFor[User_Phone](), "Phone", "db", "phone"},
+		{reflect.TypeFor[Group](), "Members", "validate", "dive"},
+	} {
+		f, ok := tc.typ.FieldByName(tc.field)
+		if !ok {
+			t.Fatalf("%v has no field %s", tc.typ, tc.field)
+		}
+		if got := f.Tag.Get(tc.key); got != tc.want {
+			t.Errorf("%v.%s tag %s = %q, want %q", tc.typ, tc.field, tc.key, got, tc.want)
+		}
+	}
+
+	// encoding/json honors the replaced json tag. Embedding User by value hides
+	// its MarshalJSON method, which has a pointer receiver.
+	b, err := json.Marshal(struct{ User }{User{ID: "u", PasswordHash: "secret"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") || !strings.Contains(string(b), `"userId":"u"`) {
+		t.Fatalf("json.Marshal() = %s", b)
+	}
+}
+
+func TestGoNames(t *testing.T) {
+	m := &User{
+		ID:      "u",
+		Email:   "u@example.com",
+		Home:    &Location{Kind: Location_KIND_HOME, Point: &Location_Point{Lat: 1}},
+		Contact: &User_Phone{Phone: "555"},
+		Labels:  map[string]string{"a": "b"},
+	}
+	if m.GetID() != "u" || m.GetEmail() != "u@example.com" || m.GetPhone() != "555" || m.GetHome().GetKind() != Location_KIND_HOME {
+		t.Fatalf("getters of %v", m)
+	}
+
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded User
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(m) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, m)
+	}
+
+	// The protobuf JSON names are those of the proto fields.
+	b, err := (&User{ID: "u", Email: "e", Contact: &User_Phone{Phone: "555"}}).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"userId":"u","emailAddress":"e","phoneNumber":"555"}`; got != want {
+		t.Fatalf("MarshalJSON() = %s, want %s", got, want)
+	}
+
+	g := &Group{Members: []*User{m}, Center: &Location_Point{Lng: 2}}
+	if !g.CloneVT().EqualVT(g) {
+		t.Fatal("CloneVT() of the renamed message differs")
+	}
+}
@@ -0,0 +1,122 @@
+package hash
+
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+)
+
+func TestHash64VTEqualMessages(t *testing.T) {
+	for name, pair := range map[string][2]*Tree{
+		"empty":     {{Data: []byte{}, Ids: []int32{}, Leaves: []*Leaf{}, ByName: map[string]*Leaf{}, Labels: map[int32]string{}}, {}},
+		"zero sign": {{Weight: math.Copysign(0, -1)}, {}},
+		"leaf":      {{Leaf: &Leaf{Name: "a"}}, {Leaf: &Leaf{Name: "a"}}},
+		"node":      {{Choice: &Tree_Node{Node: &Leaf{Count: 1}}}, {Choice: &Tree_Node{Node: &Leaf{Count: 1}}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b := pair[0], pair[1]
+			if !a.EqualVT(b) {
+				t.Fatal("the messages are not equal")
+			}
+			if a.Hash64VT() != b.Hash64VT() {
+				t.Fatal("equal messages hash differently")
+			}
+		})
+	}
+}
+
+func TestHash64VTNil(t *testing.T) {
+	var nilTree *Tree
+	if nilTree.Hash64VT() != (&Tree{}).Hash64VT() {
+		t.Fatal("a nil message hashes differently from an empty one")
+	}
+	// A nil sub-message is unset, unlike an empty one.
+	if (&Tree{Leaf: &Leaf{}}).Hash64VT() == (&Tree{}).Hash64VT() {
+		t.Fatal("an empty sub-message hashes like an unset one")
+	}
+}
+
+func TestHash64VTMapOrder(t *testing.T) {
+	// Maps filled in opposite orders iterate differently, which must not
+	// change the hash.
+	a := &Tree{ByName: map[string]*Leaf{}, Labels: map[int32]string{}}
+	b := &Tree{ByName: map[string]*Leaf{}, Labels: map[int32]string{}}
+	for i := range int32(100) {
+		a.ByName[string(rune('a'+i))] = &Leaf{Count: i}
+		a.Labels[i] = string(rune('a' + i))
+		j := 99 - i
+		b.ByName[string(rune('a'+j))] = &Leaf{Count: j}
+		b.Labels[j] = string(rune('a' + j))
+	}
+	want := a.Hash64VT()
+	for range 20 {
+		if a.Hash64VT() != want || b.Hash64VT() != want {
+			t.Fatal("the hash depends on the map order")
+		}
+	}
+
+	// Swapping the values of two keys changes the hash.
+	b.Labels[0], b.Labels[1] = b.Labels[1], b.Labels[0]
+	if b.Hash64VT() == want {
+		t.Fatal("swapping two map values kept the hash")
+	}
+}
+
+func TestHash64VTUnknownOrder(t *testing.T) {
+	// Fields 99 and 100 are not declared by Tree, so they are kept as unknown
+	// fields.
+	a, b := &Tree{}, &Tree{}
+	if err := a.UnmarshalVT([]byte{0x98, 0x06, 0x01, 0xa0, 0x06, 0x02}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.UnmarshalVT([]byte{0xa0, 0x06, 0x02, 0x98, 0x06, 0x01}); err != nil {
+		t.Fatal(err)
+	}
+	if a.Hash64VT() != b.Hash64VT() {
+		t.Fatal("the hash depends on the order of the unknown fields")
+	}
+	if a.Hash64VT() == (&Tree{}).Hash64VT() {
+		t.Fatal("unknown fields do not change the hash")
+	}
+}
+
+func TestHash64VTNaN(t *testing.T) {
+	m := &Tree{Weight: math.NaN(), Labels: map[int32]string{1: "a"}}
+	want := m.Hash64VT()
+	for range 5 {
+		if m.Hash64VT() != want {
+			t.Fatal("hashing a NaN value is not deterministic")
+		}
+	}
+	if want == (&Tree{Labels: map[int32]string{1: "a"}}).Hash64VT() {
+		t.Fatal("a NaN value hashes like an unset one")
+	}
+}
+
+func TestHash64VTDistinguishes(t *testing.T) {
+	for name, pair := range map[string][2]*Tree{
+		"unset oneof":  {{Choice: &Tree_Text{}}, {}},
+		"oneof case":   {{Choice: &Tree_Text{}}, {Choice: &Tree_Node{}}},
+		"field number": {{Name: "a"}, {Choice: &Tree_Text{Text: "a"}}},
+		"list order":   {{Ids: []int32{1, 2}}, {Ids: []int32{2, 1}}},
+		"list split":   {{Leaves: []*Leaf{{Name: "ab"}}}, {Leaves: []*Leaf{{Name: "a"}, {Name: "b"}}}},
+		"string split": {{Name: "ab"}, {Name: "a", Leaf: &Leaf{Name: "b"}}},
+		"zero level":   {{Level: new(int32)}, {}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a, b := pair[0], pair[1]
+			if a.Hash64VT() == b.Hash64VT() {
+				t.Fatal("different messages hash the same")
+			}
+		})
+	}
+}
+
+func TestHashVTMatchesHash64VT(t *testing.T) {
+	m := &Tree{Name: "a", ByName: map[string]*Leaf{"b": {Count: 1}}, Choice: &Tree_Node{}}
+	h := fnv.New64a()
+	m.HashVT(h)
+	if h.Sum64() != m.Hash64VT() {
+		t.Fatal("HashVT with FNV-1a differs from Hash64VT")
+	}
+}
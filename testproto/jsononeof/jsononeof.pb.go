@@ -5,7 +5,11 @@
 package jsononeof
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	slices "slices"
 
@@ -26,6 +30,29 @@ func (x *Child) Reset() {
 
 func (*Child) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Child) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Child) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Child) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Child) GetValue() string {
 	if x != nil {
 		return x.Value
@@ -63,6 +90,34 @@ func (x *Interleaved) Reset() {
 
 func (*Interleaved) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Interleaved) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Interleaved) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Interleaved) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	if v, ok := x.Choice.(*Interleaved_ChildValue); ok {
+		v.ChildValue.DiscardUnknownVT()
+	}
+	x.BetweenMessage.DiscardUnknownVT()
+	x.AfterMessage.DiscardUnknownVT()
+}
+
 func (x *Interleaved) GetBefore() string {
 	if x != nil {
 		return x.Before
@@ -210,6 +265,209 @@ func (m *Interleaved_ChildValue) CloneOneofVT() isInterleaved_Choice {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Child) CompareVT(that *Child) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Value, that.Value); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Interleaved) CompareVT(that *Interleaved) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := cmp.Compare(m.Before, that.Before); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*Interleaved_Text)
+		b, bok := that.Choice.(*Interleaved_Text)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.Text, b.Text); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := m.BetweenMessage.CompareVT(that.BetweenMessage); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.BetweenScalar, that.BetweenScalar); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*Interleaved_ChildValue)
+		b, bok := that.Choice.(*Interleaved_ChildValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ChildValue, b.ChildValue, (*Child).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := cmp.Compare(m.After, that.After); c != 0 {
+		return c
+	}
+	if c := m.AfterMessage.CompareVT(that.AfterMessage); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalZero, that.OptionalZero); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Child) CopyVT(dst *Child) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Value = m.Value
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Interleaved) CopyVT(dst *Interleaved) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Before = m.Before
+	switch v := m.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *Interleaved_Text:
+		d, ok := dst.Choice.(*Interleaved_Text)
+		if !ok {
+			d = &Interleaved_Text{}
+			dst.Choice = d
+		}
+		d.Text = v.Text
+	case *Interleaved_ChildValue:
+		d, ok := dst.Choice.(*Interleaved_ChildValue)
+		if !ok {
+			d = &Interleaved_ChildValue{}
+			dst.Choice = d
+		}
+		d.ChildValue = protobuf_go_lite.CopyVTValue(d.ChildValue, v.ChildValue, (*Child).CopyVT)
+	}
+	dst.BetweenMessage = protobuf_go_lite.CopyVTValue(dst.BetweenMessage, m.BetweenMessage, (*Child).CopyVT)
+	dst.BetweenScalar = m.BetweenScalar
+	dst.After = m.After
+	dst.AfterMessage = protobuf_go_lite.CopyVTValue(dst.AfterMessage, m.AfterMessage, (*Child).CopyVT)
+	dst.OptionalZero = protobuf_go_lite.CopyPtr(dst.OptionalZero, m.OptionalZero)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Child) DiffVT(that *Child) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Child) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Child) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Child{}
+	}
+	if that == nil {
+		that = &Child{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "value", m.Value, that.Value)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Interleaved) DiffVT(that *Interleaved) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Interleaved) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Interleaved) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Interleaved{}
+	}
+	if that == nil {
+		that = &Interleaved{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "before", m.Before, that.Before)
+	{
+		var a, b *string
+		if v, ok := m.Choice.(*Interleaved_Text); ok {
+			a = &v.Text
+		}
+		if v, ok := that.Choice.(*Interleaved_Text); ok {
+			b = &v.Text
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "text", a, b)
+	}
+	{
+		var a, b *Child
+		if v, ok := m.Choice.(*Interleaved_ChildValue); ok {
+			a = v.ChildValue
+		}
+		if v, ok := that.Choice.(*Interleaved_ChildValue); ok {
+			b = v.ChildValue
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "child_value", a, b, (*Child).AppendDiffVT)
+	}
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "between_message", m.BetweenMessage, that.BetweenMessage, (*Child).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "between_scalar", m.BetweenScalar, that.BetweenScalar)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "after", m.After, that.After)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "after_message", m.AfterMessage, that.AfterMessage, (*Child).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_zero", m.OptionalZero, that.OptionalZero)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *Child) EqualVT(that *Child) bool {
 	if this == that {
 		return true
@@ -309,6 +567,177 @@ func (this *Interleaved_ChildValue) EqualVT(thatIface isInterleaved_Choice) bool
 	return true
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Child) EqualVTOpts(that *Child, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Child) EqualVTOptsPrefix(that *Child, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Child{}
+		}
+		if that == nil {
+			that = &Child{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "value"); ok && this.Value != that.Value {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Interleaved) EqualVTOpts(that *Interleaved, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Interleaved) EqualVTOptsPrefix(that *Interleaved, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Interleaved{}
+		}
+		if that == nil {
+			that = &Interleaved{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "before"); ok && this.Before != that.Before {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "text"); ok {
+		a, aok := this.Choice.(*Interleaved_Text)
+		b, bok := that.Choice.(*Interleaved_Text)
+		if aok != bok || aok && a.Text != b.Text {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "between_message"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BetweenMessage, that.BetweenMessage, opts, path, (*Child).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "between_scalar"); ok && this.BetweenScalar != that.BetweenScalar {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "child_value"); ok {
+		a, aok := this.Choice.(*Interleaved_ChildValue)
+		b, bok := that.Choice.(*Interleaved_ChildValue)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ChildValue, b.ChildValue, opts, path, (*Child).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "after"); ok && this.After != that.After {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "after_message"); ok && !protobuf_go_lite.EqualVTOptsValue(this.AfterMessage, that.AfterMessage, opts, path, (*Child).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_zero"); ok && ((this.OptionalZero == nil) != (that.OptionalZero == nil) || this.OptionalZero != nil && *this.OptionalZero != *that.OptionalZero) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Child) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Child) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Child) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Value != "" {
+			w.Field(1)
+			w.String(m.Value)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Interleaved) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Interleaved) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Interleaved) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Before != "" {
+			w.Field(1)
+			w.String(m.Before)
+		}
+		switch v := m.Choice.(type) {
+		case *Interleaved_Text:
+			w.Field(2)
+			w.String(v.Text)
+		case *Interleaved_ChildValue:
+			w.Field(5)
+			v.ChildValue.WriteHashVT(w)
+		}
+		if v := m.BetweenMessage; v != nil {
+			w.Field(3)
+			v.WriteHashVT(w)
+		}
+		if m.BetweenScalar != 0 {
+			w.Field(4)
+			w.Uint64(uint64(m.BetweenScalar))
+		}
+		if m.After != "" {
+			w.Field(6)
+			w.String(m.After)
+		}
+		if v := m.AfterMessage; v != nil {
+			w.Field(7)
+			v.WriteHashVT(w)
+		}
+		if m.OptionalZero != nil {
+			w.Field(8)
+			w.Uint64(uint64(*m.OptionalZero))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 // MarshalProtoJSON marshals the Child message to JSON.
 func (x *Child) MarshalProtoJSON(s *json.MarshalState) {
 	if x == nil {
@@ -781,6 +1210,107 @@ func (m *Interleaved_ChildValue) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Child) MergeVT(src *Child) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Value != "" {
+		m.Value = src.Value
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Child) MergeMessageVT(src any) bool {
+	s, ok := src.(*Child)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Interleaved) MergeVT(src *Interleaved) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Before != "" {
+		m.Before = src.Before
+	}
+	switch v := src.Choice.(type) {
+	case *Interleaved_Text:
+		m.Choice = &Interleaved_Text{Text: v.Text}
+	case *Interleaved_ChildValue:
+		if cur, ok := m.Choice.(*Interleaved_ChildValue); ok && cur.ChildValue != nil {
+			cur.ChildValue.MergeVT(v.ChildValue)
+		} else {
+			e := new(Child)
+			e.MergeVT(v.ChildValue)
+			m.Choice = &Interleaved_ChildValue{ChildValue: e}
+		}
+	}
+	if src.BetweenMessage != nil {
+		if m.BetweenMessage == nil {
+			m.BetweenMessage = new(Child)
+		}
+		m.BetweenMessage.MergeVT(src.BetweenMessage)
+	}
+	if src.BetweenScalar != 0 {
+		m.BetweenScalar = src.BetweenScalar
+	}
+	if src.After != "" {
+		m.After = src.After
+	}
+	if src.AfterMessage != nil {
+		if m.AfterMessage == nil {
+			m.AfterMessage = new(Child)
+		}
+		m.AfterMessage.MergeVT(src.AfterMessage)
+	}
+	if src.OptionalZero != nil {
+		v := *src.OptionalZero
+		m.OptionalZero = &v
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Interleaved) MergeMessageVT(src any) bool {
+	s, ok := src.(*Interleaved)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Child) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Interleaved) RedactVT() {
+	if m == nil {
+		return
+	}
+	switch v := m.Choice.(type) {
+	case *Interleaved_ChildValue:
+		v.ChildValue.RedactVT()
+	}
+	m.BetweenMessage.RedactVT()
+	m.AfterMessage.RedactVT()
+}
+
 func (m *Child) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1091,6 +1621,437 @@ func (m *Interleaved) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Child) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Child: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Child: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Value = v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Interleaved) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Interleaved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Interleaved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Before = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Interleaved_Text{Text: v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetweenMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BetweenMessage == nil {
+				m.BetweenMessage = &Child{}
+			}
+			if err := m.BetweenMessage.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetweenScalar", wireType)
+			}
+			m.BetweenScalar = 0
+			m.BetweenScalar, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Interleaved_ChildValue); ok {
+				if err := oneof.ChildValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Child{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Interleaved_ChildValue{ChildValue: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.After = v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.AfterMessage == nil {
+				m.AfterMessage = &Child{}
+			}
+			if err := m.AfterMessage.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalZero", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalZero = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Child) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Child: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Child: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Value = v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Interleaved) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Interleaved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Interleaved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Before = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &Interleaved_Text{Text: v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetweenMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BetweenMessage == nil {
+				m.BetweenMessage = &Child{}
+			}
+			if err := m.BetweenMessage.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetweenScalar", wireType)
+			}
+			m.BetweenScalar = 0
+			m.BetweenScalar, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*Interleaved_ChildValue); ok {
+				if err := oneof.ChildValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Child{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &Interleaved_ChildValue{ChildValue: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.After = v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterMessage", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.AfterMessage == nil {
+				m.AfterMessage = &Child{}
+			}
+			if err := m.AfterMessage.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalZero", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalZero = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *Child) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,308 @@
+package lazy
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// lazyEnvelopeWire returns an envelope whose payload repeats the name field,
+// so re-encoding the decoded payload would not reproduce the input.
+func lazyEnvelopeWire() []byte {
+	return []byte{
+		0x0a, 0x02, 'i', 'd', // id
+		0x12, 0x06, // payload
+		0x0a, 0x01, 'a', // name: "a"
+		0x0a, 0x01, 'b', // name: "b"
+		0x1a, 0x03, 0x0a, 0x01, 'e', // eager { name: "e" }
+	}
+}
+
+func TestLazyMarshalVerbatim(t *testing.T) {
+	wire := lazyEnvelopeWire()
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(wire); err != nil {
+		t.Fatal(err)
+	}
+	if m.Payload != nil {
+		t.Fatal("lazy payload was decoded at unmarshal time")
+	}
+	if m.Eager == nil {
+		t.Fatal("eager payload was not decoded at unmarshal time")
+	}
+	if got := m.SizeVT(); got != len(wire) {
+		t.Fatalf("SizeVT() = %d, want %d", got, len(wire))
+	}
+	for name, marshal := range map[string]func() ([]byte, error){
+		"MarshalVT":       m.MarshalVT,
+		"MarshalVTStrict": m.MarshalVTStrict,
+	} {
+		out, err := marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, wire) {
+			t.Fatalf("%s() = %x, want verbatim %x", name, out, wire)
+		}
+	}
+
+	if got := m.GetPayload().GetName(); got != "b" {
+		t.Fatalf("GetPayload().GetName() = %q, want %q", got, "b")
+	}
+	m.GetPayload().Values = []int64{7}
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded LazyEnvelope
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.GetPayload(); got.GetName() != "b" || !slices.Equal(got.GetValues(), []int64{7}) {
+		t.Fatalf("decoded payload = %v, want mutation through getter to marshal", got)
+	}
+}
+
+func TestLazyUnmarshalUnsafe(t *testing.T) {
+	wire := lazyEnvelopeWire()
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVTUnsafe(wire); err != nil {
+		t.Fatal(err)
+	}
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, wire) {
+		t.Fatalf("MarshalVT() = %x, want verbatim %x", out, wire)
+	}
+	if got := m.GetPayload().GetName(); got != "b" {
+		t.Fatalf("GetPayload().GetName() = %q, want %q", got, "b")
+	}
+}
+
+func TestLazyMergesOccurrences(t *testing.T) {
+	wire := []byte{
+		0x12, 0x02, 0x10, 0x01, // payload { values: [1] }
+		0x12, 0x02, 0x10, 0x02, // payload { values: [2] }
+	}
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(wire); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetPayload().GetValues(); !slices.Equal(got, []int64{1, 2}) {
+		t.Fatalf("GetPayload().GetValues() = %v, want [1 2]", got)
+	}
+
+	// Occurrences after the first getter call merge into the decoded message.
+	if err := m.UnmarshalVT([]byte{0x12, 0x02, 0x10, 0x03}); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetPayload().GetValues(); !slices.Equal(got, []int64{1, 2, 3}) {
+		t.Fatalf("GetPayload().GetValues() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestLazyRejectsMalformedPayload(t *testing.T) {
+	// The payload holds a truncated varint field.
+	wire := []byte{0x12, 0x02, 0x10, 0x80}
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(wire); err == nil {
+		t.Fatal("expected malformed lazy payload to fail at unmarshal time")
+	}
+}
+
+func TestLazyAssignedFieldWins(t *testing.T) {
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(lazyEnvelopeWire()); err != nil {
+		t.Fatal(err)
+	}
+	m.Payload = &LazyPayload{Name: "assigned"}
+	if got := m.GetPayload().GetName(); got != "assigned" {
+		t.Fatalf("GetPayload().GetName() = %q, want %q", got, "assigned")
+	}
+
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded LazyEnvelope
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.GetPayload().GetName(); got != "assigned" {
+		t.Fatalf("decoded GetPayload().GetName() = %q, want %q", got, "assigned")
+	}
+}
+
+func TestLazyCloneEqual(t *testing.T) {
+	wire := lazyEnvelopeWire()
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(wire); err != nil {
+		t.Fatal(err)
+	}
+	clone := m.CloneVT()
+	out, err := clone.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, wire) {
+		t.Fatalf("clone MarshalVT() = %x, want verbatim %x", out, wire)
+	}
+
+	eager := &LazyEnvelope{
+		Id:      "id",
+		Payload: &LazyPayload{Name: "b"},
+		Eager:   &LazyPayload{Name: "e"},
+	}
+	if !m.EqualVT(eager) || !eager.EqualVT(clone) {
+		t.Fatal("lazy envelope should equal its decoded form")
+	}
+	eager.Payload.Name = "a"
+	if m.EqualVT(eager) {
+		t.Fatal("lazy envelope should differ from a different payload")
+	}
+
+	// Mutating the decoded clone must not affect the original.
+	clone.GetPayload().Name = "changed"
+	if got := m.GetPayload().GetName(); got != "b" {
+		t.Fatalf("original GetPayload().GetName() = %q after clone mutation", got)
+	}
+	decodedClone := m.CloneVT()
+	decodedClone.GetPayload().Name = "changed"
+	if got := m.GetPayload().GetName(); got != "b" {
+		t.Fatalf("original GetPayload().GetName() = %q after decoded clone mutation", got)
+	}
+}
+
+func TestLazyConcurrentGet(t *testing.T) {
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(lazyEnvelopeWire()); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	got := make([]*LazyPayload, 8)
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = m.GetPayload()
+		}()
+	}
+	wg.Wait()
+	for _, payload := range got {
+		if payload == nil || payload != got[0] {
+			t.Fatal("concurrent getters should return the same decoded payload")
+		}
+	}
+}
+
+func TestLazyDiscardUnknown(t *testing.T) {
+	// payload { name: "a", 99: 1 }
+	wire := []byte{0x12, 0x06, 0x0a, 0x01, 'a', 0x98, 0x06, 0x01}
+
+	var m LazyEnvelope
+	if err := m.UnmarshalVT(wire); err != nil {
+		t.Fatal(err)
+	}
+	m.DiscardUnknownVT()
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x12, 0x03, 0x0a, 0x01, 'a'}; !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+}
+
+func TestLazyMerge(t *testing.T) {
+	var dst, src LazyEnvelope
+	if err := dst.UnmarshalVT([]byte{0x12, 0x02, 0x10, 0x01}); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.UnmarshalVT([]byte{0x12, 0x05, 0x0a, 0x01, 'a', 0x10, 0x02}); err != nil {
+		t.Fatal(err)
+	}
+	dst.MergeVT(&src)
+	if got := dst.GetPayload(); got.GetName() != "a" || !slices.Equal(got.GetValues(), []int64{1, 2}) {
+		t.Fatalf("merged payload = %v, want name a and values [1 2]", got)
+	}
+	out, err := dst.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded LazyEnvelope
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(&dst) {
+		t.Fatal("merged lazy envelope did not round-trip")
+	}
+}
+
+func TestLazyDecodeError(t *testing.T) {
+	// The payload holds the name field with the varint wire type, which is
+	// well-formed but fails to decode.
+	wire := []byte{0x12, 0x02, 0x08, 0x01}
+	unmarshal := func() *LazyEnvelope {
+		var m LazyEnvelope
+		if err := m.UnmarshalVT(wire); err != nil {
+			t.Fatal(err)
+		}
+		return &m
+	}
+
+	m := unmarshal()
+	if _, err := m.GetPayloadErr(); err == nil {
+		t.Fatal("GetPayloadErr() returned no error for an invalid payload")
+	}
+	if m.GetPayload() != nil {
+		t.Fatal("GetPayload() returned a payload that failed to decode")
+	}
+	if out, err := m.MarshalVT(); err != nil || !bytes.Equal(out, wire) {
+		t.Fatalf("MarshalVT() = %x, %v, want verbatim %x", out, err, wire)
+	}
+	if _, err := m.MarshalJSON(); err == nil {
+		t.Fatal("MarshalJSON() returned no error for an invalid payload")
+	}
+	if got := m.MarshalProtoText(); !strings.Contains(got, "payload: [ERROR: ") {
+		t.Fatalf("MarshalProtoText() = %q, want the decode error", got)
+	}
+
+	// Pending bytes merge without decoding and keep failing.
+	var dst LazyEnvelope
+	dst.MergeVT(m)
+	if _, err := dst.GetPayloadErr(); err == nil {
+		t.Fatal("merged payload decoded without error")
+	}
+	if out, err := dst.MarshalVT(); err != nil || !bytes.Equal(out, wire) {
+		t.Fatalf("merged MarshalVT() = %x, %v, want verbatim %x", out, err, wire)
+	}
+
+	// Merging into a decoded payload reports the error in place of the value.
+	decoded := &LazyEnvelope{Payload: &LazyPayload{Name: "a"}}
+	decoded.MergeVT(m)
+	if _, err := decoded.GetPayloadErr(); err == nil {
+		t.Fatal("GetPayloadErr() after merging an invalid payload returned no error")
+	}
+	if _, err := decoded.MarshalVT(); err == nil {
+		t.Fatal("MarshalVT() after merging an invalid payload returned no error")
+	}
+
+	// RedactVT cannot redact the bytes, so it drops them and keeps the error.
+	m.RedactVT()
+	if _, err := m.GetPayloadErr(); err == nil {
+		t.Fatal("GetPayloadErr() after RedactVT returned no error")
+	}
+	if out, err := m.MarshalVT(); err == nil {
+		t.Fatalf("MarshalVT() after RedactVT = %x, want the decode error", out)
+	}
+}
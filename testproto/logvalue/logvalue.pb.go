@@ -0,0 +1,2850 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/logvalue/logvalue.proto
+
+package logvalue
+
+import (
+	cmp "cmp"
+	base64 "encoding/base64"
+	fmt "fmt"
+	io "io"
+	iter "iter"
+	slog "log/slog"
+	math "math"
+	slices "slices"
+	strconv "strconv"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+	timestamppb "github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_UNSPECIFIED Level = 0
+	Level_LEVEL_INFO        Level = 1
+	Level_LEVEL_ERROR       Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_INFO",
+		2: "LEVEL_ERROR",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_INFO":        1,
+		"LEVEL_ERROR":       2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	name, valid := Level_name[int32(x)]
+	if valid {
+		return name
+	}
+	return strconv.Itoa(int(x))
+}
+
+type Event struct {
+	unknownFields []byte
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Size          uint64                 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Ratio         float64                `protobuf:"fixed64,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Ok            bool                   `protobuf:"varint,5,opt,name=ok,proto3" json:"ok,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Level         Level                  `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	Retries       *int64                 `protobuf:"varint,8,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	Previous      *Level                 `protobuf:"varint,9,opt,name=previous,proto3,oneof" json:"previous,omitempty"`
+	Token         string                 `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Levels        []Level                `protobuf:"varint,12,rep,packed,name=levels,proto3" json:"levels,omitempty"`
+	Counts        map[string]int32       `protobuf:"bytes,13,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Flags         map[uint32]string      `protobuf:"bytes,14,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ById          map[int64]*Event       `protobuf:"bytes,15,rep,name=by_id,json=byId,proto3" json:"byId,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cause         *Event                 `protobuf:"bytes,16,opt,name=cause,proto3" json:"cause,omitempty"`
+	Children      []*Event               `protobuf:"bytes,17,rep,name=children,proto3" json:"children,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=at,proto3" json:"at,omitempty"`
+	// Types that are assignable to Target:
+	//
+	//	*Event_Host
+	//	*Event_Parent
+	//	*Event_Password
+	Target isEvent_Target `protobuf_oneof:"target"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+}
+
+func (*Event) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Event) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Event) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Event) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.ById {
+		v.DiscardUnknownVT()
+	}
+	x.Cause.DiscardUnknownVT()
+	for _, v := range x.Children {
+		v.DiscardUnknownVT()
+	}
+	x.At.DiscardUnknownVT()
+	if v, ok := x.Target.(*Event_Parent); ok {
+		v.Parent.DiscardUnknownVT()
+	}
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Event) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Event) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Event) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *Event) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetLevel() Level {
+	if x != nil {
+		return x.Level
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *Event) GetRetries() int64 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *Event) GetPrevious() Level {
+	if x != nil && x.Previous != nil {
+		return *x.Previous
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *Event) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Event) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Event) GetLevels() []Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *Event) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Event) GetFlags() map[uint32]string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Event) GetById() map[int64]*Event {
+	if x != nil {
+		return x.ById
+	}
+	return nil
+}
+
+func (x *Event) GetCause() *Event {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+func (x *Event) GetChildren() []*Event {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Event) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (m *Event) GetTarget() isEvent_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *Event) GetHost() string {
+	if x, ok := x.GetTarget().(*Event_Host); ok {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Event) GetParent() *Event {
+	if x, ok := x.GetTarget().(*Event_Parent); ok {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *Event) GetPassword() string {
+	if x, ok := x.GetTarget().(*Event_Password); ok {
+		return x.Password
+	}
+	return ""
+}
+
+type isEvent_Target interface {
+	isEvent_Target()
+}
+
+type Event_Host struct {
+	Host string `protobuf:"bytes,19,opt,name=host,proto3,oneof"`
+}
+
+type Event_Parent struct {
+	Parent *Event `protobuf:"bytes,20,opt,name=parent,proto3,oneof"`
+}
+
+type Event_Password struct {
+	Password string `protobuf:"bytes,21,opt,name=password,proto3,oneof"`
+}
+
+func (*Event_Host) isEvent_Target() {}
+
+func (*Event_Parent) isEvent_Target() {}
+
+func (*Event_Password) isEvent_Target() {}
+
+type Event_CountsEntry struct {
+	unknownFields []byte
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Event_CountsEntry) Reset() {
+	*x = Event_CountsEntry{}
+}
+
+func (*Event_CountsEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Event_CountsEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Event_CountsEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Event_CountsEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *Event_CountsEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Event_CountsEntry) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Event_FlagsEntry struct {
+	unknownFields []byte
+	Key           uint32 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Event_FlagsEntry) Reset() {
+	*x = Event_FlagsEntry{}
+}
+
+func (*Event_FlagsEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Event_FlagsEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Event_FlagsEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Event_FlagsEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *Event_FlagsEntry) GetKey() uint32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *Event_FlagsEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Event_ByIdEntry struct {
+	unknownFields []byte
+	Key           int64  `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Event `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Event_ByIdEntry) Reset() {
+	*x = Event_ByIdEntry{}
+}
+
+func (*Event_ByIdEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Event_ByIdEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Event_ByIdEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Event_ByIdEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *Event_ByIdEntry) GetKey() int64 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *Event_ByIdEntry) GetValue() *Event {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *Event) CloneVT() *Event {
+	if m == nil {
+		return (*Event)(nil)
+	}
+	r := new(Event)
+	r.Name = m.Name
+	r.Code = m.Code
+	r.Size = m.Size
+	r.Ratio = m.Ratio
+	r.Ok = m.Ok
+	r.Level = m.Level
+	r.Token = m.Token
+	r.Payload = protobuf_go_lite.CloneBytes(m.Payload)
+	r.Retries = protobuf_go_lite.ClonePtr(m.Retries)
+	r.Previous = protobuf_go_lite.ClonePtr(m.Previous)
+	r.Tags = protobuf_go_lite.CloneSlice(m.Tags)
+	r.Levels = protobuf_go_lite.CloneSlice(m.Levels)
+	r.Counts = protobuf_go_lite.CloneMap(m.Counts)
+	r.Flags = protobuf_go_lite.CloneMap(m.Flags)
+	r.ById = protobuf_go_lite.CloneVTMap(m.ById)
+	r.Cause = protobuf_go_lite.CloneVTValue(m.Cause)
+	r.Children = protobuf_go_lite.CloneVTSlice(m.Children)
+	r.At = protobuf_go_lite.CloneVTValue(m.At)
+	if m.Target != nil {
+		r.Target = m.Target.(interface{ CloneOneofVT() isEvent_Target }).CloneOneofVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *Event) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *Event_Host) CloneVT() *Event_Host {
+	if m == nil {
+		return (*Event_Host)(nil)
+	}
+	r := new(Event_Host)
+	r.Host = m.Host
+	return r
+}
+
+func (m *Event_Host) CloneOneofVT() isEvent_Target {
+	return m.CloneVT()
+}
+
+func (m *Event_Parent) CloneVT() *Event_Parent {
+	if m == nil {
+		return (*Event_Parent)(nil)
+	}
+	r := new(Event_Parent)
+	r.Parent = protobuf_go_lite.CloneVTValue(m.Parent)
+	return r
+}
+
+func (m *Event_Parent) CloneOneofVT() isEvent_Target {
+	return m.CloneVT()
+}
+
+func (m *Event_Password) CloneVT() *Event_Password {
+	if m == nil {
+		return (*Event_Password)(nil)
+	}
+	r := new(Event_Password)
+	r.Password = m.Password
+	return r
+}
+
+func (m *Event_Password) CloneOneofVT() isEvent_Target {
+	return m.CloneVT()
+}
+
+func (this *Event) EqualVT(that *Event) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Target == nil && that.Target != nil {
+		return false
+	} else if this.Target != nil {
+		if that.Target == nil {
+			return false
+		}
+		if !this.Target.(interface{ EqualVT(isEvent_Target) bool }).EqualVT(that.Target) {
+			return false
+		}
+	}
+	if this.Name != that.Name {
+		return false
+	}
+	if this.Code != that.Code {
+		return false
+	}
+	if this.Size != that.Size {
+		return false
+	}
+	if this.Ratio != that.Ratio {
+		return false
+	}
+	if this.Ok != that.Ok {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytes(this.Payload, that.Payload) {
+		return false
+	}
+	if this.Level != that.Level {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Retries, that.Retries) {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.Previous, that.Previous) {
+		return false
+	}
+	if this.Token != that.Token {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Tags, that.Tags) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.Levels, that.Levels) {
+		return false
+	}
+	if !protobuf_go_lite.EqualMap(this.Counts, that.Counts) {
+		return false
+	}
+	if !protobuf_go_lite.EqualMap(this.Flags, that.Flags) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.ById, that.ById, func() *Event { return &Event{} }) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Cause, that.Cause) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.Children, that.Children, func() *Event { return &Event{} }) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.At, that.At) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Event) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Event)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Event_Host) EqualVT(thatIface isEvent_Target) bool {
+	that, ok := thatIface.(*Event_Host)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.Host != that.Host {
+		return false
+	}
+	return true
+}
+
+func (this *Event_Parent) EqualVT(thatIface isEvent_Target) bool {
+	that, ok := thatIface.(*Event_Parent)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Parent, that.Parent, func() *Event { return &Event{} }) {
+		return false
+	}
+	return true
+}
+
+func (this *Event_Password) EqualVT(thatIface isEvent_Target) bool {
+	that, ok := thatIface.(*Event_Password)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.Password != that.Password {
+		return false
+	}
+	return true
+}
+
+// MarshalProtoJSON marshals the Level to JSON.
+func (x Level) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), Level_name)
+}
+
+// MarshalText marshals the Level to text.
+func (x Level) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), Level_name)), nil
+}
+
+// MarshalJSON marshals the Level to JSON.
+func (x Level) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Level from JSON.
+func (x *Level) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(Level_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Level enum: %v", err)
+		return
+	}
+	*x = Level(v)
+}
+
+// UnmarshalText unmarshals the Level from text.
+func (x *Level) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), Level_value)
+	if err != nil {
+		return err
+	}
+	*x = Level(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the Level from JSON.
+func (x *Level) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Event_CountsEntry message to JSON.
+func (x *Event_CountsEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != 0 || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteInt32(x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Event_CountsEntry to JSON.
+func (x *Event_CountsEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Event_CountsEntry message from JSON.
+func (x *Event_CountsEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			x.Value = s.ReadInt32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Event_CountsEntry from JSON.
+func (x *Event_CountsEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Event_FlagsEntry message to JSON.
+func (x *Event_FlagsEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != 0 || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteUint32(x.Key)
+	}
+	if x.Value != "" || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteString(x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Event_FlagsEntry to JSON.
+func (x *Event_FlagsEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Event_FlagsEntry message from JSON.
+func (x *Event_FlagsEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadUint32()
+		case "value":
+			s.AddField("value")
+			x.Value = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Event_FlagsEntry from JSON.
+func (x *Event_FlagsEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Event_ByIdEntry message to JSON.
+func (x *Event_ByIdEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != 0 || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteInt64(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Event_ByIdEntry to JSON.
+func (x *Event_ByIdEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Event_ByIdEntry message from JSON.
+func (x *Event_ByIdEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadInt64()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &Event{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Event_ByIdEntry from JSON.
+func (x *Event_ByIdEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the Event message to JSON.
+func (x *Event) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != "" || s.HasField("name") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(x.Name)
+	}
+	if x.Code != 0 || s.HasField("code") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("code")
+		s.WriteInt32(x.Code)
+	}
+	if x.Size != 0 || s.HasField("size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("size")
+		s.WriteUint64(x.Size)
+	}
+	if x.Ratio != 0 || s.HasField("ratio") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ratio")
+		s.WriteFloat64(x.Ratio)
+	}
+	if x.Ok || s.HasField("ok") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ok")
+		s.WriteBool(x.Ok)
+	}
+	if len(x.Payload) > 0 || s.HasField("payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("payload")
+		s.WriteBytes(x.Payload)
+	}
+	if x.Level != 0 || s.HasField("level") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("level")
+		x.Level.MarshalProtoJSON(s)
+	}
+	if x.Retries != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("retries")
+		s.WriteInt64(*x.Retries)
+	}
+	if x.Previous != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("previous")
+		(*x.Previous).MarshalProtoJSON(s)
+	}
+	if x.Token != "" || s.HasField("token") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("token")
+		if s.Redact() {
+			s.WriteString(json.RedactedValue)
+		} else {
+			s.WriteString(x.Token)
+		}
+	}
+	if len(x.Tags) > 0 || s.HasField("tags") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tags")
+		s.WriteStringArray(x.Tags)
+	}
+	if len(x.Levels) > 0 || s.HasField("levels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("levels")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Levels {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Counts != nil || s.HasField("counts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("counts")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Counts {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteInt32(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Flags != nil || s.HasField("flags") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("flags")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Flags {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectUint32Field(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.ById != nil || s.HasField("byId") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("byId")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.ById {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectInt64Field(k)
+			v.MarshalProtoJSON(s.WithField("byId"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Cause != nil || s.HasField("cause") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("cause")
+		x.Cause.MarshalProtoJSON(s.WithField("cause"))
+	}
+	if len(x.Children) > 0 || s.HasField("children") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("children")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Children {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("children"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.At != nil || s.HasField("at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("at")
+		x.At.MarshalProtoJSON(s.WithField("at"))
+	}
+	if x.Target != nil {
+		switch ov := x.Target.(type) {
+		case *Event_Host:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("host")
+			s.WriteString(ov.Host)
+		case *Event_Parent:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("parent")
+			ov.Parent.MarshalProtoJSON(s.WithField("parent"))
+		case *Event_Password:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("password")
+			if s.Redact() {
+				s.WriteString(json.RedactedValue)
+			} else {
+				s.WriteString(ov.Password)
+			}
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the Event to JSON.
+func (x *Event) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the Event message from JSON.
+func (x *Event) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			x.Name = s.ReadString()
+		case "code":
+			s.AddField("code")
+			x.Code = s.ReadInt32()
+		case "size":
+			s.AddField("size")
+			x.Size = s.ReadUint64()
+		case "ratio":
+			s.AddField("ratio")
+			x.Ratio = s.ReadFloat64()
+		case "ok":
+			s.AddField("ok")
+			x.Ok = s.ReadBool()
+		case "payload":
+			s.AddField("payload")
+			x.Payload = s.ReadBytes()
+		case "level":
+			s.AddField("level")
+			x.Level.UnmarshalProtoJSON(s)
+		case "retries":
+			s.AddField("retries")
+			if s.ReadNil() {
+				x.Retries = nil
+				return
+			}
+			t := s.ReadInt64()
+			x.Retries = &t
+		case "previous":
+			s.AddField("previous")
+			if s.ReadNil() {
+				x.Previous = nil
+				return
+			}
+			var v Level
+			v.UnmarshalProtoJSON(s)
+			x.Previous = &v
+		case "token":
+			s.AddField("token")
+			x.Token = s.ReadString()
+		case "tags":
+			s.AddField("tags")
+			if s.ReadNil() {
+				x.Tags = nil
+				return
+			}
+			x.Tags = s.ReadStringArray()
+		case "levels":
+			s.AddField("levels")
+			if s.ReadNil() {
+				x.Levels = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v Level
+				v.UnmarshalProtoJSON(s)
+				x.Levels = append(x.Levels, v)
+			})
+		case "counts":
+			s.AddField("counts")
+			if s.ReadNil() {
+				x.Counts = nil
+				return
+			}
+			x.Counts = make(map[string]int32)
+			s.ReadStringMap(func(key string) {
+				x.Counts[key] = s.ReadInt32()
+			})
+		case "flags":
+			s.AddField("flags")
+			if s.ReadNil() {
+				x.Flags = nil
+				return
+			}
+			x.Flags = make(map[uint32]string)
+			s.ReadUint32Map(func(key uint32) {
+				x.Flags[key] = s.ReadString()
+			})
+		case "by_id", "byId":
+			s.AddField("by_id")
+			if s.ReadNil() {
+				x.ById = nil
+				return
+			}
+			x.ById = make(map[int64]*Event)
+			s.ReadInt64Map(func(key int64) {
+				var v Event
+				v.UnmarshalProtoJSON(s)
+				x.ById[key] = &v
+			})
+		case "cause":
+			if s.ReadNil() {
+				x.Cause = nil
+				return
+			}
+			x.Cause = &Event{}
+			x.Cause.UnmarshalProtoJSON(s.WithField("cause", true))
+		case "children":
+			s.AddField("children")
+			if s.ReadNil() {
+				x.Children = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Children = append(x.Children, nil)
+					return
+				}
+				v := &Event{}
+				v.UnmarshalProtoJSON(s.WithField("children", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Children = append(x.Children, v)
+			})
+		case "at":
+			if s.ReadNil() {
+				x.At = nil
+				return
+			}
+			x.At = &timestamppb.Timestamp{}
+			x.At.UnmarshalProtoJSON(s.WithField("at", true))
+		case "host":
+			s.AddField("host")
+			ov := &Event_Host{}
+			x.Target = ov
+			ov.Host = s.ReadString()
+		case "parent":
+			ov := &Event_Parent{}
+			x.Target = ov
+			if s.ReadNil() {
+				ov.Parent = nil
+				return
+			}
+			ov.Parent = &Event{}
+			ov.Parent.UnmarshalProtoJSON(s.WithField("parent", true))
+		case "password":
+			s.AddField("password")
+			ov := &Event_Password{}
+			x.Target = ov
+			ov.Password = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the Event from JSON.
+func (x *Event) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *Event) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Target.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.At != nil {
+		size, err := m.At.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Children[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Cause != nil {
+		size, err := m.Cause.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ById) > 0 {
+		for k := range m.ById {
+			v := m.ById[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeString(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Levels) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Levels)
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.Tags[iNdEx])
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Token) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Token)
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Previous != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Previous))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Retries != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Retries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Level != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Payload) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Payload)
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Ok {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, m.Ok)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ratio != 0 {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(m.Ratio))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Size != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Code != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Host) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Event_Host) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Host)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	return len(dAtA) - i, nil
+}
+func (m *Event_Parent) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Event_Parent) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Parent != nil {
+		size, err := m.Parent.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Password) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Event_Password) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Password)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	return len(dAtA) - i, nil
+}
+func (m *Event) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if msg, ok := m.Target.(*Event_Password); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Target.(*Event_Parent); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Target.(*Event_Host); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.At != nil {
+		size, err := m.At.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Children[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Cause != nil {
+		size, err := m.Cause.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ById) > 0 {
+		for k := range m.ById {
+			v := m.ById[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Flags) > 0 {
+		for k := range m.Flags {
+			v := m.Flags[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeString(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Levels) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.Levels)
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.Tags[iNdEx])
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Token) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Token)
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Previous != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Previous))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Retries != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.Retries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Level != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Payload) > 0 {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.Payload)
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Ok {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, m.Ok)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ratio != 0 {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(m.Ratio))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Size != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Code != 0 {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i = protobuf_go_lite.EncodeString(dAtA, i, m.Name)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event_Host) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Event_Host) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Host)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	return len(dAtA) - i, nil
+}
+func (m *Event_Parent) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Event_Parent) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Parent != nil {
+		size, err := m.Parent.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Event_Password) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Event_Password) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.Password)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	return len(dAtA) - i, nil
+}
+func (m *Event) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.Name)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Code)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Size)
+	n += protobuf_go_lite.SizeFixed64NonZero(1, m.Ratio)
+	n += protobuf_go_lite.SizeBoolNonZero(1, m.Ok)
+	n += protobuf_go_lite.SizeBytesNonEmpty(1, m.Payload)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Level)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Retries)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.Previous)
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.Token)
+	n += protobuf_go_lite.SizeStringSlice(1, m.Tags)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.Levels)
+	for k, v := range m.Counts {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeVarintValue(1, v)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	for k, v := range m.Flags {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeVarintValue(1, k) + protobuf_go_lite.SizeStringValue(1, v)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	for k, v := range m.ById {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeVarintValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	if m.Cause != nil {
+		l = m.Cause.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	for _, e := range m.Children {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	if m.At != nil {
+		l = m.At.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	if vtmsg, ok := m.Target.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Event_Host) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(2, m.Host)
+	return n
+}
+func (m *Event_Parent) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	} else {
+		n += 3
+	}
+	return n
+}
+func (m *Event_Password) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(2, m.Password)
+	return n
+}
+
+// LogValue implements slog.LogValuer, logging m as a group of its set fields
+// expanded to LogMaxDepth levels of sub-messages.
+func (m *Event) LogValue() slog.Value {
+	return m.LogValueVT(protobuf_go_lite.LogMaxDepth)
+}
+
+// LogValueVT returns m as a slog.GroupValue of its set fields keyed by field
+// name, expanding depth levels of sub-messages. Repeated and map fields are
+// truncated to LogMaxElements elements and fields marked debug_redact are
+// logged as LogRedacted.
+func (m *Event) LogValueVT(depth int) slog.Value {
+	if m == nil {
+		return slog.AnyValue(nil)
+	}
+	attrs := make([]slog.Attr, 0, 19)
+	if m.Name != "" {
+		attrs = append(attrs, slog.Attr{Key: "name", Value: slog.StringValue(m.Name)})
+	}
+	if m.Code != 0 {
+		attrs = append(attrs, slog.Attr{Key: "code", Value: slog.Int64Value(int64(m.Code))})
+	}
+	if m.Size != 0 {
+		attrs = append(attrs, slog.Attr{Key: "size", Value: slog.Uint64Value(m.Size)})
+	}
+	if m.Ratio != 0 {
+		attrs = append(attrs, slog.Attr{Key: "ratio", Value: slog.Float64Value(m.Ratio)})
+	}
+	if m.Ok {
+		attrs = append(attrs, slog.Attr{Key: "ok", Value: slog.BoolValue(m.Ok)})
+	}
+	if len(m.Payload) != 0 {
+		attrs = append(attrs, slog.Attr{Key: "payload", Value: slog.StringValue(base64.StdEncoding.EncodeToString(m.Payload))})
+	}
+	if m.Level != 0 {
+		attrs = append(attrs, slog.Attr{Key: "level", Value: slog.StringValue(m.Level.String())})
+	}
+	if m.Retries != nil {
+		attrs = append(attrs, slog.Attr{Key: "retries", Value: slog.Int64Value(*m.Retries)})
+	}
+	if m.Previous != nil {
+		attrs = append(attrs, slog.Attr{Key: "previous", Value: slog.StringValue(m.Previous.String())})
+	}
+	if m.Token != "" {
+		attrs = append(attrs, slog.Attr{Key: "token", Value: slog.StringValue(protobuf_go_lite.LogRedacted)})
+	}
+	if len(m.Tags) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "tags", Value: protobuf_go_lite.LogSlice(m.Tags, func(v string) slog.Value { return slog.StringValue(v) })})
+	}
+	if len(m.Levels) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "levels", Value: protobuf_go_lite.LogSlice(m.Levels, func(v Level) slog.Value { return slog.StringValue(v.String()) })})
+	}
+	if len(m.Counts) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "counts", Value: protobuf_go_lite.LogMap(m.Counts, cmp.Compare[string], func(v int32) slog.Value { return slog.Int64Value(int64(v)) })})
+	}
+	if len(m.Flags) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "flags", Value: protobuf_go_lite.LogMap(m.Flags, cmp.Compare[uint32], func(v string) slog.Value { return slog.StringValue(v) })})
+	}
+	if len(m.ById) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "by_id", Value: protobuf_go_lite.LogMap(m.ById, cmp.Compare[int64], func(v *Event) slog.Value { return protobuf_go_lite.LogMessage(v, depth-1) })})
+	}
+	if m.Cause != nil {
+		attrs = append(attrs, slog.Attr{Key: "cause", Value: protobuf_go_lite.LogMessage(m.Cause, depth-1)})
+	}
+	if len(m.Children) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "children", Value: protobuf_go_lite.LogSlice(m.Children, func(v *Event) slog.Value { return protobuf_go_lite.LogMessage(v, depth-1) })})
+	}
+	if m.At != nil {
+		attrs = append(attrs, slog.Attr{Key: "at", Value: protobuf_go_lite.LogMessage(m.At, depth-1)})
+	}
+	switch v := m.Target.(type) {
+	case *Event_Host:
+		attrs = append(attrs, slog.Attr{Key: "host", Value: slog.StringValue(v.Host)})
+	case *Event_Parent:
+		attrs = append(attrs, slog.Attr{Key: "parent", Value: protobuf_go_lite.LogMessage(v.Parent, depth-1)})
+	case *Event_Password:
+		attrs = append(attrs, slog.Attr{Key: "password", Value: slog.StringValue(protobuf_go_lite.LogRedacted)})
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func (x Level) MarshalProtoText() string {
+	return x.String()
+}
+func (x *Event_CountsEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "CountsEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteInt(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Event_CountsEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Event_FlagsEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "FlagsEntry")
+	if x.Key != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteUint(&sb, x.Key)
+	}
+	if x.Value != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteString(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Event_FlagsEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Event_ByIdEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "ByIdEntry")
+	if x.Key != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteInt(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Event_ByIdEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Event) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Event")
+	if x.Name != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "name")
+		protobuf_go_lite.TextWriteString(&sb, x.Name)
+	}
+	if x.Code != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "code")
+		protobuf_go_lite.TextWriteInt(&sb, x.Code)
+	}
+	if x.Size != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "size")
+		protobuf_go_lite.TextWriteUint(&sb, x.Size)
+	}
+	if x.Ratio != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "ratio")
+		protobuf_go_lite.TextWriteFloat64(&sb, x.Ratio)
+	}
+	if x.Ok != false {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "ok")
+		protobuf_go_lite.TextWriteBool(&sb, x.Ok)
+	}
+	if len(x.Payload) != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "payload")
+		protobuf_go_lite.TextWriteBytes(&sb, x.Payload)
+	}
+	if x.Level != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "level")
+		protobuf_go_lite.TextWriteStringer(&sb, Level(x.Level))
+	}
+	if x.Retries != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "retries")
+		protobuf_go_lite.TextWriteInt(&sb, *x.Retries)
+	}
+	if x.Previous != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "previous")
+		protobuf_go_lite.TextWriteStringer(&sb, x.Previous)
+	}
+	if x.Token != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "token")
+		sb.WriteString("[REDACTED]")
+	}
+	if len(x.Tags) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "tags")
+		for i, v := range x.Tags {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteString(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Levels) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "levels")
+		for i, v := range x.Levels {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStringer(&sb, Level(v))
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Counts) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "counts")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.Counts) {
+			v := x.Counts[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.Flags) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "flags")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.Flags) {
+			v := x.Flags[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteUint(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteString(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.ById) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "by_id")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.ById) {
+			v := x.ById[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteInt(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &Event{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if x.Cause != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "cause")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Cause)
+	}
+	if len(x.Children) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "children")
+		for i, v := range x.Children {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &Event{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if x.At != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "at")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.At)
+	}
+	switch body := x.Target.(type) {
+	case *Event_Host:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "host")
+		protobuf_go_lite.TextWriteString(&sb, body.Host)
+	case *Event_Parent:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "parent")
+		if body.Parent == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &Event{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Parent)
+		}
+	case *Event_Password:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "password")
+		sb.WriteString("[REDACTED]")
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Event) String() string {
+	return x.MarshalProtoText()
+}
+func (m *Event) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			m.Code, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			m.Size, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Ratio = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Ok = bool(v)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			m.Payload, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.Payload, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Level = Level(_v)
+			if err != nil {
+				return err
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Retries = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v Level
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Level(_v)
+			if err != nil {
+				return err
+			}
+			m.Previous = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Token = v
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Tags = append(m.Tags, v)
+		case 12:
+			if wireType == 0 {
+				var v Level
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Level(_v)
+				if err != nil {
+					return err
+				}
+				m.Levels = append(m.Levels, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Levels) == 0 {
+					m.Levels = make([]Level, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Level
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Level(_v)
+					if err != nil {
+						return err
+					}
+					m.Levels = append(m.Levels, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Flags == nil {
+				m.Flags = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Flags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ById", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ById == nil {
+				m.ById = make(map[int64]*Event)
+			}
+			var mapkey int64
+			var mapvalue *Event
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Event{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ById[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Cause == nil {
+				m.Cause = &Event{}
+			}
+			if err := m.Cause.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Children = append(m.Children, &Event{})
+			if err := m.Children[len(m.Children)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.At == nil {
+				m.At = &timestamppb.Timestamp{}
+			}
+			if err := m.At.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Target = &Event_Host{Host: v}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Target.(*Event_Parent); ok {
+				if err := oneof.Parent.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Event{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Target = &Event_Parent{Parent: v}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Target = &Event_Password{Password: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// RangeEventChildrenVT iterates over the Children elements encoded in dAtA, a serialized Event,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEventChildrenVT(dAtA []byte) iter.Seq2[*Event, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 17, (*Event).UnmarshalVT)
+}
+func (m *Event) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Name = v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			m.Code, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			m.Size, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Ratio = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Ok = bool(v)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			m.Payload, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.Level = Level(_v)
+			if err != nil {
+				return err
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Retries = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v Level
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = Level(_v)
+			if err != nil {
+				return err
+			}
+			m.Previous = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Token = v
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Tags = append(m.Tags, v)
+		case 12:
+			if wireType == 0 {
+				var v Level
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = Level(_v)
+				if err != nil {
+					return err
+				}
+				m.Levels = append(m.Levels, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.Levels) == 0 {
+					m.Levels = make([]Level, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Level
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = Level(_v)
+					if err != nil {
+						return err
+					}
+					m.Levels = append(m.Levels, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Flags == nil {
+				m.Flags = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Flags[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ById", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.ById == nil {
+				m.ById = make(map[int64]*Event)
+			}
+			var mapkey int64
+			var mapvalue *Event
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Event{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.ById[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Cause == nil {
+				m.Cause = &Event{}
+			}
+			if err := m.Cause.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Children = append(m.Children, &Event{})
+			if err := m.Children[len(m.Children)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.At == nil {
+				m.At = &timestamppb.Timestamp{}
+			}
+			if err := m.At.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Target = &Event_Host{Host: v}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Target.(*Event_Parent); ok {
+				if err := oneof.Parent.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Event{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Target = &Event_Parent{Parent: v}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Target = &Event_Password{Password: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package logvalue
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	"github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
+)
+
+// logJSON logs msg with the JSON handler and returns the decoded "msg" group.
+func logJSON(t *testing.T, msg *Event) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("event", "msg", msg)
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("decode %s: %v", buf.Bytes(), err)
+	}
+	group, ok := record["msg"].(map[string]any)
+	if !ok {
+		t.Fatalf("msg is not logged as a group: %s", buf.Bytes())
+	}
+	return group
+}
+
+func TestLogValueFields(t *testing.T) {
+	retries := int64(3)
+	msg := &Event{
+		Name:     "deploy",
+		Code:     -2,
+		Size:     42,
+		Ok:       true,
+		Payload:  []byte{1, 2},
+		Level:    Level_LEVEL_ERROR,
+		Retries:  &retries,
+		Token:    "tok-secret",
+		Tags:     []string{"a", "b"},
+		Counts:   map[string]int32{"x": 1},
+		Flags:    map[uint32]string{10: "on", 9: "off"},
+		ById:     map[int64]*Event{7: {Name: "seven"}},
+		Cause:    &Event{Name: "cause", Token: "cause-secret"},
+		At:       &timestamppb.Timestamp{Seconds: 1},
+		Target:   &Event_Password{Password: "pw-secret"},
+		Children: []*Event{{Name: "child"}},
+	}
+	group := logJSON(t, msg)
+
+	for key, want := range map[string]any{
+		"name":     "deploy",
+		"code":     float64(-2),
+		"size":     float64(42),
+		"ok":       true,
+		"payload":  "AQI=",
+		"level":    "LEVEL_ERROR",
+		"retries":  float64(3),
+		"token":    protobuf_go_lite.LogRedacted,
+		"password": protobuf_go_lite.LogRedacted,
+	} {
+		if got := group[key]; got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if _, ok := group["ratio"]; ok {
+		t.Error("unset field ratio is logged")
+	}
+	if got := group["tags"].(map[string]any)["1"]; got != "b" {
+		t.Errorf("tags.1 = %v, want b", got)
+	}
+	if got := group["flags"].(map[string]any)["9"]; got != "off" {
+		t.Errorf("flags.9 = %v, want off", got)
+	}
+	if got := group["by_id"].(map[string]any)["7"].(map[string]any)["name"]; got != "seven" {
+		t.Errorf("by_id.7.name = %v, want seven", got)
+	}
+	if got := group["cause"].(map[string]any)["token"]; got != protobuf_go_lite.LogRedacted {
+		t.Errorf("cause.token = %v, want %s", got, protobuf_go_lite.LogRedacted)
+	}
+	if got := group["at"]; got != msg.At.String() {
+		t.Errorf("at = %v, want %s", got, msg.At.String())
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("event", "msg", msg)
+	if strings.Contains(buf.String(), "-secret") {
+		t.Fatalf("log leaks a redacted value: %s", buf.String())
+	}
+}
+
+func TestLogValueDepth(t *testing.T) {
+	msg := &Event{Name: "0"}
+	for i, m := 1, msg; i <= protobuf_go_lite.LogMaxDepth; i++ {
+		m.Cause = &Event{Name: strconv.Itoa(i)}
+		m = m.Cause
+	}
+	group := logJSON(t, msg)
+	for i := 1; i < protobuf_go_lite.LogMaxDepth; i++ {
+		group = group["cause"].(map[string]any)
+		if got := group["name"]; got != strconv.Itoa(i) {
+			t.Fatalf("level %d name = %v", i, got)
+		}
+	}
+	if got := group["cause"]; got != protobuf_go_lite.LogElided {
+		t.Fatalf("cause beyond LogMaxDepth = %v, want %s", got, protobuf_go_lite.LogElided)
+	}
+}
+
+func TestLogValueTruncation(t *testing.T) {
+	msg := &Event{Counts: make(map[string]int32)}
+	n := protobuf_go_lite.LogMaxElements + 5
+	for i := range n {
+		msg.Tags = append(msg.Tags, strconv.Itoa(i))
+		msg.Counts[strconv.Itoa(1000+i)] = int32(i)
+	}
+	group := logJSON(t, msg)
+	for _, key := range []string{"tags", "counts"} {
+		field := group[key].(map[string]any)
+		if len(field) != protobuf_go_lite.LogMaxElements+1 {
+			t.Errorf("%s has %d attributes, want %d", key, len(field), protobuf_go_lite.LogMaxElements+1)
+		}
+		if got := field[protobuf_go_lite.LogTruncatedKey]; got != float64(5) {
+			t.Errorf("%s truncated = %v, want 5", key, got)
+		}
+	}
+	if _, ok := group["counts"].(map[string]any)["1000"]; !ok {
+		t.Error("counts does not log the first key in order")
+	}
+
+	if got := (*Event)(nil).LogValue(); got.Any() != nil {
+		t.Errorf("nil LogValue() = %v", got)
+	}
+}
@@ -0,0 +1,129 @@
+package merge
+
+import (
+	"testing"
+)
+
+func newTree() *Tree {
+	level := int32(2)
+	return &Tree{
+		Name:   "dst",
+		Level:  &level,
+		Data:   []byte("dst"),
+		Weight: 1.5,
+		Ids:    []int32{1, 2},
+		Leaf:   &Leaf{Name: "leaf", Count: 1},
+		Leaves: []*Leaf{{Name: "a"}},
+		ByName: map[string]*Leaf{"a": {Name: "a", Count: 1}, "b": {Name: "b", Count: 2}},
+		Labels: map[int32]string{1: "one"},
+		Choice: &Tree_Node{Node: &Leaf{Name: "node", Count: 3}},
+	}
+}
+
+// mergeByUnmarshal merges src into dst the way the protobuf wire format
+// defines it, by unmarshaling the encoding of src on top of dst.
+func mergeByUnmarshal(t *testing.T, dst, src *Tree) *Tree {
+	t.Helper()
+	want := dst.CloneVT()
+	data, err := src.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := want.UnmarshalVT(data); err != nil {
+		t.Fatal(err)
+	}
+	return want
+}
+
+func TestMergeVTMatchesUnmarshal(t *testing.T) {
+	level := int32(0)
+	for name, src := range map[string]*Tree{
+		"empty":          {},
+		"zero optional":  {Level: &level},
+		"scalars":        {Name: "src", Data: []byte("src"), Weight: -2, Ids: []int32{3}},
+		"sub-message":    {Leaf: &Leaf{Count: 5}},
+		"empty leaf":     {Leaf: &Leaf{}, Leaves: []*Leaf{{}}},
+		"map entries":    {ByName: map[string]*Leaf{"b": {Count: 7}, "c": {}}, Labels: map[int32]string{1: "uno", 2: "dos"}},
+		"same oneof":     {Choice: &Tree_Node{Node: &Leaf{Name: "other"}}},
+		"empty oneof":    {Choice: &Tree_Node{Node: &Leaf{}}},
+		"switched oneof": {Choice: &Tree_Text{Text: "text"}},
+		"empty text":     {Choice: &Tree_Text{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			want := mergeByUnmarshal(t, newTree(), src)
+			got := newTree()
+			got.MergeVT(src)
+			if !got.EqualVT(want) {
+				t.Fatalf("MergeVT gave %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMergeVTOneofSwitch(t *testing.T) {
+	dst := &Tree{Choice: &Tree_Text{Text: "text"}}
+	dst.MergeVT(&Tree{Choice: &Tree_Node{Node: &Leaf{Name: "node"}}})
+	if got := dst.GetNode().GetName(); got != "node" {
+		t.Fatalf("node name is %q after switching the oneof, want node", got)
+	}
+
+	// Merging the same case merges the sub-messages.
+	dst.MergeVT(&Tree{Choice: &Tree_Node{Node: &Leaf{Count: 4}}})
+	if got := dst.GetNode(); got.GetName() != "node" || got.GetCount() != 4 {
+		t.Fatalf("node is %v after merging the same case, want name node and count 4", got)
+	}
+
+	dst.MergeVT(&Tree{Choice: &Tree_Text{Text: "back"}})
+	if got := dst.GetText(); got != "back" || dst.GetNode() != nil {
+		t.Fatalf("oneof is %v after switching back, want text back", dst.Choice)
+	}
+}
+
+func TestMergeVTNil(t *testing.T) {
+	dst := newTree()
+	dst.MergeVT(nil)
+	if !dst.EqualVT(newTree()) {
+		t.Fatalf("merging nil changed the message to %v", dst)
+	}
+
+	var nilTree *Tree
+	nilTree.MergeVT(newTree())
+
+	// A nil sub-message of src leaves the one of dst in place, an empty one is
+	// merged into it.
+	dst.MergeVT(&Tree{Leaf: nil})
+	if dst.GetLeaf().GetName() != "leaf" {
+		t.Fatal("merging a nil sub-message cleared the sub-message")
+	}
+	dst = &Tree{}
+	dst.MergeVT(&Tree{Leaf: &Leaf{}})
+	if dst.Leaf == nil {
+		t.Fatal("merging an empty sub-message left the sub-message nil")
+	}
+}
+
+func TestMergeVTDoesNotAlias(t *testing.T) {
+	src := newTree()
+	dst := &Tree{}
+	dst.MergeVT(src)
+
+	src.Data[0] = 'x'
+	src.Leaf.Name = "changed"
+	src.Leaves[0].Name = "changed"
+	src.ByName["a"].Name = "changed"
+	src.Labels[1] = "changed"
+	src.GetNode().Name = "changed"
+	if !dst.EqualVT(newTree()) {
+		t.Fatalf("changing src changed the merged message to %v", dst)
+	}
+}
+
+func TestMergeMessageVT(t *testing.T) {
+	dst := &Tree{}
+	if dst.MergeMessageVT(&Leaf{}) {
+		t.Fatal("MergeMessageVT merged a message of another type")
+	}
+	if !dst.MergeMessageVT(newTree()) || !dst.EqualVT(newTree()) {
+		t.Fatalf("MergeMessageVT gave %v", dst)
+	}
+}
@@ -5,7 +5,10 @@
 package proto2
 
 import (
+	bytes "bytes"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	math "math"
 	slices "slices"
@@ -73,6 +76,29 @@ func (x *DoubleMessage) Reset() {
 
 func (*DoubleMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *DoubleMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *DoubleMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *DoubleMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *DoubleMessage) GetRequiredField() float64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -121,6 +147,29 @@ func (x *FloatMessage) Reset() {
 
 func (*FloatMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FloatMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FloatMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FloatMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *FloatMessage) GetRequiredField() float32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -169,6 +218,29 @@ func (x *Int32Message) Reset() {
 
 func (*Int32Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Int32Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Int32Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Int32Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Int32Message) GetRequiredField() int32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -217,6 +289,29 @@ func (x *Int64Message) Reset() {
 
 func (*Int64Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Int64Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Int64Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Int64Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Int64Message) GetRequiredField() int64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -265,6 +360,29 @@ func (x *Uint32Message) Reset() {
 
 func (*Uint32Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Uint32Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Uint32Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Uint32Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Uint32Message) GetRequiredField() uint32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -313,6 +431,29 @@ func (x *Uint64Message) Reset() {
 
 func (*Uint64Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Uint64Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Uint64Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Uint64Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Uint64Message) GetRequiredField() uint64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -361,6 +502,29 @@ func (x *Sint32Message) Reset() {
 
 func (*Sint32Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Sint32Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Sint32Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Sint32Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Sint32Message) GetRequiredField() int32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -409,6 +573,29 @@ func (x *Sint64Message) Reset() {
 
 func (*Sint64Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Sint64Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Sint64Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Sint64Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Sint64Message) GetRequiredField() int64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -457,6 +644,29 @@ func (x *Fixed32Message) Reset() {
 
 func (*Fixed32Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Fixed32Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Fixed32Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Fixed32Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Fixed32Message) GetRequiredField() uint32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -505,6 +715,29 @@ func (x *Fixed64Message) Reset() {
 
 func (*Fixed64Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Fixed64Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Fixed64Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Fixed64Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Fixed64Message) GetRequiredField() uint64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -553,6 +786,29 @@ func (x *Sfixed32Message) Reset() {
 
 func (*Sfixed32Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Sfixed32Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Sfixed32Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Sfixed32Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Sfixed32Message) GetRequiredField() int32 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -601,6 +857,29 @@ func (x *Sfixed64Message) Reset() {
 
 func (*Sfixed64Message) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Sfixed64Message) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Sfixed64Message) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Sfixed64Message) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Sfixed64Message) GetRequiredField() int64 {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -648,6 +927,29 @@ func (x *BoolMessage) Reset() {
 
 func (*BoolMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *BoolMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *BoolMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *BoolMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *BoolMessage) GetRequiredField() bool {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -695,6 +997,29 @@ func (x *StringMessage) Reset() {
 
 func (*StringMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StringMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StringMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StringMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *StringMessage) GetRequiredField() string {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -735,6 +1060,29 @@ func (x *BytesMessage) Reset() {
 
 func (*BytesMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *BytesMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *BytesMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *BytesMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *BytesMessage) GetRequiredField() []byte {
 	if x != nil && x.RequiredField != nil {
 		return x.RequiredField
@@ -775,6 +1123,29 @@ func (x *EnumMessage) Reset() {
 
 func (*EnumMessage) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumMessage) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumMessage) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumMessage) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *EnumMessage) GetRequiredField() EnumMessage_Num {
 	if x != nil && x.RequiredField != nil {
 		return *x.RequiredField
@@ -1105,3011 +1476,10363 @@ func (m *EnumMessage) CloneMessageVT() protobuf_go_lite.CloneMessage {
 	return m.CloneVT()
 }
 
-func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *DoubleMessage) CompareVT(that *DoubleMessage) int {
+	if m == that {
+		return 0
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *DoubleMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*DoubleMessage)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *FloatMessage) CompareVT(that *FloatMessage) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *FloatMessage) EqualVT(that *FloatMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *FloatMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*FloatMessage)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Int32Message) CompareVT(that *Int32Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Int32Message) EqualVT(that *Int32Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Int32Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Int32Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Int64Message) CompareVT(that *Int64Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Int64Message) EqualVT(that *Int64Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Int64Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Int64Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Uint32Message) CompareVT(that *Uint32Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Uint32Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Uint32Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Uint64Message) CompareVT(that *Uint64Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Uint64Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Uint64Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Sint32Message) CompareVT(that *Sint32Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Sint32Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Sint32Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Sint64Message) CompareVT(that *Sint64Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Sint64Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Sint64Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Fixed32Message) CompareVT(that *Fixed32Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Fixed32Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Fixed32Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Fixed64Message) CompareVT(that *Fixed64Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Fixed64Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Fixed64Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Sfixed32Message) CompareVT(that *Sfixed32Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Sfixed32Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Sfixed32Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Sfixed64Message) CompareVT(that *Sfixed64Message) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *Sfixed64Message) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*Sfixed64Message)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *BoolMessage) CompareVT(that *BoolMessage) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.CompareBoolPtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.CompareBoolPtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.CompareFunc(m.RepeatedField, that.RepeatedField, protobuf_go_lite.CompareBool); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.CompareFunc(m.PackedField, that.PackedField, protobuf_go_lite.CompareBool); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *BoolMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*BoolMessage)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *StringMessage) CompareVT(that *StringMessage) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *StringMessage) EqualVT(that *StringMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *StringMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*StringMessage)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *BytesMessage) CompareVT(that *BytesMessage) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *BytesMessage) EqualVT(that *BytesMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualBytesPresent(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualBytesPresent(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.CompareBytesPresent(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualBytesSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.CompareBytesPresent(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.CompareFunc(m.RepeatedField, that.RepeatedField, bytes.Compare); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *BytesMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*BytesMessage)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *EnumMessage) CompareVT(that *EnumMessage) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.RequiredField, that.RequiredField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
-		return false
+	if c := protobuf_go_lite.ComparePtr(m.OptionalField, that.OptionalField); c != 0 {
+		return c
 	}
-	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
-		return false
+	if c := slices.Compare(m.RepeatedField, that.RepeatedField); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.Compare(m.PackedField, that.PackedField); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *EnumMessage) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*EnumMessage)
-	if !ok {
-		return false
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *DoubleMessage) CopyVT(dst *DoubleMessage) {
+	if m == dst || dst == nil {
+		return
 	}
-	return this.EqualVT(that)
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-// NOTE: protobuf-go-lite json only supports proto3 and editions: proto2 is not supported.
-
-func (m *DoubleMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *FloatMessage) CopyVT(dst *FloatMessage) {
+	if m == dst || dst == nil {
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	return dAtA[:n], nil
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *DoubleMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Int32Message) CopyVT(dst *Int32Message) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *DoubleMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Int64Message) CopyVT(dst *Int64Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f1))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Uint32Message) CopyVT(dst *Uint32Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f2))
-			i--
-			dAtA[i] = 0x19
-		}
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x11
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Uint64Message) CopyVT(dst *Uint64Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.RequiredField))))
-		i--
-		dAtA[i] = 0x9
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	return len(dAtA) - i, nil
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *FloatMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Sint32Message) CopyVT(dst *Sint32Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	return dAtA[:n], nil
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *FloatMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Sint64Message) CopyVT(dst *Sint64Message) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *FloatMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Fixed32Message) CopyVT(dst *Fixed32Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f1))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Fixed64Message) CopyVT(dst *Fixed64Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f2))
-			i--
-			dAtA[i] = 0x1d
-		}
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x15
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Sfixed32Message) CopyVT(dst *Sfixed32Message) {
+	if m == dst || dst == nil {
+		return
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.RequiredField))))
-		i--
-		dAtA[i] = 0xd
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	return len(dAtA) - i, nil
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *Int32Message) MarshalVT() (dAtA []byte, err error) {
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Sfixed64Message) CopyVT(dst *Sfixed64Message) {
+	if m == dst || dst == nil {
+		return
+	}
 	if m == nil {
-		return nil, nil
+		dst.Reset()
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *BoolMessage) CopyVT(dst *BoolMessage) {
+	if m == dst || dst == nil {
+		return
 	}
-	return dAtA[:n], nil
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *Int32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *StringMessage) CopyVT(dst *StringMessage) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (m *Int32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *BytesMessage) CopyVT(dst *BytesMessage) {
+	if m == dst || dst == nil {
+		return
+	}
 	if m == nil {
-		return 0, nil
+		dst.Reset()
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	dst.RequiredField = protobuf_go_lite.CopyBytes(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyBytes(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopyBytesSlice(dst.RepeatedField, m.RepeatedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *EnumMessage) CopyVT(dst *EnumMessage) {
+	if m == dst || dst == nil {
+		return
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	dst.RequiredField = protobuf_go_lite.CopyPtr(dst.RequiredField, m.RequiredField)
+	dst.OptionalField = protobuf_go_lite.CopyPtr(dst.OptionalField, m.OptionalField)
+	dst.RepeatedField = protobuf_go_lite.CopySlice(dst.RepeatedField, m.RepeatedField)
+	dst.PackedField = protobuf_go_lite.CopySlice(dst.PackedField, m.PackedField)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *DoubleMessage) DiffVT(that *DoubleMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *DoubleMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *DoubleMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if m == nil {
+		m = &DoubleMessage{}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &DoubleMessage{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Int64Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *FloatMessage) DiffVT(that *FloatMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *FloatMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *FloatMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &FloatMessage{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &FloatMessage{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Int64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Int32Message) DiffVT(that *Int32Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Int64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Int32Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Int32Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		m = &Int32Message{}
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if that == nil {
+		that = &Int32Message{}
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Int64Message) DiffVT(that *Int64Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Int64Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Int64Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if m == nil {
+		m = &Int64Message{}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &Int64Message{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Uint32Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Uint32Message) DiffVT(that *Uint32Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Uint32Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Uint32Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &Uint32Message{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &Uint32Message{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Uint32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Uint64Message) DiffVT(that *Uint64Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Uint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Uint64Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Uint64Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return 0, nil
+		m = &Uint64Message{}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
-	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &Uint64Message{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Uint64Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Sint32Message) DiffVT(that *Sint32Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Sint32Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Sint32Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &Sint32Message{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &Sint32Message{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Uint64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Sint64Message) DiffVT(that *Sint64Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Uint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Sint64Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Sint64Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if m == nil {
+		m = &Sint64Message{}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &Sint64Message{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Sint32Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Fixed32Message) DiffVT(that *Fixed32Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Fixed32Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Fixed32Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &Fixed32Message{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &Fixed32Message{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Sint32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Fixed64Message) DiffVT(that *Fixed64Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Sint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Fixed64Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Fixed64Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		m = &Fixed64Message{}
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeZigzag32Packed(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if that == nil {
+		that = &Fixed64Message{}
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeZigzag32(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
-		}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Sfixed32Message) DiffVT(that *Sfixed32Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Sfixed32Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Sfixed32Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if m == nil {
+		m = &Sfixed32Message{}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &Sfixed32Message{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Sint64Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Sfixed64Message) DiffVT(that *Sfixed64Message) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Sfixed64Message) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Sfixed64Message) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &Sfixed64Message{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &Sfixed64Message{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Sint64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *BoolMessage) DiffVT(that *BoolMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Sint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *BoolMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *BoolMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		m = &BoolMessage{}
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeZigzag64Packed(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if that == nil {
+		that = &BoolMessage{}
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
-		}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *StringMessage) DiffVT(that *StringMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *StringMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *StringMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if m == nil {
+		m = &StringMessage{}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if that == nil {
+		that = &StringMessage{}
 	}
-	return len(dAtA) - i, nil
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Fixed32Message) MarshalVT() (dAtA []byte, err error) {
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *BytesMessage) DiffVT(that *BytesMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *BytesMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *BytesMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
 	if m == nil {
-		return nil, nil
+		m = &BytesMessage{}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that == nil {
+		that = &BytesMessage{}
 	}
-	return dAtA[:n], nil
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffBytesSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
 }
 
-func (m *Fixed32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *EnumMessage) DiffVT(that *EnumMessage) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
 }
 
-func (m *Fixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *EnumMessage) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *EnumMessage) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if m == nil {
+		m = &EnumMessage{}
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if that == nil {
+		that = &EnumMessage{}
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
-		}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_field", m.RequiredField, that.RequiredField)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_field", m.OptionalField, that.OptionalField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "repeated_field", m.RepeatedField, that.RepeatedField)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_field", m.PackedField, that.PackedField)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Fixed64Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Fixed64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Fixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *DoubleMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*DoubleMessage)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *FloatMessage) EqualVT(that *FloatMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed32Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FloatMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*FloatMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *Sfixed32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Int32Message) EqualVT(that *Int32Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Int32Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Int32Message)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *Int64Message) EqualVT(that *Int64Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed64Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Int64Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Int64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *Sfixed64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Uint32Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Uint32Message)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *BoolMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Uint64Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Uint64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *BoolMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *BoolMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBool(dAtA, i, m.PackedField[iNdEx])
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBool(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *StringMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Sint32Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Sint32Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StringMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *StringMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeString(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x1a
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeString(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x12
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeString(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0xa
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *BytesMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Sint64Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Sint64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BytesMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *BytesMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x1a
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.OptionalField)
-		i--
-		dAtA[i] = 0x12
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RequiredField)
-		i--
-		dAtA[i] = 0xa
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *EnumMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Fixed32Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Fixed32Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EnumMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *EnumMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *DoubleMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Fixed64Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Fixed64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *DoubleMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *DoubleMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f1))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f2))
-			i--
-			dAtA[i] = 0x19
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x11
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.RequiredField))))
-		i--
-		dAtA[i] = 0x9
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *FloatMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Sfixed32Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Sfixed32Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FloatMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *FloatMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f1))
-		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f2))
-			i--
-			dAtA[i] = 0x1d
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x15
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.RequiredField))))
-		i--
-		dAtA[i] = 0xd
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Int32Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Sfixed64Message) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*Sfixed64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Int32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *Int32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Int64Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BoolMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*BoolMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Int64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return this.EqualVT(that)
 }
-
-func (m *Int64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+func (this *StringMessage) EqualVT(that *StringMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Uint32Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *StringMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StringMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
-
-func (m *Uint32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+func (this *BytesMessage) EqualVT(that *BytesMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytesPresent(this.RequiredField, that.RequiredField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytesPresent(this.OptionalField, that.OptionalField) {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytesSlice(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Uint32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *BytesMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*BytesMessage)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	return this.EqualVT(that)
+}
+func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if !protobuf_go_lite.EqualPtr(this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if !protobuf_go_lite.EqualPtr(this.OptionalField, that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if !protobuf_go_lite.EqualSlice(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if !protobuf_go_lite.EqualSlice(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Uint64Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EnumMessage) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*EnumMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *Uint64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *DoubleMessage) EqualVTOpts(that *DoubleMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Uint64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *DoubleMessage) EqualVTOptsPrefix(that *DoubleMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &DoubleMessage{}
+		}
+		if that == nil {
+			that = &DoubleMessage{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.OptionalField, that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Sint32Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !protobuf_go_lite.EqualOptsFloatSlice(opts, this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !protobuf_go_lite.EqualOptsFloatSlice(opts, this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sint32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *FloatMessage) EqualVTOpts(that *FloatMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Sint32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeZigzag32Packed(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *FloatMessage) EqualVTOptsPrefix(that *FloatMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeZigzag32(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &FloatMessage{}
+		}
+		if that == nil {
+			that = &FloatMessage{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.RequiredField, that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.OptionalField, that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Sint64Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !protobuf_go_lite.EqualOptsFloatSlice(opts, this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !protobuf_go_lite.EqualOptsFloatSlice(opts, this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sint64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Int32Message) EqualVTOpts(that *Int32Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Sint64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Int32Message) EqualVTOptsPrefix(that *Int32Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Int32Message{}
+		}
+		if that == nil {
+			that = &Int32Message{}
+		}
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeZigzag64Packed(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
-		}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Fixed32Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Int64Message) EqualVTOpts(that *Int64Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Int64Message) EqualVTOptsPrefix(that *Int64Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Int64Message{}
+		}
+		if that == nil {
+			that = &Int64Message{}
+		}
 	}
-	return dAtA[:n], nil
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Fixed32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Uint32Message) EqualVTOpts(that *Uint32Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Fixed32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Uint32Message) EqualVTOptsPrefix(that *Uint32Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
+		if this == nil {
+			this = &Uint32Message{}
+		}
+		if that == nil {
+			that = &Uint32Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Fixed64Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Fixed64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Uint64Message) EqualVTOpts(that *Uint64Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Fixed64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Uint64Message) EqualVTOptsPrefix(that *Uint64Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
+		if this == nil {
+			this = &Uint64Message{}
+		}
+		if that == nil {
+			that = &Uint64Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Sfixed32Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Sint32Message) EqualVTOpts(that *Sint32Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Sfixed32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Sint32Message) EqualVTOptsPrefix(that *Sint32Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
+		if this == nil {
+			this = &Sint32Message{}
+		}
+		if that == nil {
+			that = &Sint32Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Sfixed64Message) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sfixed64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Sint64Message) EqualVTOpts(that *Sint64Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Sfixed64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Sint64Message) EqualVTOptsPrefix(that *Sint64Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
+		if this == nil {
+			this = &Sint64Message{}
+		}
+		if that == nil {
+			that = &Sint64Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BoolMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *BoolMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Fixed32Message) EqualVTOpts(that *Fixed32Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *BoolMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Fixed32Message) EqualVTOptsPrefix(that *Fixed32Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBool(dAtA, i, m.PackedField[iNdEx])
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Fixed32Message{}
+		}
+		if that == nil {
+			that = &Fixed32Message{}
 		}
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)))
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBool(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x18
-		}
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x10
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0x8
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *StringMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Fixed64Message) EqualVTOpts(that *Fixed64Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Fixed64Message) EqualVTOptsPrefix(that *Fixed64Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Fixed64Message{}
+		}
+		if that == nil {
+			that = &Fixed64Message{}
+		}
 	}
-	return dAtA[:n], nil
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *StringMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Sfixed32Message) EqualVTOpts(that *Sfixed32Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *StringMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Sfixed32Message) EqualVTOptsPrefix(that *Sfixed32Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeString(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x1a
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Sfixed32Message{}
+		}
+		if that == nil {
+			that = &Sfixed32Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeString(dAtA, i, *m.OptionalField)
-		i--
-		dAtA[i] = 0x12
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeString(dAtA, i, *m.RequiredField)
-		i--
-		dAtA[i] = 0xa
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BytesMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *BytesMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Sfixed64Message) EqualVTOpts(that *Sfixed64Message, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *BytesMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Sfixed64Message) EqualVTOptsPrefix(that *Sfixed64Message, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RepeatedField[iNdEx])
-			i--
-			dAtA[i] = 0x1a
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Sfixed64Message{}
+		}
+		if that == nil {
+			that = &Sfixed64Message{}
 		}
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.OptionalField)
-		i--
-		dAtA[i] = 0x12
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RequiredField)
-		i--
-		dAtA[i] = 0xa
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EnumMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *EnumMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *BoolMessage) EqualVTOpts(that *BoolMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *EnumMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *BoolMessage) EqualVTOptsPrefix(that *BoolMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &BoolMessage{}
+		}
+		if that == nil {
+			that = &BoolMessage{}
+		}
 	}
-	if len(m.PackedField) > 0 {
-		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
-		i--
-		dAtA[i] = 0x22
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *DoubleMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *StringMessage) EqualVTOpts(that *StringMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *FloatMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *StringMessage) EqualVTOptsPrefix(that *StringMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &StringMessage{}
+		}
+		if that == nil {
+			that = &StringMessage{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Int32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *BytesMessage) EqualVTOpts(that *BytesMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Int64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *BytesMessage) EqualVTOptsPrefix(that *BytesMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &BytesMessage{}
+		}
+		if that == nil {
+			that = &BytesMessage{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || string(this.RequiredField) != string(that.RequiredField)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || string(this.OptionalField) != string(that.OptionalField)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !protobuf_go_lite.EqualBytesSlice(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Uint32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *EnumMessage) EqualVTOpts(that *EnumMessage, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-func (m *Uint64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *EnumMessage) EqualVTOptsPrefix(that *EnumMessage, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &EnumMessage{}
+		}
+		if that == nil {
+			that = &EnumMessage{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "required_field"); ok && ((this.RequiredField == nil) != (that.RequiredField == nil) || this.RequiredField != nil && *this.RequiredField != *that.RequiredField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_field"); ok && ((this.OptionalField == nil) != (that.OptionalField == nil) || this.OptionalField != nil && *this.OptionalField != *that.OptionalField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "repeated_field"); ok && !slices.Equal(this.RepeatedField, that.RepeatedField) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "packed_field"); ok && !slices.Equal(this.PackedField, that.PackedField) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Sint32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeZigzagPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeZigzagPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeZigzagSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeZigzagPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *DoubleMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
 
-func (m *Sint64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeZigzagPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeZigzagPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeZigzagSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeZigzagPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *DoubleMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
 }
 
-func (m *Fixed32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *DoubleMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Float64(float64(*m.RequiredField))
+		}
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Float64(float64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Float64(float64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Float64(float64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+	w.End()
 }
 
-func (m *Fixed64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *FloatMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
 
-func (m *Sfixed32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *FloatMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
 }
 
-func (m *Sfixed64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *FloatMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Float64(float64(*m.RequiredField))
+		}
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Float64(float64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Float64(float64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Float64(float64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+	w.End()
 }
 
-func (m *BoolMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeBoolPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeBoolPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeBoolSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeBoolPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Int32Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
 
-func (m *StringMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeStringPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeStringPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeStringSlice(1, m.RepeatedField)
-	n += len(m.unknownFields)
-	return n
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Int32Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
 }
 
-func (m *BytesMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Int32Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
+		}
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeBytesPresent(1, m.RequiredField)
-	n += protobuf_go_lite.SizeBytesPresent(1, m.OptionalField)
-	n += protobuf_go_lite.SizeBytesSlice(1, m.RepeatedField)
-	n += len(m.unknownFields)
-	return n
+	w.End()
 }
 
-func (m *EnumMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
-	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
-	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
-	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
-	n += len(m.unknownFields)
-	return n
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Int64Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
 
-func (x *DoubleMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "DoubleMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteFloat64(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteFloat64(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteFloat64(&sb, v)
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Int64Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Int64Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteFloat64(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *DoubleMessage) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Uint32Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *FloatMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "FloatMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteFloat32(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteFloat32(&sb, *x.OptionalField)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Uint32Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Uint32Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
+		}
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteFloat32(&sb, v)
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Uint64Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Uint64Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Uint64Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteFloat32(&sb, v)
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Sint32Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Sint32Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Sint32Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
+		}
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *FloatMessage) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Sint64Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Int32Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Int32Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Sint64Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Sint64Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Int32Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Fixed32Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Int64Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Int64Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Fixed32Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Fixed32Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Int64Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Fixed64Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Uint32Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Uint32Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Fixed64Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Fixed64Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Uint32Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Sfixed32Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Uint64Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Uint64Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Sfixed32Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Sfixed32Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Uint64Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Sfixed64Message) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Sint32Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sint32Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Sfixed64Message) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Sfixed64Message) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Sint32Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *BoolMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Sint64Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sint64Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *BoolMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *BoolMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Bool(*m.RequiredField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Bool(*m.OptionalField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Bool(v)
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Bool(v)
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Sint64Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *StringMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Fixed32Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Fixed32Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *StringMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *StringMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.String(*m.RequiredField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.String(*m.OptionalField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.String(v)
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Fixed32Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *BytesMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Fixed64Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Fixed64Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *BytesMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *BytesMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Bytes(m.RequiredField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteUint(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Bytes(m.OptionalField)
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Bytes(v)
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Fixed64Message) String() string {
-	return x.MarshalProtoText()
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *EnumMessage) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
 }
-func (x *Sfixed32Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sfixed32Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *EnumMessage) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *EnumMessage) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.RequiredField != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.RequiredField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+		if m.OptionalField != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalField))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		if len(m.RepeatedField) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.RepeatedField)))
+			for _, v := range m.RepeatedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.PackedField) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.PackedField)))
+			for _, v := range m.PackedField {
+				w.Uint64(uint64(v))
+			}
+		}
+		w.Unknown(m.unknownFields)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	w.End()
 }
 
-func (x *Sfixed32Message) String() string {
-	return x.MarshalProtoText()
+// NOTE: protobuf-go-lite json only supports proto3 and editions: proto2 is not supported.
+
+func (m *DoubleMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (x *Sfixed64Message) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sfixed64Message")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+
+func (m *DoubleMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *DoubleMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
 	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f1))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteInt(&sb, v)
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f2))
+			i--
+			dAtA[i] = 0x19
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.OptionalField))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.RequiredField))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
 }
 
-func (x *Sfixed64Message) String() string {
-	return x.MarshalProtoText()
+func (m *FloatMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (x *BoolMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BoolMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteBool(&sb, *x.RequiredField)
+
+func (m *FloatMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *FloatMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteBool(&sb, *x.OptionalField)
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
 	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteBool(&sb, v)
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f1))
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteBool(&sb, v)
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f2))
+			i--
+			dAtA[i] = 0x1d
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.OptionalField))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.RequiredField))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
 }
 
-func (x *BoolMessage) String() string {
-	return x.MarshalProtoText()
+func (m *Int32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (x *StringMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StringMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteString(&sb, *x.RequiredField)
+
+func (m *Int32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Int32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteString(&sb, *x.OptionalField)
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
 	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteString(&sb, v)
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Int64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Int64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Int64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uint32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Uint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uint64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Uint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sint32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Sint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeZigzag32Packed(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeZigzag32(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sint64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Sint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeZigzag64Packed(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fixed32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Fixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fixed64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Fixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sfixed32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Sfixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sfixed64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Sfixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *BoolMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBool(dAtA, i, m.PackedField[iNdEx])
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBool(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StringMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeString(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeString(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytesMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytesMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *BytesMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.OptionalField)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RequiredField)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnumMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *EnumMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *DoubleMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f1))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(f2))
+			i--
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.OptionalField))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(math.Float64bits(float64(*m.RequiredField))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FloatMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FloatMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *FloatMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f1))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(f2))
+			i--
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.OptionalField))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(math.Float32bits(float32(*m.RequiredField))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Int32Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Int32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Int32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Int64Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Int64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Int64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint32Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uint32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Uint32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint64Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uint64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Uint64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint32Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sint32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Sint32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeZigzag32Packed(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeZigzag32(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeZigzag32(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint64Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sint64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Sint64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeZigzag64Packed(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeZigzag64(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeZigzag64(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed32Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fixed32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Fixed32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed64Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fixed64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Fixed64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed32Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sfixed32Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Sfixed32Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.OptionalField))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed32(dAtA, i, uint32(*m.RequiredField))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed64Message) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sfixed64Message) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *Sfixed64Message) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.PackedField[iNdEx]))
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeFixed64(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *BoolMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBool(dAtA, i, m.PackedField[iNdEx])
+		}
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(len(m.PackedField)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBool(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeBool(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StringMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeString(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeString(dAtA, i, *m.OptionalField)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeString(dAtA, i, *m.RequiredField)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytesMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytesMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *BytesMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RepeatedField[iNdEx])
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.OptionalField)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeBytes(dAtA, i, m.RequiredField)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnumMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *EnumMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		i = protobuf_go_lite.EncodeVarintPacked(dAtA, i, m.PackedField)
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *DoubleMessage) MergeVT(src *DoubleMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *DoubleMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*DoubleMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *FloatMessage) MergeVT(src *FloatMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *FloatMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*FloatMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Int32Message) MergeVT(src *Int32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Int32Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Int32Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Int64Message) MergeVT(src *Int64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Int64Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Int64Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Uint32Message) MergeVT(src *Uint32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Uint32Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Uint32Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Uint64Message) MergeVT(src *Uint64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Uint64Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Uint64Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Sint32Message) MergeVT(src *Sint32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sint32Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Sint32Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Sint64Message) MergeVT(src *Sint64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sint64Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Sint64Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Fixed32Message) MergeVT(src *Fixed32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Fixed32Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Fixed32Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Fixed64Message) MergeVT(src *Fixed64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Fixed64Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Fixed64Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Sfixed32Message) MergeVT(src *Sfixed32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sfixed32Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Sfixed32Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Sfixed64Message) MergeVT(src *Sfixed64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sfixed64Message) MergeMessageVT(src any) bool {
+	s, ok := src.(*Sfixed64Message)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *BoolMessage) MergeVT(src *BoolMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *BoolMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*BoolMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *StringMessage) MergeVT(src *StringMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *StringMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*StringMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *BytesMessage) MergeVT(src *BytesMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		m.RequiredField = slices.Clone(src.RequiredField)
+	}
+	if src.OptionalField != nil {
+		m.OptionalField = slices.Clone(src.OptionalField)
+	}
+	for _, v := range src.RepeatedField {
+		m.RepeatedField = append(m.RepeatedField, slices.Clone(v))
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *BytesMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*BytesMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *EnumMessage) MergeVT(src *EnumMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		v := *src.RequiredField
+		m.RequiredField = &v
+	}
+	if src.OptionalField != nil {
+		v := *src.OptionalField
+		m.OptionalField = &v
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EnumMessage) MergeMessageVT(src any) bool {
+	s, ok := src.(*EnumMessage)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *DoubleMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *FloatMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Int32Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Int64Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Uint32Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Uint64Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Sint32Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Sint64Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Fixed32Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Fixed64Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Sfixed32Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Sfixed64Message) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *BoolMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *StringMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *BytesMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *EnumMessage) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+func (m *DoubleMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FloatMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Int32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Int64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Uint32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Uint64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sint32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeZigzagPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeZigzagPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeZigzagSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeZigzagPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sint64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeZigzagPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeZigzagPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeZigzagSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeZigzagPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Fixed32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Fixed64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sfixed32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed32Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed32Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed32Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sfixed64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeFixed64Ptr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeFixed64Slice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeFixed64Packed(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *BoolMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeBoolPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeBoolPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeBoolSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeBoolPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StringMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeStringPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeStringSlice(1, m.RepeatedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *BytesMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeBytesPresent(1, m.RequiredField)
+	n += protobuf_go_lite.SizeBytesPresent(1, m.OptionalField)
+	n += protobuf_go_lite.SizeBytesSlice(1, m.RepeatedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EnumMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeVarintPtr(1, m.RequiredField)
+	n += protobuf_go_lite.SizeVarintPtr(1, m.OptionalField)
+	n += protobuf_go_lite.SizeVarintSlice(1, m.RepeatedField)
+	n += protobuf_go_lite.SizeVarintPacked(1, m.PackedField)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (x *DoubleMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "DoubleMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteFloat64(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteFloat64(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteFloat64(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteFloat64(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *DoubleMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (x *FloatMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "FloatMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteFloat32(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteFloat32(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteFloat32(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteFloat32(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *FloatMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Int32Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Int32Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Int32Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Int64Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Int64Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Int64Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Uint32Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Uint32Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Uint32Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Uint64Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Uint64Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Uint64Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Sint32Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sint32Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Sint32Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Sint64Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sint64Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Sint64Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Fixed32Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Fixed32Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Fixed32Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Fixed64Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Fixed64Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteUint(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteUint(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Fixed64Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Sfixed32Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sfixed32Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Sfixed32Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *Sfixed64Message) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "Sfixed64Message")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteInt(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteInt(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *Sfixed64Message) String() string {
+	return x.MarshalProtoText()
+}
+func (x *BoolMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BoolMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteBool(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteBool(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteBool(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteBool(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *BoolMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StringMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StringMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteString(&sb, *x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteString(&sb, *x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteString(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StringMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (x *BytesMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BytesMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteBytes(&sb, x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteBytes(&sb, x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteBytes(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *BytesMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (x EnumMessage_Num) MarshalProtoText() string {
+	return x.String()
+}
+func (x *EnumMessage) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "EnumMessage")
+	if x.RequiredField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
+		protobuf_go_lite.TextWriteStringer(&sb, x.RequiredField)
+	}
+	if x.OptionalField != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
+		protobuf_go_lite.TextWriteStringer(&sb, x.OptionalField)
+	}
+	if len(x.RepeatedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
+		for i, v := range x.RepeatedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStringer(&sb, EnumMessage_Num(v))
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.PackedField) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
+		for i, v := range x.PackedField {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStringer(&sb, EnumMessage_Num(v))
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *EnumMessage) String() string {
+	return x.MarshalProtoText()
+}
+func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				v2 := float64(math.Float64frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					v2 := float64(math.Float64frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FloatMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FloatMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				v2 := float32(math.Float32frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					v2 := float32(math.Float32frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				var _v32 int32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 int32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				var _v32 int32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 int32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				var _v64 int64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 int64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				var _v64 int64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 int64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v int32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v int32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v int64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v int64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.RequiredField = &b
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.OptionalField = &b
+		case 3:
+			if wireType == 0 {
+				var v bool
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, bool(v))
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 1)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v bool
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, bool(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v bool
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, bool(v))
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 1)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v bool
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, bool(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RepeatedField = append(m.RepeatedField, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BytesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BytesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			m.RequiredField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.RequiredField, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			m.OptionalField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.OptionalField, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.RepeatedField = append(m.RepeatedField, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v EnumMessage_Num
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = EnumMessage_Num(_v)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v EnumMessage_Num
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = EnumMessage_Num(_v)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = EnumMessage_Num(_v)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = EnumMessage_Num(_v)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = EnumMessage_Num(_v)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = EnumMessage_Num(_v)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *DoubleMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				v2 := float64(math.Float64frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					v2 := float64(math.Float64frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *FloatMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FloatMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FloatMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				v2 := float32(math.Float32frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					v2 := float32(math.Float32frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Int32Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Int64Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Uint32Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Uint64Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sint32Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				var _v32 int32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 int32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				var _v32 int32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 int32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sint64Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				var _v64 int64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 int64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				var _v64 int64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 int64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Fixed32Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Fixed64Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = uint64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = uint64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sfixed32Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v int32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v int32
+				var _v32 uint32
+				_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int32(_v32)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 4)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					var _v32 uint32
+					_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int32(_v32)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sfixed64Message) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v int64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v int64
+				var _v64 uint64
+				_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				v = int64(_v64)
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 8)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					var _v64 uint64
+					_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					v = int64(_v64)
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BoolMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.RequiredField = &b
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.OptionalField = &b
+		case 3:
+			if wireType == 0 {
+				var v bool
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, bool(v))
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 1)
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v bool
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, bool(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v bool
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, bool(v))
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedFixedElementCount(dAtA[iNdEx:postIndex], 1)
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v bool
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, bool(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StringMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RepeatedField = append(m.RepeatedField, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BytesMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BytesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BytesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			m.RequiredField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.RequiredField, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			m.OptionalField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.OptionalField, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.RepeatedField = append(m.RepeatedField, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *EnumMessage) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v EnumMessage_Num
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = EnumMessage_Num(_v)
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v EnumMessage_Num
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = EnumMessage_Num(_v)
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = EnumMessage_Num(_v)
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = EnumMessage_Num(_v)
+					if err != nil {
+						return err
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				var _v uint64
+				_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				v = EnumMessage_Num(_v)
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					var _v uint64
+					_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+					v = EnumMessage_Num(_v)
+					if err != nil {
+						return err
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
-}
-
-func (x *StringMessage) String() string {
-	return x.MarshalProtoText()
-}
-func (x *BytesMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BytesMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteBytes(&sb, x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteBytes(&sb, x.OptionalField)
 	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteBytes(&sb, v)
-		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
+		return fmt.Errorf("proto: required field required_field not set")
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
-}
 
-func (x *BytesMessage) String() string {
-	return x.MarshalProtoText()
-}
-func (x EnumMessage_Num) MarshalProtoText() string {
-	return x.String()
-}
-func (x *EnumMessage) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "EnumMessage")
-	if x.RequiredField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "required_field")
-		protobuf_go_lite.TextWriteStringer(&sb, x.RequiredField)
-	}
-	if x.OptionalField != nil {
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "optional_field")
-		protobuf_go_lite.TextWriteStringer(&sb, x.OptionalField)
-	}
-	if len(x.RepeatedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "repeated_field")
-		for i, v := range x.RepeatedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteStringer(&sb, EnumMessage_Num(v))
-		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
-	}
-	if len(x.PackedField) > 0 {
-		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "packed_field")
-		for i, v := range x.PackedField {
-			protobuf_go_lite.TextWriteListSeparator(&sb, i)
-			protobuf_go_lite.TextWriteStringer(&sb, EnumMessage_Num(v))
-		}
-		protobuf_go_lite.TextWriteListEnd(&sb)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
+	return nil
 }
 
-func (x *EnumMessage) String() string {
-	return x.MarshalProtoText()
-}
-func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *DoubleMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4129,6 +11852,13 @@ func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: DoubleMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
@@ -4245,7 +11975,7 @@ func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4254,7 +11984,11 @@ func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *FloatMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4274,6 +12008,13 @@ func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: FloatMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 5 {
@@ -4390,7 +12131,7 @@ func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4399,7 +12140,11 @@ func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Int32Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4419,6 +12164,13 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Int32Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -4517,7 +12269,7 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4526,7 +12278,11 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Int64Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4546,6 +12302,13 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Int64Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -4644,7 +12407,7 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4653,7 +12416,11 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Uint32Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4673,6 +12440,13 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Uint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -4771,7 +12545,7 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4780,7 +12554,11 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Uint64Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4800,6 +12578,13 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Uint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -4898,7 +12683,7 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -4907,7 +12692,11 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sint32Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -4927,6 +12716,13 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Sint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -5037,7 +12833,7 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5046,7 +12842,11 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sint64Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5066,6 +12866,13 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Sint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -5176,7 +12983,7 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5185,7 +12992,11 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Fixed32Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5205,6 +13016,13 @@ func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Fixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 5 {
@@ -5315,7 +13133,7 @@ func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5324,7 +13142,11 @@ func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Fixed64Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5344,6 +13166,13 @@ func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Fixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
@@ -5454,7 +13283,7 @@ func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5463,7 +13292,11 @@ func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sfixed32Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5483,6 +13316,13 @@ func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Sfixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 5 {
@@ -5593,7 +13433,7 @@ func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5602,7 +13442,11 @@ func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Sfixed64Message) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5622,6 +13466,13 @@ func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Sfixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
@@ -5732,7 +13583,7 @@ func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5741,7 +13592,11 @@ func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BoolMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5761,6 +13616,13 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: BoolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -5861,7 +13723,7 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5870,7 +13732,11 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StringMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5890,13 +13756,20 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: StringMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -5907,7 +13780,7 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -5917,7 +13790,7 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -5938,7 +13811,7 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -5947,7 +13820,11 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *BytesMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -5967,12 +13844,19 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: BytesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
 			}
-			m.RequiredField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.RequiredField, dAtA, iNdEx)
+			m.RequiredField, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -5981,7 +13865,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
 			}
-			m.OptionalField, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.OptionalField, dAtA, iNdEx)
+			m.OptionalField, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -5990,7 +13874,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
 			var v []byte
-			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -6011,7 +13895,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -6020,7 +13904,11 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *EnumMessage) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -6040,6 +13928,13 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: EnumMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
@@ -6150,7 +14045,7 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 1) {
 		return fmt.Errorf("proto: required field required_field not set")
 	}
 
@@ -6159,6 +14054,7 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *DoubleMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -19,3 +19,19 @@ func TestPackedFixed32MalformedLength(t *testing.T) {
 		t.Fatalf("UnmarshalVTUnsafe malformed packed fixed32 = %v, want ErrUnexpectedEOF", err)
 	}
 }
+
+func TestUnmarshalFieldsRequired(t *testing.T) {
+	// optional_field: 5, required_field is missing.
+	wire := []byte{0x10, 0x05}
+
+	var out Int32Message
+	if err := out.UnmarshalVTFields(wire, 2); err != nil {
+		t.Fatalf("UnmarshalVTFields without required field = %v, want nil", err)
+	}
+	if out.GetOptionalField() != 5 {
+		t.Fatalf("GetOptionalField() = %d, want 5", out.GetOptionalField())
+	}
+	if err := out.UnmarshalVTFields(wire, 1, 2); err == nil {
+		t.Fatal("UnmarshalVTFields with missing selected required field should fail")
+	}
+}
@@ -5,7 +5,10 @@
 package proto3opt
 
 import (
+	bytes "bytes"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	math "math"
 	slices "slices"
@@ -77,6 +80,29 @@ func (x *OptionalFieldInProto3) Reset() {
 
 func (*OptionalFieldInProto3) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *OptionalFieldInProto3) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *OptionalFieldInProto3) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *OptionalFieldInProto3) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *OptionalFieldInProto3) GetOptionalInt32() int32 {
 	if x != nil && x.OptionalInt32 != nil {
 		return *x.OptionalInt32
@@ -220,6 +246,139 @@ func (m *OptionalFieldInProto3) CloneMessageVT() protobuf_go_lite.CloneMessage {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *OptionalFieldInProto3) CompareVT(that *OptionalFieldInProto3) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalInt32, that.OptionalInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalInt64, that.OptionalInt64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalUint32, that.OptionalUint32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalUint64, that.OptionalUint64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalSint32, that.OptionalSint32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalSint64, that.OptionalSint64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalFixed32, that.OptionalFixed32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalFixed64, that.OptionalFixed64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalSfixed32, that.OptionalSfixed32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalSfixed64, that.OptionalSfixed64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalFloat, that.OptionalFloat); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalDouble, that.OptionalDouble); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBoolPtr(m.OptionalBool, that.OptionalBool); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalString, that.OptionalString); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBytesPresent(m.OptionalBytes, that.OptionalBytes); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.OptionalEnum, that.OptionalEnum); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *OptionalFieldInProto3) CopyVT(dst *OptionalFieldInProto3) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.OptionalInt32 = protobuf_go_lite.CopyPtr(dst.OptionalInt32, m.OptionalInt32)
+	dst.OptionalInt64 = protobuf_go_lite.CopyPtr(dst.OptionalInt64, m.OptionalInt64)
+	dst.OptionalUint32 = protobuf_go_lite.CopyPtr(dst.OptionalUint32, m.OptionalUint32)
+	dst.OptionalUint64 = protobuf_go_lite.CopyPtr(dst.OptionalUint64, m.OptionalUint64)
+	dst.OptionalSint32 = protobuf_go_lite.CopyPtr(dst.OptionalSint32, m.OptionalSint32)
+	dst.OptionalSint64 = protobuf_go_lite.CopyPtr(dst.OptionalSint64, m.OptionalSint64)
+	dst.OptionalFixed32 = protobuf_go_lite.CopyPtr(dst.OptionalFixed32, m.OptionalFixed32)
+	dst.OptionalFixed64 = protobuf_go_lite.CopyPtr(dst.OptionalFixed64, m.OptionalFixed64)
+	dst.OptionalSfixed32 = protobuf_go_lite.CopyPtr(dst.OptionalSfixed32, m.OptionalSfixed32)
+	dst.OptionalSfixed64 = protobuf_go_lite.CopyPtr(dst.OptionalSfixed64, m.OptionalSfixed64)
+	dst.OptionalFloat = protobuf_go_lite.CopyPtr(dst.OptionalFloat, m.OptionalFloat)
+	dst.OptionalDouble = protobuf_go_lite.CopyPtr(dst.OptionalDouble, m.OptionalDouble)
+	dst.OptionalBool = protobuf_go_lite.CopyPtr(dst.OptionalBool, m.OptionalBool)
+	dst.OptionalString = protobuf_go_lite.CopyPtr(dst.OptionalString, m.OptionalString)
+	dst.OptionalBytes = protobuf_go_lite.CopyBytes(dst.OptionalBytes, m.OptionalBytes)
+	dst.OptionalEnum = protobuf_go_lite.CopyPtr(dst.OptionalEnum, m.OptionalEnum)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *OptionalFieldInProto3) DiffVT(that *OptionalFieldInProto3) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *OptionalFieldInProto3) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *OptionalFieldInProto3) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &OptionalFieldInProto3{}
+	}
+	if that == nil {
+		that = &OptionalFieldInProto3{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_int32", m.OptionalInt32, that.OptionalInt32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_int64", m.OptionalInt64, that.OptionalInt64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_uint32", m.OptionalUint32, that.OptionalUint32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_uint64", m.OptionalUint64, that.OptionalUint64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_sint32", m.OptionalSint32, that.OptionalSint32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_sint64", m.OptionalSint64, that.OptionalSint64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_fixed32", m.OptionalFixed32, that.OptionalFixed32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_fixed64", m.OptionalFixed64, that.OptionalFixed64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_sfixed32", m.OptionalSfixed32, that.OptionalSfixed32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_sfixed64", m.OptionalSfixed64, that.OptionalSfixed64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_float", m.OptionalFloat, that.OptionalFloat)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_double", m.OptionalDouble, that.OptionalDouble)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_bool", m.OptionalBool, that.OptionalBool)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_string", m.OptionalString, that.OptionalString)
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "optional_bytes", m.OptionalBytes, that.OptionalBytes)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "optional_enum", m.OptionalEnum, that.OptionalEnum)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *OptionalFieldInProto3) EqualVT(that *OptionalFieldInProto3) bool {
 	if this == that {
 		return true
@@ -285,6 +444,168 @@ func (this *OptionalFieldInProto3) EqualMessageVT(thatMsg any) bool {
 	return this.EqualVT(that)
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *OptionalFieldInProto3) EqualVTOpts(that *OptionalFieldInProto3, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *OptionalFieldInProto3) EqualVTOptsPrefix(that *OptionalFieldInProto3, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &OptionalFieldInProto3{}
+		}
+		if that == nil {
+			that = &OptionalFieldInProto3{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_int32"); ok && ((this.OptionalInt32 == nil) != (that.OptionalInt32 == nil) || this.OptionalInt32 != nil && *this.OptionalInt32 != *that.OptionalInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_int64"); ok && ((this.OptionalInt64 == nil) != (that.OptionalInt64 == nil) || this.OptionalInt64 != nil && *this.OptionalInt64 != *that.OptionalInt64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_uint32"); ok && ((this.OptionalUint32 == nil) != (that.OptionalUint32 == nil) || this.OptionalUint32 != nil && *this.OptionalUint32 != *that.OptionalUint32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_uint64"); ok && ((this.OptionalUint64 == nil) != (that.OptionalUint64 == nil) || this.OptionalUint64 != nil && *this.OptionalUint64 != *that.OptionalUint64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_sint32"); ok && ((this.OptionalSint32 == nil) != (that.OptionalSint32 == nil) || this.OptionalSint32 != nil && *this.OptionalSint32 != *that.OptionalSint32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_sint64"); ok && ((this.OptionalSint64 == nil) != (that.OptionalSint64 == nil) || this.OptionalSint64 != nil && *this.OptionalSint64 != *that.OptionalSint64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_fixed32"); ok && ((this.OptionalFixed32 == nil) != (that.OptionalFixed32 == nil) || this.OptionalFixed32 != nil && *this.OptionalFixed32 != *that.OptionalFixed32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_fixed64"); ok && ((this.OptionalFixed64 == nil) != (that.OptionalFixed64 == nil) || this.OptionalFixed64 != nil && *this.OptionalFixed64 != *that.OptionalFixed64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_sfixed32"); ok && ((this.OptionalSfixed32 == nil) != (that.OptionalSfixed32 == nil) || this.OptionalSfixed32 != nil && *this.OptionalSfixed32 != *that.OptionalSfixed32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_sfixed64"); ok && ((this.OptionalSfixed64 == nil) != (that.OptionalSfixed64 == nil) || this.OptionalSfixed64 != nil && *this.OptionalSfixed64 != *that.OptionalSfixed64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_float"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.OptionalFloat, that.OptionalFloat) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_double"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.OptionalDouble, that.OptionalDouble) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_bool"); ok && ((this.OptionalBool == nil) != (that.OptionalBool == nil) || this.OptionalBool != nil && *this.OptionalBool != *that.OptionalBool) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_string"); ok && ((this.OptionalString == nil) != (that.OptionalString == nil) || this.OptionalString != nil && *this.OptionalString != *that.OptionalString) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_bytes"); ok && ((this.OptionalBytes == nil) != (that.OptionalBytes == nil) || string(this.OptionalBytes) != string(that.OptionalBytes)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "optional_enum"); ok && ((this.OptionalEnum == nil) != (that.OptionalEnum == nil) || this.OptionalEnum != nil && *this.OptionalEnum != *that.OptionalEnum) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *OptionalFieldInProto3) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *OptionalFieldInProto3) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *OptionalFieldInProto3) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.OptionalInt32 != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.OptionalInt32))
+		}
+		if m.OptionalInt64 != nil {
+			w.Field(2)
+			w.Uint64(uint64(*m.OptionalInt64))
+		}
+		if m.OptionalUint32 != nil {
+			w.Field(3)
+			w.Uint64(uint64(*m.OptionalUint32))
+		}
+		if m.OptionalUint64 != nil {
+			w.Field(4)
+			w.Uint64(uint64(*m.OptionalUint64))
+		}
+		if m.OptionalSint32 != nil {
+			w.Field(5)
+			w.Uint64(uint64(*m.OptionalSint32))
+		}
+		if m.OptionalSint64 != nil {
+			w.Field(6)
+			w.Uint64(uint64(*m.OptionalSint64))
+		}
+		if m.OptionalFixed32 != nil {
+			w.Field(7)
+			w.Uint64(uint64(*m.OptionalFixed32))
+		}
+		if m.OptionalFixed64 != nil {
+			w.Field(8)
+			w.Uint64(uint64(*m.OptionalFixed64))
+		}
+		if m.OptionalSfixed32 != nil {
+			w.Field(9)
+			w.Uint64(uint64(*m.OptionalSfixed32))
+		}
+		if m.OptionalSfixed64 != nil {
+			w.Field(10)
+			w.Uint64(uint64(*m.OptionalSfixed64))
+		}
+		if m.OptionalFloat != nil {
+			w.Field(11)
+			w.Float64(float64(*m.OptionalFloat))
+		}
+		if m.OptionalDouble != nil {
+			w.Field(12)
+			w.Float64(float64(*m.OptionalDouble))
+		}
+		if m.OptionalBool != nil {
+			w.Field(13)
+			w.Bool(*m.OptionalBool)
+		}
+		if m.OptionalString != nil {
+			w.Field(14)
+			w.String(*m.OptionalString)
+		}
+		if m.OptionalBytes != nil {
+			w.Field(15)
+			w.Bytes(m.OptionalBytes)
+		}
+		if m.OptionalEnum != nil {
+			w.Field(16)
+			w.Uint64(uint64(*m.OptionalEnum))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 // MarshalProtoJSON marshals the SimpleEnum to JSON.
 func (x SimpleEnum) MarshalProtoJSON(s *json.MarshalState) {
 	s.WriteEnum(int32(x), SimpleEnum_name)
@@ -795,6 +1116,96 @@ func (m *OptionalFieldInProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *OptionalFieldInProto3) MergeVT(src *OptionalFieldInProto3) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.OptionalInt32 != nil {
+		v := *src.OptionalInt32
+		m.OptionalInt32 = &v
+	}
+	if src.OptionalInt64 != nil {
+		v := *src.OptionalInt64
+		m.OptionalInt64 = &v
+	}
+	if src.OptionalUint32 != nil {
+		v := *src.OptionalUint32
+		m.OptionalUint32 = &v
+	}
+	if src.OptionalUint64 != nil {
+		v := *src.OptionalUint64
+		m.OptionalUint64 = &v
+	}
+	if src.OptionalSint32 != nil {
+		v := *src.OptionalSint32
+		m.OptionalSint32 = &v
+	}
+	if src.OptionalSint64 != nil {
+		v := *src.OptionalSint64
+		m.OptionalSint64 = &v
+	}
+	if src.OptionalFixed32 != nil {
+		v := *src.OptionalFixed32
+		m.OptionalFixed32 = &v
+	}
+	if src.OptionalFixed64 != nil {
+		v := *src.OptionalFixed64
+		m.OptionalFixed64 = &v
+	}
+	if src.OptionalSfixed32 != nil {
+		v := *src.OptionalSfixed32
+		m.OptionalSfixed32 = &v
+	}
+	if src.OptionalSfixed64 != nil {
+		v := *src.OptionalSfixed64
+		m.OptionalSfixed64 = &v
+	}
+	if src.OptionalFloat != nil {
+		v := *src.OptionalFloat
+		m.OptionalFloat = &v
+	}
+	if src.OptionalDouble != nil {
+		v := *src.OptionalDouble
+		m.OptionalDouble = &v
+	}
+	if src.OptionalBool != nil {
+		v := *src.OptionalBool
+		m.OptionalBool = &v
+	}
+	if src.OptionalString != nil {
+		v := *src.OptionalString
+		m.OptionalString = &v
+	}
+	if src.OptionalBytes != nil {
+		m.OptionalBytes = slices.Clone(src.OptionalBytes)
+	}
+	if src.OptionalEnum != nil {
+		v := *src.OptionalEnum
+		m.OptionalEnum = &v
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *OptionalFieldInProto3) MergeMessageVT(src any) bool {
+	s, ok := src.(*OptionalFieldInProto3)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *OptionalFieldInProto3) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *OptionalFieldInProto3) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1118,6 +1529,471 @@ func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *OptionalFieldInProto3) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalSint64 = &v
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalString = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			m.OptionalBytes, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.OptionalBytes, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalEnum", wireType)
+			}
+			var v SimpleEnum
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = SimpleEnum(_v)
+			if err != nil {
+				return err
+			}
+			m.OptionalEnum = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *OptionalFieldInProto3) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalSint64 = &v
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.OptionalString = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			m.OptionalBytes, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalEnum", wireType)
+			}
+			var v SimpleEnum
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = SimpleEnum(_v)
+			if err != nil {
+				return err
+			}
+			m.OptionalEnum = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *OptionalFieldInProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
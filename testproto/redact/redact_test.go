@@ -0,0 +1,92 @@
+package redact
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/json"
+)
+
+func newCredentials() *Credentials {
+	pin := int64(1234)
+	return &Credentials{
+		User:    "alice",
+		Token:   "tok-secret",
+		Pin:     &pin,
+		Keys:    []string{"key-secret"},
+		Headers: map[string]string{"authorization": "hdr-secret"},
+		Secret:  &Secret{Value: []byte("val-secret")},
+		Parent:  &Credentials{User: "parent", Token: "parent-secret"},
+		Children: []*Credentials{
+			{User: "child", Auth: &Credentials_Password{Password: "child-secret"}},
+		},
+		ByName: map[string]*Credentials{
+			"bob": {User: "bob", Token: "bob-secret"},
+		},
+		Auth:   &Credentials_Delegate{Delegate: &Credentials{User: "delegate", Token: "delegate-secret"}},
+		ApiKey: "api-secret",
+	}
+}
+
+func TestRedactText(t *testing.T) {
+	got := newCredentials().String()
+	if strings.Contains(got, "-secret") {
+		t.Fatalf("text leaks a redacted value: %s", got)
+	}
+	for _, want := range []string{`user: "alice"`, `token: [REDACTED]`, `pin: [REDACTED]`, `keys: [REDACTED]`, `headers: [REDACTED]`, `secret: [REDACTED]`, `password: [REDACTED]`, `api_key: [REDACTED]`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("text %s is missing %s", got, want)
+		}
+	}
+	if got := (&Credentials{User: "alice"}).String(); got != `Credentials {user: "alice"}` {
+		t.Errorf("unset redacted fields are written: %s", got)
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	msg := newCredentials()
+	plain, err := msg.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(plain), "tok-secret") {
+		t.Fatalf("default config redacted the token: %s", plain)
+	}
+
+	redacted, err := json.MarshalerConfig{Redact: true}.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(redacted), "-secret") {
+		t.Fatalf("redacted JSON leaks a value: %s", redacted)
+	}
+	for _, want := range []string{`"user":"alice"`, `"token":"[REDACTED]"`, `"headers":"[REDACTED]"`, `"secret":"[REDACTED]"`} {
+		if !strings.Contains(string(redacted), want) {
+			t.Errorf("redacted JSON %s is missing %s", redacted, want)
+		}
+	}
+}
+
+func TestRedactVT(t *testing.T) {
+	msg := newCredentials()
+	msg.RedactVT()
+
+	want := &Credentials{
+		User:     "alice",
+		Parent:   &Credentials{User: "parent"},
+		Children: []*Credentials{{User: "child"}},
+		ByName:   map[string]*Credentials{"bob": {User: "bob"}},
+		Auth:     &Credentials_Delegate{Delegate: &Credentials{User: "delegate"}},
+	}
+	if !msg.EqualVT(want) {
+		t.Fatalf("RedactVT() = %v, want %v", msg, want)
+	}
+
+	anonymous := &Credentials{Auth: &Credentials_Anonymous{Anonymous: "guest"}}
+	anonymous.RedactVT()
+	if anonymous.GetAnonymous() != "guest" {
+		t.Fatal("RedactVT cleared a oneof member not marked debug_redact")
+	}
+
+	(*Credentials)(nil).RedactVT()
+}
@@ -5,8 +5,13 @@
 package sizebaseline
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	iter "iter"
 	math "math"
 	slices "slices"
 	strconv "strconv"
@@ -108,6 +113,49 @@ func (x *SizeBaseline) Reset() {
 
 func (*SizeBaseline) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SizeBaseline) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SizeBaseline) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SizeBaseline) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.NestedValues {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.NestedByName {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.NestedById {
+		v.DiscardUnknownVT()
+	}
+	x.Nested.DiscardUnknownVT()
+	x.Timestamp.DiscardUnknownVT()
+	x.Duration.DiscardUnknownVT()
+	x.StringWrapper.DiscardUnknownVT()
+	x.BytesWrapper.DiscardUnknownVT()
+	x.StructValue.DiscardUnknownVT()
+	x.ValueValue.DiscardUnknownVT()
+	x.ListValue.DiscardUnknownVT()
+	if v, ok := x.Selection.(*SizeBaseline_SelectedNested); ok {
+		v.SelectedNested.DiscardUnknownVT()
+	}
+}
+
 func (x *SizeBaseline) GetExplicitInt32() int32 {
 	if x != nil && x.ExplicitInt32 != nil {
 		return *x.ExplicitInt32
@@ -402,6 +450,29 @@ func (x *SizeBaseline_Nested) Reset() {
 
 func (*SizeBaseline_Nested) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SizeBaseline_Nested) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SizeBaseline_Nested) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SizeBaseline_Nested) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *SizeBaseline_Nested) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -435,6 +506,30 @@ func (x *SizeBaseline_NestedByNameEntry) Reset() {
 
 func (*SizeBaseline_NestedByNameEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SizeBaseline_NestedByNameEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SizeBaseline_NestedByNameEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SizeBaseline_NestedByNameEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
 func (x *SizeBaseline_NestedByNameEntry) GetKey() string {
 	if x != nil && x.Key != nil {
 		return *x.Key
@@ -461,6 +556,30 @@ func (x *SizeBaseline_NestedByIdEntry) Reset() {
 
 func (*SizeBaseline_NestedByIdEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SizeBaseline_NestedByIdEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SizeBaseline_NestedByIdEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SizeBaseline_NestedByIdEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
 func (x *SizeBaseline_NestedByIdEntry) GetKey() uint32 {
 	if x != nil && x.Key != nil {
 		return *x.Key
@@ -585,6 +704,383 @@ func (m *SizeBaseline_SelectedNested) CloneOneofVT() isSizeBaseline_Selection {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *SizeBaseline_Nested) CompareVT(that *SizeBaseline_Nested) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.Count, that.Count); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.Labels, that.Labels); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *SizeBaseline) CompareVT(that *SizeBaseline) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitInt32, that.ExplicitInt32); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.ImplicitInt32, that.ImplicitInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitInt64, that.ExplicitInt64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitUint32, that.ExplicitUint32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitUint64, that.ExplicitUint64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitSint32, that.ExplicitSint32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.ExplicitSint64, that.ExplicitSint64); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Fixed32Value, that.Fixed32Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Fixed64Value, that.Fixed64Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Sfixed32Value, that.Sfixed32Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.Sfixed64Value, that.Sfixed64Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.FloatValue, that.FloatValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.DoubleValue, that.DoubleValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBoolPtr(m.BoolValue, that.BoolValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.StringValue, that.StringValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareBytesPresent(m.BytesValue, that.BytesValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.RequiredInt32, that.RequiredInt32); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.PackedInt32, that.PackedInt32); c != 0 {
+		return c
+	}
+	if c := slices.Compare(m.ExpandedInt32, that.ExpandedInt32); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.NestedValues, that.NestedValues, (*SizeBaseline_Nested).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.NestedByName, that.NestedByName, cmp.Compare[string], (*SizeBaseline_Nested).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.NestedById, that.NestedById, cmp.Compare[uint32], (*SizeBaseline_Nested).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.State, that.State); c != 0 {
+		return c
+	}
+	if c := m.Nested.CompareVT(that.Nested); c != 0 {
+		return c
+	}
+	if c := m.Timestamp.CompareVT(that.Timestamp); c != 0 {
+		return c
+	}
+	if c := m.Duration.CompareVT(that.Duration); c != 0 {
+		return c
+	}
+	if c := m.StringWrapper.CompareVT(that.StringWrapper); c != 0 {
+		return c
+	}
+	if c := m.BytesWrapper.CompareVT(that.BytesWrapper); c != 0 {
+		return c
+	}
+	if c := m.StructValue.CompareVT(that.StructValue); c != 0 {
+		return c
+	}
+	if c := m.ValueValue.CompareVT(that.ValueValue); c != 0 {
+		return c
+	}
+	if c := m.ListValue.CompareVT(that.ListValue); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Selection.(*SizeBaseline_SelectedName)
+		b, bok := that.Selection.(*SizeBaseline_SelectedName)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.SelectedName, b.SelectedName); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Selection.(*SizeBaseline_SelectedId)
+		b, bok := that.Selection.(*SizeBaseline_SelectedId)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.SelectedId, b.SelectedId); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Selection.(*SizeBaseline_SelectedNested)
+		b, bok := that.Selection.(*SizeBaseline_SelectedNested)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.SelectedNested, b.SelectedNested, (*SizeBaseline_Nested).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := protobuf_go_lite.ComparePtr(m.DefaultString, that.DefaultString); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.ComparePtr(m.DefaultInt32, that.DefaultInt32); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *SizeBaseline_Nested) CopyVT(dst *SizeBaseline_Nested) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Name = protobuf_go_lite.CopyPtr(dst.Name, m.Name)
+	dst.Count = m.Count
+	dst.Labels = protobuf_go_lite.CopySlice(dst.Labels, m.Labels)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *SizeBaseline) CopyVT(dst *SizeBaseline) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.ExplicitInt32 = protobuf_go_lite.CopyPtr(dst.ExplicitInt32, m.ExplicitInt32)
+	dst.ImplicitInt32 = m.ImplicitInt32
+	dst.ExplicitInt64 = protobuf_go_lite.CopyPtr(dst.ExplicitInt64, m.ExplicitInt64)
+	dst.ExplicitUint32 = protobuf_go_lite.CopyPtr(dst.ExplicitUint32, m.ExplicitUint32)
+	dst.ExplicitUint64 = protobuf_go_lite.CopyPtr(dst.ExplicitUint64, m.ExplicitUint64)
+	dst.ExplicitSint32 = protobuf_go_lite.CopyPtr(dst.ExplicitSint32, m.ExplicitSint32)
+	dst.ExplicitSint64 = protobuf_go_lite.CopyPtr(dst.ExplicitSint64, m.ExplicitSint64)
+	dst.Fixed32Value = protobuf_go_lite.CopyPtr(dst.Fixed32Value, m.Fixed32Value)
+	dst.Fixed64Value = protobuf_go_lite.CopyPtr(dst.Fixed64Value, m.Fixed64Value)
+	dst.Sfixed32Value = protobuf_go_lite.CopyPtr(dst.Sfixed32Value, m.Sfixed32Value)
+	dst.Sfixed64Value = protobuf_go_lite.CopyPtr(dst.Sfixed64Value, m.Sfixed64Value)
+	dst.FloatValue = protobuf_go_lite.CopyPtr(dst.FloatValue, m.FloatValue)
+	dst.DoubleValue = protobuf_go_lite.CopyPtr(dst.DoubleValue, m.DoubleValue)
+	dst.BoolValue = protobuf_go_lite.CopyPtr(dst.BoolValue, m.BoolValue)
+	dst.StringValue = protobuf_go_lite.CopyPtr(dst.StringValue, m.StringValue)
+	dst.BytesValue = protobuf_go_lite.CopyBytes(dst.BytesValue, m.BytesValue)
+	dst.RequiredInt32 = protobuf_go_lite.CopyPtr(dst.RequiredInt32, m.RequiredInt32)
+	dst.PackedInt32 = protobuf_go_lite.CopySlice(dst.PackedInt32, m.PackedInt32)
+	dst.ExpandedInt32 = protobuf_go_lite.CopySlice(dst.ExpandedInt32, m.ExpandedInt32)
+	dst.NestedValues = protobuf_go_lite.CopyVTSlice(dst.NestedValues, m.NestedValues, (*SizeBaseline_Nested).CopyVT)
+	dst.NestedByName = protobuf_go_lite.CopyVTMap(dst.NestedByName, m.NestedByName, (*SizeBaseline_Nested).CopyVT)
+	dst.NestedById = protobuf_go_lite.CopyVTMap(dst.NestedById, m.NestedById, (*SizeBaseline_Nested).CopyVT)
+	dst.State = protobuf_go_lite.CopyPtr(dst.State, m.State)
+	dst.Nested = protobuf_go_lite.CopyVTValue(dst.Nested, m.Nested, (*SizeBaseline_Nested).CopyVT)
+	dst.Timestamp = protobuf_go_lite.CopyVTValue(dst.Timestamp, m.Timestamp, (*timestamppb.Timestamp).CopyVT)
+	dst.Duration = protobuf_go_lite.CopyVTValue(dst.Duration, m.Duration, (*durationpb.Duration).CopyVT)
+	dst.StringWrapper = protobuf_go_lite.CopyVTValue(dst.StringWrapper, m.StringWrapper, (*wrapperspb.StringValue).CopyVT)
+	dst.BytesWrapper = protobuf_go_lite.CopyVTValue(dst.BytesWrapper, m.BytesWrapper, (*wrapperspb.BytesValue).CopyVT)
+	dst.StructValue = protobuf_go_lite.CopyVTValue(dst.StructValue, m.StructValue, (*structpb.Struct).CopyVT)
+	dst.ValueValue = protobuf_go_lite.CopyVTValue(dst.ValueValue, m.ValueValue, (*structpb.Value).CopyVT)
+	dst.ListValue = protobuf_go_lite.CopyVTValue(dst.ListValue, m.ListValue, (*structpb.ListValue).CopyVT)
+	dst.DefaultString = protobuf_go_lite.CopyPtr(dst.DefaultString, m.DefaultString)
+	dst.DefaultInt32 = protobuf_go_lite.CopyPtr(dst.DefaultInt32, m.DefaultInt32)
+	switch v := m.Selection.(type) {
+	case nil:
+		dst.Selection = nil
+	case *SizeBaseline_SelectedName:
+		d, ok := dst.Selection.(*SizeBaseline_SelectedName)
+		if !ok {
+			d = &SizeBaseline_SelectedName{}
+			dst.Selection = d
+		}
+		d.SelectedName = v.SelectedName
+	case *SizeBaseline_SelectedId:
+		d, ok := dst.Selection.(*SizeBaseline_SelectedId)
+		if !ok {
+			d = &SizeBaseline_SelectedId{}
+			dst.Selection = d
+		}
+		d.SelectedId = v.SelectedId
+	case *SizeBaseline_SelectedNested:
+		d, ok := dst.Selection.(*SizeBaseline_SelectedNested)
+		if !ok {
+			d = &SizeBaseline_SelectedNested{}
+			dst.Selection = d
+		}
+		d.SelectedNested = protobuf_go_lite.CopyVTValue(d.SelectedNested, v.SelectedNested, (*SizeBaseline_Nested).CopyVT)
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *SizeBaseline_Nested) DiffVT(that *SizeBaseline_Nested) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *SizeBaseline_Nested) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *SizeBaseline_Nested) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &SizeBaseline_Nested{}
+	}
+	if that == nil {
+		that = &SizeBaseline_Nested{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "name", m.Name, that.Name)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "count", m.Count, that.Count)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "labels", m.Labels, that.Labels)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *SizeBaseline) DiffVT(that *SizeBaseline) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *SizeBaseline) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *SizeBaseline) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &SizeBaseline{}
+	}
+	if that == nil {
+		that = &SizeBaseline{}
+	}
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_int32", m.ExplicitInt32, that.ExplicitInt32)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "implicit_int32", m.ImplicitInt32, that.ImplicitInt32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_int64", m.ExplicitInt64, that.ExplicitInt64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_uint32", m.ExplicitUint32, that.ExplicitUint32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_uint64", m.ExplicitUint64, that.ExplicitUint64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_sint32", m.ExplicitSint32, that.ExplicitSint32)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "explicit_sint64", m.ExplicitSint64, that.ExplicitSint64)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "fixed32_value", m.Fixed32Value, that.Fixed32Value)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "fixed64_value", m.Fixed64Value, that.Fixed64Value)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "sfixed32_value", m.Sfixed32Value, that.Sfixed32Value)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "sfixed64_value", m.Sfixed64Value, that.Sfixed64Value)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "float_value", m.FloatValue, that.FloatValue)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "double_value", m.DoubleValue, that.DoubleValue)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "bool_value", m.BoolValue, that.BoolValue)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "string_value", m.StringValue, that.StringValue)
+	diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "bytes_value", m.BytesValue, that.BytesValue)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "required_int32", m.RequiredInt32, that.RequiredInt32)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "packed_int32", m.PackedInt32, that.PackedInt32)
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "expanded_int32", m.ExpandedInt32, that.ExpandedInt32)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "nested_values", m.NestedValues, that.NestedValues, (*SizeBaseline_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "nested_by_name", m.NestedByName, that.NestedByName, (*SizeBaseline_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "nested_by_id", m.NestedById, that.NestedById, (*SizeBaseline_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "state", m.State, that.State)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "nested", m.Nested, that.Nested, (*SizeBaseline_Nested).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "timestamp", m.Timestamp, that.Timestamp, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "duration", m.Duration, that.Duration, (*durationpb.Duration).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "string_wrapper", m.StringWrapper, that.StringWrapper, (*wrapperspb.StringValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "bytes_wrapper", m.BytesWrapper, that.BytesWrapper, (*wrapperspb.BytesValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "struct_value", m.StructValue, that.StructValue, (*structpb.Struct).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "value_value", m.ValueValue, that.ValueValue, (*structpb.Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "list_value", m.ListValue, that.ListValue, (*structpb.ListValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "default_string", m.DefaultString, that.DefaultString)
+	diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "default_int32", m.DefaultInt32, that.DefaultInt32)
+	{
+		var a, b *string
+		if v, ok := m.Selection.(*SizeBaseline_SelectedName); ok {
+			a = &v.SelectedName
+		}
+		if v, ok := that.Selection.(*SizeBaseline_SelectedName); ok {
+			b = &v.SelectedName
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "selected_name", a, b)
+	}
+	{
+		var a, b *int32
+		if v, ok := m.Selection.(*SizeBaseline_SelectedId); ok {
+			a = &v.SelectedId
+		}
+		if v, ok := that.Selection.(*SizeBaseline_SelectedId); ok {
+			b = &v.SelectedId
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "selected_id", a, b)
+	}
+	{
+		var a, b *SizeBaseline_Nested
+		if v, ok := m.Selection.(*SizeBaseline_SelectedNested); ok {
+			a = v.SelectedNested
+		}
+		if v, ok := that.Selection.(*SizeBaseline_SelectedNested); ok {
+			b = v.SelectedNested
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "selected_nested", a, b, (*SizeBaseline_Nested).AppendDiffVT)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *SizeBaseline_Nested) EqualVT(that *SizeBaseline_Nested) bool {
 	if this == that {
 		return true
@@ -788,104 +1284,506 @@ func (this *SizeBaseline_SelectedNested) EqualVT(thatIface isSizeBaseline_Select
 	return true
 }
 
-// MarshalProtoJSON marshals the SizeBaseline_State to JSON.
-func (x SizeBaseline_State) MarshalProtoJSON(s *json.MarshalState) {
-	s.WriteEnum(int32(x), SizeBaseline_State_name)
-}
-
-// MarshalText marshals the SizeBaseline_State to text.
-func (x SizeBaseline_State) MarshalText() ([]byte, error) {
-	return []byte(json.GetEnumString(int32(x), SizeBaseline_State_name)), nil
-}
-
-// MarshalJSON marshals the SizeBaseline_State to JSON.
-func (x SizeBaseline_State) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
-}
-
-// UnmarshalProtoJSON unmarshals the SizeBaseline_State from JSON.
-func (x *SizeBaseline_State) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	v := s.ReadEnum(SizeBaseline_State_value)
-	if err := s.Err(); err != nil {
-		s.SetErrorf("could not read State enum: %v", err)
-		return
-	}
-	*x = SizeBaseline_State(v)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *SizeBaseline_Nested) EqualVTOpts(that *SizeBaseline_Nested, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// UnmarshalText unmarshals the SizeBaseline_State from text.
-func (x *SizeBaseline_State) UnmarshalText(b []byte) error {
-	i, err := json.ParseEnumString(string(b), SizeBaseline_State_value)
-	if err != nil {
-		return err
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *SizeBaseline_Nested) EqualVTOptsPrefix(that *SizeBaseline_Nested, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	*x = SizeBaseline_State(i)
-	return nil
-}
-
-// UnmarshalJSON unmarshals the SizeBaseline_State from JSON.
-func (x *SizeBaseline_State) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
-}
-
-// MarshalProtoJSON marshals the SizeBaseline_Nested message to JSON.
-func (x *SizeBaseline_Nested) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &SizeBaseline_Nested{}
+		}
+		if that == nil {
+			that = &SizeBaseline_Nested{}
+		}
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.Name != nil {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("name")
-		s.WriteString(*x.Name)
+	if _, ok := opts.FieldPath(prefix, "name"); ok && ((this.Name == nil) != (that.Name == nil) || this.Name != nil && *this.Name != *that.Name) {
+		return false
 	}
-	if x.Count != 0 || s.HasField("count") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("count")
-		s.WriteInt64(x.Count)
+	if _, ok := opts.FieldPath(prefix, "count"); ok && this.Count != that.Count {
+		return false
 	}
-	if len(x.Labels) > 0 || s.HasField("labels") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("labels")
-		s.WriteStringArray(x.Labels)
+	if _, ok := opts.FieldPath(prefix, "labels"); ok && !slices.Equal(this.Labels, that.Labels) {
+		return false
 	}
-	s.WriteObjectEnd()
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// MarshalJSON marshals the SizeBaseline_Nested to JSON.
-func (x *SizeBaseline_Nested) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *SizeBaseline) EqualVTOpts(that *SizeBaseline, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// UnmarshalProtoJSON unmarshals the SizeBaseline_Nested message from JSON.
-func (x *SizeBaseline_Nested) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *SizeBaseline) EqualVTOptsPrefix(that *SizeBaseline, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "name":
-			s.AddField("name")
-			if s.ReadNil() {
-				x.Name = nil
-				return
-			}
-			t := s.ReadString()
-			x.Name = &t
-		case "count":
-			s.AddField("count")
-			x.Count = s.ReadInt64()
-		case "labels":
-			s.AddField("labels")
-			if s.ReadNil() {
-				x.Labels = nil
-				return
-			}
-			x.Labels = s.ReadStringArray()
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &SizeBaseline{}
+		}
+		if that == nil {
+			that = &SizeBaseline{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_int32"); ok && ((this.ExplicitInt32 == nil) != (that.ExplicitInt32 == nil) || this.ExplicitInt32 != nil && *this.ExplicitInt32 != *that.ExplicitInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "implicit_int32"); ok && this.ImplicitInt32 != that.ImplicitInt32 {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_int64"); ok && ((this.ExplicitInt64 == nil) != (that.ExplicitInt64 == nil) || this.ExplicitInt64 != nil && *this.ExplicitInt64 != *that.ExplicitInt64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_uint32"); ok && ((this.ExplicitUint32 == nil) != (that.ExplicitUint32 == nil) || this.ExplicitUint32 != nil && *this.ExplicitUint32 != *that.ExplicitUint32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_uint64"); ok && ((this.ExplicitUint64 == nil) != (that.ExplicitUint64 == nil) || this.ExplicitUint64 != nil && *this.ExplicitUint64 != *that.ExplicitUint64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_sint32"); ok && ((this.ExplicitSint32 == nil) != (that.ExplicitSint32 == nil) || this.ExplicitSint32 != nil && *this.ExplicitSint32 != *that.ExplicitSint32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "explicit_sint64"); ok && ((this.ExplicitSint64 == nil) != (that.ExplicitSint64 == nil) || this.ExplicitSint64 != nil && *this.ExplicitSint64 != *that.ExplicitSint64) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "fixed32_value"); ok && ((this.Fixed32Value == nil) != (that.Fixed32Value == nil) || this.Fixed32Value != nil && *this.Fixed32Value != *that.Fixed32Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "fixed64_value"); ok && ((this.Fixed64Value == nil) != (that.Fixed64Value == nil) || this.Fixed64Value != nil && *this.Fixed64Value != *that.Fixed64Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sfixed32_value"); ok && ((this.Sfixed32Value == nil) != (that.Sfixed32Value == nil) || this.Sfixed32Value != nil && *this.Sfixed32Value != *that.Sfixed32Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "sfixed64_value"); ok && ((this.Sfixed64Value == nil) != (that.Sfixed64Value == nil) || this.Sfixed64Value != nil && *this.Sfixed64Value != *that.Sfixed64Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "float_value"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.FloatValue, that.FloatValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "double_value"); ok && !protobuf_go_lite.EqualOptsFloatPtr(opts, this.DoubleValue, that.DoubleValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bool_value"); ok && ((this.BoolValue == nil) != (that.BoolValue == nil) || this.BoolValue != nil && *this.BoolValue != *that.BoolValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "string_value"); ok && ((this.StringValue == nil) != (that.StringValue == nil) || this.StringValue != nil && *this.StringValue != *that.StringValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bytes_value"); ok && ((this.BytesValue == nil) != (that.BytesValue == nil) || string(this.BytesValue) != string(that.BytesValue)) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "required_int32"); ok && ((this.RequiredInt32 == nil) != (that.RequiredInt32 == nil) || this.RequiredInt32 != nil && *this.RequiredInt32 != *that.RequiredInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "packed_int32"); ok && !slices.Equal(this.PackedInt32, that.PackedInt32) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "expanded_int32"); ok && !slices.Equal(this.ExpandedInt32, that.ExpandedInt32) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_values"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.NestedValues, that.NestedValues, opts, path, (*SizeBaseline_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_by_name"); ok && !protobuf_go_lite.EqualVTOptsMap(this.NestedByName, that.NestedByName, opts, path, (*SizeBaseline_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested_by_id"); ok && !protobuf_go_lite.EqualVTOptsMap(this.NestedById, that.NestedById, opts, path, (*SizeBaseline_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "state"); ok && ((this.State == nil) != (that.State == nil) || this.State != nil && *this.State != *that.State) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "nested"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Nested, that.Nested, opts, path, (*SizeBaseline_Nested).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamp"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Timestamp, that.Timestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "duration"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Duration, that.Duration, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "string_wrapper"); ok && !protobuf_go_lite.EqualVTOptsValue(this.StringWrapper, that.StringWrapper, opts, path, (*wrapperspb.StringValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bytes_wrapper"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BytesWrapper, that.BytesWrapper, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "struct_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.StructValue, that.StructValue, opts, path, (*structpb.Struct).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "value_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.ValueValue, that.ValueValue, opts, path, (*structpb.Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "list_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.ListValue, that.ListValue, opts, path, (*structpb.ListValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "selected_name"); ok {
+		a, aok := this.Selection.(*SizeBaseline_SelectedName)
+		b, bok := that.Selection.(*SizeBaseline_SelectedName)
+		if aok != bok || aok && a.SelectedName != b.SelectedName {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "selected_id"); ok {
+		a, aok := this.Selection.(*SizeBaseline_SelectedId)
+		b, bok := that.Selection.(*SizeBaseline_SelectedId)
+		if aok != bok || aok && a.SelectedId != b.SelectedId {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "selected_nested"); ok {
+		a, aok := this.Selection.(*SizeBaseline_SelectedNested)
+		b, bok := that.Selection.(*SizeBaseline_SelectedNested)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.SelectedNested, b.SelectedNested, opts, path, (*SizeBaseline_Nested).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "default_string"); ok && ((this.DefaultString == nil) != (that.DefaultString == nil) || this.DefaultString != nil && *this.DefaultString != *that.DefaultString) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "default_int32"); ok && ((this.DefaultInt32 == nil) != (that.DefaultInt32 == nil) || this.DefaultInt32 != nil && *this.DefaultInt32 != *that.DefaultInt32) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *SizeBaseline_Nested) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *SizeBaseline_Nested) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *SizeBaseline_Nested) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Name != nil {
+			w.Field(1)
+			w.String(*m.Name)
+		}
+		if m.Count != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.Count))
+		}
+		if len(m.Labels) != 0 {
+			w.Field(3)
+			w.Uint64(uint64(len(m.Labels)))
+			for _, v := range m.Labels {
+				w.String(v)
+			}
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *SizeBaseline) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *SizeBaseline) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *SizeBaseline) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.ExplicitInt32 != nil {
+			w.Field(1)
+			w.Uint64(uint64(*m.ExplicitInt32))
+		}
+		if m.ImplicitInt32 != 0 {
+			w.Field(2)
+			w.Uint64(uint64(m.ImplicitInt32))
+		}
+		if m.ExplicitInt64 != nil {
+			w.Field(3)
+			w.Uint64(uint64(*m.ExplicitInt64))
+		}
+		if m.ExplicitUint32 != nil {
+			w.Field(4)
+			w.Uint64(uint64(*m.ExplicitUint32))
+		}
+		if m.ExplicitUint64 != nil {
+			w.Field(5)
+			w.Uint64(uint64(*m.ExplicitUint64))
+		}
+		if m.ExplicitSint32 != nil {
+			w.Field(6)
+			w.Uint64(uint64(*m.ExplicitSint32))
+		}
+		if m.ExplicitSint64 != nil {
+			w.Field(7)
+			w.Uint64(uint64(*m.ExplicitSint64))
+		}
+		if m.Fixed32Value != nil {
+			w.Field(8)
+			w.Uint64(uint64(*m.Fixed32Value))
+		}
+		if m.Fixed64Value != nil {
+			w.Field(9)
+			w.Uint64(uint64(*m.Fixed64Value))
+		}
+		if m.Sfixed32Value != nil {
+			w.Field(10)
+			w.Uint64(uint64(*m.Sfixed32Value))
+		}
+		if m.Sfixed64Value != nil {
+			w.Field(11)
+			w.Uint64(uint64(*m.Sfixed64Value))
+		}
+		if m.FloatValue != nil {
+			w.Field(12)
+			w.Float64(float64(*m.FloatValue))
+		}
+		if m.DoubleValue != nil {
+			w.Field(13)
+			w.Float64(float64(*m.DoubleValue))
+		}
+		if m.BoolValue != nil {
+			w.Field(14)
+			w.Bool(*m.BoolValue)
+		}
+		if m.StringValue != nil {
+			w.Field(15)
+			w.String(*m.StringValue)
+		}
+		if m.BytesValue != nil {
+			w.Field(16)
+			w.Bytes(m.BytesValue)
+		}
+		if m.RequiredInt32 != nil {
+			w.Field(17)
+			w.Uint64(uint64(*m.RequiredInt32))
+		}
+		if len(m.PackedInt32) != 0 {
+			w.Field(18)
+			w.Uint64(uint64(len(m.PackedInt32)))
+			for _, v := range m.PackedInt32 {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.ExpandedInt32) != 0 {
+			w.Field(19)
+			w.Uint64(uint64(len(m.ExpandedInt32)))
+			for _, v := range m.ExpandedInt32 {
+				w.Uint64(uint64(v))
+			}
+		}
+		if len(m.NestedValues) != 0 {
+			w.Field(20)
+			w.Uint64(uint64(len(m.NestedValues)))
+			for _, v := range m.NestedValues {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.NestedByName) != 0 {
+			w.Field(21)
+			protobuf_go_lite.HashMap(w, m.NestedByName, func(w *protobuf_go_lite.Hasher, k string, v *SizeBaseline_Nested) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		if len(m.NestedById) != 0 {
+			w.Field(22)
+			protobuf_go_lite.HashMap(w, m.NestedById, func(w *protobuf_go_lite.Hasher, k uint32, v *SizeBaseline_Nested) {
+				w.Uint64(uint64(k))
+				v.WriteHashVT(w)
+			})
+		}
+		if m.State != nil {
+			w.Field(23)
+			w.Uint64(uint64(*m.State))
+		}
+		if v := m.Nested; v != nil {
+			w.Field(24)
+			v.WriteHashVT(w)
+		}
+		if v := m.Timestamp; v != nil {
+			w.Field(25)
+			v.WriteHashVT(w)
+		}
+		if v := m.Duration; v != nil {
+			w.Field(26)
+			v.WriteHashVT(w)
+		}
+		if v := m.StringWrapper; v != nil {
+			w.Field(27)
+			v.WriteHashVT(w)
+		}
+		if v := m.BytesWrapper; v != nil {
+			w.Field(28)
+			v.WriteHashVT(w)
+		}
+		if v := m.StructValue; v != nil {
+			w.Field(29)
+			v.WriteHashVT(w)
+		}
+		if v := m.ValueValue; v != nil {
+			w.Field(30)
+			v.WriteHashVT(w)
+		}
+		if v := m.ListValue; v != nil {
+			w.Field(31)
+			v.WriteHashVT(w)
+		}
+		switch v := m.Selection.(type) {
+		case *SizeBaseline_SelectedName:
+			w.Field(32)
+			w.String(v.SelectedName)
+		case *SizeBaseline_SelectedId:
+			w.Field(33)
+			w.Uint64(uint64(v.SelectedId))
+		case *SizeBaseline_SelectedNested:
+			w.Field(34)
+			v.SelectedNested.WriteHashVT(w)
+		}
+		if m.DefaultString != nil {
+			w.Field(35)
+			w.String(*m.DefaultString)
+		}
+		if m.DefaultInt32 != nil {
+			w.Field(36)
+			w.Uint64(uint64(*m.DefaultInt32))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the SizeBaseline_State to JSON.
+func (x SizeBaseline_State) MarshalProtoJSON(s *json.MarshalState) {
+	s.WriteEnum(int32(x), SizeBaseline_State_name)
+}
+
+// MarshalText marshals the SizeBaseline_State to text.
+func (x SizeBaseline_State) MarshalText() ([]byte, error) {
+	return []byte(json.GetEnumString(int32(x), SizeBaseline_State_name)), nil
+}
+
+// MarshalJSON marshals the SizeBaseline_State to JSON.
+func (x SizeBaseline_State) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SizeBaseline_State from JSON.
+func (x *SizeBaseline_State) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	v := s.ReadEnum(SizeBaseline_State_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read State enum: %v", err)
+		return
+	}
+	*x = SizeBaseline_State(v)
+}
+
+// UnmarshalText unmarshals the SizeBaseline_State from text.
+func (x *SizeBaseline_State) UnmarshalText(b []byte) error {
+	i, err := json.ParseEnumString(string(b), SizeBaseline_State_value)
+	if err != nil {
+		return err
+	}
+	*x = SizeBaseline_State(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the SizeBaseline_State from JSON.
+func (x *SizeBaseline_State) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SizeBaseline_Nested message to JSON.
+func (x *SizeBaseline_Nested) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(*x.Name)
+	}
+	if x.Count != 0 || s.HasField("count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("count")
+		s.WriteInt64(x.Count)
+	}
+	if len(x.Labels) > 0 || s.HasField("labels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("labels")
+		s.WriteStringArray(x.Labels)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SizeBaseline_Nested to JSON.
+func (x *SizeBaseline_Nested) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SizeBaseline_Nested message from JSON.
+func (x *SizeBaseline_Nested) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			if s.ReadNil() {
+				x.Name = nil
+				return
+			}
+			t := s.ReadString()
+			x.Name = &t
+		case "count":
+			s.AddField("count")
+			x.Count = s.ReadInt64()
+		case "labels":
+			s.AddField("labels")
+			if s.ReadNil() {
+				x.Labels = nil
+				return
+			}
+			x.Labels = s.ReadStringArray()
 		}
 	})
 }
@@ -2400,20 +3298,280 @@ func (m *SizeBaseline_SelectedNested) MarshalToSizedBufferVTStrict(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SizeBaseline_Nested) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *SizeBaseline_Nested) MergeVT(src *SizeBaseline_Nested) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeStringPtr(1, m.Name)
-	n += protobuf_go_lite.SizeVarintNonZero(1, m.Count)
-	n += protobuf_go_lite.SizeStringSlice(1, m.Labels)
-	n += len(m.unknownFields)
-	return n
+	if src.Name != nil {
+		v := *src.Name
+		m.Name = &v
+	}
+	if src.Count != 0 {
+		m.Count = src.Count
+	}
+	m.Labels = append(m.Labels, src.Labels...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
 
-func (m *SizeBaseline) SizeVT() (n int) {
+func (m *SizeBaseline_Nested) MergeMessageVT(src any) bool {
+	s, ok := src.(*SizeBaseline_Nested)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *SizeBaseline) MergeVT(src *SizeBaseline) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.ExplicitInt32 != nil {
+		v := *src.ExplicitInt32
+		m.ExplicitInt32 = &v
+	}
+	if src.ImplicitInt32 != 0 {
+		m.ImplicitInt32 = src.ImplicitInt32
+	}
+	if src.ExplicitInt64 != nil {
+		v := *src.ExplicitInt64
+		m.ExplicitInt64 = &v
+	}
+	if src.ExplicitUint32 != nil {
+		v := *src.ExplicitUint32
+		m.ExplicitUint32 = &v
+	}
+	if src.ExplicitUint64 != nil {
+		v := *src.ExplicitUint64
+		m.ExplicitUint64 = &v
+	}
+	if src.ExplicitSint32 != nil {
+		v := *src.ExplicitSint32
+		m.ExplicitSint32 = &v
+	}
+	if src.ExplicitSint64 != nil {
+		v := *src.ExplicitSint64
+		m.ExplicitSint64 = &v
+	}
+	if src.Fixed32Value != nil {
+		v := *src.Fixed32Value
+		m.Fixed32Value = &v
+	}
+	if src.Fixed64Value != nil {
+		v := *src.Fixed64Value
+		m.Fixed64Value = &v
+	}
+	if src.Sfixed32Value != nil {
+		v := *src.Sfixed32Value
+		m.Sfixed32Value = &v
+	}
+	if src.Sfixed64Value != nil {
+		v := *src.Sfixed64Value
+		m.Sfixed64Value = &v
+	}
+	if src.FloatValue != nil {
+		v := *src.FloatValue
+		m.FloatValue = &v
+	}
+	if src.DoubleValue != nil {
+		v := *src.DoubleValue
+		m.DoubleValue = &v
+	}
+	if src.BoolValue != nil {
+		v := *src.BoolValue
+		m.BoolValue = &v
+	}
+	if src.StringValue != nil {
+		v := *src.StringValue
+		m.StringValue = &v
+	}
+	if src.BytesValue != nil {
+		m.BytesValue = slices.Clone(src.BytesValue)
+	}
+	if src.RequiredInt32 != nil {
+		v := *src.RequiredInt32
+		m.RequiredInt32 = &v
+	}
+	m.PackedInt32 = append(m.PackedInt32, src.PackedInt32...)
+	m.ExpandedInt32 = append(m.ExpandedInt32, src.ExpandedInt32...)
+	for _, v := range src.NestedValues {
+		var e *SizeBaseline_Nested
+		if v != nil {
+			e = new(SizeBaseline_Nested)
+			e.MergeVT(v)
+		}
+		m.NestedValues = append(m.NestedValues, e)
+	}
+	if len(src.NestedByName) > 0 {
+		if m.NestedByName == nil {
+			m.NestedByName = make(map[string]*SizeBaseline_Nested, len(src.NestedByName))
+		}
+		for k, v := range src.NestedByName {
+			var e *SizeBaseline_Nested
+			if v != nil {
+				e = new(SizeBaseline_Nested)
+				e.MergeVT(v)
+			}
+			m.NestedByName[k] = e
+		}
+	}
+	if len(src.NestedById) > 0 {
+		if m.NestedById == nil {
+			m.NestedById = make(map[uint32]*SizeBaseline_Nested, len(src.NestedById))
+		}
+		for k, v := range src.NestedById {
+			var e *SizeBaseline_Nested
+			if v != nil {
+				e = new(SizeBaseline_Nested)
+				e.MergeVT(v)
+			}
+			m.NestedById[k] = e
+		}
+	}
+	if src.State != nil {
+		v := *src.State
+		m.State = &v
+	}
+	if src.Nested != nil {
+		if m.Nested == nil {
+			m.Nested = new(SizeBaseline_Nested)
+		}
+		m.Nested.MergeVT(src.Nested)
+	}
+	if src.Timestamp != nil {
+		if m.Timestamp == nil {
+			m.Timestamp = new(timestamppb.Timestamp)
+		}
+		m.Timestamp.MergeVT(src.Timestamp)
+	}
+	if src.Duration != nil {
+		if m.Duration == nil {
+			m.Duration = new(durationpb.Duration)
+		}
+		m.Duration.MergeVT(src.Duration)
+	}
+	if src.StringWrapper != nil {
+		if m.StringWrapper == nil {
+			m.StringWrapper = new(wrapperspb.StringValue)
+		}
+		m.StringWrapper.MergeVT(src.StringWrapper)
+	}
+	if src.BytesWrapper != nil {
+		if m.BytesWrapper == nil {
+			m.BytesWrapper = new(wrapperspb.BytesValue)
+		}
+		m.BytesWrapper.MergeVT(src.BytesWrapper)
+	}
+	if src.StructValue != nil {
+		if m.StructValue == nil {
+			m.StructValue = new(structpb.Struct)
+		}
+		m.StructValue.MergeVT(src.StructValue)
+	}
+	if src.ValueValue != nil {
+		if m.ValueValue == nil {
+			m.ValueValue = new(structpb.Value)
+		}
+		m.ValueValue.MergeVT(src.ValueValue)
+	}
+	if src.ListValue != nil {
+		if m.ListValue == nil {
+			m.ListValue = new(structpb.ListValue)
+		}
+		m.ListValue.MergeVT(src.ListValue)
+	}
+	switch v := src.Selection.(type) {
+	case *SizeBaseline_SelectedName:
+		m.Selection = &SizeBaseline_SelectedName{SelectedName: v.SelectedName}
+	case *SizeBaseline_SelectedId:
+		m.Selection = &SizeBaseline_SelectedId{SelectedId: v.SelectedId}
+	case *SizeBaseline_SelectedNested:
+		if cur, ok := m.Selection.(*SizeBaseline_SelectedNested); ok && cur.SelectedNested != nil {
+			cur.SelectedNested.MergeVT(v.SelectedNested)
+		} else {
+			e := new(SizeBaseline_Nested)
+			e.MergeVT(v.SelectedNested)
+			m.Selection = &SizeBaseline_SelectedNested{SelectedNested: e}
+		}
+	}
+	if src.DefaultString != nil {
+		v := *src.DefaultString
+		m.DefaultString = &v
+	}
+	if src.DefaultInt32 != nil {
+		v := *src.DefaultInt32
+		m.DefaultInt32 = &v
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *SizeBaseline) MergeMessageVT(src any) bool {
+	s, ok := src.(*SizeBaseline)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *SizeBaseline_Nested) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *SizeBaseline) RedactVT() {
+	if m == nil {
+		return
+	}
+	for _, v := range m.NestedValues {
+		v.RedactVT()
+	}
+	for _, v := range m.NestedByName {
+		v.RedactVT()
+	}
+	for _, v := range m.NestedById {
+		v.RedactVT()
+	}
+	m.Nested.RedactVT()
+	m.Timestamp.RedactVT()
+	m.Duration.RedactVT()
+	m.StringWrapper.RedactVT()
+	m.BytesWrapper.RedactVT()
+	m.StructValue.RedactVT()
+	m.ValueValue.RedactVT()
+	m.ListValue.RedactVT()
+	switch v := m.Selection.(type) {
+	case *SizeBaseline_SelectedNested:
+		v.SelectedNested.RedactVT()
+	}
+}
+
+func (m *SizeBaseline_Nested) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringPtr(1, m.Name)
+	n += protobuf_go_lite.SizeVarintNonZero(1, m.Count)
+	n += protobuf_go_lite.SizeStringSlice(1, m.Labels)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SizeBaseline) SizeVT() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3473,6 +4631,1421 @@ func (m *SizeBaseline) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeSizeBaselineNestedValuesVT iterates over the NestedValues elements encoded in dAtA, a serialized SizeBaseline,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeSizeBaselineNestedValuesVT(dAtA []byte) iter.Seq2[*SizeBaseline_Nested, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 20, (*SizeBaseline_Nested).UnmarshalVT)
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *SizeBaseline_Nested) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeBaseline_Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeBaseline_Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.Nested.name contains invalid UTF-8")
+			}
+			m.Name = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.Nested.labels contains invalid UTF-8")
+			}
+			m.Labels = append(m.Labels, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *SizeBaseline) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeBaseline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeBaseline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			m.ImplicitInt32 = 0
+			m.ImplicitInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt64", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt64 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitUint32", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitUint32 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitUint64", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitUint64 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitSint32", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.ExplicitSint32 = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitSint64", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.ExplicitSint64 = &v
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Value", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Fixed32Value = &v
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Value", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Fixed64Value = &v
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Value", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.Sfixed32Value = &v
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Value", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Sfixed64Value = &v
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.FloatValue = &v2
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.DoubleValue = &v2
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.BoolValue = &b
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.string_value contains invalid UTF-8")
+			}
+			m.StringValue = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			m.BytesValue, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.BytesValue, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredInt32 = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 18:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 19:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.ExpandedInt32 = append(m.ExpandedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.ExpandedInt32) == 0 {
+					m.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.ExpandedInt32 = append(m.ExpandedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.NestedValues = append(m.NestedValues, &SizeBaseline_Nested{})
+			if err := m.NestedValues[len(m.NestedValues)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedByName == nil {
+				m.NestedByName = make(map[string]*SizeBaseline_Nested)
+			}
+			var mapkey string
+			var mapvalue *SizeBaseline_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if !utf8.ValidString(mapkey) {
+						return fmt.Errorf("proto: field sizebaseline.SizeBaseline.NestedByNameEntry.key contains invalid UTF-8")
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &SizeBaseline_Nested{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedById", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedById == nil {
+				m.NestedById = make(map[uint32]*SizeBaseline_Nested)
+			}
+			var mapkey uint32
+			var mapvalue *SizeBaseline_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &SizeBaseline_Nested{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedById[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var v SizeBaseline_State
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = SizeBaseline_State(_v)
+			if err != nil {
+				return err
+			}
+			m.State = &v
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Nested == nil {
+				m.Nested = &SizeBaseline_Nested{}
+			}
+			if err := m.Nested.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringWrapper", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringWrapper == nil {
+				m.StringWrapper = &wrapperspb.StringValue{}
+			}
+			if err := m.StringWrapper.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWrapper", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesWrapper == nil {
+				m.BytesWrapper = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesWrapper.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StructValue == nil {
+				m.StructValue = &structpb.Struct{}
+			}
+			if err := m.StructValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ValueValue == nil {
+				m.ValueValue = &structpb.Value{}
+			}
+			if err := m.ValueValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ListValue == nil {
+				m.ListValue = &structpb.ListValue{}
+			}
+			if err := m.ListValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.selected_name contains invalid UTF-8")
+			}
+			m.Selection = &SizeBaseline_SelectedName{SelectedName: v}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedId", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Selection = &SizeBaseline_SelectedId{SelectedId: v}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedNested", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Selection.(*SizeBaseline_SelectedNested); ok {
+				if err := oneof.SelectedNested.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &SizeBaseline_Nested{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Selection = &SizeBaseline_SelectedNested{SelectedNested: v}
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.default_string contains invalid UTF-8")
+			}
+			m.DefaultString = &v
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DefaultInt32 = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 17) {
+		return fmt.Errorf("proto: required field required_int32 not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *SizeBaseline_Nested) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeBaseline_Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeBaseline_Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.Nested.name contains invalid UTF-8")
+			}
+			m.Name = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			m.Count, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.Nested.labels contains invalid UTF-8")
+			}
+			m.Labels = append(m.Labels, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *SizeBaseline) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SizeBaseline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SizeBaseline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			m.ImplicitInt32 = 0
+			m.ImplicitInt32, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt64", wireType)
+			}
+			var v int64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitInt64 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitUint32", wireType)
+			}
+			var v uint32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitUint32 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitUint64", wireType)
+			}
+			var v uint64
+			v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.ExplicitUint64 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitSint32", wireType)
+			}
+			var v int32
+			var _v32 int32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeSint32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.ExplicitSint32 = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitSint64", wireType)
+			}
+			var v int64
+			var _v64 int64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeSint64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.ExplicitSint64 = &v
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Value", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			m.Fixed32Value = &v
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Value", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Fixed64Value = &v
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Value", wireType)
+			}
+			var v int32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int32(_v32)
+			m.Sfixed32Value = &v
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Value", wireType)
+			}
+			var v int64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = int64(_v64)
+			m.Sfixed64Value = &v
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			var v uint32
+			var _v32 uint32
+			_v32, iNdEx, err = protobuf_go_lite.DecodeFixed32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint32(_v32)
+			v2 := float32(math.Float32frombits(v))
+			m.FloatValue = &v2
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			v2 := float64(math.Float64frombits(v))
+			m.DoubleValue = &v2
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.BoolValue = &b
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.string_value contains invalid UTF-8")
+			}
+			m.StringValue = &v
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			m.BytesValue, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
+			if err != nil {
+				return err
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.RequiredInt32 = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 18:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 19:
+			if wireType == 0 {
+				var v int32
+				v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				m.ExpandedInt32 = append(m.ExpandedInt32, v)
+			} else if wireType == 2 {
+				packedStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				iNdEx = packedStart
+				var elementCount int
+				elementCount = protobuf_go_lite.PackedVarintElementCount(dAtA[iNdEx:postIndex])
+				if elementCount != 0 && len(m.ExpandedInt32) == 0 {
+					m.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					m.ExpandedInt32 = append(m.ExpandedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.NestedValues = append(m.NestedValues, &SizeBaseline_Nested{})
+			if err := m.NestedValues[len(m.NestedValues)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedByName", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedByName == nil {
+				m.NestedByName = make(map[string]*SizeBaseline_Nested)
+			}
+			var mapkey string
+			var mapvalue *SizeBaseline_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if !utf8.ValidString(mapkey) {
+						return fmt.Errorf("proto: field sizebaseline.SizeBaseline.NestedByNameEntry.key contains invalid UTF-8")
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &SizeBaseline_Nested{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedByName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedById", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.NestedById == nil {
+				m.NestedById = make(map[uint32]*SizeBaseline_Nested)
+			}
+			var mapkey uint32
+			var mapvalue *SizeBaseline_Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintUint32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &SizeBaseline_Nested{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.NestedById[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var v SizeBaseline_State
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = SizeBaseline_State(_v)
+			if err != nil {
+				return err
+			}
+			m.State = &v
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Nested == nil {
+				m.Nested = &SizeBaseline_Nested{}
+			}
+			if err := m.Nested.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringWrapper", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringWrapper == nil {
+				m.StringWrapper = &wrapperspb.StringValue{}
+			}
+			if err := m.StringWrapper.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWrapper", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesWrapper == nil {
+				m.BytesWrapper = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesWrapper.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StructValue == nil {
+				m.StructValue = &structpb.Struct{}
+			}
+			if err := m.StructValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ValueValue == nil {
+				m.ValueValue = &structpb.Value{}
+			}
+			if err := m.ValueValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ListValue == nil {
+				m.ListValue = &structpb.ListValue{}
+			}
+			if err := m.ListValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.selected_name contains invalid UTF-8")
+			}
+			m.Selection = &SizeBaseline_SelectedName{SelectedName: v}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedId", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Selection = &SizeBaseline_SelectedId{SelectedId: v}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedNested", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Selection.(*SizeBaseline_SelectedNested); ok {
+				if err := oneof.SelectedNested.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &SizeBaseline_Nested{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Selection = &SizeBaseline_SelectedNested{SelectedNested: v}
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultString", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if !utf8.ValidString(v) {
+				return fmt.Errorf("proto: field sizebaseline.SizeBaseline.default_string contains invalid UTF-8")
+			}
+			m.DefaultString = &v
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
+			}
+			var v int32
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.DefaultInt32 = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 && slices.Contains(fields, 17) {
+		return fmt.Errorf("proto: required field required_int32 not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *SizeBaseline_Nested) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,13 +3,18 @@ package sizebaseline
 import (
 	"bytes"
 	stdjson "encoding/json"
+	"hash/fnv"
 	"io"
+	"maps"
+	"math"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
 	"github.com/aperturerobotics/protobuf-go-lite/types/known/durationpb"
 	"github.com/aperturerobotics/protobuf-go-lite/types/known/structpb"
 	"github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
@@ -168,6 +173,366 @@ func TestSizeBaselineUnknownFieldsAndRequired(t *testing.T) {
 	require.Equal(t, len(remarshaled), out.SizeVT())
 }
 
+func TestSizeBaselineUnknownFieldsAPI(t *testing.T) {
+	unknownField := []byte{0x98, 0x06, 0x7b}
+	nestedUnknown := []byte{0xa0, 0x06, 0x01}
+
+	msg := newSizeBaseline(t)
+	require.Nil(t, msg.GetUnknownFieldsVT())
+	require.Nil(t, (*SizeBaseline)(nil).GetUnknownFieldsVT())
+	msg.SetUnknownFieldsVT(unknownField)
+	require.Equal(t, unknownField, msg.GetUnknownFieldsVT())
+	msg.GetNested().SetUnknownFieldsVT(nestedUnknown)
+	msg.NestedValues = append(msg.NestedValues, &SizeBaseline_Nested{})
+	msg.NestedValues[len(msg.NestedValues)-1].SetUnknownFieldsVT(nestedUnknown)
+	msg.Selection = &SizeBaseline_SelectedNested{SelectedNested: &SizeBaseline_Nested{}}
+	msg.GetSelectedNested().SetUnknownFieldsVT(nestedUnknown)
+	for _, nested := range msg.GetNestedByName() {
+		nested.SetUnknownFieldsVT(nestedUnknown)
+	}
+
+	wire, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.True(t, bytes.HasSuffix(wire, unknownField), "unknown field was not marshaled")
+
+	var out SizeBaseline
+	require.NoError(t, out.UnmarshalVT(wire))
+	require.Equal(t, unknownField, out.GetUnknownFieldsVT())
+	require.Equal(t, nestedUnknown, out.GetNested().GetUnknownFieldsVT())
+
+	out.DiscardUnknownVT()
+	require.Nil(t, out.GetUnknownFieldsVT())
+	require.Nil(t, out.GetNested().GetUnknownFieldsVT())
+	require.Nil(t, out.GetSelectedNested().GetUnknownFieldsVT())
+	for _, nested := range out.GetNestedValues() {
+		require.Nil(t, nested.GetUnknownFieldsVT())
+	}
+	for _, nested := range out.GetNestedByName() {
+		require.Nil(t, nested.GetUnknownFieldsVT())
+	}
+	remarshaled, err := out.MarshalVT()
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(remarshaled, nestedUnknown), "nested unknown field was not discarded")
+	(*SizeBaseline)(nil).DiscardUnknownVT()
+}
+
+func TestSizeBaselineRangeNestedValues(t *testing.T) {
+	msg := newSizeBaseline(t)
+	msg.NestedValues = []*SizeBaseline_Nested{
+		{Name: ptrString("a"), Count: 1},
+		{Name: ptrString("b"), Labels: []string{"x"}},
+		{Count: 3},
+	}
+	wire, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	var got []*SizeBaseline_Nested
+	var prev *SizeBaseline_Nested
+	for nested, err := range RangeSizeBaselineNestedValuesVT(wire) {
+		require.NoError(t, err)
+		if prev != nil {
+			require.Same(t, prev, nested, "element should be reused")
+		}
+		prev = nested
+		got = append(got, nested.CloneVT())
+	}
+	require.Len(t, got, len(msg.NestedValues))
+	for i, nested := range got {
+		require.Truef(t, nested.EqualVT(msg.NestedValues[i]), "element %d = %v", i, nested)
+	}
+
+	var count int
+	for range RangeSizeBaselineNestedValuesVT(wire) {
+		count++
+		break
+	}
+	require.Equal(t, 1, count)
+
+	// A truncated trailing field is reported after the elements before it.
+	count = 0
+	var lastErr error
+	for _, err := range RangeSizeBaselineNestedValuesVT(append(wire, 0x0a, 0x05)) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		count++
+	}
+	require.ErrorIs(t, lastErr, io.ErrUnexpectedEOF)
+	require.Equal(t, len(msg.NestedValues), count)
+}
+
+func TestSizeBaselineMergeMatchesUnmarshal(t *testing.T) {
+	newSrc := func() *SizeBaseline {
+		requiredInt32 := int32(8)
+		return &SizeBaseline{
+			ImplicitInt32: 13,
+			RequiredInt32: &requiredInt32,
+			BytesValue:    []byte("src"),
+			PackedInt32:   []int32{7},
+			NestedValues:  []*SizeBaseline_Nested{{Name: ptrString("third")}},
+			NestedByName: map[string]*SizeBaseline_Nested{
+				"primary":   {Count: 11},
+				"secondary": {Name: ptrString("secondary")},
+			},
+			Nested:    &SizeBaseline_Nested{Count: 100, Labels: []string{"y"}},
+			Duration:  durationpb.New(time.Second),
+			Selection: &SizeBaseline_SelectedNested{SelectedNested: &SizeBaseline_Nested{Count: 5}},
+		}
+	}
+	dst := newSizeBaseline(t)
+	dst.SetUnknownFieldsVT([]byte{0x98, 0x06, 0x7b})
+	src := newSrc()
+	src.SetUnknownFieldsVT([]byte{0xa0, 0x06, 0x01})
+
+	dstWire, err := dst.MarshalVT()
+	require.NoError(t, err)
+	srcWire, err := src.MarshalVT()
+	require.NoError(t, err)
+	var want SizeBaseline
+	require.NoError(t, want.UnmarshalVT(append(dstWire, srcWire...)))
+
+	dst.MergeVT(src)
+	require.True(t, dst.EqualVT(&want), "MergeVT result differs from unmarshaling the concatenated messages")
+	require.Equal(t, want.GetUnknownFieldsVT(), dst.GetUnknownFieldsVT())
+
+	// The merged values must not alias src.
+	src.BytesValue[0] = 'X'
+	src.NestedValues[0].Name = ptrString("changed")
+	src.NestedByName["secondary"].Count = 1
+	src.GetSelectedNested().Count = 1
+	require.True(t, dst.EqualVT(&want), "MergeVT result aliases src")
+
+	// A oneof sub-message merges into the same case.
+	dst.MergeVT(newSrc())
+	require.Equal(t, int64(5), dst.GetSelectedNested().GetCount())
+	dst.MergeVT(&SizeBaseline{Selection: &SizeBaseline_SelectedName{SelectedName: "name"}})
+	require.Equal(t, "name", dst.GetSelectedName())
+
+	require.True(t, dst.MergeMessageVT(newSrc()))
+	require.False(t, dst.MergeMessageVT(&SizeBaseline_Nested{}))
+}
+
+func TestSizeBaselineCopyVT(t *testing.T) {
+	src := newSizeBaseline(t)
+	src.SetUnknownFieldsVT([]byte{0x98, 0x06, 0x7b})
+
+	dst := &SizeBaseline{
+		PackedInt32:  make([]int32, 0, 8),
+		NestedValues: []*SizeBaseline_Nested{{Name: ptrString("old")}, {}, {}},
+		NestedByName: map[string]*SizeBaseline_Nested{"stale": {}, "primary": {}},
+		Nested:       &SizeBaseline_Nested{Labels: []string{"old", "old"}},
+		Selection:    &SizeBaseline_SelectedNested{SelectedNested: &SizeBaseline_Nested{}},
+	}
+	packed, firstNested, primary, nested := dst.PackedInt32, dst.NestedValues[0], dst.NestedByName["primary"], dst.Nested
+
+	src.CopyVT(dst)
+	require.True(t, dst.EqualVT(src))
+	require.Equal(t, src.GetUnknownFieldsVT(), dst.GetUnknownFieldsVT())
+	require.Same(t, &packed[:1][0], &dst.PackedInt32[0], "packed slice was not reused")
+	require.Same(t, firstNested, dst.NestedValues[0], "repeated sub-message was not reused")
+	require.Same(t, primary, dst.NestedByName["primary"], "map sub-message was not reused")
+	require.Same(t, nested, dst.Nested, "sub-message was not reused")
+	require.NotContains(t, dst.NestedByName, "stale")
+
+	// The copy must not alias src.
+	src.BytesValue[0] = 'X'
+	src.NestedValues[0].Labels[0] = "changed"
+	src.NestedByName["primary"].Count = 1
+	*src.ExplicitInt32 = 1
+	require.False(t, dst.EqualVT(src))
+	require.Equal(t, []byte("bytes"), dst.GetBytesValue())
+	require.Equal(t, "a", dst.GetNestedValues()[0].GetLabels()[0])
+	require.Equal(t, int32(11), dst.GetExplicitInt32())
+
+	// Copying the same message again reuses every allocation.
+	src = newSizeBaseline(t)
+	allocs := testing.AllocsPerRun(10, func() {
+		src.CopyVT(dst)
+	})
+	require.Zero(t, allocs)
+	require.True(t, dst.EqualVT(src))
+
+	(*SizeBaseline)(nil).CopyVT(dst)
+	require.True(t, dst.EqualVT(&SizeBaseline{}))
+
+	// A nil dst is left unchanged.
+	require.NotPanics(t, func() { src.CopyVT(nil) })
+	require.NotPanics(t, func() { (*SizeBaseline)(nil).CopyVT(nil) })
+}
+
+func TestSizeBaselineDiffVT(t *testing.T) {
+	a, b := newSizeBaseline(t), newSizeBaseline(t)
+	require.Empty(t, a.DiffVT(b))
+
+	b.ImplicitInt32 = 0
+	*b.ExplicitInt64 = 23
+	b.BytesValue = nil
+	b.NestedValues[1].Labels[0] = "d"
+	b.NestedValues = append(b.NestedValues, &SizeBaseline_Nested{Count: 3})
+	b.NestedByName["primary"].Count = 11
+	b.NestedByName["extra"] = &SizeBaseline_Nested{}
+	delete(b.NestedById, 1)
+	b.StringWrapper = nil
+	b.Selection = &SizeBaseline_SelectedId{SelectedId: 5}
+	b.SetUnknownFieldsVT([]byte{0x98, 0x06, 0x7b})
+
+	var got []string
+	for _, d := range a.DiffVT(b) {
+		got = append(got, d.String())
+	}
+	require.Equal(t, []string{
+		"implicit_int32: 12 -> <unset>",
+		"explicit_int64: 22 -> 23",
+		"bytes_value: 6279746573 -> <unset>",
+		`nested_values[1].labels[0]: "c" -> "d"`,
+		`nested_values[2]: <unset> -> Nested {count: 3}`,
+		`nested_by_name["extra"]: <unset> -> Nested {}`,
+		`nested_by_name["primary"].count: 10 -> 11`,
+		`nested_by_id[1]: Nested {name: "one" count: 1} -> <unset>`,
+		`string_wrapper: "wrapped" -> <unset>`,
+		`selected_name: "chosen" -> <unset>`,
+		"selected_id: <unset> -> 5",
+		"<unknown>: <unset> -> 98067b",
+	}, got)
+
+	diffs := b.DiffVT(a)
+	require.Len(t, diffs, len(got))
+	require.Nil(t, diffs[0].Old, "unset values are reported as nil")
+	require.Equal(t, int32(12), diffs[0].New)
+	require.Equal(t, int64(23), diffs[1].Old)
+	require.Len(t, (*SizeBaseline)(nil).DiffVT(&SizeBaseline{}), 0)
+}
+
+func TestSizeBaselineEqualVTOpts(t *testing.T) {
+	a, b := newSizeBaseline(t), newSizeBaseline(t)
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}))
+
+	b.ImplicitInt32 = 13
+	b.NestedByName["primary"].Count = 11
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}))
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{
+		IgnoreFields: []string{"implicit_int32"},
+	}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{
+		IgnoreFields: []string{"implicit_int32", "nested_by_name.count"},
+	}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{
+		IgnoreFields: []string{"implicit_int32", "nested_by_name"},
+	}))
+
+	b = newSizeBaseline(t)
+	b.Selection = &SizeBaseline_SelectedId{SelectedId: 5}
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{
+		IgnoreFields: []string{"selected_name"},
+	}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{
+		IgnoreFields: []string{"selected_name", "selected_id"},
+	}))
+
+	b = newSizeBaseline(t)
+	b.SetUnknownFieldsVT([]byte{0x98, 0x06, 0x7b})
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{IgnoreUnknown: true}))
+
+	b = newSizeBaseline(t)
+	*b.DoubleValue += 1e-9
+	*b.FloatValue = float32(math.NaN())
+	*a.FloatValue = float32(math.NaN())
+	require.False(t, a.EqualVT(b))
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{FloatEpsilon: 1e-6}))
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{NaNEqual: true}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{FloatEpsilon: 1e-6, NaNEqual: true}))
+
+	a, b = newSizeBaseline(t), newSizeBaseline(t)
+	a.StringWrapper = nil
+	b.StringWrapper = &wrapperspb.StringValue{}
+	require.False(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{}))
+	require.True(t, a.EqualVTOpts(b, protobuf_go_lite.EqualOptions{EmptyMessages: protobuf_go_lite.NilEqualsEmpty}))
+	require.True(t, (*SizeBaseline)(nil).EqualVTOpts(&SizeBaseline{}, protobuf_go_lite.EqualOptions{EmptyMessages: protobuf_go_lite.NilEqualsEmpty}))
+}
+
+func TestSizeBaselineHash64VT(t *testing.T) {
+	a, b := newSizeBaseline(t), newSizeBaseline(t)
+	require.True(t, a.EqualVT(b))
+	require.Equal(t, a.Hash64VT(), b.Hash64VT())
+
+	// Rebuild the maps in a different insertion order.
+	b.NestedByName = maps.Clone(b.NestedByName)
+	b.NestedByName["extra"] = &SizeBaseline_Nested{}
+	delete(b.NestedByName, "extra")
+	require.Equal(t, a.Hash64VT(), b.Hash64VT())
+
+	b.NestedByName["primary"].Count = 11
+	require.NotEqual(t, a.Hash64VT(), b.Hash64VT())
+
+	// Nil and empty elements compare equal, so they must hash the same.
+	a, b = newSizeBaseline(t), newSizeBaseline(t)
+	a.NestedValues = append(a.NestedValues, nil)
+	b.NestedValues = append(b.NestedValues, &SizeBaseline_Nested{})
+	require.True(t, a.EqualVT(b))
+	require.Equal(t, a.Hash64VT(), b.Hash64VT())
+
+	*a.DoubleValue, *b.DoubleValue = 0, math.Copysign(0, -1)
+	require.True(t, a.EqualVT(b))
+	require.Equal(t, a.Hash64VT(), b.Hash64VT())
+
+	a.SetUnknownFieldsVT([]byte{0x98, 0x06, 0x7b, 0xa0, 0x06, 0x01})
+	b.SetUnknownFieldsVT([]byte{0xa0, 0x06, 0x01, 0x98, 0x06, 0x7b})
+	require.Equal(t, a.Hash64VT(), b.Hash64VT())
+	b.SetUnknownFieldsVT([]byte{0xa0, 0x06, 0x02, 0x98, 0x06, 0x7b})
+	require.NotEqual(t, a.Hash64VT(), b.Hash64VT())
+
+	require.Equal(t, (*SizeBaseline)(nil).Hash64VT(), (&SizeBaseline{}).Hash64VT())
+	require.NotEqual(t, (&SizeBaseline{}).Hash64VT(), (&SizeBaseline{StringWrapper: &wrapperspb.StringValue{}}).Hash64VT())
+
+	h := fnv.New64a()
+	a.HashVT(h)
+	require.Equal(t, a.Hash64VT(), h.Sum64())
+}
+
+func TestSizeBaselineCompareVT(t *testing.T) {
+	a, b := newSizeBaseline(t), newSizeBaseline(t)
+	require.Zero(t, a.CompareVT(b))
+
+	// Unset orders before set, and the lowest differing field number decides.
+	b.ExplicitInt32 = nil
+	b.ImplicitInt32 = 100
+	require.Equal(t, 1, a.CompareVT(b))
+	require.Equal(t, -1, b.CompareVT(a))
+
+	// Map entries compare in key order.
+	a, b = newSizeBaseline(t), newSizeBaseline(t)
+	b.NestedByName["aaa"] = &SizeBaseline_Nested{}
+	require.Equal(t, 1, a.CompareVT(b), "the first key of b, aaa, orders before primary")
+	delete(b.NestedByName, "aaa")
+	b.NestedByName["zzz"] = &SizeBaseline_Nested{}
+	require.Equal(t, -1, a.CompareVT(b), "the shorter map orders first")
+
+	// A oneof member compares like an explicit field.
+	a, b = newSizeBaseline(t), newSizeBaseline(t)
+	b.Selection = &SizeBaseline_SelectedId{SelectedId: 1}
+	require.Equal(t, 1, a.CompareVT(b), "selected_name is set in a only")
+
+	msgs := []*SizeBaseline_Nested{
+		{Name: ptrString("b")},
+		{Name: ptrString("a"), Count: 2},
+		nil,
+		{Name: ptrString("a"), Count: 1, Labels: []string{"x"}},
+		{},
+		{Name: ptrString("a"), Count: 1},
+	}
+	slices.SortFunc(msgs, protobuf_go_lite.Compare[*SizeBaseline_Nested])
+	require.Equal(t, []*SizeBaseline_Nested{
+		nil,
+		{},
+		{Name: ptrString("a"), Count: 1},
+		{Name: ptrString("a"), Count: 1, Labels: []string{"x"}},
+		{Name: ptrString("a"), Count: 2},
+		{Name: ptrString("b")},
+	}, msgs)
+}
+
 func TestSizeBaselineMapEntryTruncatedValue(t *testing.T) {
 	wire := []byte{0xaa, 0x01, 0x03, 0x12, 0x05, 0x00}
 
@@ -0,0 +1,103 @@
+package sqlcolumn
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/aperturerobotics/protobuf-go-lite/internal/sqltest"
+)
+
+var (
+	_ sql.Scanner   = (*Profile)(nil)
+	_ driver.Valuer = (*Settings)(nil)
+	_ sql.Scanner   = (*Stored)(nil)
+)
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sqltest.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestSQLBinary(t *testing.T) {
+	db := openDB(t)
+	msg := &Profile{Name: "alice", Age: 30, Emails: []string{"a@example.com"}}
+	if _, err := db.Exec("PUT", "alice", msg); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Profile{Name: "stale"}
+	if err := db.QueryRow("GET", "alice").Scan(got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.EqualVT(msg) {
+		t.Fatalf("scanned %v, want %v", got, msg)
+	}
+
+	value, err := msg.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := msg.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value.([]byte)) != string(want) {
+		t.Fatalf("Value() = %x, want %x", value, want)
+	}
+}
+
+func TestSQLJSON(t *testing.T) {
+	db := openDB(t)
+	msg := &Settings{Theme: "dark", Flags: map[string]bool{"beta": true}}
+	value, err := msg.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != `{"theme":"dark","flags":{"beta":true}}` {
+		t.Fatalf("Value() = %#v", value)
+	}
+	if _, err := db.Exec("PUT", "settings", msg); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Settings{}
+	if err := db.QueryRow("GET", "settings").Scan(got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.EqualVT(msg) {
+		t.Fatalf("scanned %v, want %v", got, msg)
+	}
+}
+
+func TestSQLNull(t *testing.T) {
+	db := openDB(t)
+	if _, err := db.Exec("PUT", "none", (*Stored)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	var raw any
+	if err := db.QueryRow("GET", "none").Scan(&raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw != nil {
+		t.Fatalf("nil message stored as %#v, want NULL", raw)
+	}
+
+	got := &Stored{Plain: &Plain{Name: "stale"}}
+	if err := db.QueryRow("GET", "none").Scan(got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Plain != nil {
+		t.Fatalf("NULL scanned as %v, want an empty message", got)
+	}
+
+	if err := got.Scan(42); err == nil {
+		t.Fatal("Scan accepted an int64 column")
+	}
+}
@@ -0,0 +1,1422 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/stdtypes/stdfile.proto
+
+package stdtypes
+
+import (
+	bytes "bytes"
+	cmp "cmp"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	slices "slices"
+	time "time"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+	_ "github.com/aperturerobotics/protobuf-go-lite/types/known/durationpb"
+	_ "github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
+	_ "github.com/aperturerobotics/protobuf-go-lite/types/known/wrapperspb"
+)
+
+// Every Timestamp, Duration and wrapper field of this file uses a native Go
+// type.
+//protobuf-go-lite:stdtime
+//protobuf-go-lite:stdduration
+//protobuf-go-lite:wktpointer
+
+type StdFile struct {
+	unknownFields []byte
+	Created       *time.Time       `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
+	Timeout       *time.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Label         *string          `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Intervals     []time.Duration  `protobuf:"bytes,4,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	Counts        map[string]int32 `protobuf:"bytes,5,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *StdFile) Reset() {
+	*x = StdFile{}
+}
+
+func (*StdFile) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdFile) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdFile) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdFile) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdFile) GetCreated() time.Time {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	return time.Time{}
+}
+
+func (x *StdFile) GetTimeout() time.Duration {
+	if x != nil && x.Timeout != nil {
+		return *x.Timeout
+	}
+	return 0
+}
+
+func (x *StdFile) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *StdFile) GetIntervals() []time.Duration {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *StdFile) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type StdFile_CountsEntry struct {
+	unknownFields []byte
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *int32 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdFile_CountsEntry) Reset() {
+	*x = StdFile_CountsEntry{}
+}
+
+func (*StdFile_CountsEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdFile_CountsEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdFile_CountsEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdFile_CountsEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdFile_CountsEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdFile_CountsEntry) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (m *StdFile) CloneVT() *StdFile {
+	if m == nil {
+		return (*StdFile)(nil)
+	}
+	r := new(StdFile)
+	r.Created = protobuf_go_lite.CloneStdPtr(m.Created)
+	r.Timeout = protobuf_go_lite.CloneStdPtr(m.Timeout)
+	r.Label = protobuf_go_lite.CloneStdPtr(m.Label)
+	r.Intervals = protobuf_go_lite.CloneStdSlice(m.Intervals)
+	r.Counts = protobuf_go_lite.CloneStdMap(m.Counts)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *StdFile) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *StdFile) CompareVT(that *StdFile) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Created, that.Created); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Timeout, that.Timeout); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Label, that.Label); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.Intervals, that.Intervals, protobuf_go_lite.CompareStd[time.Duration]); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.Counts, that.Counts, cmp.Compare[string], protobuf_go_lite.CompareStd[int32]); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *StdFile) CopyVT(dst *StdFile) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Created = protobuf_go_lite.CopyStdPtr(dst.Created, m.Created)
+	dst.Timeout = protobuf_go_lite.CopyStdPtr(dst.Timeout, m.Timeout)
+	dst.Label = protobuf_go_lite.CopyStdPtr(dst.Label, m.Label)
+	dst.Intervals = protobuf_go_lite.CopyStdSlice(dst.Intervals, m.Intervals)
+	dst.Counts = protobuf_go_lite.CopyStdMap(dst.Counts, m.Counts)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *StdFile) DiffVT(that *StdFile) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *StdFile) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *StdFile) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &StdFile{}
+	}
+	if that == nil {
+		that = &StdFile{}
+	}
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "created", m.Created, that.Created)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "timeout", m.Timeout, that.Timeout)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "label", m.Label, that.Label)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "intervals", m.Intervals, that.Intervals)
+	diffs = protobuf_go_lite.AppendDiffStdMap(diffs, prefix, "counts", m.Counts, that.Counts)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *StdFile) EqualVT(that *StdFile) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Created, that.Created) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Timeout, that.Timeout) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Label, that.Label) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.Intervals, that.Intervals) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdMap(this.Counts, that.Counts) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *StdFile) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StdFile)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *StdFile) EqualVTOpts(that *StdFile, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *StdFile) EqualVTOptsPrefix(that *StdFile, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &StdFile{}
+		}
+		if that == nil {
+			that = &StdFile{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "created"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Created, that.Created) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "timeout"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Timeout, that.Timeout) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "label"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Label, that.Label) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "intervals"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.Intervals, that.Intervals) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "counts"); ok && !protobuf_go_lite.EqualOptsStdMap(opts, this.Counts, that.Counts) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *StdFile) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *StdFile) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *StdFile) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Created != nil {
+			w.Field(1)
+			protobuf_go_lite.HashStd(w, *m.Created)
+		}
+		if m.Timeout != nil {
+			w.Field(2)
+			protobuf_go_lite.HashStd(w, *m.Timeout)
+		}
+		if m.Label != nil {
+			w.Field(3)
+			protobuf_go_lite.HashStd(w, *m.Label)
+		}
+		if len(m.Intervals) != 0 {
+			w.Field(4)
+			w.Uint64(uint64(len(m.Intervals)))
+			for _, v := range m.Intervals {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.Counts) != 0 {
+			w.Field(5)
+			protobuf_go_lite.HashMap(w, m.Counts, func(w *protobuf_go_lite.Hasher, k string, v int32) {
+				w.String(k)
+				protobuf_go_lite.HashStd(w, v)
+			})
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the StdFile_CountsEntry message to JSON.
+func (x *StdFile_CountsEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteInt32(*x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdFile_CountsEntry to JSON.
+func (x *StdFile_CountsEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdFile_CountsEntry message from JSON.
+func (x *StdFile_CountsEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			t := s.ReadInt32()
+			x.Value = &t
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdFile_CountsEntry from JSON.
+func (x *StdFile_CountsEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdFile message to JSON.
+func (x *StdFile) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Created != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created")
+		s.WriteTime(*x.Created)
+	}
+	if x.Timeout != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timeout")
+		s.WriteDuration(*x.Timeout)
+	}
+	if x.Label != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("label")
+		s.WriteString(*x.Label)
+	}
+	if len(x.Intervals) > 0 || s.HasField("intervals") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("intervals")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Intervals {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteDuration(element)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Counts != nil || s.HasField("counts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("counts")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Counts {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteInt32(v)
+		}
+		s.WriteObjectEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdFile to JSON.
+func (x *StdFile) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdFile message from JSON.
+func (x *StdFile) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "created":
+			s.AddField("created")
+			if s.ReadNil() {
+				x.Created = nil
+				return
+			}
+			x.Created = s.ReadTime()
+		case "timeout":
+			s.AddField("timeout")
+			if s.ReadNil() {
+				x.Timeout = nil
+				return
+			}
+			x.Timeout = s.ReadDuration()
+		case "label":
+			s.AddField("label")
+			if s.ReadNil() {
+				x.Label = nil
+				return
+			}
+			t := s.ReadString()
+			x.Label = &t
+		case "intervals":
+			s.AddField("intervals")
+			if s.ReadNil() {
+				x.Intervals = nil
+				return
+			}
+			s.ReadArray(func() {
+				if t := s.ReadDuration(); t != nil {
+					x.Intervals = append(x.Intervals, *t)
+				}
+			})
+		case "counts":
+			s.AddField("counts")
+			if s.ReadNil() {
+				x.Counts = nil
+				return
+			}
+			x.Counts = make(map[string]int32)
+			s.ReadStringMap(func(key string) {
+				x.Counts[key] = s.ReadInt32()
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdFile from JSON.
+func (x *StdFile) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *StdFile) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdFile) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdFile) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Intervals) > 0 {
+		for iNdEx := len(m.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Intervals[iNdEx])
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Label != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Label)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timeout != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Timeout)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Created != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Created)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdFile) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdFile) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdFile) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if len(m.Counts) > 0 {
+		for k := range m.Counts {
+			v := m.Counts[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Intervals) > 0 {
+		for iNdEx := len(m.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Intervals[iNdEx])
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Label != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Label)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timeout != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Timeout)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Created != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Created)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *StdFile) MergeVT(src *StdFile) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Created != nil {
+		if m.Created == nil {
+			m.Created = new(time.Time)
+		}
+		protobuf_go_lite.MergeStd(m.Created, *src.Created)
+	}
+	if src.Timeout != nil {
+		if m.Timeout == nil {
+			m.Timeout = new(time.Duration)
+		}
+		protobuf_go_lite.MergeStd(m.Timeout, *src.Timeout)
+	}
+	if src.Label != nil {
+		if m.Label == nil {
+			m.Label = new(string)
+		}
+		protobuf_go_lite.MergeStd(m.Label, *src.Label)
+	}
+	m.Intervals = append(m.Intervals, protobuf_go_lite.CloneStdSlice(src.Intervals)...)
+	if len(src.Counts) > 0 {
+		if m.Counts == nil {
+			m.Counts = make(map[string]int32, len(src.Counts))
+		}
+		for k, v := range src.Counts {
+			m.Counts[k] = protobuf_go_lite.CloneStd(v)
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *StdFile) MergeMessageVT(src any) bool {
+	s, ok := src.(*StdFile)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *StdFile) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+func (m *StdFile) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdPtr(1, m.Created)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Timeout)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Label)
+	n += protobuf_go_lite.SizeStdSlice(1, m.Intervals)
+	for k, v := range m.Counts {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStdValue(1, v)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (x *StdFile_CountsEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "CountsEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdFile_CountsEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdFile) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StdFile")
+	if x.Created != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "created")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Created)
+	}
+	if x.Timeout != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "timeout")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Timeout)
+	}
+	if x.Label != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "label")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Label)
+	}
+	if len(x.Intervals) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "intervals")
+		for i, v := range x.Intervals {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Counts) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "counts")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.Counts) {
+			v := x.Counts[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdFile) String() string {
+	return x.MarshalProtoText()
+}
+func (m *StdFile) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Created); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timeout); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Label == nil {
+				m.Label = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Label); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Intervals = append(m.Intervals, v)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdFile) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Created); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timeout); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Label == nil {
+				m.Label = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Label); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Intervals = append(m.Intervals, v)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdFile) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Created); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timeout); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Label == nil {
+				m.Label = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Label); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Intervals = append(m.Intervals, v)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StdFile) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Created); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timeout); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Label == nil {
+				m.Label = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Label); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Intervals = append(m.Intervals, v)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Counts == nil {
+				m.Counts = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Counts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,11454 @@
+// Code generated by protoc-gen-go-lite. DO NOT EDIT.
+// protoc-gen-go-lite version: v0.8.1
+// source: github.com/aperturerobotics/protobuf-go-lite/testproto/stdtypes/stdtypes.proto
+
+package stdtypes
+
+import (
+	bytes "bytes"
+	cmp "cmp"
+	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	iter "iter"
+	slices "slices"
+	time "time"
+
+	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
+	json "github.com/aperturerobotics/protobuf-go-lite/json"
+	durationpb "github.com/aperturerobotics/protobuf-go-lite/types/known/durationpb"
+	timestamppb "github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
+	wrapperspb "github.com/aperturerobotics/protobuf-go-lite/types/known/wrapperspb"
+)
+
+// StdTypes uses native Go types for its well-known type fields, except for
+// plain_timestamp.
+type StdTypes struct {
+	unknownFields []byte
+	// protobuf-go-lite:stdtime
+	Timestamp *time.Time `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// protobuf-go-lite:stdduration
+	Duration *time.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// protobuf-go-lite:wktpointer
+	DoubleValue *float64 `protobuf:"bytes,3,opt,name=double_value,json=doubleValue,proto3" json:"doubleValue,omitempty"`
+	// protobuf-go-lite:wktpointer
+	FloatValue *float32 `protobuf:"bytes,4,opt,name=float_value,json=floatValue,proto3" json:"floatValue,omitempty"`
+	// protobuf-go-lite:wktpointer
+	Int64Value *int64 `protobuf:"bytes,5,opt,name=int64_value,json=int64Value,proto3" json:"int64Value,omitempty"`
+	// protobuf-go-lite:wktpointer
+	Uint64Value *uint64 `protobuf:"bytes,6,opt,name=uint64_value,json=uint64Value,proto3" json:"uint64Value,omitempty"`
+	// protobuf-go-lite:wktpointer
+	Int32Value *int32 `protobuf:"bytes,7,opt,name=int32_value,json=int32Value,proto3" json:"int32Value,omitempty"`
+	// protobuf-go-lite:wktpointer
+	Uint32Value *uint32 `protobuf:"bytes,8,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32Value,omitempty"`
+	// protobuf-go-lite:wktpointer
+	BoolValue *bool `protobuf:"bytes,9,opt,name=bool_value,json=boolValue,proto3" json:"boolValue,omitempty"`
+	// protobuf-go-lite:wktpointer
+	StringValue *string `protobuf:"bytes,10,opt,name=string_value,json=stringValue,proto3" json:"stringValue,omitempty"`
+	// protobuf-go-lite:wktpointer
+	BytesValue *[]byte `protobuf:"bytes,11,opt,name=bytes_value,json=bytesValue,proto3" json:"bytesValue,omitempty"`
+	// protobuf-go-lite:stdtime
+	Timestamps []time.Time `protobuf:"bytes,12,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	// protobuf-go-lite:stdduration
+	Durations []time.Duration `protobuf:"bytes,13,rep,name=durations,proto3" json:"durations,omitempty"`
+	// protobuf-go-lite:wktpointer
+	Int64Values []int64 `protobuf:"bytes,14,rep,name=int64_values,json=int64Values,proto3" json:"int64Values,omitempty"`
+	// protobuf-go-lite:wktpointer
+	BytesValues [][]byte `protobuf:"bytes,15,rep,name=bytes_values,json=bytesValues,proto3" json:"bytesValues,omitempty"`
+	// protobuf-go-lite:wktpointer
+	FloatValues []float32 `protobuf:"bytes,16,rep,name=float_values,json=floatValues,proto3" json:"floatValues,omitempty"`
+	// protobuf-go-lite:stdtime
+	TimestampMap map[string]time.Time `protobuf:"bytes,17,rep,name=timestamp_map,json=timestampMap,proto3" json:"timestampMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// protobuf-go-lite:stdduration
+	DurationMap map[int32]time.Duration `protobuf:"bytes,18,rep,name=duration_map,json=durationMap,proto3" json:"durationMap,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// protobuf-go-lite:wktpointer
+	StringMap map[string]string `protobuf:"bytes,19,rep,name=string_map,json=stringMap,proto3" json:"stringMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// protobuf-go-lite:wktpointer
+	BytesMap map[string][]byte `protobuf:"bytes,20,rep,name=bytes_map,json=bytesMap,proto3" json:"bytesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//
+	//	*StdTypes_ChoiceTimestamp
+	//	*StdTypes_ChoiceDuration
+	//	*StdTypes_ChoiceBool
+	//	*StdTypes_ChoiceBytes
+	//	*StdTypes_ChoiceName
+	Choice         isStdTypes_Choice      `protobuf_oneof:"choice"`
+	PlainTimestamp *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=plain_timestamp,json=plainTimestamp,proto3" json:"plainTimestamp,omitempty"`
+}
+
+func (x *StdTypes) Reset() {
+	*x = StdTypes{}
+}
+
+func (*StdTypes) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypes) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypes) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypes) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.PlainTimestamp.DiscardUnknownVT()
+}
+
+func (x *StdTypes) GetTimestamp() time.Time {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return time.Time{}
+}
+
+func (x *StdTypes) GetDuration() time.Duration {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *StdTypes) GetDoubleValue() float64 {
+	if x != nil && x.DoubleValue != nil {
+		return *x.DoubleValue
+	}
+	return 0
+}
+
+func (x *StdTypes) GetFloatValue() float32 {
+	if x != nil && x.FloatValue != nil {
+		return *x.FloatValue
+	}
+	return 0
+}
+
+func (x *StdTypes) GetInt64Value() int64 {
+	if x != nil && x.Int64Value != nil {
+		return *x.Int64Value
+	}
+	return 0
+}
+
+func (x *StdTypes) GetUint64Value() uint64 {
+	if x != nil && x.Uint64Value != nil {
+		return *x.Uint64Value
+	}
+	return 0
+}
+
+func (x *StdTypes) GetInt32Value() int32 {
+	if x != nil && x.Int32Value != nil {
+		return *x.Int32Value
+	}
+	return 0
+}
+
+func (x *StdTypes) GetUint32Value() uint32 {
+	if x != nil && x.Uint32Value != nil {
+		return *x.Uint32Value
+	}
+	return 0
+}
+
+func (x *StdTypes) GetBoolValue() bool {
+	if x != nil && x.BoolValue != nil {
+		return *x.BoolValue
+	}
+	return false
+}
+
+func (x *StdTypes) GetStringValue() string {
+	if x != nil && x.StringValue != nil {
+		return *x.StringValue
+	}
+	return ""
+}
+
+func (x *StdTypes) GetBytesValue() []byte {
+	if x != nil && x.BytesValue != nil {
+		return *x.BytesValue
+	}
+	return nil
+}
+
+func (x *StdTypes) GetTimestamps() []time.Time {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *StdTypes) GetDurations() []time.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *StdTypes) GetInt64Values() []int64 {
+	if x != nil {
+		return x.Int64Values
+	}
+	return nil
+}
+
+func (x *StdTypes) GetBytesValues() [][]byte {
+	if x != nil {
+		return x.BytesValues
+	}
+	return nil
+}
+
+func (x *StdTypes) GetFloatValues() []float32 {
+	if x != nil {
+		return x.FloatValues
+	}
+	return nil
+}
+
+func (x *StdTypes) GetTimestampMap() map[string]time.Time {
+	if x != nil {
+		return x.TimestampMap
+	}
+	return nil
+}
+
+func (x *StdTypes) GetDurationMap() map[int32]time.Duration {
+	if x != nil {
+		return x.DurationMap
+	}
+	return nil
+}
+
+func (x *StdTypes) GetStringMap() map[string]string {
+	if x != nil {
+		return x.StringMap
+	}
+	return nil
+}
+
+func (x *StdTypes) GetBytesMap() map[string][]byte {
+	if x != nil {
+		return x.BytesMap
+	}
+	return nil
+}
+
+func (m *StdTypes) GetChoice() isStdTypes_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *StdTypes) GetChoiceTimestamp() time.Time {
+	if x, ok := x.GetChoice().(*StdTypes_ChoiceTimestamp); ok {
+		return x.ChoiceTimestamp
+	}
+	return time.Time{}
+}
+
+func (x *StdTypes) GetChoiceDuration() time.Duration {
+	if x, ok := x.GetChoice().(*StdTypes_ChoiceDuration); ok {
+		return x.ChoiceDuration
+	}
+	return 0
+}
+
+func (x *StdTypes) GetChoiceBool() bool {
+	if x, ok := x.GetChoice().(*StdTypes_ChoiceBool); ok {
+		return x.ChoiceBool
+	}
+	return false
+}
+
+func (x *StdTypes) GetChoiceBytes() []byte {
+	if x, ok := x.GetChoice().(*StdTypes_ChoiceBytes); ok {
+		return x.ChoiceBytes
+	}
+	return nil
+}
+
+func (x *StdTypes) GetChoiceName() string {
+	if x, ok := x.GetChoice().(*StdTypes_ChoiceName); ok {
+		return x.ChoiceName
+	}
+	return ""
+}
+
+func (x *StdTypes) GetPlainTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlainTimestamp
+	}
+	return nil
+}
+
+type isStdTypes_Choice interface {
+	isStdTypes_Choice()
+}
+
+type StdTypes_ChoiceTimestamp struct {
+	// protobuf-go-lite:stdtime
+	ChoiceTimestamp time.Time `protobuf:"bytes,21,opt,name=choice_timestamp,json=choiceTimestamp,proto3,oneof"`
+}
+
+type StdTypes_ChoiceDuration struct {
+	// protobuf-go-lite:stdduration
+	ChoiceDuration time.Duration `protobuf:"bytes,22,opt,name=choice_duration,json=choiceDuration,proto3,oneof"`
+}
+
+type StdTypes_ChoiceBool struct {
+	// protobuf-go-lite:wktpointer
+	ChoiceBool bool `protobuf:"bytes,23,opt,name=choice_bool,json=choiceBool,proto3,oneof"`
+}
+
+type StdTypes_ChoiceBytes struct {
+	// protobuf-go-lite:wktpointer
+	ChoiceBytes []byte `protobuf:"bytes,24,opt,name=choice_bytes,json=choiceBytes,proto3,oneof"`
+}
+
+type StdTypes_ChoiceName struct {
+	ChoiceName string `protobuf:"bytes,25,opt,name=choice_name,json=choiceName,proto3,oneof"`
+}
+
+func (*StdTypes_ChoiceTimestamp) isStdTypes_Choice() {}
+
+func (*StdTypes_ChoiceDuration) isStdTypes_Choice() {}
+
+func (*StdTypes_ChoiceBool) isStdTypes_Choice() {}
+
+func (*StdTypes_ChoiceBytes) isStdTypes_Choice() {}
+
+func (*StdTypes_ChoiceName) isStdTypes_Choice() {}
+
+// StdTypesWKT has the fields of StdTypes with the well-known type messages.
+type StdTypesWKT struct {
+	unknownFields []byte
+	Timestamp     *timestamppb.Timestamp             `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration      *durationpb.Duration               `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	DoubleValue   *wrapperspb.DoubleValue            `protobuf:"bytes,3,opt,name=double_value,json=doubleValue,proto3" json:"doubleValue,omitempty"`
+	FloatValue    *wrapperspb.FloatValue             `protobuf:"bytes,4,opt,name=float_value,json=floatValue,proto3" json:"floatValue,omitempty"`
+	Int64Value    *wrapperspb.Int64Value             `protobuf:"bytes,5,opt,name=int64_value,json=int64Value,proto3" json:"int64Value,omitempty"`
+	Uint64Value   *wrapperspb.UInt64Value            `protobuf:"bytes,6,opt,name=uint64_value,json=uint64Value,proto3" json:"uint64Value,omitempty"`
+	Int32Value    *wrapperspb.Int32Value             `protobuf:"bytes,7,opt,name=int32_value,json=int32Value,proto3" json:"int32Value,omitempty"`
+	Uint32Value   *wrapperspb.UInt32Value            `protobuf:"bytes,8,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32Value,omitempty"`
+	BoolValue     *wrapperspb.BoolValue              `protobuf:"bytes,9,opt,name=bool_value,json=boolValue,proto3" json:"boolValue,omitempty"`
+	StringValue   *wrapperspb.StringValue            `protobuf:"bytes,10,opt,name=string_value,json=stringValue,proto3" json:"stringValue,omitempty"`
+	BytesValue    *wrapperspb.BytesValue             `protobuf:"bytes,11,opt,name=bytes_value,json=bytesValue,proto3" json:"bytesValue,omitempty"`
+	Timestamps    []*timestamppb.Timestamp           `protobuf:"bytes,12,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Durations     []*durationpb.Duration             `protobuf:"bytes,13,rep,name=durations,proto3" json:"durations,omitempty"`
+	Int64Values   []*wrapperspb.Int64Value           `protobuf:"bytes,14,rep,name=int64_values,json=int64Values,proto3" json:"int64Values,omitempty"`
+	BytesValues   []*wrapperspb.BytesValue           `protobuf:"bytes,15,rep,name=bytes_values,json=bytesValues,proto3" json:"bytesValues,omitempty"`
+	FloatValues   []*wrapperspb.FloatValue           `protobuf:"bytes,16,rep,name=float_values,json=floatValues,proto3" json:"floatValues,omitempty"`
+	TimestampMap  map[string]*timestamppb.Timestamp  `protobuf:"bytes,17,rep,name=timestamp_map,json=timestampMap,proto3" json:"timestampMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DurationMap   map[int32]*durationpb.Duration     `protobuf:"bytes,18,rep,name=duration_map,json=durationMap,proto3" json:"durationMap,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StringMap     map[string]*wrapperspb.StringValue `protobuf:"bytes,19,rep,name=string_map,json=stringMap,proto3" json:"stringMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BytesMap      map[string]*wrapperspb.BytesValue  `protobuf:"bytes,20,rep,name=bytes_map,json=bytesMap,proto3" json:"bytesMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//
+	//	*StdTypesWKT_ChoiceTimestamp
+	//	*StdTypesWKT_ChoiceDuration
+	//	*StdTypesWKT_ChoiceBool
+	//	*StdTypesWKT_ChoiceBytes
+	//	*StdTypesWKT_ChoiceName
+	Choice         isStdTypesWKT_Choice   `protobuf_oneof:"choice"`
+	PlainTimestamp *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=plain_timestamp,json=plainTimestamp,proto3" json:"plainTimestamp,omitempty"`
+}
+
+func (x *StdTypesWKT) Reset() {
+	*x = StdTypesWKT{}
+}
+
+func (*StdTypesWKT) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypesWKT) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypesWKT) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypesWKT) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Timestamp.DiscardUnknownVT()
+	x.Duration.DiscardUnknownVT()
+	x.DoubleValue.DiscardUnknownVT()
+	x.FloatValue.DiscardUnknownVT()
+	x.Int64Value.DiscardUnknownVT()
+	x.Uint64Value.DiscardUnknownVT()
+	x.Int32Value.DiscardUnknownVT()
+	x.Uint32Value.DiscardUnknownVT()
+	x.BoolValue.DiscardUnknownVT()
+	x.StringValue.DiscardUnknownVT()
+	x.BytesValue.DiscardUnknownVT()
+	for _, v := range x.Timestamps {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Durations {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Int64Values {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.BytesValues {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.FloatValues {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.TimestampMap {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.DurationMap {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.StringMap {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.BytesMap {
+		v.DiscardUnknownVT()
+	}
+	if v, ok := x.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+		v.ChoiceTimestamp.DiscardUnknownVT()
+	}
+	if v, ok := x.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+		v.ChoiceDuration.DiscardUnknownVT()
+	}
+	if v, ok := x.Choice.(*StdTypesWKT_ChoiceBool); ok {
+		v.ChoiceBool.DiscardUnknownVT()
+	}
+	if v, ok := x.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+		v.ChoiceBytes.DiscardUnknownVT()
+	}
+	x.PlainTimestamp.DiscardUnknownVT()
+}
+
+func (x *StdTypesWKT) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetDoubleValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.DoubleValue
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetFloatValue() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.FloatValue
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetInt64Value() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Int64Value
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetUint64Value() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Uint64Value
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetInt32Value() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Int32Value
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetUint32Value() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Uint32Value
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetBoolValue() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.BoolValue
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetStringValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StringValue
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetBytesValue() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetTimestamps() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetDurations() []*durationpb.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetInt64Values() []*wrapperspb.Int64Value {
+	if x != nil {
+		return x.Int64Values
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetBytesValues() []*wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesValues
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetFloatValues() []*wrapperspb.FloatValue {
+	if x != nil {
+		return x.FloatValues
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetTimestampMap() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampMap
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetDurationMap() map[int32]*durationpb.Duration {
+	if x != nil {
+		return x.DurationMap
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetStringMap() map[string]*wrapperspb.StringValue {
+	if x != nil {
+		return x.StringMap
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetBytesMap() map[string]*wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesMap
+	}
+	return nil
+}
+
+func (m *StdTypesWKT) GetChoice() isStdTypesWKT_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetChoiceTimestamp() *timestamppb.Timestamp {
+	if x, ok := x.GetChoice().(*StdTypesWKT_ChoiceTimestamp); ok {
+		return x.ChoiceTimestamp
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetChoiceDuration() *durationpb.Duration {
+	if x, ok := x.GetChoice().(*StdTypesWKT_ChoiceDuration); ok {
+		return x.ChoiceDuration
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetChoiceBool() *wrapperspb.BoolValue {
+	if x, ok := x.GetChoice().(*StdTypesWKT_ChoiceBool); ok {
+		return x.ChoiceBool
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetChoiceBytes() *wrapperspb.BytesValue {
+	if x, ok := x.GetChoice().(*StdTypesWKT_ChoiceBytes); ok {
+		return x.ChoiceBytes
+	}
+	return nil
+}
+
+func (x *StdTypesWKT) GetChoiceName() string {
+	if x, ok := x.GetChoice().(*StdTypesWKT_ChoiceName); ok {
+		return x.ChoiceName
+	}
+	return ""
+}
+
+func (x *StdTypesWKT) GetPlainTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlainTimestamp
+	}
+	return nil
+}
+
+type isStdTypesWKT_Choice interface {
+	isStdTypesWKT_Choice()
+}
+
+type StdTypesWKT_ChoiceTimestamp struct {
+	ChoiceTimestamp *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=choice_timestamp,json=choiceTimestamp,proto3,oneof"`
+}
+
+type StdTypesWKT_ChoiceDuration struct {
+	ChoiceDuration *durationpb.Duration `protobuf:"bytes,22,opt,name=choice_duration,json=choiceDuration,proto3,oneof"`
+}
+
+type StdTypesWKT_ChoiceBool struct {
+	ChoiceBool *wrapperspb.BoolValue `protobuf:"bytes,23,opt,name=choice_bool,json=choiceBool,proto3,oneof"`
+}
+
+type StdTypesWKT_ChoiceBytes struct {
+	ChoiceBytes *wrapperspb.BytesValue `protobuf:"bytes,24,opt,name=choice_bytes,json=choiceBytes,proto3,oneof"`
+}
+
+type StdTypesWKT_ChoiceName struct {
+	ChoiceName string `protobuf:"bytes,25,opt,name=choice_name,json=choiceName,proto3,oneof"`
+}
+
+func (*StdTypesWKT_ChoiceTimestamp) isStdTypesWKT_Choice() {}
+
+func (*StdTypesWKT_ChoiceDuration) isStdTypesWKT_Choice() {}
+
+func (*StdTypesWKT_ChoiceBool) isStdTypesWKT_Choice() {}
+
+func (*StdTypesWKT_ChoiceBytes) isStdTypesWKT_Choice() {}
+
+func (*StdTypesWKT_ChoiceName) isStdTypesWKT_Choice() {}
+
+type StdTypes_TimestampMapEntry struct {
+	unknownFields []byte
+	Key           string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *time.Time `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypes_TimestampMapEntry) Reset() {
+	*x = StdTypes_TimestampMapEntry{}
+}
+
+func (*StdTypes_TimestampMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypes_TimestampMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypes_TimestampMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypes_TimestampMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdTypes_TimestampMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypes_TimestampMapEntry) GetValue() time.Time {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return time.Time{}
+}
+
+type StdTypes_DurationMapEntry struct {
+	unknownFields []byte
+	Key           int32          `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *time.Duration `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypes_DurationMapEntry) Reset() {
+	*x = StdTypes_DurationMapEntry{}
+}
+
+func (*StdTypes_DurationMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypes_DurationMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypes_DurationMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypes_DurationMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdTypes_DurationMapEntry) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *StdTypes_DurationMapEntry) GetValue() time.Duration {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+type StdTypes_StringMapEntry struct {
+	unknownFields []byte
+	Key           string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypes_StringMapEntry) Reset() {
+	*x = StdTypes_StringMapEntry{}
+}
+
+func (*StdTypes_StringMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypes_StringMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypes_StringMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypes_StringMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdTypes_StringMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypes_StringMapEntry) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+type StdTypes_BytesMapEntry struct {
+	unknownFields []byte
+	Key           string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *[]byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypes_BytesMapEntry) Reset() {
+	*x = StdTypes_BytesMapEntry{}
+}
+
+func (*StdTypes_BytesMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypes_BytesMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypes_BytesMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypes_BytesMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
+func (x *StdTypes_BytesMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypes_BytesMapEntry) GetValue() []byte {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return nil
+}
+
+type StdTypesWKT_TimestampMapEntry struct {
+	unknownFields []byte
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypesWKT_TimestampMapEntry) Reset() {
+	*x = StdTypesWKT_TimestampMapEntry{}
+}
+
+func (*StdTypesWKT_TimestampMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypesWKT_TimestampMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypesWKT_TimestampMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypesWKT_TimestampMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *StdTypesWKT_TimestampMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypesWKT_TimestampMapEntry) GetValue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type StdTypesWKT_DurationMapEntry struct {
+	unknownFields []byte
+	Key           int32                `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *durationpb.Duration `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypesWKT_DurationMapEntry) Reset() {
+	*x = StdTypesWKT_DurationMapEntry{}
+}
+
+func (*StdTypesWKT_DurationMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypesWKT_DurationMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypesWKT_DurationMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypesWKT_DurationMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *StdTypesWKT_DurationMapEntry) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *StdTypesWKT_DurationMapEntry) GetValue() *durationpb.Duration {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type StdTypesWKT_StringMapEntry struct {
+	unknownFields []byte
+	Key           string                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypesWKT_StringMapEntry) Reset() {
+	*x = StdTypesWKT_StringMapEntry{}
+}
+
+func (*StdTypesWKT_StringMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypesWKT_StringMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypesWKT_StringMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypesWKT_StringMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *StdTypesWKT_StringMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypesWKT_StringMapEntry) GetValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type StdTypesWKT_BytesMapEntry struct {
+	unknownFields []byte
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *wrapperspb.BytesValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StdTypesWKT_BytesMapEntry) Reset() {
+	*x = StdTypesWKT_BytesMapEntry{}
+}
+
+func (*StdTypesWKT_BytesMapEntry) ProtoMessage() {}
+
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *StdTypesWKT_BytesMapEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *StdTypesWKT_BytesMapEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *StdTypesWKT_BytesMapEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
+func (x *StdTypesWKT_BytesMapEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StdTypesWKT_BytesMapEntry) GetValue() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (m *StdTypes) CloneVT() *StdTypes {
+	if m == nil {
+		return (*StdTypes)(nil)
+	}
+	r := new(StdTypes)
+	r.Timestamp = protobuf_go_lite.CloneStdPtr(m.Timestamp)
+	r.Duration = protobuf_go_lite.CloneStdPtr(m.Duration)
+	r.DoubleValue = protobuf_go_lite.CloneStdPtr(m.DoubleValue)
+	r.FloatValue = protobuf_go_lite.CloneStdPtr(m.FloatValue)
+	r.Int64Value = protobuf_go_lite.CloneStdPtr(m.Int64Value)
+	r.Uint64Value = protobuf_go_lite.CloneStdPtr(m.Uint64Value)
+	r.Int32Value = protobuf_go_lite.CloneStdPtr(m.Int32Value)
+	r.Uint32Value = protobuf_go_lite.CloneStdPtr(m.Uint32Value)
+	r.BoolValue = protobuf_go_lite.CloneStdPtr(m.BoolValue)
+	r.StringValue = protobuf_go_lite.CloneStdPtr(m.StringValue)
+	r.BytesValue = protobuf_go_lite.CloneStdPtr(m.BytesValue)
+	r.Timestamps = protobuf_go_lite.CloneStdSlice(m.Timestamps)
+	r.Durations = protobuf_go_lite.CloneStdSlice(m.Durations)
+	r.Int64Values = protobuf_go_lite.CloneStdSlice(m.Int64Values)
+	r.BytesValues = protobuf_go_lite.CloneStdSlice(m.BytesValues)
+	r.FloatValues = protobuf_go_lite.CloneStdSlice(m.FloatValues)
+	r.TimestampMap = protobuf_go_lite.CloneStdMap(m.TimestampMap)
+	r.DurationMap = protobuf_go_lite.CloneStdMap(m.DurationMap)
+	r.StringMap = protobuf_go_lite.CloneStdMap(m.StringMap)
+	r.BytesMap = protobuf_go_lite.CloneStdMap(m.BytesMap)
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneOneofVT() isStdTypes_Choice }).CloneOneofVT()
+	}
+	r.PlainTimestamp = protobuf_go_lite.CloneVTValue(m.PlainTimestamp)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *StdTypes) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *StdTypes_ChoiceTimestamp) CloneVT() *StdTypes_ChoiceTimestamp {
+	if m == nil {
+		return (*StdTypes_ChoiceTimestamp)(nil)
+	}
+	r := new(StdTypes_ChoiceTimestamp)
+	r.ChoiceTimestamp = protobuf_go_lite.CloneStd(m.ChoiceTimestamp)
+	return r
+}
+
+func (m *StdTypes_ChoiceTimestamp) CloneOneofVT() isStdTypes_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypes_ChoiceDuration) CloneVT() *StdTypes_ChoiceDuration {
+	if m == nil {
+		return (*StdTypes_ChoiceDuration)(nil)
+	}
+	r := new(StdTypes_ChoiceDuration)
+	r.ChoiceDuration = protobuf_go_lite.CloneStd(m.ChoiceDuration)
+	return r
+}
+
+func (m *StdTypes_ChoiceDuration) CloneOneofVT() isStdTypes_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypes_ChoiceBool) CloneVT() *StdTypes_ChoiceBool {
+	if m == nil {
+		return (*StdTypes_ChoiceBool)(nil)
+	}
+	r := new(StdTypes_ChoiceBool)
+	r.ChoiceBool = protobuf_go_lite.CloneStd(m.ChoiceBool)
+	return r
+}
+
+func (m *StdTypes_ChoiceBool) CloneOneofVT() isStdTypes_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypes_ChoiceBytes) CloneVT() *StdTypes_ChoiceBytes {
+	if m == nil {
+		return (*StdTypes_ChoiceBytes)(nil)
+	}
+	r := new(StdTypes_ChoiceBytes)
+	r.ChoiceBytes = protobuf_go_lite.CloneStd(m.ChoiceBytes)
+	return r
+}
+
+func (m *StdTypes_ChoiceBytes) CloneOneofVT() isStdTypes_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypes_ChoiceName) CloneVT() *StdTypes_ChoiceName {
+	if m == nil {
+		return (*StdTypes_ChoiceName)(nil)
+	}
+	r := new(StdTypes_ChoiceName)
+	r.ChoiceName = m.ChoiceName
+	return r
+}
+
+func (m *StdTypes_ChoiceName) CloneOneofVT() isStdTypes_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT) CloneVT() *StdTypesWKT {
+	if m == nil {
+		return (*StdTypesWKT)(nil)
+	}
+	r := new(StdTypesWKT)
+	r.Timestamp = protobuf_go_lite.CloneVTValue(m.Timestamp)
+	r.Duration = protobuf_go_lite.CloneVTValue(m.Duration)
+	r.DoubleValue = protobuf_go_lite.CloneVTValue(m.DoubleValue)
+	r.FloatValue = protobuf_go_lite.CloneVTValue(m.FloatValue)
+	r.Int64Value = protobuf_go_lite.CloneVTValue(m.Int64Value)
+	r.Uint64Value = protobuf_go_lite.CloneVTValue(m.Uint64Value)
+	r.Int32Value = protobuf_go_lite.CloneVTValue(m.Int32Value)
+	r.Uint32Value = protobuf_go_lite.CloneVTValue(m.Uint32Value)
+	r.BoolValue = protobuf_go_lite.CloneVTValue(m.BoolValue)
+	r.StringValue = protobuf_go_lite.CloneVTValue(m.StringValue)
+	r.BytesValue = protobuf_go_lite.CloneVTValue(m.BytesValue)
+	r.Timestamps = protobuf_go_lite.CloneVTSlice(m.Timestamps)
+	r.Durations = protobuf_go_lite.CloneVTSlice(m.Durations)
+	r.Int64Values = protobuf_go_lite.CloneVTSlice(m.Int64Values)
+	r.BytesValues = protobuf_go_lite.CloneVTSlice(m.BytesValues)
+	r.FloatValues = protobuf_go_lite.CloneVTSlice(m.FloatValues)
+	r.TimestampMap = protobuf_go_lite.CloneVTMap(m.TimestampMap)
+	r.DurationMap = protobuf_go_lite.CloneVTMap(m.DurationMap)
+	r.StringMap = protobuf_go_lite.CloneVTMap(m.StringMap)
+	r.BytesMap = protobuf_go_lite.CloneVTMap(m.BytesMap)
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneOneofVT() isStdTypesWKT_Choice }).CloneOneofVT()
+	}
+	r.PlainTimestamp = protobuf_go_lite.CloneVTValue(m.PlainTimestamp)
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = slices.Clone(m.unknownFields)
+	}
+	return r
+}
+
+func (m *StdTypesWKT) CloneMessageVT() protobuf_go_lite.CloneMessage {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) CloneVT() *StdTypesWKT_ChoiceTimestamp {
+	if m == nil {
+		return (*StdTypesWKT_ChoiceTimestamp)(nil)
+	}
+	r := new(StdTypesWKT_ChoiceTimestamp)
+	r.ChoiceTimestamp = protobuf_go_lite.CloneVTValue(m.ChoiceTimestamp)
+	return r
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) CloneOneofVT() isStdTypesWKT_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT_ChoiceDuration) CloneVT() *StdTypesWKT_ChoiceDuration {
+	if m == nil {
+		return (*StdTypesWKT_ChoiceDuration)(nil)
+	}
+	r := new(StdTypesWKT_ChoiceDuration)
+	r.ChoiceDuration = protobuf_go_lite.CloneVTValue(m.ChoiceDuration)
+	return r
+}
+
+func (m *StdTypesWKT_ChoiceDuration) CloneOneofVT() isStdTypesWKT_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT_ChoiceBool) CloneVT() *StdTypesWKT_ChoiceBool {
+	if m == nil {
+		return (*StdTypesWKT_ChoiceBool)(nil)
+	}
+	r := new(StdTypesWKT_ChoiceBool)
+	r.ChoiceBool = protobuf_go_lite.CloneVTValue(m.ChoiceBool)
+	return r
+}
+
+func (m *StdTypesWKT_ChoiceBool) CloneOneofVT() isStdTypesWKT_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT_ChoiceBytes) CloneVT() *StdTypesWKT_ChoiceBytes {
+	if m == nil {
+		return (*StdTypesWKT_ChoiceBytes)(nil)
+	}
+	r := new(StdTypesWKT_ChoiceBytes)
+	r.ChoiceBytes = protobuf_go_lite.CloneVTValue(m.ChoiceBytes)
+	return r
+}
+
+func (m *StdTypesWKT_ChoiceBytes) CloneOneofVT() isStdTypesWKT_Choice {
+	return m.CloneVT()
+}
+
+func (m *StdTypesWKT_ChoiceName) CloneVT() *StdTypesWKT_ChoiceName {
+	if m == nil {
+		return (*StdTypesWKT_ChoiceName)(nil)
+	}
+	r := new(StdTypesWKT_ChoiceName)
+	r.ChoiceName = m.ChoiceName
+	return r
+}
+
+func (m *StdTypesWKT_ChoiceName) CloneOneofVT() isStdTypesWKT_Choice {
+	return m.CloneVT()
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *StdTypes) CompareVT(that *StdTypes) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Timestamp, that.Timestamp); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Duration, that.Duration); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.DoubleValue, that.DoubleValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.FloatValue, that.FloatValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Int64Value, that.Int64Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Uint64Value, that.Uint64Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Int32Value, that.Int32Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.Uint32Value, that.Uint32Value); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.BoolValue, that.BoolValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.StringValue, that.StringValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareStdPtr(m.BytesValue, that.BytesValue); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.Timestamps, that.Timestamps, protobuf_go_lite.CompareStd[time.Time]); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.Durations, that.Durations, protobuf_go_lite.CompareStd[time.Duration]); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.Int64Values, that.Int64Values, protobuf_go_lite.CompareStd[int64]); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.BytesValues, that.BytesValues, protobuf_go_lite.CompareStd[[]byte]); c != 0 {
+		return c
+	}
+	if c := slices.CompareFunc(m.FloatValues, that.FloatValues, protobuf_go_lite.CompareStd[float32]); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.TimestampMap, that.TimestampMap, cmp.Compare[string], protobuf_go_lite.CompareStd[time.Time]); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.DurationMap, that.DurationMap, cmp.Compare[int32], protobuf_go_lite.CompareStd[time.Duration]); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.StringMap, that.StringMap, cmp.Compare[string], protobuf_go_lite.CompareStd[string]); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareMap(m.BytesMap, that.BytesMap, cmp.Compare[string], protobuf_go_lite.CompareStd[[]byte]); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*StdTypes_ChoiceTimestamp)
+		b, bok := that.Choice.(*StdTypes_ChoiceTimestamp)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareStd(a.ChoiceTimestamp, b.ChoiceTimestamp); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypes_ChoiceDuration)
+		b, bok := that.Choice.(*StdTypes_ChoiceDuration)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareStd(a.ChoiceDuration, b.ChoiceDuration); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypes_ChoiceBool)
+		b, bok := that.Choice.(*StdTypes_ChoiceBool)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareStd(a.ChoiceBool, b.ChoiceBool); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypes_ChoiceBytes)
+		b, bok := that.Choice.(*StdTypes_ChoiceBytes)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareStd(a.ChoiceBytes, b.ChoiceBytes); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypes_ChoiceName)
+		b, bok := that.Choice.(*StdTypes_ChoiceName)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ChoiceName, b.ChoiceName); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := m.PlainTimestamp.CompareVT(that.PlainTimestamp); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *StdTypesWKT) CompareVT(that *StdTypesWKT) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := m.Timestamp.CompareVT(that.Timestamp); c != 0 {
+		return c
+	}
+	if c := m.Duration.CompareVT(that.Duration); c != 0 {
+		return c
+	}
+	if c := m.DoubleValue.CompareVT(that.DoubleValue); c != 0 {
+		return c
+	}
+	if c := m.FloatValue.CompareVT(that.FloatValue); c != 0 {
+		return c
+	}
+	if c := m.Int64Value.CompareVT(that.Int64Value); c != 0 {
+		return c
+	}
+	if c := m.Uint64Value.CompareVT(that.Uint64Value); c != 0 {
+		return c
+	}
+	if c := m.Int32Value.CompareVT(that.Int32Value); c != 0 {
+		return c
+	}
+	if c := m.Uint32Value.CompareVT(that.Uint32Value); c != 0 {
+		return c
+	}
+	if c := m.BoolValue.CompareVT(that.BoolValue); c != 0 {
+		return c
+	}
+	if c := m.StringValue.CompareVT(that.StringValue); c != 0 {
+		return c
+	}
+	if c := m.BytesValue.CompareVT(that.BytesValue); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Timestamps, that.Timestamps, (*timestamppb.Timestamp).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Durations, that.Durations, (*durationpb.Duration).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Int64Values, that.Int64Values, (*wrapperspb.Int64Value).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.BytesValues, that.BytesValues, (*wrapperspb.BytesValue).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.FloatValues, that.FloatValues, (*wrapperspb.FloatValue).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.TimestampMap, that.TimestampMap, cmp.Compare[string], (*timestamppb.Timestamp).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.DurationMap, that.DurationMap, cmp.Compare[int32], (*durationpb.Duration).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.StringMap, that.StringMap, cmp.Compare[string], (*wrapperspb.StringValue).CompareVT); c != 0 {
+		return c
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.BytesMap, that.BytesMap, cmp.Compare[string], (*wrapperspb.BytesValue).CompareVT); c != 0 {
+		return c
+	}
+	{
+		a, aok := m.Choice.(*StdTypesWKT_ChoiceTimestamp)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceTimestamp)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ChoiceTimestamp, b.ChoiceTimestamp, (*timestamppb.Timestamp).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypesWKT_ChoiceDuration)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceDuration)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ChoiceDuration, b.ChoiceDuration, (*durationpb.Duration).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypesWKT_ChoiceBool)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceBool)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ChoiceBool, b.ChoiceBool, (*wrapperspb.BoolValue).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypesWKT_ChoiceBytes)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceBytes)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ChoiceBytes, b.ChoiceBytes, (*wrapperspb.BytesValue).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Choice.(*StdTypesWKT_ChoiceName)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceName)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.ChoiceName, b.ChoiceName); c != 0 {
+				return c
+			}
+		}
+	}
+	if c := m.PlainTimestamp.CompareVT(that.PlainTimestamp); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *StdTypes) CopyVT(dst *StdTypes) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Timestamp = protobuf_go_lite.CopyStdPtr(dst.Timestamp, m.Timestamp)
+	dst.Duration = protobuf_go_lite.CopyStdPtr(dst.Duration, m.Duration)
+	dst.DoubleValue = protobuf_go_lite.CopyStdPtr(dst.DoubleValue, m.DoubleValue)
+	dst.FloatValue = protobuf_go_lite.CopyStdPtr(dst.FloatValue, m.FloatValue)
+	dst.Int64Value = protobuf_go_lite.CopyStdPtr(dst.Int64Value, m.Int64Value)
+	dst.Uint64Value = protobuf_go_lite.CopyStdPtr(dst.Uint64Value, m.Uint64Value)
+	dst.Int32Value = protobuf_go_lite.CopyStdPtr(dst.Int32Value, m.Int32Value)
+	dst.Uint32Value = protobuf_go_lite.CopyStdPtr(dst.Uint32Value, m.Uint32Value)
+	dst.BoolValue = protobuf_go_lite.CopyStdPtr(dst.BoolValue, m.BoolValue)
+	dst.StringValue = protobuf_go_lite.CopyStdPtr(dst.StringValue, m.StringValue)
+	dst.BytesValue = protobuf_go_lite.CopyStdPtr(dst.BytesValue, m.BytesValue)
+	dst.Timestamps = protobuf_go_lite.CopyStdSlice(dst.Timestamps, m.Timestamps)
+	dst.Durations = protobuf_go_lite.CopyStdSlice(dst.Durations, m.Durations)
+	dst.Int64Values = protobuf_go_lite.CopyStdSlice(dst.Int64Values, m.Int64Values)
+	dst.BytesValues = protobuf_go_lite.CopyStdSlice(dst.BytesValues, m.BytesValues)
+	dst.FloatValues = protobuf_go_lite.CopyStdSlice(dst.FloatValues, m.FloatValues)
+	dst.TimestampMap = protobuf_go_lite.CopyStdMap(dst.TimestampMap, m.TimestampMap)
+	dst.DurationMap = protobuf_go_lite.CopyStdMap(dst.DurationMap, m.DurationMap)
+	dst.StringMap = protobuf_go_lite.CopyStdMap(dst.StringMap, m.StringMap)
+	dst.BytesMap = protobuf_go_lite.CopyStdMap(dst.BytesMap, m.BytesMap)
+	switch v := m.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *StdTypes_ChoiceTimestamp:
+		d, ok := dst.Choice.(*StdTypes_ChoiceTimestamp)
+		if !ok {
+			d = &StdTypes_ChoiceTimestamp{}
+			dst.Choice = d
+		}
+		d.ChoiceTimestamp = protobuf_go_lite.CopyStd(d.ChoiceTimestamp, v.ChoiceTimestamp)
+	case *StdTypes_ChoiceDuration:
+		d, ok := dst.Choice.(*StdTypes_ChoiceDuration)
+		if !ok {
+			d = &StdTypes_ChoiceDuration{}
+			dst.Choice = d
+		}
+		d.ChoiceDuration = protobuf_go_lite.CopyStd(d.ChoiceDuration, v.ChoiceDuration)
+	case *StdTypes_ChoiceBool:
+		d, ok := dst.Choice.(*StdTypes_ChoiceBool)
+		if !ok {
+			d = &StdTypes_ChoiceBool{}
+			dst.Choice = d
+		}
+		d.ChoiceBool = protobuf_go_lite.CopyStd(d.ChoiceBool, v.ChoiceBool)
+	case *StdTypes_ChoiceBytes:
+		d, ok := dst.Choice.(*StdTypes_ChoiceBytes)
+		if !ok {
+			d = &StdTypes_ChoiceBytes{}
+			dst.Choice = d
+		}
+		d.ChoiceBytes = protobuf_go_lite.CopyStd(d.ChoiceBytes, v.ChoiceBytes)
+	case *StdTypes_ChoiceName:
+		d, ok := dst.Choice.(*StdTypes_ChoiceName)
+		if !ok {
+			d = &StdTypes_ChoiceName{}
+			dst.Choice = d
+		}
+		d.ChoiceName = v.ChoiceName
+	}
+	dst.PlainTimestamp = protobuf_go_lite.CopyVTValue(dst.PlainTimestamp, m.PlainTimestamp, (*timestamppb.Timestamp).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *StdTypesWKT) CopyVT(dst *StdTypesWKT) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Timestamp = protobuf_go_lite.CopyVTValue(dst.Timestamp, m.Timestamp, (*timestamppb.Timestamp).CopyVT)
+	dst.Duration = protobuf_go_lite.CopyVTValue(dst.Duration, m.Duration, (*durationpb.Duration).CopyVT)
+	dst.DoubleValue = protobuf_go_lite.CopyVTValue(dst.DoubleValue, m.DoubleValue, (*wrapperspb.DoubleValue).CopyVT)
+	dst.FloatValue = protobuf_go_lite.CopyVTValue(dst.FloatValue, m.FloatValue, (*wrapperspb.FloatValue).CopyVT)
+	dst.Int64Value = protobuf_go_lite.CopyVTValue(dst.Int64Value, m.Int64Value, (*wrapperspb.Int64Value).CopyVT)
+	dst.Uint64Value = protobuf_go_lite.CopyVTValue(dst.Uint64Value, m.Uint64Value, (*wrapperspb.UInt64Value).CopyVT)
+	dst.Int32Value = protobuf_go_lite.CopyVTValue(dst.Int32Value, m.Int32Value, (*wrapperspb.Int32Value).CopyVT)
+	dst.Uint32Value = protobuf_go_lite.CopyVTValue(dst.Uint32Value, m.Uint32Value, (*wrapperspb.UInt32Value).CopyVT)
+	dst.BoolValue = protobuf_go_lite.CopyVTValue(dst.BoolValue, m.BoolValue, (*wrapperspb.BoolValue).CopyVT)
+	dst.StringValue = protobuf_go_lite.CopyVTValue(dst.StringValue, m.StringValue, (*wrapperspb.StringValue).CopyVT)
+	dst.BytesValue = protobuf_go_lite.CopyVTValue(dst.BytesValue, m.BytesValue, (*wrapperspb.BytesValue).CopyVT)
+	dst.Timestamps = protobuf_go_lite.CopyVTSlice(dst.Timestamps, m.Timestamps, (*timestamppb.Timestamp).CopyVT)
+	dst.Durations = protobuf_go_lite.CopyVTSlice(dst.Durations, m.Durations, (*durationpb.Duration).CopyVT)
+	dst.Int64Values = protobuf_go_lite.CopyVTSlice(dst.Int64Values, m.Int64Values, (*wrapperspb.Int64Value).CopyVT)
+	dst.BytesValues = protobuf_go_lite.CopyVTSlice(dst.BytesValues, m.BytesValues, (*wrapperspb.BytesValue).CopyVT)
+	dst.FloatValues = protobuf_go_lite.CopyVTSlice(dst.FloatValues, m.FloatValues, (*wrapperspb.FloatValue).CopyVT)
+	dst.TimestampMap = protobuf_go_lite.CopyVTMap(dst.TimestampMap, m.TimestampMap, (*timestamppb.Timestamp).CopyVT)
+	dst.DurationMap = protobuf_go_lite.CopyVTMap(dst.DurationMap, m.DurationMap, (*durationpb.Duration).CopyVT)
+	dst.StringMap = protobuf_go_lite.CopyVTMap(dst.StringMap, m.StringMap, (*wrapperspb.StringValue).CopyVT)
+	dst.BytesMap = protobuf_go_lite.CopyVTMap(dst.BytesMap, m.BytesMap, (*wrapperspb.BytesValue).CopyVT)
+	switch v := m.Choice.(type) {
+	case nil:
+		dst.Choice = nil
+	case *StdTypesWKT_ChoiceTimestamp:
+		d, ok := dst.Choice.(*StdTypesWKT_ChoiceTimestamp)
+		if !ok {
+			d = &StdTypesWKT_ChoiceTimestamp{}
+			dst.Choice = d
+		}
+		d.ChoiceTimestamp = protobuf_go_lite.CopyVTValue(d.ChoiceTimestamp, v.ChoiceTimestamp, (*timestamppb.Timestamp).CopyVT)
+	case *StdTypesWKT_ChoiceDuration:
+		d, ok := dst.Choice.(*StdTypesWKT_ChoiceDuration)
+		if !ok {
+			d = &StdTypesWKT_ChoiceDuration{}
+			dst.Choice = d
+		}
+		d.ChoiceDuration = protobuf_go_lite.CopyVTValue(d.ChoiceDuration, v.ChoiceDuration, (*durationpb.Duration).CopyVT)
+	case *StdTypesWKT_ChoiceBool:
+		d, ok := dst.Choice.(*StdTypesWKT_ChoiceBool)
+		if !ok {
+			d = &StdTypesWKT_ChoiceBool{}
+			dst.Choice = d
+		}
+		d.ChoiceBool = protobuf_go_lite.CopyVTValue(d.ChoiceBool, v.ChoiceBool, (*wrapperspb.BoolValue).CopyVT)
+	case *StdTypesWKT_ChoiceBytes:
+		d, ok := dst.Choice.(*StdTypesWKT_ChoiceBytes)
+		if !ok {
+			d = &StdTypesWKT_ChoiceBytes{}
+			dst.Choice = d
+		}
+		d.ChoiceBytes = protobuf_go_lite.CopyVTValue(d.ChoiceBytes, v.ChoiceBytes, (*wrapperspb.BytesValue).CopyVT)
+	case *StdTypesWKT_ChoiceName:
+		d, ok := dst.Choice.(*StdTypesWKT_ChoiceName)
+		if !ok {
+			d = &StdTypesWKT_ChoiceName{}
+			dst.Choice = d
+		}
+		d.ChoiceName = v.ChoiceName
+	}
+	dst.PlainTimestamp = protobuf_go_lite.CopyVTValue(dst.PlainTimestamp, m.PlainTimestamp, (*timestamppb.Timestamp).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *StdTypes) DiffVT(that *StdTypes) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *StdTypes) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *StdTypes) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &StdTypes{}
+	}
+	if that == nil {
+		that = &StdTypes{}
+	}
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "timestamp", m.Timestamp, that.Timestamp)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "duration", m.Duration, that.Duration)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "double_value", m.DoubleValue, that.DoubleValue)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "float_value", m.FloatValue, that.FloatValue)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "int64_value", m.Int64Value, that.Int64Value)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "uint64_value", m.Uint64Value, that.Uint64Value)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "int32_value", m.Int32Value, that.Int32Value)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "uint32_value", m.Uint32Value, that.Uint32Value)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "bool_value", m.BoolValue, that.BoolValue)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "string_value", m.StringValue, that.StringValue)
+	diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "bytes_value", m.BytesValue, that.BytesValue)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "timestamps", m.Timestamps, that.Timestamps)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "durations", m.Durations, that.Durations)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "int64_values", m.Int64Values, that.Int64Values)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "bytes_values", m.BytesValues, that.BytesValues)
+	diffs = protobuf_go_lite.AppendDiffStdSlice(diffs, prefix, "float_values", m.FloatValues, that.FloatValues)
+	diffs = protobuf_go_lite.AppendDiffStdMap(diffs, prefix, "timestamp_map", m.TimestampMap, that.TimestampMap)
+	diffs = protobuf_go_lite.AppendDiffStdMap(diffs, prefix, "duration_map", m.DurationMap, that.DurationMap)
+	diffs = protobuf_go_lite.AppendDiffStdMap(diffs, prefix, "string_map", m.StringMap, that.StringMap)
+	diffs = protobuf_go_lite.AppendDiffStdMap(diffs, prefix, "bytes_map", m.BytesMap, that.BytesMap)
+	{
+		var a, b *time.Time
+		if v, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+			a = &v.ChoiceTimestamp
+		}
+		if v, ok := that.Choice.(*StdTypes_ChoiceTimestamp); ok {
+			b = &v.ChoiceTimestamp
+		}
+		diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "choice_timestamp", a, b)
+	}
+	{
+		var a, b *time.Duration
+		if v, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+			a = &v.ChoiceDuration
+		}
+		if v, ok := that.Choice.(*StdTypes_ChoiceDuration); ok {
+			b = &v.ChoiceDuration
+		}
+		diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "choice_duration", a, b)
+	}
+	{
+		var a, b *bool
+		if v, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+			a = &v.ChoiceBool
+		}
+		if v, ok := that.Choice.(*StdTypes_ChoiceBool); ok {
+			b = &v.ChoiceBool
+		}
+		diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "choice_bool", a, b)
+	}
+	{
+		var a, b *[]byte
+		if v, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+			a = &v.ChoiceBytes
+		}
+		if v, ok := that.Choice.(*StdTypes_ChoiceBytes); ok {
+			b = &v.ChoiceBytes
+		}
+		diffs = protobuf_go_lite.AppendDiffStdPtr(diffs, prefix, "choice_bytes", a, b)
+	}
+	{
+		var a, b *string
+		if v, ok := m.Choice.(*StdTypes_ChoiceName); ok {
+			a = &v.ChoiceName
+		}
+		if v, ok := that.Choice.(*StdTypes_ChoiceName); ok {
+			b = &v.ChoiceName
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "choice_name", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "plain_timestamp", m.PlainTimestamp, that.PlainTimestamp, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *StdTypesWKT) DiffVT(that *StdTypesWKT) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *StdTypesWKT) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *StdTypesWKT) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &StdTypesWKT{}
+	}
+	if that == nil {
+		that = &StdTypesWKT{}
+	}
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "timestamp", m.Timestamp, that.Timestamp, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "duration", m.Duration, that.Duration, (*durationpb.Duration).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "double_value", m.DoubleValue, that.DoubleValue, (*wrapperspb.DoubleValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "float_value", m.FloatValue, that.FloatValue, (*wrapperspb.FloatValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "int64_value", m.Int64Value, that.Int64Value, (*wrapperspb.Int64Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "uint64_value", m.Uint64Value, that.Uint64Value, (*wrapperspb.UInt64Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "int32_value", m.Int32Value, that.Int32Value, (*wrapperspb.Int32Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "uint32_value", m.Uint32Value, that.Uint32Value, (*wrapperspb.UInt32Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "bool_value", m.BoolValue, that.BoolValue, (*wrapperspb.BoolValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "string_value", m.StringValue, that.StringValue, (*wrapperspb.StringValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "bytes_value", m.BytesValue, that.BytesValue, (*wrapperspb.BytesValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "timestamps", m.Timestamps, that.Timestamps, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "durations", m.Durations, that.Durations, (*durationpb.Duration).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "int64_values", m.Int64Values, that.Int64Values, (*wrapperspb.Int64Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "bytes_values", m.BytesValues, that.BytesValues, (*wrapperspb.BytesValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "float_values", m.FloatValues, that.FloatValues, (*wrapperspb.FloatValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "timestamp_map", m.TimestampMap, that.TimestampMap, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "duration_map", m.DurationMap, that.DurationMap, (*durationpb.Duration).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "string_map", m.StringMap, that.StringMap, (*wrapperspb.StringValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "bytes_map", m.BytesMap, that.BytesMap, (*wrapperspb.BytesValue).AppendDiffVT)
+	{
+		var a, b *timestamppb.Timestamp
+		if v, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+			a = v.ChoiceTimestamp
+		}
+		if v, ok := that.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+			b = v.ChoiceTimestamp
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "choice_timestamp", a, b, (*timestamppb.Timestamp).AppendDiffVT)
+	}
+	{
+		var a, b *durationpb.Duration
+		if v, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+			a = v.ChoiceDuration
+		}
+		if v, ok := that.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+			b = v.ChoiceDuration
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "choice_duration", a, b, (*durationpb.Duration).AppendDiffVT)
+	}
+	{
+		var a, b *wrapperspb.BoolValue
+		if v, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+			a = v.ChoiceBool
+		}
+		if v, ok := that.Choice.(*StdTypesWKT_ChoiceBool); ok {
+			b = v.ChoiceBool
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "choice_bool", a, b, (*wrapperspb.BoolValue).AppendDiffVT)
+	}
+	{
+		var a, b *wrapperspb.BytesValue
+		if v, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+			a = v.ChoiceBytes
+		}
+		if v, ok := that.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+			b = v.ChoiceBytes
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "choice_bytes", a, b, (*wrapperspb.BytesValue).AppendDiffVT)
+	}
+	{
+		var a, b *string
+		if v, ok := m.Choice.(*StdTypesWKT_ChoiceName); ok {
+			a = &v.ChoiceName
+		}
+		if v, ok := that.Choice.(*StdTypesWKT_ChoiceName); ok {
+			b = &v.ChoiceName
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "choice_name", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "plain_timestamp", m.PlainTimestamp, that.PlainTimestamp, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *StdTypes) EqualVT(that *StdTypes) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface{ EqualVT(isStdTypes_Choice) bool }).EqualVT(that.Choice) {
+			return false
+		}
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Timestamp, that.Timestamp) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Duration, that.Duration) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.DoubleValue, that.DoubleValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.FloatValue, that.FloatValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Int64Value, that.Int64Value) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Uint64Value, that.Uint64Value) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Int32Value, that.Int32Value) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.Uint32Value, that.Uint32Value) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.BoolValue, that.BoolValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.StringValue, that.StringValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdPtr(this.BytesValue, that.BytesValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.Timestamps, that.Timestamps) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.Durations, that.Durations) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.Int64Values, that.Int64Values) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.BytesValues, that.BytesValues) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdSlice(this.FloatValues, that.FloatValues) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdMap(this.TimestampMap, that.TimestampMap) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdMap(this.DurationMap, that.DurationMap) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdMap(this.StringMap, that.StringMap) {
+		return false
+	}
+	if !protobuf_go_lite.EqualStdMap(this.BytesMap, that.BytesMap) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.PlainTimestamp, that.PlainTimestamp) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *StdTypes) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StdTypes)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *StdTypes_ChoiceTimestamp) EqualVT(thatIface isStdTypes_Choice) bool {
+	that, ok := thatIface.(*StdTypes_ChoiceTimestamp)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualStd(this.ChoiceTimestamp, that.ChoiceTimestamp) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypes_ChoiceDuration) EqualVT(thatIface isStdTypes_Choice) bool {
+	that, ok := thatIface.(*StdTypes_ChoiceDuration)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualStd(this.ChoiceDuration, that.ChoiceDuration) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypes_ChoiceBool) EqualVT(thatIface isStdTypes_Choice) bool {
+	that, ok := thatIface.(*StdTypes_ChoiceBool)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualStd(this.ChoiceBool, that.ChoiceBool) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypes_ChoiceBytes) EqualVT(thatIface isStdTypes_Choice) bool {
+	that, ok := thatIface.(*StdTypes_ChoiceBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualStd(this.ChoiceBytes, that.ChoiceBytes) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypes_ChoiceName) EqualVT(thatIface isStdTypes_Choice) bool {
+	that, ok := thatIface.(*StdTypes_ChoiceName)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.ChoiceName != that.ChoiceName {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypesWKT) EqualVT(that *StdTypesWKT) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVT(isStdTypesWKT_Choice) bool
+		}).EqualVT(that.Choice) {
+			return false
+		}
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Timestamp, that.Timestamp) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Duration, that.Duration) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.DoubleValue, that.DoubleValue) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.FloatValue, that.FloatValue) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Int64Value, that.Int64Value) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Uint64Value, that.Uint64Value) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Int32Value, that.Int32Value) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.Uint32Value, that.Uint32Value) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.BoolValue, that.BoolValue) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.StringValue, that.StringValue) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.BytesValue, that.BytesValue) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.Timestamps, that.Timestamps, func() *timestamppb.Timestamp { return &timestamppb.Timestamp{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.Durations, that.Durations, func() *durationpb.Duration { return &durationpb.Duration{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.Int64Values, that.Int64Values, func() *wrapperspb.Int64Value { return &wrapperspb.Int64Value{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.BytesValues, that.BytesValues, func() *wrapperspb.BytesValue { return &wrapperspb.BytesValue{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTSliceImplicit(this.FloatValues, that.FloatValues, func() *wrapperspb.FloatValue { return &wrapperspb.FloatValue{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.TimestampMap, that.TimestampMap, func() *timestamppb.Timestamp { return &timestamppb.Timestamp{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.DurationMap, that.DurationMap, func() *durationpb.Duration { return &durationpb.Duration{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.StringMap, that.StringMap, func() *wrapperspb.StringValue { return &wrapperspb.StringValue{} }) {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTMapImplicit(this.BytesMap, that.BytesMap, func() *wrapperspb.BytesValue { return &wrapperspb.BytesValue{} }) {
+		return false
+	}
+	if !protobuf_go_lite.IsEqualVT(this.PlainTimestamp, that.PlainTimestamp) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *StdTypesWKT) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*StdTypesWKT)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *StdTypesWKT_ChoiceTimestamp) EqualVT(thatIface isStdTypesWKT_Choice) bool {
+	that, ok := thatIface.(*StdTypesWKT_ChoiceTimestamp)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.ChoiceTimestamp, that.ChoiceTimestamp, func() *timestamppb.Timestamp { return &timestamppb.Timestamp{} }) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypesWKT_ChoiceDuration) EqualVT(thatIface isStdTypesWKT_Choice) bool {
+	that, ok := thatIface.(*StdTypesWKT_ChoiceDuration)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.ChoiceDuration, that.ChoiceDuration, func() *durationpb.Duration { return &durationpb.Duration{} }) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypesWKT_ChoiceBool) EqualVT(thatIface isStdTypesWKT_Choice) bool {
+	that, ok := thatIface.(*StdTypesWKT_ChoiceBool)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.ChoiceBool, that.ChoiceBool, func() *wrapperspb.BoolValue { return &wrapperspb.BoolValue{} }) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypesWKT_ChoiceBytes) EqualVT(thatIface isStdTypesWKT_Choice) bool {
+	that, ok := thatIface.(*StdTypesWKT_ChoiceBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.ChoiceBytes, that.ChoiceBytes, func() *wrapperspb.BytesValue { return &wrapperspb.BytesValue{} }) {
+		return false
+	}
+	return true
+}
+
+func (this *StdTypesWKT_ChoiceName) EqualVT(thatIface isStdTypesWKT_Choice) bool {
+	that, ok := thatIface.(*StdTypesWKT_ChoiceName)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.ChoiceName != that.ChoiceName {
+		return false
+	}
+	return true
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *StdTypes) EqualVTOpts(that *StdTypes, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *StdTypes) EqualVTOptsPrefix(that *StdTypes, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &StdTypes{}
+		}
+		if that == nil {
+			that = &StdTypes{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "timestamp"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Timestamp, that.Timestamp) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "duration"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Duration, that.Duration) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "double_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.DoubleValue, that.DoubleValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "float_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.FloatValue, that.FloatValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "int64_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Int64Value, that.Int64Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "uint64_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Uint64Value, that.Uint64Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "int32_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Int32Value, that.Int32Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "uint32_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.Uint32Value, that.Uint32Value) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bool_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.BoolValue, that.BoolValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "string_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.StringValue, that.StringValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bytes_value"); ok && !protobuf_go_lite.EqualOptsStdPtr(opts, this.BytesValue, that.BytesValue) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "timestamps"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.Timestamps, that.Timestamps) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "durations"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.Durations, that.Durations) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "int64_values"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.Int64Values, that.Int64Values) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bytes_values"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.BytesValues, that.BytesValues) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "float_values"); ok && !protobuf_go_lite.EqualOptsStdSlice(opts, this.FloatValues, that.FloatValues) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "timestamp_map"); ok && !protobuf_go_lite.EqualOptsStdMap(opts, this.TimestampMap, that.TimestampMap) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "duration_map"); ok && !protobuf_go_lite.EqualOptsStdMap(opts, this.DurationMap, that.DurationMap) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "string_map"); ok && !protobuf_go_lite.EqualOptsStdMap(opts, this.StringMap, that.StringMap) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "bytes_map"); ok && !protobuf_go_lite.EqualOptsStdMap(opts, this.BytesMap, that.BytesMap) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_timestamp"); ok {
+		a, aok := this.Choice.(*StdTypes_ChoiceTimestamp)
+		b, bok := that.Choice.(*StdTypes_ChoiceTimestamp)
+		if aok != bok || aok && !protobuf_go_lite.EqualOptsStd(opts, a.ChoiceTimestamp, b.ChoiceTimestamp) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_duration"); ok {
+		a, aok := this.Choice.(*StdTypes_ChoiceDuration)
+		b, bok := that.Choice.(*StdTypes_ChoiceDuration)
+		if aok != bok || aok && !protobuf_go_lite.EqualOptsStd(opts, a.ChoiceDuration, b.ChoiceDuration) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_bool"); ok {
+		a, aok := this.Choice.(*StdTypes_ChoiceBool)
+		b, bok := that.Choice.(*StdTypes_ChoiceBool)
+		if aok != bok || aok && !protobuf_go_lite.EqualOptsStd(opts, a.ChoiceBool, b.ChoiceBool) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_bytes"); ok {
+		a, aok := this.Choice.(*StdTypes_ChoiceBytes)
+		b, bok := that.Choice.(*StdTypes_ChoiceBytes)
+		if aok != bok || aok && !protobuf_go_lite.EqualOptsStd(opts, a.ChoiceBytes, b.ChoiceBytes) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_name"); ok {
+		a, aok := this.Choice.(*StdTypes_ChoiceName)
+		b, bok := that.Choice.(*StdTypes_ChoiceName)
+		if aok != bok || aok && a.ChoiceName != b.ChoiceName {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "plain_timestamp"); ok && !protobuf_go_lite.EqualVTOptsValue(this.PlainTimestamp, that.PlainTimestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *StdTypesWKT) EqualVTOpts(that *StdTypesWKT, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *StdTypesWKT) EqualVTOptsPrefix(that *StdTypesWKT, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &StdTypesWKT{}
+		}
+		if that == nil {
+			that = &StdTypesWKT{}
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamp"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Timestamp, that.Timestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "duration"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Duration, that.Duration, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "double_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.DoubleValue, that.DoubleValue, opts, path, (*wrapperspb.DoubleValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "float_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.FloatValue, that.FloatValue, opts, path, (*wrapperspb.FloatValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "int64_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Int64Value, that.Int64Value, opts, path, (*wrapperspb.Int64Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "uint64_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Uint64Value, that.Uint64Value, opts, path, (*wrapperspb.UInt64Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "int32_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Int32Value, that.Int32Value, opts, path, (*wrapperspb.Int32Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "uint32_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Uint32Value, that.Uint32Value, opts, path, (*wrapperspb.UInt32Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bool_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BoolValue, that.BoolValue, opts, path, (*wrapperspb.BoolValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "string_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.StringValue, that.StringValue, opts, path, (*wrapperspb.StringValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bytes_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BytesValue, that.BytesValue, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamps"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Timestamps, that.Timestamps, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "durations"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Durations, that.Durations, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "int64_values"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Int64Values, that.Int64Values, opts, path, (*wrapperspb.Int64Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bytes_values"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.BytesValues, that.BytesValues, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "float_values"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.FloatValues, that.FloatValues, opts, path, (*wrapperspb.FloatValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamp_map"); ok && !protobuf_go_lite.EqualVTOptsMap(this.TimestampMap, that.TimestampMap, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "duration_map"); ok && !protobuf_go_lite.EqualVTOptsMap(this.DurationMap, that.DurationMap, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "string_map"); ok && !protobuf_go_lite.EqualVTOptsMap(this.StringMap, that.StringMap, opts, path, (*wrapperspb.StringValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bytes_map"); ok && !protobuf_go_lite.EqualVTOptsMap(this.BytesMap, that.BytesMap, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "choice_timestamp"); ok {
+		a, aok := this.Choice.(*StdTypesWKT_ChoiceTimestamp)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceTimestamp)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ChoiceTimestamp, b.ChoiceTimestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "choice_duration"); ok {
+		a, aok := this.Choice.(*StdTypesWKT_ChoiceDuration)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceDuration)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ChoiceDuration, b.ChoiceDuration, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "choice_bool"); ok {
+		a, aok := this.Choice.(*StdTypesWKT_ChoiceBool)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceBool)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ChoiceBool, b.ChoiceBool, opts, path, (*wrapperspb.BoolValue).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "choice_bytes"); ok {
+		a, aok := this.Choice.(*StdTypesWKT_ChoiceBytes)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceBytes)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ChoiceBytes, b.ChoiceBytes, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "choice_name"); ok {
+		a, aok := this.Choice.(*StdTypesWKT_ChoiceName)
+		b, bok := that.Choice.(*StdTypesWKT_ChoiceName)
+		if aok != bok || aok && a.ChoiceName != b.ChoiceName {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "plain_timestamp"); ok && !protobuf_go_lite.EqualVTOptsValue(this.PlainTimestamp, that.PlainTimestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *StdTypes) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *StdTypes) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *StdTypes) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.Timestamp != nil {
+			w.Field(1)
+			protobuf_go_lite.HashStd(w, *m.Timestamp)
+		}
+		if m.Duration != nil {
+			w.Field(2)
+			protobuf_go_lite.HashStd(w, *m.Duration)
+		}
+		if m.DoubleValue != nil {
+			w.Field(3)
+			protobuf_go_lite.HashStd(w, *m.DoubleValue)
+		}
+		if m.FloatValue != nil {
+			w.Field(4)
+			protobuf_go_lite.HashStd(w, *m.FloatValue)
+		}
+		if m.Int64Value != nil {
+			w.Field(5)
+			protobuf_go_lite.HashStd(w, *m.Int64Value)
+		}
+		if m.Uint64Value != nil {
+			w.Field(6)
+			protobuf_go_lite.HashStd(w, *m.Uint64Value)
+		}
+		if m.Int32Value != nil {
+			w.Field(7)
+			protobuf_go_lite.HashStd(w, *m.Int32Value)
+		}
+		if m.Uint32Value != nil {
+			w.Field(8)
+			protobuf_go_lite.HashStd(w, *m.Uint32Value)
+		}
+		if m.BoolValue != nil {
+			w.Field(9)
+			protobuf_go_lite.HashStd(w, *m.BoolValue)
+		}
+		if m.StringValue != nil {
+			w.Field(10)
+			protobuf_go_lite.HashStd(w, *m.StringValue)
+		}
+		if m.BytesValue != nil {
+			w.Field(11)
+			protobuf_go_lite.HashStd(w, *m.BytesValue)
+		}
+		if len(m.Timestamps) != 0 {
+			w.Field(12)
+			w.Uint64(uint64(len(m.Timestamps)))
+			for _, v := range m.Timestamps {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.Durations) != 0 {
+			w.Field(13)
+			w.Uint64(uint64(len(m.Durations)))
+			for _, v := range m.Durations {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.Int64Values) != 0 {
+			w.Field(14)
+			w.Uint64(uint64(len(m.Int64Values)))
+			for _, v := range m.Int64Values {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.BytesValues) != 0 {
+			w.Field(15)
+			w.Uint64(uint64(len(m.BytesValues)))
+			for _, v := range m.BytesValues {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.FloatValues) != 0 {
+			w.Field(16)
+			w.Uint64(uint64(len(m.FloatValues)))
+			for _, v := range m.FloatValues {
+				protobuf_go_lite.HashStd(w, v)
+			}
+		}
+		if len(m.TimestampMap) != 0 {
+			w.Field(17)
+			protobuf_go_lite.HashMap(w, m.TimestampMap, func(w *protobuf_go_lite.Hasher, k string, v time.Time) {
+				w.String(k)
+				protobuf_go_lite.HashStd(w, v)
+			})
+		}
+		if len(m.DurationMap) != 0 {
+			w.Field(18)
+			protobuf_go_lite.HashMap(w, m.DurationMap, func(w *protobuf_go_lite.Hasher, k int32, v time.Duration) {
+				w.Uint64(uint64(k))
+				protobuf_go_lite.HashStd(w, v)
+			})
+		}
+		if len(m.StringMap) != 0 {
+			w.Field(19)
+			protobuf_go_lite.HashMap(w, m.StringMap, func(w *protobuf_go_lite.Hasher, k string, v string) {
+				w.String(k)
+				protobuf_go_lite.HashStd(w, v)
+			})
+		}
+		if len(m.BytesMap) != 0 {
+			w.Field(20)
+			protobuf_go_lite.HashMap(w, m.BytesMap, func(w *protobuf_go_lite.Hasher, k string, v []byte) {
+				w.String(k)
+				protobuf_go_lite.HashStd(w, v)
+			})
+		}
+		switch v := m.Choice.(type) {
+		case *StdTypes_ChoiceTimestamp:
+			w.Field(21)
+			protobuf_go_lite.HashStd(w, v.ChoiceTimestamp)
+		case *StdTypes_ChoiceDuration:
+			w.Field(22)
+			protobuf_go_lite.HashStd(w, v.ChoiceDuration)
+		case *StdTypes_ChoiceBool:
+			w.Field(23)
+			protobuf_go_lite.HashStd(w, v.ChoiceBool)
+		case *StdTypes_ChoiceBytes:
+			w.Field(24)
+			protobuf_go_lite.HashStd(w, v.ChoiceBytes)
+		case *StdTypes_ChoiceName:
+			w.Field(25)
+			w.String(v.ChoiceName)
+		}
+		if v := m.PlainTimestamp; v != nil {
+			w.Field(26)
+			v.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *StdTypesWKT) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *StdTypesWKT) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *StdTypesWKT) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if v := m.Timestamp; v != nil {
+			w.Field(1)
+			v.WriteHashVT(w)
+		}
+		if v := m.Duration; v != nil {
+			w.Field(2)
+			v.WriteHashVT(w)
+		}
+		if v := m.DoubleValue; v != nil {
+			w.Field(3)
+			v.WriteHashVT(w)
+		}
+		if v := m.FloatValue; v != nil {
+			w.Field(4)
+			v.WriteHashVT(w)
+		}
+		if v := m.Int64Value; v != nil {
+			w.Field(5)
+			v.WriteHashVT(w)
+		}
+		if v := m.Uint64Value; v != nil {
+			w.Field(6)
+			v.WriteHashVT(w)
+		}
+		if v := m.Int32Value; v != nil {
+			w.Field(7)
+			v.WriteHashVT(w)
+		}
+		if v := m.Uint32Value; v != nil {
+			w.Field(8)
+			v.WriteHashVT(w)
+		}
+		if v := m.BoolValue; v != nil {
+			w.Field(9)
+			v.WriteHashVT(w)
+		}
+		if v := m.StringValue; v != nil {
+			w.Field(10)
+			v.WriteHashVT(w)
+		}
+		if v := m.BytesValue; v != nil {
+			w.Field(11)
+			v.WriteHashVT(w)
+		}
+		if len(m.Timestamps) != 0 {
+			w.Field(12)
+			w.Uint64(uint64(len(m.Timestamps)))
+			for _, v := range m.Timestamps {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.Durations) != 0 {
+			w.Field(13)
+			w.Uint64(uint64(len(m.Durations)))
+			for _, v := range m.Durations {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.Int64Values) != 0 {
+			w.Field(14)
+			w.Uint64(uint64(len(m.Int64Values)))
+			for _, v := range m.Int64Values {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.BytesValues) != 0 {
+			w.Field(15)
+			w.Uint64(uint64(len(m.BytesValues)))
+			for _, v := range m.BytesValues {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.FloatValues) != 0 {
+			w.Field(16)
+			w.Uint64(uint64(len(m.FloatValues)))
+			for _, v := range m.FloatValues {
+				v.WriteHashVT(w)
+			}
+		}
+		if len(m.TimestampMap) != 0 {
+			w.Field(17)
+			protobuf_go_lite.HashMap(w, m.TimestampMap, func(w *protobuf_go_lite.Hasher, k string, v *timestamppb.Timestamp) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		if len(m.DurationMap) != 0 {
+			w.Field(18)
+			protobuf_go_lite.HashMap(w, m.DurationMap, func(w *protobuf_go_lite.Hasher, k int32, v *durationpb.Duration) {
+				w.Uint64(uint64(k))
+				v.WriteHashVT(w)
+			})
+		}
+		if len(m.StringMap) != 0 {
+			w.Field(19)
+			protobuf_go_lite.HashMap(w, m.StringMap, func(w *protobuf_go_lite.Hasher, k string, v *wrapperspb.StringValue) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		if len(m.BytesMap) != 0 {
+			w.Field(20)
+			protobuf_go_lite.HashMap(w, m.BytesMap, func(w *protobuf_go_lite.Hasher, k string, v *wrapperspb.BytesValue) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		switch v := m.Choice.(type) {
+		case *StdTypesWKT_ChoiceTimestamp:
+			w.Field(21)
+			v.ChoiceTimestamp.WriteHashVT(w)
+		case *StdTypesWKT_ChoiceDuration:
+			w.Field(22)
+			v.ChoiceDuration.WriteHashVT(w)
+		case *StdTypesWKT_ChoiceBool:
+			w.Field(23)
+			v.ChoiceBool.WriteHashVT(w)
+		case *StdTypesWKT_ChoiceBytes:
+			w.Field(24)
+			v.ChoiceBytes.WriteHashVT(w)
+		case *StdTypesWKT_ChoiceName:
+			w.Field(25)
+			w.String(v.ChoiceName)
+		}
+		if v := m.PlainTimestamp; v != nil {
+			w.Field(26)
+			v.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the StdTypes_TimestampMapEntry message to JSON.
+func (x *StdTypes_TimestampMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteTime(*x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypes_TimestampMapEntry to JSON.
+func (x *StdTypes_TimestampMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypes_TimestampMapEntry message from JSON.
+func (x *StdTypes_TimestampMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = s.ReadTime()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypes_TimestampMapEntry from JSON.
+func (x *StdTypes_TimestampMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypes_DurationMapEntry message to JSON.
+func (x *StdTypes_DurationMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != 0 || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteInt32(x.Key)
+	}
+	if x.Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteDuration(*x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypes_DurationMapEntry to JSON.
+func (x *StdTypes_DurationMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypes_DurationMapEntry message from JSON.
+func (x *StdTypes_DurationMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadInt32()
+		case "value":
+			s.AddField("value")
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = s.ReadDuration()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypes_DurationMapEntry from JSON.
+func (x *StdTypes_DurationMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypes_StringMapEntry message to JSON.
+func (x *StdTypes_StringMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteString(*x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypes_StringMapEntry to JSON.
+func (x *StdTypes_StringMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypes_StringMapEntry message from JSON.
+func (x *StdTypes_StringMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			t := s.ReadString()
+			x.Value = &t
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypes_StringMapEntry from JSON.
+func (x *StdTypes_StringMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypes_BytesMapEntry message to JSON.
+func (x *StdTypes_BytesMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteBytes(*x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypes_BytesMapEntry to JSON.
+func (x *StdTypes_BytesMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypes_BytesMapEntry message from JSON.
+func (x *StdTypes_BytesMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			t := s.ReadBytes()
+			x.Value = &t
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypes_BytesMapEntry from JSON.
+func (x *StdTypes_BytesMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypes message to JSON.
+func (x *StdTypes) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Timestamp != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestamp")
+		s.WriteTime(*x.Timestamp)
+	}
+	if x.Duration != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		s.WriteDuration(*x.Duration)
+	}
+	if x.DoubleValue != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("doubleValue")
+		s.WriteFloat64(*x.DoubleValue)
+	}
+	if x.FloatValue != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("floatValue")
+		s.WriteFloat32(*x.FloatValue)
+	}
+	if x.Int64Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int64Value")
+		s.WriteInt64(*x.Int64Value)
+	}
+	if x.Uint64Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uint64Value")
+		s.WriteUint64(*x.Uint64Value)
+	}
+	if x.Int32Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int32Value")
+		s.WriteInt32(*x.Int32Value)
+	}
+	if x.Uint32Value != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uint32Value")
+		s.WriteUint32(*x.Uint32Value)
+	}
+	if x.BoolValue != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("boolValue")
+		s.WriteBool(*x.BoolValue)
+	}
+	if x.StringValue != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stringValue")
+		s.WriteString(*x.StringValue)
+	}
+	if x.BytesValue != nil {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesValue")
+		s.WriteBytes(*x.BytesValue)
+	}
+	if len(x.Timestamps) > 0 || s.HasField("timestamps") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestamps")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Timestamps {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteTime(element)
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Durations) > 0 || s.HasField("durations") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("durations")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Durations {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteDuration(element)
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Int64Values) > 0 || s.HasField("int64Values") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int64Values")
+		s.WriteInt64Array(x.Int64Values)
+	}
+	if len(x.BytesValues) > 0 || s.HasField("bytesValues") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesValues")
+		s.WriteBytesArray(x.BytesValues)
+	}
+	if len(x.FloatValues) > 0 || s.HasField("floatValues") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("floatValues")
+		s.WriteFloat32Array(x.FloatValues)
+	}
+	if x.TimestampMap != nil || s.HasField("timestampMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestampMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.TimestampMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteTime(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.DurationMap != nil || s.HasField("durationMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("durationMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.DurationMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectInt32Field(k)
+			s.WriteDuration(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.StringMap != nil || s.HasField("stringMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stringMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.StringMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.BytesMap != nil || s.HasField("bytesMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.BytesMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteBytes(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Choice != nil {
+		switch ov := x.Choice.(type) {
+		case *StdTypes_ChoiceTimestamp:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceTimestamp")
+			s.WriteTime(ov.ChoiceTimestamp)
+		case *StdTypes_ChoiceDuration:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceDuration")
+			s.WriteDuration(ov.ChoiceDuration)
+		case *StdTypes_ChoiceBool:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceBool")
+			s.WriteBool(ov.ChoiceBool)
+		case *StdTypes_ChoiceBytes:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceBytes")
+			s.WriteBytes(ov.ChoiceBytes)
+		case *StdTypes_ChoiceName:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceName")
+			s.WriteString(ov.ChoiceName)
+		}
+	}
+	if x.PlainTimestamp != nil || s.HasField("plainTimestamp") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("plainTimestamp")
+		x.PlainTimestamp.MarshalProtoJSON(s.WithField("plainTimestamp"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypes to JSON.
+func (x *StdTypes) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypes message from JSON.
+func (x *StdTypes) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "timestamp":
+			s.AddField("timestamp")
+			if s.ReadNil() {
+				x.Timestamp = nil
+				return
+			}
+			x.Timestamp = s.ReadTime()
+		case "duration":
+			s.AddField("duration")
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			x.Duration = s.ReadDuration()
+		case "double_value", "doubleValue":
+			s.AddField("double_value")
+			if s.ReadNil() {
+				x.DoubleValue = nil
+				return
+			}
+			t := s.ReadFloat64()
+			x.DoubleValue = &t
+		case "float_value", "floatValue":
+			s.AddField("float_value")
+			if s.ReadNil() {
+				x.FloatValue = nil
+				return
+			}
+			t := s.ReadFloat32()
+			x.FloatValue = &t
+		case "int64_value", "int64Value":
+			s.AddField("int64_value")
+			if s.ReadNil() {
+				x.Int64Value = nil
+				return
+			}
+			t := s.ReadInt64()
+			x.Int64Value = &t
+		case "uint64_value", "uint64Value":
+			s.AddField("uint64_value")
+			if s.ReadNil() {
+				x.Uint64Value = nil
+				return
+			}
+			t := s.ReadUint64()
+			x.Uint64Value = &t
+		case "int32_value", "int32Value":
+			s.AddField("int32_value")
+			if s.ReadNil() {
+				x.Int32Value = nil
+				return
+			}
+			t := s.ReadInt32()
+			x.Int32Value = &t
+		case "uint32_value", "uint32Value":
+			s.AddField("uint32_value")
+			if s.ReadNil() {
+				x.Uint32Value = nil
+				return
+			}
+			t := s.ReadUint32()
+			x.Uint32Value = &t
+		case "bool_value", "boolValue":
+			s.AddField("bool_value")
+			if s.ReadNil() {
+				x.BoolValue = nil
+				return
+			}
+			t := s.ReadBool()
+			x.BoolValue = &t
+		case "string_value", "stringValue":
+			s.AddField("string_value")
+			if s.ReadNil() {
+				x.StringValue = nil
+				return
+			}
+			t := s.ReadString()
+			x.StringValue = &t
+		case "bytes_value", "bytesValue":
+			s.AddField("bytes_value")
+			if s.ReadNil() {
+				x.BytesValue = nil
+				return
+			}
+			t := s.ReadBytes()
+			x.BytesValue = &t
+		case "timestamps":
+			s.AddField("timestamps")
+			if s.ReadNil() {
+				x.Timestamps = nil
+				return
+			}
+			s.ReadArray(func() {
+				if t := s.ReadTime(); t != nil {
+					x.Timestamps = append(x.Timestamps, *t)
+				}
+			})
+		case "durations":
+			s.AddField("durations")
+			if s.ReadNil() {
+				x.Durations = nil
+				return
+			}
+			s.ReadArray(func() {
+				if t := s.ReadDuration(); t != nil {
+					x.Durations = append(x.Durations, *t)
+				}
+			})
+		case "int64_values", "int64Values":
+			s.AddField("int64_values")
+			if s.ReadNil() {
+				x.Int64Values = nil
+				return
+			}
+			x.Int64Values = s.ReadInt64Array()
+		case "bytes_values", "bytesValues":
+			s.AddField("bytes_values")
+			if s.ReadNil() {
+				x.BytesValues = nil
+				return
+			}
+			x.BytesValues = s.ReadBytesArray()
+		case "float_values", "floatValues":
+			s.AddField("float_values")
+			if s.ReadNil() {
+				x.FloatValues = nil
+				return
+			}
+			x.FloatValues = s.ReadFloat32Array()
+		case "timestamp_map", "timestampMap":
+			s.AddField("timestamp_map")
+			if s.ReadNil() {
+				x.TimestampMap = nil
+				return
+			}
+			x.TimestampMap = make(map[string]time.Time)
+			s.ReadStringMap(func(key string) {
+				if t := s.ReadTime(); t != nil {
+					x.TimestampMap[key] = *t
+				}
+			})
+		case "duration_map", "durationMap":
+			s.AddField("duration_map")
+			if s.ReadNil() {
+				x.DurationMap = nil
+				return
+			}
+			x.DurationMap = make(map[int32]time.Duration)
+			s.ReadInt32Map(func(key int32) {
+				if t := s.ReadDuration(); t != nil {
+					x.DurationMap[key] = *t
+				}
+			})
+		case "string_map", "stringMap":
+			s.AddField("string_map")
+			if s.ReadNil() {
+				x.StringMap = nil
+				return
+			}
+			x.StringMap = make(map[string]string)
+			s.ReadStringMap(func(key string) {
+				x.StringMap[key] = s.ReadString()
+			})
+		case "bytes_map", "bytesMap":
+			s.AddField("bytes_map")
+			if s.ReadNil() {
+				x.BytesMap = nil
+				return
+			}
+			x.BytesMap = make(map[string][]byte)
+			s.ReadStringMap(func(key string) {
+				x.BytesMap[key] = s.ReadBytes()
+			})
+		case "choice_timestamp", "choiceTimestamp":
+			s.AddField("choice_timestamp")
+			ov := &StdTypes_ChoiceTimestamp{}
+			x.Choice = ov
+			if t := s.ReadTime(); t != nil {
+				ov.ChoiceTimestamp = *t
+			}
+		case "choice_duration", "choiceDuration":
+			s.AddField("choice_duration")
+			ov := &StdTypes_ChoiceDuration{}
+			x.Choice = ov
+			if t := s.ReadDuration(); t != nil {
+				ov.ChoiceDuration = *t
+			}
+		case "choice_bool", "choiceBool":
+			s.AddField("choice_bool")
+			ov := &StdTypes_ChoiceBool{}
+			x.Choice = ov
+			ov.ChoiceBool = s.ReadBool()
+		case "choice_bytes", "choiceBytes":
+			s.AddField("choice_bytes")
+			ov := &StdTypes_ChoiceBytes{}
+			x.Choice = ov
+			ov.ChoiceBytes = s.ReadBytes()
+		case "choice_name", "choiceName":
+			s.AddField("choice_name")
+			ov := &StdTypes_ChoiceName{}
+			x.Choice = ov
+			ov.ChoiceName = s.ReadString()
+		case "plain_timestamp", "plainTimestamp":
+			if s.ReadNil() {
+				x.PlainTimestamp = nil
+				return
+			}
+			x.PlainTimestamp = &timestamppb.Timestamp{}
+			x.PlainTimestamp.UnmarshalProtoJSON(s.WithField("plain_timestamp", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypes from JSON.
+func (x *StdTypes) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypesWKT_TimestampMapEntry message to JSON.
+func (x *StdTypesWKT_TimestampMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypesWKT_TimestampMapEntry to JSON.
+func (x *StdTypesWKT_TimestampMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypesWKT_TimestampMapEntry message from JSON.
+func (x *StdTypesWKT_TimestampMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &timestamppb.Timestamp{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypesWKT_TimestampMapEntry from JSON.
+func (x *StdTypesWKT_TimestampMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypesWKT_DurationMapEntry message to JSON.
+func (x *StdTypesWKT_DurationMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != 0 || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteInt32(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypesWKT_DurationMapEntry to JSON.
+func (x *StdTypesWKT_DurationMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypesWKT_DurationMapEntry message from JSON.
+func (x *StdTypesWKT_DurationMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadInt32()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &durationpb.Duration{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypesWKT_DurationMapEntry from JSON.
+func (x *StdTypesWKT_DurationMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypesWKT_StringMapEntry message to JSON.
+func (x *StdTypesWKT_StringMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypesWKT_StringMapEntry to JSON.
+func (x *StdTypesWKT_StringMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypesWKT_StringMapEntry message from JSON.
+func (x *StdTypesWKT_StringMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &wrapperspb.StringValue{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypesWKT_StringMapEntry from JSON.
+func (x *StdTypesWKT_StringMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypesWKT_BytesMapEntry message to JSON.
+func (x *StdTypesWKT_BytesMapEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != nil || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		x.Value.MarshalProtoJSON(s.WithField("value"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypesWKT_BytesMapEntry to JSON.
+func (x *StdTypesWKT_BytesMapEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypesWKT_BytesMapEntry message from JSON.
+func (x *StdTypesWKT_BytesMapEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			if s.ReadNil() {
+				x.Value = nil
+				return
+			}
+			x.Value = &wrapperspb.BytesValue{}
+			x.Value.UnmarshalProtoJSON(s.WithField("value", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypesWKT_BytesMapEntry from JSON.
+func (x *StdTypesWKT_BytesMapEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StdTypesWKT message to JSON.
+func (x *StdTypesWKT) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Timestamp != nil || s.HasField("timestamp") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestamp")
+		x.Timestamp.MarshalProtoJSON(s.WithField("timestamp"))
+	}
+	if x.Duration != nil || s.HasField("duration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		x.Duration.MarshalProtoJSON(s.WithField("duration"))
+	}
+	if x.DoubleValue != nil || s.HasField("doubleValue") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("doubleValue")
+		x.DoubleValue.MarshalProtoJSON(s.WithField("doubleValue"))
+	}
+	if x.FloatValue != nil || s.HasField("floatValue") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("floatValue")
+		x.FloatValue.MarshalProtoJSON(s.WithField("floatValue"))
+	}
+	if x.Int64Value != nil || s.HasField("int64Value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int64Value")
+		x.Int64Value.MarshalProtoJSON(s.WithField("int64Value"))
+	}
+	if x.Uint64Value != nil || s.HasField("uint64Value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uint64Value")
+		x.Uint64Value.MarshalProtoJSON(s.WithField("uint64Value"))
+	}
+	if x.Int32Value != nil || s.HasField("int32Value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int32Value")
+		x.Int32Value.MarshalProtoJSON(s.WithField("int32Value"))
+	}
+	if x.Uint32Value != nil || s.HasField("uint32Value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uint32Value")
+		x.Uint32Value.MarshalProtoJSON(s.WithField("uint32Value"))
+	}
+	if x.BoolValue != nil || s.HasField("boolValue") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("boolValue")
+		x.BoolValue.MarshalProtoJSON(s.WithField("boolValue"))
+	}
+	if x.StringValue != nil || s.HasField("stringValue") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stringValue")
+		x.StringValue.MarshalProtoJSON(s.WithField("stringValue"))
+	}
+	if x.BytesValue != nil || s.HasField("bytesValue") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesValue")
+		x.BytesValue.MarshalProtoJSON(s.WithField("bytesValue"))
+	}
+	if len(x.Timestamps) > 0 || s.HasField("timestamps") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestamps")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Timestamps {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("timestamps"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Durations) > 0 || s.HasField("durations") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("durations")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Durations {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("durations"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Int64Values) > 0 || s.HasField("int64Values") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("int64Values")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Int64Values {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("int64Values"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.BytesValues) > 0 || s.HasField("bytesValues") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesValues")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.BytesValues {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("bytesValues"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.FloatValues) > 0 || s.HasField("floatValues") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("floatValues")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.FloatValues {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("floatValues"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.TimestampMap != nil || s.HasField("timestampMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("timestampMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.TimestampMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			v.MarshalProtoJSON(s.WithField("timestampMap"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.DurationMap != nil || s.HasField("durationMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("durationMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.DurationMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectInt32Field(k)
+			v.MarshalProtoJSON(s.WithField("durationMap"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.StringMap != nil || s.HasField("stringMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stringMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.StringMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			v.MarshalProtoJSON(s.WithField("stringMap"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.BytesMap != nil || s.HasField("bytesMap") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bytesMap")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.BytesMap {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			v.MarshalProtoJSON(s.WithField("bytesMap"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.Choice != nil {
+		switch ov := x.Choice.(type) {
+		case *StdTypesWKT_ChoiceTimestamp:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceTimestamp")
+			ov.ChoiceTimestamp.MarshalProtoJSON(s.WithField("choiceTimestamp"))
+		case *StdTypesWKT_ChoiceDuration:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceDuration")
+			ov.ChoiceDuration.MarshalProtoJSON(s.WithField("choiceDuration"))
+		case *StdTypesWKT_ChoiceBool:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceBool")
+			ov.ChoiceBool.MarshalProtoJSON(s.WithField("choiceBool"))
+		case *StdTypesWKT_ChoiceBytes:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceBytes")
+			ov.ChoiceBytes.MarshalProtoJSON(s.WithField("choiceBytes"))
+		case *StdTypesWKT_ChoiceName:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("choiceName")
+			s.WriteString(ov.ChoiceName)
+		}
+	}
+	if x.PlainTimestamp != nil || s.HasField("plainTimestamp") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("plainTimestamp")
+		x.PlainTimestamp.MarshalProtoJSON(s.WithField("plainTimestamp"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StdTypesWKT to JSON.
+func (x *StdTypesWKT) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StdTypesWKT message from JSON.
+func (x *StdTypesWKT) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "timestamp":
+			if s.ReadNil() {
+				x.Timestamp = nil
+				return
+			}
+			x.Timestamp = &timestamppb.Timestamp{}
+			x.Timestamp.UnmarshalProtoJSON(s.WithField("timestamp", true))
+		case "duration":
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			x.Duration = &durationpb.Duration{}
+			x.Duration.UnmarshalProtoJSON(s.WithField("duration", true))
+		case "double_value", "doubleValue":
+			if s.ReadNil() {
+				x.DoubleValue = nil
+				return
+			}
+			x.DoubleValue = &wrapperspb.DoubleValue{}
+			x.DoubleValue.UnmarshalProtoJSON(s.WithField("double_value", true))
+		case "float_value", "floatValue":
+			if s.ReadNil() {
+				x.FloatValue = nil
+				return
+			}
+			x.FloatValue = &wrapperspb.FloatValue{}
+			x.FloatValue.UnmarshalProtoJSON(s.WithField("float_value", true))
+		case "int64_value", "int64Value":
+			if s.ReadNil() {
+				x.Int64Value = nil
+				return
+			}
+			x.Int64Value = &wrapperspb.Int64Value{}
+			x.Int64Value.UnmarshalProtoJSON(s.WithField("int64_value", true))
+		case "uint64_value", "uint64Value":
+			if s.ReadNil() {
+				x.Uint64Value = nil
+				return
+			}
+			x.Uint64Value = &wrapperspb.UInt64Value{}
+			x.Uint64Value.UnmarshalProtoJSON(s.WithField("uint64_value", true))
+		case "int32_value", "int32Value":
+			if s.ReadNil() {
+				x.Int32Value = nil
+				return
+			}
+			x.Int32Value = &wrapperspb.Int32Value{}
+			x.Int32Value.UnmarshalProtoJSON(s.WithField("int32_value", true))
+		case "uint32_value", "uint32Value":
+			if s.ReadNil() {
+				x.Uint32Value = nil
+				return
+			}
+			x.Uint32Value = &wrapperspb.UInt32Value{}
+			x.Uint32Value.UnmarshalProtoJSON(s.WithField("uint32_value", true))
+		case "bool_value", "boolValue":
+			if s.ReadNil() {
+				x.BoolValue = nil
+				return
+			}
+			x.BoolValue = &wrapperspb.BoolValue{}
+			x.BoolValue.UnmarshalProtoJSON(s.WithField("bool_value", true))
+		case "string_value", "stringValue":
+			if s.ReadNil() {
+				x.StringValue = nil
+				return
+			}
+			x.StringValue = &wrapperspb.StringValue{}
+			x.StringValue.UnmarshalProtoJSON(s.WithField("string_value", true))
+		case "bytes_value", "bytesValue":
+			if s.ReadNil() {
+				x.BytesValue = nil
+				return
+			}
+			x.BytesValue = &wrapperspb.BytesValue{}
+			x.BytesValue.UnmarshalProtoJSON(s.WithField("bytes_value", true))
+		case "timestamps":
+			s.AddField("timestamps")
+			if s.ReadNil() {
+				x.Timestamps = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Timestamps = append(x.Timestamps, nil)
+					return
+				}
+				v := &timestamppb.Timestamp{}
+				v.UnmarshalProtoJSON(s.WithField("timestamps", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Timestamps = append(x.Timestamps, v)
+			})
+		case "durations":
+			s.AddField("durations")
+			if s.ReadNil() {
+				x.Durations = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Durations = append(x.Durations, nil)
+					return
+				}
+				v := &durationpb.Duration{}
+				v.UnmarshalProtoJSON(s.WithField("durations", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Durations = append(x.Durations, v)
+			})
+		case "int64_values", "int64Values":
+			s.AddField("int64_values")
+			if s.ReadNil() {
+				x.Int64Values = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Int64Values = append(x.Int64Values, nil)
+					return
+				}
+				v := &wrapperspb.Int64Value{}
+				v.UnmarshalProtoJSON(s.WithField("int64_values", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Int64Values = append(x.Int64Values, v)
+			})
+		case "bytes_values", "bytesValues":
+			s.AddField("bytes_values")
+			if s.ReadNil() {
+				x.BytesValues = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.BytesValues = append(x.BytesValues, nil)
+					return
+				}
+				v := &wrapperspb.BytesValue{}
+				v.UnmarshalProtoJSON(s.WithField("bytes_values", false))
+				if s.Err() != nil {
+					return
+				}
+				x.BytesValues = append(x.BytesValues, v)
+			})
+		case "float_values", "floatValues":
+			s.AddField("float_values")
+			if s.ReadNil() {
+				x.FloatValues = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.FloatValues = append(x.FloatValues, nil)
+					return
+				}
+				v := &wrapperspb.FloatValue{}
+				v.UnmarshalProtoJSON(s.WithField("float_values", false))
+				if s.Err() != nil {
+					return
+				}
+				x.FloatValues = append(x.FloatValues, v)
+			})
+		case "timestamp_map", "timestampMap":
+			s.AddField("timestamp_map")
+			if s.ReadNil() {
+				x.TimestampMap = nil
+				return
+			}
+			x.TimestampMap = make(map[string]*timestamppb.Timestamp)
+			s.ReadStringMap(func(key string) {
+				var v timestamppb.Timestamp
+				v.UnmarshalProtoJSON(s)
+				x.TimestampMap[key] = &v
+			})
+		case "duration_map", "durationMap":
+			s.AddField("duration_map")
+			if s.ReadNil() {
+				x.DurationMap = nil
+				return
+			}
+			x.DurationMap = make(map[int32]*durationpb.Duration)
+			s.ReadInt32Map(func(key int32) {
+				var v durationpb.Duration
+				v.UnmarshalProtoJSON(s)
+				x.DurationMap[key] = &v
+			})
+		case "string_map", "stringMap":
+			s.AddField("string_map")
+			if s.ReadNil() {
+				x.StringMap = nil
+				return
+			}
+			x.StringMap = make(map[string]*wrapperspb.StringValue)
+			s.ReadStringMap(func(key string) {
+				var v wrapperspb.StringValue
+				v.UnmarshalProtoJSON(s)
+				x.StringMap[key] = &v
+			})
+		case "bytes_map", "bytesMap":
+			s.AddField("bytes_map")
+			if s.ReadNil() {
+				x.BytesMap = nil
+				return
+			}
+			x.BytesMap = make(map[string]*wrapperspb.BytesValue)
+			s.ReadStringMap(func(key string) {
+				var v wrapperspb.BytesValue
+				v.UnmarshalProtoJSON(s)
+				x.BytesMap[key] = &v
+			})
+		case "choice_timestamp", "choiceTimestamp":
+			ov := &StdTypesWKT_ChoiceTimestamp{}
+			x.Choice = ov
+			if s.ReadNil() {
+				ov.ChoiceTimestamp = nil
+				return
+			}
+			ov.ChoiceTimestamp = &timestamppb.Timestamp{}
+			ov.ChoiceTimestamp.UnmarshalProtoJSON(s.WithField("choice_timestamp", true))
+		case "choice_duration", "choiceDuration":
+			ov := &StdTypesWKT_ChoiceDuration{}
+			x.Choice = ov
+			if s.ReadNil() {
+				ov.ChoiceDuration = nil
+				return
+			}
+			ov.ChoiceDuration = &durationpb.Duration{}
+			ov.ChoiceDuration.UnmarshalProtoJSON(s.WithField("choice_duration", true))
+		case "choice_bool", "choiceBool":
+			ov := &StdTypesWKT_ChoiceBool{}
+			x.Choice = ov
+			if s.ReadNil() {
+				ov.ChoiceBool = nil
+				return
+			}
+			ov.ChoiceBool = &wrapperspb.BoolValue{}
+			ov.ChoiceBool.UnmarshalProtoJSON(s.WithField("choice_bool", true))
+		case "choice_bytes", "choiceBytes":
+			ov := &StdTypesWKT_ChoiceBytes{}
+			x.Choice = ov
+			if s.ReadNil() {
+				ov.ChoiceBytes = nil
+				return
+			}
+			ov.ChoiceBytes = &wrapperspb.BytesValue{}
+			ov.ChoiceBytes.UnmarshalProtoJSON(s.WithField("choice_bytes", true))
+		case "choice_name", "choiceName":
+			s.AddField("choice_name")
+			ov := &StdTypesWKT_ChoiceName{}
+			x.Choice = ov
+			ov.ChoiceName = s.ReadString()
+		case "plain_timestamp", "plainTimestamp":
+			if s.ReadNil() {
+				x.PlainTimestamp = nil
+				return
+			}
+			x.PlainTimestamp = &timestamppb.Timestamp{}
+			x.PlainTimestamp.UnmarshalProtoJSON(s.WithField("plain_timestamp", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StdTypesWKT from JSON.
+func (x *StdTypesWKT) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+func (m *StdTypes) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTypes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.PlainTimestamp != nil {
+		size, err := m.PlainTimestamp.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.BytesMap) > 0 {
+		for k := range m.BytesMap {
+			v := m.BytesMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.StringMap) > 0 {
+		for k := range m.StringMap {
+			v := m.StringMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DurationMap) > 0 {
+		for k := range m.DurationMap {
+			v := m.DurationMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TimestampMap) > 0 {
+		for k := range m.TimestampMap {
+			v := m.TimestampMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FloatValues) > 0 {
+		for iNdEx := len(m.FloatValues) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.FloatValues[iNdEx])
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BytesValues) > 0 {
+		for iNdEx := len(m.BytesValues) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.BytesValues[iNdEx])
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Int64Values) > 0 {
+		for iNdEx := len(m.Int64Values) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Int64Values[iNdEx])
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Durations) > 0 {
+		for iNdEx := len(m.Durations) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Durations[iNdEx])
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Timestamps) > 0 {
+		for iNdEx := len(m.Timestamps) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Timestamps[iNdEx])
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BytesValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.BytesValue)
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StringValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.StringValue)
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BoolValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.BoolValue)
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Uint32Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Uint32Value)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Int32Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Int32Value)
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Uint64Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Uint64Value)
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Int64Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Int64Value)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FloatValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.FloatValue)
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DoubleValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.DoubleValue)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Duration != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Duration)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Timestamp)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTypes_ChoiceTimestamp) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceTimestamp) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceTimestamp)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceDuration) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceDuration) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceDuration)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceBool)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceBytes)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceName) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceName) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.ChoiceName)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTypesWKT) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.PlainTimestamp != nil {
+		size, err := m.PlainTimestamp.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.BytesMap) > 0 {
+		for k := range m.BytesMap {
+			v := m.BytesMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.StringMap) > 0 {
+		for k := range m.StringMap {
+			v := m.StringMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DurationMap) > 0 {
+		for k := range m.DurationMap {
+			v := m.DurationMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TimestampMap) > 0 {
+		for k := range m.TimestampMap {
+			v := m.TimestampMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FloatValues) > 0 {
+		for iNdEx := len(m.FloatValues) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.FloatValues[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BytesValues) > 0 {
+		for iNdEx := len(m.BytesValues) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.BytesValues[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Int64Values) > 0 {
+		for iNdEx := len(m.Int64Values) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Int64Values[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Durations) > 0 {
+		for iNdEx := len(m.Durations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Durations[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Timestamps) > 0 {
+		for iNdEx := len(m.Timestamps) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Timestamps[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BytesValue != nil {
+		size, err := m.BytesValue.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StringValue != nil {
+		size, err := m.StringValue.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BoolValue != nil {
+		size, err := m.BoolValue.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Uint32Value != nil {
+		size, err := m.Uint32Value.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Int32Value != nil {
+		size, err := m.Int32Value.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Uint64Value != nil {
+		size, err := m.Uint64Value.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Int64Value != nil {
+		size, err := m.Int64Value.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FloatValue != nil {
+		size, err := m.FloatValue.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DoubleValue != nil {
+		size, err := m.DoubleValue.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Duration != nil {
+		size, err := m.Duration.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		size, err := m.Timestamp.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceTimestamp != nil {
+		size, err := m.ChoiceTimestamp.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceDuration) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceDuration) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceDuration != nil {
+		size, err := m.ChoiceDuration.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceBool != nil {
+		size, err := m.ChoiceBool.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceBytes != nil {
+		size, err := m.ChoiceBytes.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceName) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceName) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.ChoiceName)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTypes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.PlainTimestamp != nil {
+		size, err := m.PlainTimestamp.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if msg, ok := m.Choice.(*StdTypes_ChoiceName); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if len(m.BytesMap) > 0 {
+		for k := range m.BytesMap {
+			v := m.BytesMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.StringMap) > 0 {
+		for k := range m.StringMap {
+			v := m.StringMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DurationMap) > 0 {
+		for k := range m.DurationMap {
+			v := m.DurationMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TimestampMap) > 0 {
+		for k := range m.TimestampMap {
+			v := m.TimestampMap[k]
+			baseI := i
+			i = protobuf_go_lite.EncodeStd(dAtA, i, v)
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FloatValues) > 0 {
+		for iNdEx := len(m.FloatValues) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.FloatValues[iNdEx])
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BytesValues) > 0 {
+		for iNdEx := len(m.BytesValues) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.BytesValues[iNdEx])
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Int64Values) > 0 {
+		for iNdEx := len(m.Int64Values) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Int64Values[iNdEx])
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Durations) > 0 {
+		for iNdEx := len(m.Durations) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Durations[iNdEx])
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Timestamps) > 0 {
+		for iNdEx := len(m.Timestamps) - 1; iNdEx >= 0; iNdEx-- {
+			i = protobuf_go_lite.EncodeStd(dAtA, i, m.Timestamps[iNdEx])
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BytesValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.BytesValue)
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StringValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.StringValue)
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BoolValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.BoolValue)
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Uint32Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Uint32Value)
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Int32Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Int32Value)
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Uint64Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Uint64Value)
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Int64Value != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Int64Value)
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FloatValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.FloatValue)
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DoubleValue != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.DoubleValue)
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Duration != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Duration)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		i = protobuf_go_lite.EncodeStd(dAtA, i, *m.Timestamp)
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTypes_ChoiceTimestamp) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceTimestamp) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceTimestamp)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceDuration) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceDuration) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceDuration)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceBool) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceBool) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceBool)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceBytes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceBytes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeStd(dAtA, i, m.ChoiceBytes)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	return len(dAtA) - i, nil
+}
+func (m *StdTypes_ChoiceName) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypes_ChoiceName) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.ChoiceName)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTypesWKT) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i = protobuf_go_lite.EncodeRawBytes(dAtA, i, m.unknownFields)
+	}
+	if m.PlainTimestamp != nil {
+		size, err := m.PlainTimestamp.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if msg, ok := m.Choice.(*StdTypesWKT_ChoiceName); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if len(m.BytesMap) > 0 {
+		for k := range m.BytesMap {
+			v := m.BytesMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.StringMap) > 0 {
+		for k := range m.StringMap {
+			v := m.StringMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DurationMap) > 0 {
+		for k := range m.DurationMap {
+			v := m.DurationMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TimestampMap) > 0 {
+		for k := range m.TimestampMap {
+			v := m.TimestampMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protobuf_go_lite.EncodeString(dAtA, i, k)
+			i--
+			dAtA[i] = 0xa
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FloatValues) > 0 {
+		for iNdEx := len(m.FloatValues) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.FloatValues[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BytesValues) > 0 {
+		for iNdEx := len(m.BytesValues) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.BytesValues[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Int64Values) > 0 {
+		for iNdEx := len(m.Int64Values) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Int64Values[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Durations) > 0 {
+		for iNdEx := len(m.Durations) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Durations[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Timestamps) > 0 {
+		for iNdEx := len(m.Timestamps) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Timestamps[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.BytesValue != nil {
+		size, err := m.BytesValue.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StringValue != nil {
+		size, err := m.StringValue.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BoolValue != nil {
+		size, err := m.BoolValue.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Uint32Value != nil {
+		size, err := m.Uint32Value.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Int32Value != nil {
+		size, err := m.Int32Value.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Uint64Value != nil {
+		size, err := m.Uint64Value.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Int64Value != nil {
+		size, err := m.Int64Value.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FloatValue != nil {
+		size, err := m.FloatValue.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DoubleValue != nil {
+		size, err := m.DoubleValue.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Duration != nil {
+		size, err := m.Duration.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		size, err := m.Timestamp.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceTimestamp != nil {
+		size, err := m.ChoiceTimestamp.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceDuration) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceDuration) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceDuration != nil {
+		size, err := m.ChoiceDuration.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceBool) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceBool) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceBool != nil {
+		size, err := m.ChoiceBool.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceBytes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceBytes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ChoiceBytes != nil {
+		size, err := m.ChoiceBytes.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	} else {
+		i = protobuf_go_lite.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdTypesWKT_ChoiceName) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StdTypesWKT_ChoiceName) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protobuf_go_lite.EncodeString(dAtA, i, m.ChoiceName)
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	return len(dAtA) - i, nil
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *StdTypes) MergeVT(src *StdTypes) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Timestamp != nil {
+		if m.Timestamp == nil {
+			m.Timestamp = new(time.Time)
+		}
+		protobuf_go_lite.MergeStd(m.Timestamp, *src.Timestamp)
+	}
+	if src.Duration != nil {
+		if m.Duration == nil {
+			m.Duration = new(time.Duration)
+		}
+		protobuf_go_lite.MergeStd(m.Duration, *src.Duration)
+	}
+	if src.DoubleValue != nil {
+		if m.DoubleValue == nil {
+			m.DoubleValue = new(float64)
+		}
+		protobuf_go_lite.MergeStd(m.DoubleValue, *src.DoubleValue)
+	}
+	if src.FloatValue != nil {
+		if m.FloatValue == nil {
+			m.FloatValue = new(float32)
+		}
+		protobuf_go_lite.MergeStd(m.FloatValue, *src.FloatValue)
+	}
+	if src.Int64Value != nil {
+		if m.Int64Value == nil {
+			m.Int64Value = new(int64)
+		}
+		protobuf_go_lite.MergeStd(m.Int64Value, *src.Int64Value)
+	}
+	if src.Uint64Value != nil {
+		if m.Uint64Value == nil {
+			m.Uint64Value = new(uint64)
+		}
+		protobuf_go_lite.MergeStd(m.Uint64Value, *src.Uint64Value)
+	}
+	if src.Int32Value != nil {
+		if m.Int32Value == nil {
+			m.Int32Value = new(int32)
+		}
+		protobuf_go_lite.MergeStd(m.Int32Value, *src.Int32Value)
+	}
+	if src.Uint32Value != nil {
+		if m.Uint32Value == nil {
+			m.Uint32Value = new(uint32)
+		}
+		protobuf_go_lite.MergeStd(m.Uint32Value, *src.Uint32Value)
+	}
+	if src.BoolValue != nil {
+		if m.BoolValue == nil {
+			m.BoolValue = new(bool)
+		}
+		protobuf_go_lite.MergeStd(m.BoolValue, *src.BoolValue)
+	}
+	if src.StringValue != nil {
+		if m.StringValue == nil {
+			m.StringValue = new(string)
+		}
+		protobuf_go_lite.MergeStd(m.StringValue, *src.StringValue)
+	}
+	if src.BytesValue != nil {
+		if m.BytesValue == nil {
+			m.BytesValue = new([]byte)
+		}
+		protobuf_go_lite.MergeStd(m.BytesValue, *src.BytesValue)
+	}
+	m.Timestamps = append(m.Timestamps, protobuf_go_lite.CloneStdSlice(src.Timestamps)...)
+	m.Durations = append(m.Durations, protobuf_go_lite.CloneStdSlice(src.Durations)...)
+	m.Int64Values = append(m.Int64Values, protobuf_go_lite.CloneStdSlice(src.Int64Values)...)
+	m.BytesValues = append(m.BytesValues, protobuf_go_lite.CloneStdSlice(src.BytesValues)...)
+	m.FloatValues = append(m.FloatValues, protobuf_go_lite.CloneStdSlice(src.FloatValues)...)
+	if len(src.TimestampMap) > 0 {
+		if m.TimestampMap == nil {
+			m.TimestampMap = make(map[string]time.Time, len(src.TimestampMap))
+		}
+		for k, v := range src.TimestampMap {
+			m.TimestampMap[k] = protobuf_go_lite.CloneStd(v)
+		}
+	}
+	if len(src.DurationMap) > 0 {
+		if m.DurationMap == nil {
+			m.DurationMap = make(map[int32]time.Duration, len(src.DurationMap))
+		}
+		for k, v := range src.DurationMap {
+			m.DurationMap[k] = protobuf_go_lite.CloneStd(v)
+		}
+	}
+	if len(src.StringMap) > 0 {
+		if m.StringMap == nil {
+			m.StringMap = make(map[string]string, len(src.StringMap))
+		}
+		for k, v := range src.StringMap {
+			m.StringMap[k] = protobuf_go_lite.CloneStd(v)
+		}
+	}
+	if len(src.BytesMap) > 0 {
+		if m.BytesMap == nil {
+			m.BytesMap = make(map[string][]byte, len(src.BytesMap))
+		}
+		for k, v := range src.BytesMap {
+			m.BytesMap[k] = protobuf_go_lite.CloneStd(v)
+		}
+	}
+	switch v := src.Choice.(type) {
+	case *StdTypes_ChoiceTimestamp:
+		if cur, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+			protobuf_go_lite.MergeStd(&cur.ChoiceTimestamp, v.ChoiceTimestamp)
+		} else {
+			m.Choice = &StdTypes_ChoiceTimestamp{ChoiceTimestamp: protobuf_go_lite.CloneStd(v.ChoiceTimestamp)}
+		}
+	case *StdTypes_ChoiceDuration:
+		if cur, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+			protobuf_go_lite.MergeStd(&cur.ChoiceDuration, v.ChoiceDuration)
+		} else {
+			m.Choice = &StdTypes_ChoiceDuration{ChoiceDuration: protobuf_go_lite.CloneStd(v.ChoiceDuration)}
+		}
+	case *StdTypes_ChoiceBool:
+		if cur, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+			protobuf_go_lite.MergeStd(&cur.ChoiceBool, v.ChoiceBool)
+		} else {
+			m.Choice = &StdTypes_ChoiceBool{ChoiceBool: protobuf_go_lite.CloneStd(v.ChoiceBool)}
+		}
+	case *StdTypes_ChoiceBytes:
+		if cur, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+			protobuf_go_lite.MergeStd(&cur.ChoiceBytes, v.ChoiceBytes)
+		} else {
+			m.Choice = &StdTypes_ChoiceBytes{ChoiceBytes: protobuf_go_lite.CloneStd(v.ChoiceBytes)}
+		}
+	case *StdTypes_ChoiceName:
+		m.Choice = &StdTypes_ChoiceName{ChoiceName: v.ChoiceName}
+	}
+	if src.PlainTimestamp != nil {
+		if m.PlainTimestamp == nil {
+			m.PlainTimestamp = new(timestamppb.Timestamp)
+		}
+		m.PlainTimestamp.MergeVT(src.PlainTimestamp)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *StdTypes) MergeMessageVT(src any) bool {
+	s, ok := src.(*StdTypes)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *StdTypesWKT) MergeVT(src *StdTypesWKT) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Timestamp != nil {
+		if m.Timestamp == nil {
+			m.Timestamp = new(timestamppb.Timestamp)
+		}
+		m.Timestamp.MergeVT(src.Timestamp)
+	}
+	if src.Duration != nil {
+		if m.Duration == nil {
+			m.Duration = new(durationpb.Duration)
+		}
+		m.Duration.MergeVT(src.Duration)
+	}
+	if src.DoubleValue != nil {
+		if m.DoubleValue == nil {
+			m.DoubleValue = new(wrapperspb.DoubleValue)
+		}
+		m.DoubleValue.MergeVT(src.DoubleValue)
+	}
+	if src.FloatValue != nil {
+		if m.FloatValue == nil {
+			m.FloatValue = new(wrapperspb.FloatValue)
+		}
+		m.FloatValue.MergeVT(src.FloatValue)
+	}
+	if src.Int64Value != nil {
+		if m.Int64Value == nil {
+			m.Int64Value = new(wrapperspb.Int64Value)
+		}
+		m.Int64Value.MergeVT(src.Int64Value)
+	}
+	if src.Uint64Value != nil {
+		if m.Uint64Value == nil {
+			m.Uint64Value = new(wrapperspb.UInt64Value)
+		}
+		m.Uint64Value.MergeVT(src.Uint64Value)
+	}
+	if src.Int32Value != nil {
+		if m.Int32Value == nil {
+			m.Int32Value = new(wrapperspb.Int32Value)
+		}
+		m.Int32Value.MergeVT(src.Int32Value)
+	}
+	if src.Uint32Value != nil {
+		if m.Uint32Value == nil {
+			m.Uint32Value = new(wrapperspb.UInt32Value)
+		}
+		m.Uint32Value.MergeVT(src.Uint32Value)
+	}
+	if src.BoolValue != nil {
+		if m.BoolValue == nil {
+			m.BoolValue = new(wrapperspb.BoolValue)
+		}
+		m.BoolValue.MergeVT(src.BoolValue)
+	}
+	if src.StringValue != nil {
+		if m.StringValue == nil {
+			m.StringValue = new(wrapperspb.StringValue)
+		}
+		m.StringValue.MergeVT(src.StringValue)
+	}
+	if src.BytesValue != nil {
+		if m.BytesValue == nil {
+			m.BytesValue = new(wrapperspb.BytesValue)
+		}
+		m.BytesValue.MergeVT(src.BytesValue)
+	}
+	for _, v := range src.Timestamps {
+		var e *timestamppb.Timestamp
+		if v != nil {
+			e = new(timestamppb.Timestamp)
+			e.MergeVT(v)
+		}
+		m.Timestamps = append(m.Timestamps, e)
+	}
+	for _, v := range src.Durations {
+		var e *durationpb.Duration
+		if v != nil {
+			e = new(durationpb.Duration)
+			e.MergeVT(v)
+		}
+		m.Durations = append(m.Durations, e)
+	}
+	for _, v := range src.Int64Values {
+		var e *wrapperspb.Int64Value
+		if v != nil {
+			e = new(wrapperspb.Int64Value)
+			e.MergeVT(v)
+		}
+		m.Int64Values = append(m.Int64Values, e)
+	}
+	for _, v := range src.BytesValues {
+		var e *wrapperspb.BytesValue
+		if v != nil {
+			e = new(wrapperspb.BytesValue)
+			e.MergeVT(v)
+		}
+		m.BytesValues = append(m.BytesValues, e)
+	}
+	for _, v := range src.FloatValues {
+		var e *wrapperspb.FloatValue
+		if v != nil {
+			e = new(wrapperspb.FloatValue)
+			e.MergeVT(v)
+		}
+		m.FloatValues = append(m.FloatValues, e)
+	}
+	if len(src.TimestampMap) > 0 {
+		if m.TimestampMap == nil {
+			m.TimestampMap = make(map[string]*timestamppb.Timestamp, len(src.TimestampMap))
+		}
+		for k, v := range src.TimestampMap {
+			var e *timestamppb.Timestamp
+			if v != nil {
+				e = new(timestamppb.Timestamp)
+				e.MergeVT(v)
+			}
+			m.TimestampMap[k] = e
+		}
+	}
+	if len(src.DurationMap) > 0 {
+		if m.DurationMap == nil {
+			m.DurationMap = make(map[int32]*durationpb.Duration, len(src.DurationMap))
+		}
+		for k, v := range src.DurationMap {
+			var e *durationpb.Duration
+			if v != nil {
+				e = new(durationpb.Duration)
+				e.MergeVT(v)
+			}
+			m.DurationMap[k] = e
+		}
+	}
+	if len(src.StringMap) > 0 {
+		if m.StringMap == nil {
+			m.StringMap = make(map[string]*wrapperspb.StringValue, len(src.StringMap))
+		}
+		for k, v := range src.StringMap {
+			var e *wrapperspb.StringValue
+			if v != nil {
+				e = new(wrapperspb.StringValue)
+				e.MergeVT(v)
+			}
+			m.StringMap[k] = e
+		}
+	}
+	if len(src.BytesMap) > 0 {
+		if m.BytesMap == nil {
+			m.BytesMap = make(map[string]*wrapperspb.BytesValue, len(src.BytesMap))
+		}
+		for k, v := range src.BytesMap {
+			var e *wrapperspb.BytesValue
+			if v != nil {
+				e = new(wrapperspb.BytesValue)
+				e.MergeVT(v)
+			}
+			m.BytesMap[k] = e
+		}
+	}
+	switch v := src.Choice.(type) {
+	case *StdTypesWKT_ChoiceTimestamp:
+		if cur, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok && cur.ChoiceTimestamp != nil {
+			cur.ChoiceTimestamp.MergeVT(v.ChoiceTimestamp)
+		} else {
+			e := new(timestamppb.Timestamp)
+			e.MergeVT(v.ChoiceTimestamp)
+			m.Choice = &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: e}
+		}
+	case *StdTypesWKT_ChoiceDuration:
+		if cur, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok && cur.ChoiceDuration != nil {
+			cur.ChoiceDuration.MergeVT(v.ChoiceDuration)
+		} else {
+			e := new(durationpb.Duration)
+			e.MergeVT(v.ChoiceDuration)
+			m.Choice = &StdTypesWKT_ChoiceDuration{ChoiceDuration: e}
+		}
+	case *StdTypesWKT_ChoiceBool:
+		if cur, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok && cur.ChoiceBool != nil {
+			cur.ChoiceBool.MergeVT(v.ChoiceBool)
+		} else {
+			e := new(wrapperspb.BoolValue)
+			e.MergeVT(v.ChoiceBool)
+			m.Choice = &StdTypesWKT_ChoiceBool{ChoiceBool: e}
+		}
+	case *StdTypesWKT_ChoiceBytes:
+		if cur, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok && cur.ChoiceBytes != nil {
+			cur.ChoiceBytes.MergeVT(v.ChoiceBytes)
+		} else {
+			e := new(wrapperspb.BytesValue)
+			e.MergeVT(v.ChoiceBytes)
+			m.Choice = &StdTypesWKT_ChoiceBytes{ChoiceBytes: e}
+		}
+	case *StdTypesWKT_ChoiceName:
+		m.Choice = &StdTypesWKT_ChoiceName{ChoiceName: v.ChoiceName}
+	}
+	if src.PlainTimestamp != nil {
+		if m.PlainTimestamp == nil {
+			m.PlainTimestamp = new(timestamppb.Timestamp)
+		}
+		m.PlainTimestamp.MergeVT(src.PlainTimestamp)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *StdTypesWKT) MergeMessageVT(src any) bool {
+	s, ok := src.(*StdTypesWKT)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *StdTypes) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.PlainTimestamp.RedactVT()
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *StdTypesWKT) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.Timestamp.RedactVT()
+	m.Duration.RedactVT()
+	m.DoubleValue.RedactVT()
+	m.FloatValue.RedactVT()
+	m.Int64Value.RedactVT()
+	m.Uint64Value.RedactVT()
+	m.Int32Value.RedactVT()
+	m.Uint32Value.RedactVT()
+	m.BoolValue.RedactVT()
+	m.StringValue.RedactVT()
+	m.BytesValue.RedactVT()
+	for _, v := range m.Timestamps {
+		v.RedactVT()
+	}
+	for _, v := range m.Durations {
+		v.RedactVT()
+	}
+	for _, v := range m.Int64Values {
+		v.RedactVT()
+	}
+	for _, v := range m.BytesValues {
+		v.RedactVT()
+	}
+	for _, v := range m.FloatValues {
+		v.RedactVT()
+	}
+	for _, v := range m.TimestampMap {
+		v.RedactVT()
+	}
+	for _, v := range m.DurationMap {
+		v.RedactVT()
+	}
+	for _, v := range m.StringMap {
+		v.RedactVT()
+	}
+	for _, v := range m.BytesMap {
+		v.RedactVT()
+	}
+	switch v := m.Choice.(type) {
+	case *StdTypesWKT_ChoiceTimestamp:
+		v.ChoiceTimestamp.RedactVT()
+	case *StdTypesWKT_ChoiceDuration:
+		v.ChoiceDuration.RedactVT()
+	case *StdTypesWKT_ChoiceBool:
+		v.ChoiceBool.RedactVT()
+	case *StdTypesWKT_ChoiceBytes:
+		v.ChoiceBytes.RedactVT()
+	}
+	m.PlainTimestamp.RedactVT()
+}
+
+func (m *StdTypes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdPtr(1, m.Timestamp)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Duration)
+	n += protobuf_go_lite.SizeStdPtr(1, m.DoubleValue)
+	n += protobuf_go_lite.SizeStdPtr(1, m.FloatValue)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Int64Value)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Uint64Value)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Int32Value)
+	n += protobuf_go_lite.SizeStdPtr(1, m.Uint32Value)
+	n += protobuf_go_lite.SizeStdPtr(1, m.BoolValue)
+	n += protobuf_go_lite.SizeStdPtr(1, m.StringValue)
+	n += protobuf_go_lite.SizeStdPtr(1, m.BytesValue)
+	n += protobuf_go_lite.SizeStdSlice(1, m.Timestamps)
+	n += protobuf_go_lite.SizeStdSlice(1, m.Durations)
+	n += protobuf_go_lite.SizeStdSlice(1, m.Int64Values)
+	n += protobuf_go_lite.SizeStdSlice(1, m.BytesValues)
+	n += protobuf_go_lite.SizeStdSlice(2, m.FloatValues)
+	for k, v := range m.TimestampMap {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStdValue(1, v)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.DurationMap {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeVarintValue(1, k) + protobuf_go_lite.SizeStdValue(1, v)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.StringMap {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStdValue(1, v)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.BytesMap {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStdValue(1, v)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.PlainTimestamp != nil {
+		l = m.PlainTimestamp.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StdTypes_ChoiceTimestamp) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdValue(2, m.ChoiceTimestamp)
+	return n
+}
+func (m *StdTypes_ChoiceDuration) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdValue(2, m.ChoiceDuration)
+	return n
+}
+func (m *StdTypes_ChoiceBool) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdValue(2, m.ChoiceBool)
+	return n
+}
+func (m *StdTypes_ChoiceBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStdValue(2, m.ChoiceBytes)
+	return n
+}
+func (m *StdTypes_ChoiceName) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(2, m.ChoiceName)
+	return n
+}
+func (m *StdTypesWKT) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Duration != nil {
+		l = m.Duration.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.DoubleValue != nil {
+		l = m.DoubleValue.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.FloatValue != nil {
+		l = m.FloatValue.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Int64Value != nil {
+		l = m.Int64Value.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Uint64Value != nil {
+		l = m.Uint64Value.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Int32Value != nil {
+		l = m.Int32Value.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.Uint32Value != nil {
+		l = m.Uint32Value.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.BoolValue != nil {
+		l = m.BoolValue.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.StringValue != nil {
+		l = m.StringValue.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	if m.BytesValue != nil {
+		l = m.BytesValue.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.Timestamps {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.Durations {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.Int64Values {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.BytesValues {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(1, l)
+	}
+	for _, e := range m.FloatValues {
+		l = e.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	for k, v := range m.TimestampMap {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.DurationMap {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeVarintValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.StringMap {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	for k, v := range m.BytesMap {
+		_ = k
+		_ = v
+		l = 0
+		if v != nil {
+			l = v.SizeVT()
+		}
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeMessage(1, l)
+		n += protobuf_go_lite.SizeMessage(2, mapEntrySize)
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.PlainTimestamp != nil {
+		l = m.PlainTimestamp.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StdTypesWKT_ChoiceTimestamp) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChoiceTimestamp != nil {
+		l = m.ChoiceTimestamp.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	} else {
+		n += 3
+	}
+	return n
+}
+func (m *StdTypesWKT_ChoiceDuration) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChoiceDuration != nil {
+		l = m.ChoiceDuration.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	} else {
+		n += 3
+	}
+	return n
+}
+func (m *StdTypesWKT_ChoiceBool) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChoiceBool != nil {
+		l = m.ChoiceBool.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	} else {
+		n += 3
+	}
+	return n
+}
+func (m *StdTypesWKT_ChoiceBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChoiceBytes != nil {
+		l = m.ChoiceBytes.SizeVT()
+		n += protobuf_go_lite.SizeMessage(2, l)
+	} else {
+		n += 3
+	}
+	return n
+}
+func (m *StdTypesWKT_ChoiceName) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(2, m.ChoiceName)
+	return n
+}
+func (x *StdTypes_TimestampMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "TimestampMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypes_TimestampMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypes_DurationMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "DurationMapEntry")
+	if x.Key != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteInt(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypes_DurationMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypes_StringMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StringMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypes_StringMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypes_BytesMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BytesMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypes_BytesMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypes) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StdTypes")
+	if x.Timestamp != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "timestamp")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Timestamp)
+	}
+	if x.Duration != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "duration")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Duration)
+	}
+	if x.DoubleValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "double_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.DoubleValue)
+	}
+	if x.FloatValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "float_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.FloatValue)
+	}
+	if x.Int64Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "int64_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Int64Value)
+	}
+	if x.Uint64Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "uint64_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Uint64Value)
+	}
+	if x.Int32Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "int32_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Int32Value)
+	}
+	if x.Uint32Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "uint32_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.Uint32Value)
+	}
+	if x.BoolValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "bool_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.BoolValue)
+	}
+	if x.StringValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "string_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.StringValue)
+	}
+	if x.BytesValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "bytes_value")
+		protobuf_go_lite.TextWriteStd(&sb, *x.BytesValue)
+	}
+	if len(x.Timestamps) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "timestamps")
+		for i, v := range x.Timestamps {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Durations) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "durations")
+		for i, v := range x.Durations {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Int64Values) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "int64_values")
+		for i, v := range x.Int64Values {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.BytesValues) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "bytes_values")
+		for i, v := range x.BytesValues {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.FloatValues) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "float_values")
+		for i, v := range x.FloatValues {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.TimestampMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "timestamp_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.TimestampMap) {
+			v := x.TimestampMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.DurationMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "duration_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.DurationMap) {
+			v := x.DurationMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteInt(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.StringMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "string_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.StringMap) {
+			v := x.StringMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.BytesMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "bytes_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.BytesMap) {
+			v := x.BytesMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			protobuf_go_lite.TextWriteStd(&sb, v)
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	switch body := x.Choice.(type) {
+	case *StdTypes_ChoiceTimestamp:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_timestamp")
+		protobuf_go_lite.TextWriteStd(&sb, body.ChoiceTimestamp)
+	case *StdTypes_ChoiceDuration:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_duration")
+		protobuf_go_lite.TextWriteStd(&sb, body.ChoiceDuration)
+	case *StdTypes_ChoiceBool:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_bool")
+		protobuf_go_lite.TextWriteStd(&sb, body.ChoiceBool)
+	case *StdTypes_ChoiceBytes:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_bytes")
+		protobuf_go_lite.TextWriteStd(&sb, body.ChoiceBytes)
+	case *StdTypes_ChoiceName:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_name")
+		protobuf_go_lite.TextWriteString(&sb, body.ChoiceName)
+	}
+	if x.PlainTimestamp != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "plain_timestamp")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.PlainTimestamp)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypes) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypesWKT_TimestampMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "TimestampMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypesWKT_TimestampMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypesWKT_DurationMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "DurationMapEntry")
+	if x.Key != 0 {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteInt(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypesWKT_DurationMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypesWKT_StringMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StringMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypesWKT_StringMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypesWKT_BytesMapEntry) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "BytesMapEntry")
+	if x.Key != "" {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "key")
+		protobuf_go_lite.TextWriteString(&sb, x.Key)
+	}
+	if x.Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Value)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypesWKT_BytesMapEntry) String() string {
+	return x.MarshalProtoText()
+}
+func (x *StdTypesWKT) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "StdTypesWKT")
+	if x.Timestamp != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "timestamp")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Timestamp)
+	}
+	if x.Duration != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "duration")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Duration)
+	}
+	if x.DoubleValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "double_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.DoubleValue)
+	}
+	if x.FloatValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "float_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.FloatValue)
+	}
+	if x.Int64Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "int64_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Int64Value)
+	}
+	if x.Uint64Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "uint64_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Uint64Value)
+	}
+	if x.Int32Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "int32_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Int32Value)
+	}
+	if x.Uint32Value != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "uint32_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.Uint32Value)
+	}
+	if x.BoolValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "bool_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.BoolValue)
+	}
+	if x.StringValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "string_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.StringValue)
+	}
+	if x.BytesValue != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "bytes_value")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.BytesValue)
+	}
+	if len(x.Timestamps) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "timestamps")
+		for i, v := range x.Timestamps {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &timestamppb.Timestamp{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Durations) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "durations")
+		for i, v := range x.Durations {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &durationpb.Duration{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.Int64Values) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "int64_values")
+		for i, v := range x.Int64Values {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.Int64Value{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.BytesValues) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "bytes_values")
+		for i, v := range x.BytesValues {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.BytesValue{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.FloatValues) > 0 {
+		protobuf_go_lite.TextWriteListStart(&sb, initialLen, "float_values")
+		for i, v := range x.FloatValues {
+			protobuf_go_lite.TextWriteListSeparator(&sb, i)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.FloatValue{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteListEnd(&sb)
+	}
+	if len(x.TimestampMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "timestamp_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.TimestampMap) {
+			v := x.TimestampMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &timestamppb.Timestamp{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.DurationMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "duration_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.DurationMap) {
+			v := x.DurationMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteInt(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &durationpb.Duration{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.StringMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "string_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.StringMap) {
+			v := x.StringMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.StringValue{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	if len(x.BytesMap) > 0 {
+		protobuf_go_lite.TextWriteMapStart(&sb, initialLen, "bytes_map")
+		for _, k := range protobuf_go_lite.TextSortedMapKeys(x.BytesMap) {
+			v := x.BytesMap[k]
+			protobuf_go_lite.TextWriteMapEntryPrefix(&sb)
+			protobuf_go_lite.TextWriteString(&sb, k)
+			protobuf_go_lite.TextWriteMapKeyValueSeparator(&sb)
+			if v == nil {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.BytesValue{})
+			} else {
+				protobuf_go_lite.TextWriteTextMarshaler(&sb, v)
+			}
+		}
+		protobuf_go_lite.TextWriteMapEnd(&sb)
+	}
+	switch body := x.Choice.(type) {
+	case *StdTypesWKT_ChoiceTimestamp:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_timestamp")
+		if body.ChoiceTimestamp == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &timestamppb.Timestamp{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.ChoiceTimestamp)
+		}
+	case *StdTypesWKT_ChoiceDuration:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_duration")
+		if body.ChoiceDuration == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &durationpb.Duration{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.ChoiceDuration)
+		}
+	case *StdTypesWKT_ChoiceBool:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_bool")
+		if body.ChoiceBool == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.BoolValue{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.ChoiceBool)
+		}
+	case *StdTypesWKT_ChoiceBytes:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_bytes")
+		if body.ChoiceBytes == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &wrapperspb.BytesValue{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.ChoiceBytes)
+		}
+	case *StdTypesWKT_ChoiceName:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "choice_name")
+		protobuf_go_lite.TextWriteString(&sb, body.ChoiceName)
+	}
+	if x.PlainTimestamp != nil {
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "plain_timestamp")
+		protobuf_go_lite.TextWriteTextMarshaler(&sb, x.PlainTimestamp)
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *StdTypesWKT) String() string {
+	return x.MarshalProtoText()
+}
+func (m *StdTypes) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timestamp); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Duration); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = new(float64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.DoubleValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = new(float32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.FloatValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = new(int64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = new(uint64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = new(int32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = new(uint32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = new(bool)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BoolValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.StringValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = new([]byte)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BytesValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Time
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, v)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, v)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v int64
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, v)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v []byte
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, v)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v float32
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, v)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]time.Time)
+			}
+			var mapkey string
+			var mapvalue time.Time
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]time.Duration)
+			}
+			var mapkey int32
+			var mapvalue time.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceTimestamp); err != nil {
+					return err
+				}
+			} else {
+				var v time.Time
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceDuration); err != nil {
+					return err
+				}
+			} else {
+				var v time.Duration
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBool); err != nil {
+					return err
+				}
+			} else {
+				var v bool
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBytes); err != nil {
+					return err
+				}
+			} else {
+				var v []byte
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypes_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdTypesWKT) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypesWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypesWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, &durationpb.Duration{})
+			if err := m.Durations[len(m.Durations)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, &wrapperspb.Int64Value{})
+			if err := m.Int64Values[len(m.Int64Values)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, &wrapperspb.BytesValue{})
+			if err := m.BytesValues[len(m.BytesValues)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, &wrapperspb.FloatValue{})
+			if err := m.FloatValues[len(m.FloatValues)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]*timestamppb.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *timestamppb.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &timestamppb.Timestamp{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]*durationpb.Duration)
+			}
+			var mapkey int32
+			var mapvalue *durationpb.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &durationpb.Duration{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]*wrapperspb.StringValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.StringValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.StringValue{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string]*wrapperspb.BytesValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.BytesValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.BytesValue{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+				if err := oneof.ChoiceTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &timestamppb.Timestamp{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+				if err := oneof.ChoiceDuration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &durationpb.Duration{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+				if err := oneof.ChoiceBool.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BoolValue{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+				if err := oneof.ChoiceBytes.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BytesValue{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypesWKT_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// RangeStdTypesWKTTimestampsVT iterates over the Timestamps elements encoded in dAtA, a serialized StdTypesWKT,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeStdTypesWKTTimestampsVT(dAtA []byte) iter.Seq2[*timestamppb.Timestamp, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 12, (*timestamppb.Timestamp).UnmarshalVT)
+}
+
+// RangeStdTypesWKTDurationsVT iterates over the Durations elements encoded in dAtA, a serialized StdTypesWKT,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeStdTypesWKTDurationsVT(dAtA []byte) iter.Seq2[*durationpb.Duration, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 13, (*durationpb.Duration).UnmarshalVT)
+}
+
+// RangeStdTypesWKTInt64ValuesVT iterates over the Int64Values elements encoded in dAtA, a serialized StdTypesWKT,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeStdTypesWKTInt64ValuesVT(dAtA []byte) iter.Seq2[*wrapperspb.Int64Value, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 14, (*wrapperspb.Int64Value).UnmarshalVT)
+}
+
+// RangeStdTypesWKTBytesValuesVT iterates over the BytesValues elements encoded in dAtA, a serialized StdTypesWKT,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeStdTypesWKTBytesValuesVT(dAtA []byte) iter.Seq2[*wrapperspb.BytesValue, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 15, (*wrapperspb.BytesValue).UnmarshalVT)
+}
+
+// RangeStdTypesWKTFloatValuesVT iterates over the FloatValues elements encoded in dAtA, a serialized StdTypesWKT,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeStdTypesWKTFloatValuesVT(dAtA []byte) iter.Seq2[*wrapperspb.FloatValue, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 16, (*wrapperspb.FloatValue).UnmarshalVT)
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdTypes) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timestamp); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Duration); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = new(float64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.DoubleValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = new(float32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.FloatValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = new(int64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = new(uint64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = new(int32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = new(uint32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = new(bool)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BoolValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.StringValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = new([]byte)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BytesValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Time
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, v)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, v)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v int64
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, v)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v []byte
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, v)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v float32
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, v)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]time.Time)
+			}
+			var mapkey string
+			var mapvalue time.Time
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]time.Duration)
+			}
+			var mapkey int32
+			var mapvalue time.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceTimestamp); err != nil {
+					return err
+				}
+			} else {
+				var v time.Time
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceDuration); err != nil {
+					return err
+				}
+			} else {
+				var v time.Duration
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBool); err != nil {
+					return err
+				}
+			} else {
+				var v bool
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBytes); err != nil {
+					return err
+				}
+			} else {
+				var v []byte
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypes_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdTypesWKT) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypesWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypesWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, &durationpb.Duration{})
+			if err := m.Durations[len(m.Durations)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, &wrapperspb.Int64Value{})
+			if err := m.Int64Values[len(m.Int64Values)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, &wrapperspb.BytesValue{})
+			if err := m.BytesValues[len(m.BytesValues)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, &wrapperspb.FloatValue{})
+			if err := m.FloatValues[len(m.FloatValues)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]*timestamppb.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *timestamppb.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &timestamppb.Timestamp{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]*durationpb.Duration)
+			}
+			var mapkey int32
+			var mapvalue *durationpb.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &durationpb.Duration{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]*wrapperspb.StringValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.StringValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.StringValue{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string]*wrapperspb.BytesValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.BytesValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.BytesValue{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+				if err := oneof.ChoiceTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &timestamppb.Timestamp{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+				if err := oneof.ChoiceDuration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &durationpb.Duration{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+				if err := oneof.ChoiceBool.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BoolValue{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+				if err := oneof.ChoiceBytes.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BytesValue{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypesWKT_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdTypes) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timestamp); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Duration); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = new(float64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.DoubleValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = new(float32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.FloatValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = new(int64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = new(uint64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = new(int32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = new(uint32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = new(bool)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BoolValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.StringValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = new([]byte)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BytesValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Time
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, v)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, v)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v int64
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, v)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v []byte
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, v)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v float32
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, v)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]time.Time)
+			}
+			var mapkey string
+			var mapvalue time.Time
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]time.Duration)
+			}
+			var mapkey int32
+			var mapvalue time.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceTimestamp); err != nil {
+					return err
+				}
+			} else {
+				var v time.Time
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceDuration); err != nil {
+					return err
+				}
+			} else {
+				var v time.Duration
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBool); err != nil {
+					return err
+				}
+			} else {
+				var v bool
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBytes); err != nil {
+					return err
+				}
+			} else {
+				var v []byte
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypes_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *StdTypesWKT) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypesWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypesWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, &durationpb.Duration{})
+			if err := m.Durations[len(m.Durations)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, &wrapperspb.Int64Value{})
+			if err := m.Int64Values[len(m.Int64Values)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, &wrapperspb.BytesValue{})
+			if err := m.BytesValues[len(m.BytesValues)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, &wrapperspb.FloatValue{})
+			if err := m.FloatValues[len(m.FloatValues)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]*timestamppb.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *timestamppb.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &timestamppb.Timestamp{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]*durationpb.Duration)
+			}
+			var mapkey int32
+			var mapvalue *durationpb.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &durationpb.Duration{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]*wrapperspb.StringValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.StringValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.StringValue{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string]*wrapperspb.BytesValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.BytesValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.BytesValue{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+				if err := oneof.ChoiceTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &timestamppb.Timestamp{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+				if err := oneof.ChoiceDuration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &durationpb.Duration{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+				if err := oneof.ChoiceBool.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BoolValue{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+				if err := oneof.ChoiceBytes.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BytesValue{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypesWKT_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StdTypes) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Timestamp); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Duration); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = new(float64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.DoubleValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = new(float32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.FloatValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = new(int64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = new(uint64)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint64Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = new(int32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Int32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = new(uint32)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.Uint32Value); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = new(bool)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BoolValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = new(string)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.StringValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = new([]byte)
+			}
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], m.BytesValue); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Time
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, v)
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v time.Duration
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, v)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v int64
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, v)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v []byte
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, v)
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			var v float32
+			if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, v)
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]time.Time)
+			}
+			var mapkey string
+			var mapvalue time.Time
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]time.Duration)
+			}
+			var mapkey int32
+			var mapvalue time.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStartmapvalue:postmsgIndexmapvalue], &mapvalue); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceTimestamp); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceTimestamp); err != nil {
+					return err
+				}
+			} else {
+				var v time.Time
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceDuration); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceDuration); err != nil {
+					return err
+				}
+			} else {
+				var v time.Duration
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBool); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBool); err != nil {
+					return err
+				}
+			} else {
+				var v bool
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypes_ChoiceBytes); ok {
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &oneof.ChoiceBytes); err != nil {
+					return err
+				}
+			} else {
+				var v []byte
+				if err := protobuf_go_lite.UnmarshalStd(dAtA[msgStart:postIndex], &v); err != nil {
+					return err
+				}
+				m.Choice = &StdTypes_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypes_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdTypesWKT) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdTypesWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdTypesWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Timestamps = append(m.Timestamps, &timestamppb.Timestamp{})
+			if err := m.Timestamps[len(m.Timestamps)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durations", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Durations = append(m.Durations, &durationpb.Duration{})
+			if err := m.Durations[len(m.Durations)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Int64Values = append(m.Int64Values, &wrapperspb.Int64Value{})
+			if err := m.Int64Values[len(m.Int64Values)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.BytesValues = append(m.BytesValues, &wrapperspb.BytesValue{})
+			if err := m.BytesValues[len(m.BytesValues)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValues", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.FloatValues = append(m.FloatValues, &wrapperspb.FloatValue{})
+			if err := m.FloatValues[len(m.FloatValues)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.TimestampMap == nil {
+				m.TimestampMap = make(map[string]*timestamppb.Timestamp)
+			}
+			var mapkey string
+			var mapvalue *timestamppb.Timestamp
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &timestamppb.Timestamp{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.TimestampMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.DurationMap == nil {
+				m.DurationMap = make(map[int32]*durationpb.Duration)
+			}
+			var mapkey int32
+			var mapvalue *durationpb.Duration
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeVarintInt32(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &durationpb.Duration{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.DurationMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.StringMap == nil {
+				m.StringMap = make(map[string]*wrapperspb.StringValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.StringValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.StringValue{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.StringMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string]*wrapperspb.BytesValue)
+			}
+			var mapkey string
+			var mapvalue *wrapperspb.BytesValue
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &wrapperspb.BytesValue{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceTimestamp); ok {
+				if err := oneof.ChoiceTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &timestamppb.Timestamp{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: v}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceDuration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceDuration); ok {
+				if err := oneof.ChoiceDuration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &durationpb.Duration{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceDuration{ChoiceDuration: v}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBool", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBool); ok {
+				if err := oneof.ChoiceBool.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BoolValue{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBool{ChoiceBool: v}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceBytes", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Choice.(*StdTypesWKT_ChoiceBytes); ok {
+				if err := oneof.ChoiceBytes.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &wrapperspb.BytesValue{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Choice = &StdTypesWKT_ChoiceBytes{ChoiceBytes: v}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceName", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Choice = &StdTypesWKT_ChoiceName{ChoiceName: v}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainTimestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.PlainTimestamp == nil {
+				m.PlainTimestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.PlainTimestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
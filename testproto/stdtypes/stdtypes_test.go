@@ -0,0 +1,275 @@
+package stdtypes
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aperturerobotics/protobuf-go-lite/types/known/durationpb"
+	"github.com/aperturerobotics/protobuf-go-lite/types/known/timestamppb"
+	"github.com/aperturerobotics/protobuf-go-lite/types/known/wrapperspb"
+)
+
+var testTime = time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// newStdTypes returns a StdTypes with every native field set and its twin
+// using the well-known type messages. Maps hold a single entry so that the
+// encodings are deterministic.
+func newStdTypes() (*StdTypes, *StdTypesWKT) {
+	std := &StdTypes{
+		Timestamp:    ptr(testTime),
+		Duration:     ptr(90*time.Second + 5*time.Millisecond),
+		DoubleValue:  ptr(1.5),
+		FloatValue:   ptr(float32(-2.25)),
+		Int64Value:   ptr(int64(-1) << 40),
+		Uint64Value:  ptr(uint64(1) << 63),
+		Int32Value:   ptr(int32(-7)),
+		Uint32Value:  ptr(uint32(7)),
+		BoolValue:    ptr(true),
+		StringValue:  ptr("hello"),
+		BytesValue:   ptr([]byte("world")),
+		Timestamps:   []time.Time{testTime, time.Unix(0, 0).UTC()},
+		Durations:    []time.Duration{time.Nanosecond, -time.Minute},
+		Int64Values:  []int64{0, 42},
+		BytesValues:  [][]byte{{}, {1, 2}},
+		FloatValues:  []float32{0.5},
+		TimestampMap: map[string]time.Time{"t": testTime},
+		DurationMap:  map[int32]time.Duration{3: time.Hour},
+		StringMap:    map[string]string{"k": "v"},
+		BytesMap:     map[string][]byte{"b": {0xff}},
+		Choice:       &StdTypes_ChoiceDuration{ChoiceDuration: time.Second},
+	}
+	wkt := &StdTypesWKT{
+		Timestamp:    timestamppb.New(testTime),
+		Duration:     durationpb.New(90*time.Second + 5*time.Millisecond),
+		DoubleValue:  wrapperspb.Double(1.5),
+		FloatValue:   wrapperspb.Float(-2.25),
+		Int64Value:   wrapperspb.Int64(-1 << 40),
+		Uint64Value:  wrapperspb.UInt64(1 << 63),
+		Int32Value:   wrapperspb.Int32(-7),
+		Uint32Value:  wrapperspb.UInt32(7),
+		BoolValue:    wrapperspb.Bool(true),
+		StringValue:  wrapperspb.String("hello"),
+		BytesValue:   wrapperspb.Bytes([]byte("world")),
+		Timestamps:   []*timestamppb.Timestamp{timestamppb.New(testTime), timestamppb.New(time.Unix(0, 0))},
+		Durations:    []*durationpb.Duration{durationpb.New(time.Nanosecond), durationpb.New(-time.Minute)},
+		Int64Values:  []*wrapperspb.Int64Value{wrapperspb.Int64(0), wrapperspb.Int64(42)},
+		BytesValues:  []*wrapperspb.BytesValue{wrapperspb.Bytes([]byte{}), wrapperspb.Bytes([]byte{1, 2})},
+		FloatValues:  []*wrapperspb.FloatValue{wrapperspb.Float(0.5)},
+		TimestampMap: map[string]*timestamppb.Timestamp{"t": timestamppb.New(testTime)},
+		DurationMap:  map[int32]*durationpb.Duration{3: durationpb.New(time.Hour)},
+		StringMap:    map[string]*wrapperspb.StringValue{"k": wrapperspb.String("v")},
+		BytesMap:     map[string]*wrapperspb.BytesValue{"b": wrapperspb.Bytes([]byte{0xff})},
+		Choice:       &StdTypesWKT_ChoiceDuration{ChoiceDuration: durationpb.New(time.Second)},
+	}
+	return std, wkt
+}
+
+func TestStdTypesWire(t *testing.T) {
+	std, wkt := newStdTypes()
+
+	out, err := std.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := wkt.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, want) {
+		t.Fatalf("MarshalVT() = %x, want %x", out, want)
+	}
+	if got := std.SizeVT(); got != len(out) {
+		t.Fatalf("SizeVT() = %d, want %d", got, len(out))
+	}
+
+	var decoded StdTypes
+	if err := decoded.UnmarshalVT(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(std) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, std)
+	}
+	// A zero time.Time is not the Unix epoch.
+	if !decoded.Timestamps[1].Equal(time.Unix(0, 0)) {
+		t.Fatalf("decoded epoch timestamp = %v", decoded.Timestamps[1])
+	}
+}
+
+func TestStdTypesOneof(t *testing.T) {
+	for _, tc := range []struct {
+		std isStdTypes_Choice
+		wkt isStdTypesWKT_Choice
+	}{
+		{&StdTypes_ChoiceTimestamp{ChoiceTimestamp: testTime}, &StdTypesWKT_ChoiceTimestamp{ChoiceTimestamp: timestamppb.New(testTime)}},
+		{&StdTypes_ChoiceDuration{}, &StdTypesWKT_ChoiceDuration{ChoiceDuration: &durationpb.Duration{}}},
+		{&StdTypes_ChoiceBool{}, &StdTypesWKT_ChoiceBool{ChoiceBool: &wrapperspb.BoolValue{}}},
+		{&StdTypes_ChoiceBytes{ChoiceBytes: []byte{1}}, &StdTypesWKT_ChoiceBytes{ChoiceBytes: wrapperspb.Bytes([]byte{1})}},
+	} {
+		std, wkt := &StdTypes{Choice: tc.std}, &StdTypesWKT{Choice: tc.wkt}
+		out, err := std.MarshalVT()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := wkt.MarshalVT()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, want) {
+			t.Fatalf("MarshalVT(%T) = %x, want %x", tc.std, out, want)
+		}
+		var decoded StdTypes
+		if err := decoded.UnmarshalVT(want); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.EqualVT(std) {
+			t.Fatalf("UnmarshalVT(%T) = %v, want %v", tc.std, &decoded, std)
+		}
+	}
+}
+
+func TestStdTypesMergesOccurrences(t *testing.T) {
+	// Two occurrences of the timestamp field, the second setting only nanos.
+	wire := []byte{
+		0x0a, 0x02, 0x08, 0x05, // timestamp { seconds: 5 }
+		0x0a, 0x02, 0x10, 0x07, // timestamp { nanos: 7 }
+	}
+	var m StdTypes
+	if err := m.UnmarshalVT(wire); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(5, 7).UTC(); *m.Timestamp != want {
+		t.Fatalf("Timestamp = %v, want %v", *m.Timestamp, want)
+	}
+}
+
+func TestStdTypesCloneEqual(t *testing.T) {
+	std, _ := newStdTypes()
+
+	clone := std.CloneVT()
+	if !clone.EqualVT(std) {
+		t.Fatalf("CloneVT() = %v, want %v", clone, std)
+	}
+	(*clone.BytesValue)[0] = 'W'
+	clone.BytesValues[1][0] = 9
+	clone.BytesMap["b"][0] = 0
+	*clone.Timestamp = clone.Timestamp.Add(time.Second)
+	if got := string(*std.BytesValue); got != "world" {
+		t.Fatalf("CloneVT() shares BytesValue: %q", got)
+	}
+	if std.BytesValues[1][0] != 1 || std.BytesMap["b"][0] != 0xff {
+		t.Fatal("CloneVT() shares repeated or map bytes")
+	}
+	if clone.EqualVT(std) {
+		t.Fatal("EqualVT() = true after changing the clone")
+	}
+
+	// Times are compared as instants, not by location.
+	other, _ := newStdTypes()
+	other.Timestamp = ptr(testTime.In(time.FixedZone("x", 3600)))
+	if !other.EqualVT(std) {
+		t.Fatal("EqualVT() = false for the same instant in another location")
+	}
+}
+
+func TestStdTypesMerge(t *testing.T) {
+	dst := &StdTypes{
+		Timestamp:   ptr(time.Unix(1, 0).UTC()),
+		Int64Values: []int64{1},
+		Choice:      &StdTypes_ChoiceDuration{ChoiceDuration: time.Second},
+	}
+	src := &StdTypes{
+		Timestamp:   ptr(time.Unix(0, 5).UTC()),
+		Int32Value:  ptr(int32(0)),
+		Int64Values: []int64{2},
+		Choice:      &StdTypes_ChoiceDuration{ChoiceDuration: time.Millisecond},
+	}
+	dst.MergeVT(src)
+
+	if want := time.Unix(1, 5).UTC(); *dst.Timestamp != want {
+		t.Fatalf("Timestamp = %v, want %v", *dst.Timestamp, want)
+	}
+	if dst.Int32Value == nil || *dst.Int32Value != 0 {
+		t.Fatalf("Int32Value = %v, want a set zero", dst.Int32Value)
+	}
+	if len(dst.Int64Values) != 2 || dst.Int64Values[1] != 2 {
+		t.Fatalf("Int64Values = %v, want [1 2]", dst.Int64Values)
+	}
+	if got := dst.Choice.(*StdTypes_ChoiceDuration).ChoiceDuration; got != time.Second+time.Millisecond {
+		t.Fatalf("ChoiceDuration = %v, want %v", got, time.Second+time.Millisecond)
+	}
+}
+
+func TestStdTypesJSON(t *testing.T) {
+	std, wkt := newStdTypes()
+
+	// Each form decodes the JSON of the other.
+	out, err := std.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decodedWKT StdTypesWKT
+	if err := decodedWKT.UnmarshalJSON(out); err != nil {
+		t.Fatal(err)
+	}
+	if !decodedWKT.EqualVT(wkt) {
+		t.Fatalf("UnmarshalJSON(%s) = %v, want %v", out, &decodedWKT, wkt)
+	}
+
+	want, err := wkt.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded StdTypes
+	if err := decoded.UnmarshalJSON(want); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(std) {
+		t.Fatalf("UnmarshalJSON(%s) = %v, want %v", want, &decoded, std)
+	}
+
+	var null StdTypes
+	if err := null.UnmarshalJSON([]byte(`{"timestamp":null,"int64Value":null}`)); err != nil {
+		t.Fatal(err)
+	}
+	if null.Timestamp != nil || null.Int64Value != nil {
+		t.Fatalf("UnmarshalJSON(null) = %v, want unset fields", &null)
+	}
+}
+
+func TestStdTypesText(t *testing.T) {
+	std, wkt := newStdTypes()
+
+	got := strings.TrimPrefix(std.MarshalProtoText(), "StdTypes ")
+	want := strings.TrimPrefix(wkt.MarshalProtoText(), "StdTypesWKT ")
+	if got != want {
+		t.Fatalf("MarshalProtoText() = %s, want %s", got, want)
+	}
+}
+
+func TestStdFileDirectives(t *testing.T) {
+	// The directives before the syntax statement apply to every field of the file.
+	m := &StdFile{
+		Created:   ptr(testTime),
+		Timeout:   ptr(time.Minute),
+		Label:     ptr("label"),
+		Intervals: []time.Duration{time.Second},
+		Counts:    map[string]int32{"a": 1},
+	}
+	out, err := m.MarshalVT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded StdFile
+	if err := decoded.UnmarshalVT(out); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.EqualVT(m) {
+		t.Fatalf("UnmarshalVT() = %v, want %v", &decoded, m)
+	}
+}
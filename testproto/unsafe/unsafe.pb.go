@@ -5,8 +5,13 @@
 package unsafe
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	maps "maps"
 	slices "slices"
 
 	protobuf_go_lite "github.com/aperturerobotics/protobuf-go-lite"
@@ -31,6 +36,44 @@ func (x *UnsafeTest) Reset() {
 
 func (*UnsafeTest) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	if v, ok := x.Sub.(*UnsafeTest_Sub1_); ok {
+		v.Sub1.DiscardUnknownVT()
+	}
+	if v, ok := x.Sub.(*UnsafeTest_Sub2_); ok {
+		v.Sub2.DiscardUnknownVT()
+	}
+	if v, ok := x.Sub.(*UnsafeTest_Sub3_); ok {
+		v.Sub3.DiscardUnknownVT()
+	}
+	if v, ok := x.Sub.(*UnsafeTest_Sub4_); ok {
+		v.Sub4.DiscardUnknownVT()
+	}
+	if v, ok := x.Sub.(*UnsafeTest_Sub5_); ok {
+		v.Sub5.DiscardUnknownVT()
+	}
+}
+
 func (m *UnsafeTest) GetSub() isUnsafeTest_Sub {
 	if m != nil {
 		return m.Sub
@@ -119,6 +162,29 @@ func (x *UnsafeTest_Sub1) Reset() {
 
 func (*UnsafeTest_Sub1) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub1) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub1) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub1) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub1) GetS() string {
 	if x != nil {
 		return x.S
@@ -145,6 +211,29 @@ func (x *UnsafeTest_Sub2) Reset() {
 
 func (*UnsafeTest_Sub2) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub2) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub2) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub2) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub2) GetS() []string {
 	if x != nil {
 		return x.S
@@ -170,6 +259,29 @@ func (x *UnsafeTest_Sub3) Reset() {
 
 func (*UnsafeTest_Sub3) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub3) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub3) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub3) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub3) GetFoo() map[string][]byte {
 	if x != nil {
 		return x.Foo
@@ -192,6 +304,29 @@ func (x *UnsafeTest_Sub4) Reset() {
 
 func (*UnsafeTest_Sub4) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub4) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub4) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub4) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (m *UnsafeTest_Sub4) GetFoo() isUnsafeTest_Sub4_Foo {
 	if m != nil {
 		return m.Foo
@@ -240,6 +375,29 @@ func (x *UnsafeTest_Sub5) Reset() {
 
 func (*UnsafeTest_Sub5) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub5) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub5) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub5) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub5) GetFoo() map[string]string {
 	if x != nil {
 		return x.Foo
@@ -259,6 +417,29 @@ func (x *UnsafeTest_Sub3_FooEntry) Reset() {
 
 func (*UnsafeTest_Sub3_FooEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub3_FooEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub3_FooEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub3_FooEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub3_FooEntry) GetKey() string {
 	if x != nil {
 		return x.Key
@@ -285,6 +466,29 @@ func (x *UnsafeTest_Sub5_FooEntry) Reset() {
 
 func (*UnsafeTest_Sub5_FooEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UnsafeTest_Sub5_FooEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UnsafeTest_Sub5_FooEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UnsafeTest_Sub5_FooEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UnsafeTest_Sub5_FooEntry) GetKey() string {
 	if x != nil {
 		return x.Key
@@ -492,229 +696,664 @@ func (m *UnsafeTest_Sub5_) CloneOneofVT() isUnsafeTest_Sub {
 	return m.CloneVT()
 }
 
-func (this *UnsafeTest_Sub1) EqualVT(that *UnsafeTest_Sub1) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest_Sub1) CompareVT(that *UnsafeTest_Sub1) int {
+	if m == that {
+		return 0
 	}
-	if this.S != that.S {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualBytes(this.B, that.B) {
-		return false
+	if that == nil {
+		return 1
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := cmp.Compare(m.S, that.S); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(m.B, that.B); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub1) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest_Sub1)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest_Sub2) CompareVT(that *UnsafeTest_Sub2) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *UnsafeTest_Sub2) EqualVT(that *UnsafeTest_Sub2) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualSlice(this.S, that.S) {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualBytesSlice(this.B, that.B) {
-		return false
+	if c := slices.Compare(m.S, that.S); c != 0 {
+		return c
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := slices.CompareFunc(m.B, that.B, bytes.Compare); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub2) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest_Sub2)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest_Sub3) CompareVT(that *UnsafeTest_Sub3) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *UnsafeTest_Sub3) EqualVT(that *UnsafeTest_Sub3) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if !protobuf_go_lite.EqualBytesMap(this.Foo, that.Foo) {
-		return false
+	if that == nil {
+		return 1
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if c := protobuf_go_lite.CompareMap(m.Foo, that.Foo, cmp.Compare[string], bytes.Compare); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub3) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest_Sub3)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest_Sub4) CompareVT(that *UnsafeTest_Sub4) int {
+	if m == that {
+		return 0
 	}
-	return this.EqualVT(that)
-}
-func (this *UnsafeTest_Sub4) EqualVT(that *UnsafeTest_Sub4) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+	if m == nil {
+		return -1
 	}
-	if this.Foo == nil && that.Foo != nil {
-		return false
-	} else if this.Foo != nil {
-		if that.Foo == nil {
-			return false
+	if that == nil {
+		return 1
+	}
+	{
+		a, aok := m.Foo.(*UnsafeTest_Sub4_S)
+		b, bok := that.Foo.(*UnsafeTest_Sub4_S)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
 		}
-		if !this.Foo.(interface {
-			EqualVT(isUnsafeTest_Sub4_Foo) bool
-		}).EqualVT(that.Foo) {
-			return false
+		if aok {
+			if c := cmp.Compare(a.S, b.S); c != 0 {
+				return c
+			}
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *UnsafeTest_Sub4) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest_Sub4)
-	if !ok {
-		return false
+	{
+		a, aok := m.Foo.(*UnsafeTest_Sub4_B)
+		b, bok := that.Foo.(*UnsafeTest_Sub4_B)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := bytes.Compare(a.B, b.B); c != 0 {
+				return c
+			}
+		}
 	}
-	return this.EqualVT(that)
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
-func (this *UnsafeTest_Sub4_S) EqualVT(thatIface isUnsafeTest_Sub4_Foo) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub4_S)
-	if !ok {
-		return false
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest_Sub5) CompareVT(that *UnsafeTest_Sub5) int {
+	if m == that {
+		return 0
 	}
-	if this == that {
-		return true
+	if m == nil {
+		return -1
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if that == nil {
+		return 1
 	}
-	if this.S != that.S {
-		return false
+	if c := protobuf_go_lite.CompareMap(m.Foo, that.Foo, cmp.Compare[string], cmp.Compare[string]); c != 0 {
+		return c
 	}
-	return true
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub4_B) EqualVT(thatIface isUnsafeTest_Sub4_Foo) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub4_B)
-	if !ok {
-		return false
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *UnsafeTest) CompareVT(that *UnsafeTest) int {
+	if m == that {
+		return 0
 	}
-	if this == that {
-		return true
+	if m == nil {
+		return -1
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if that == nil {
+		return 1
 	}
-	if !protobuf_go_lite.EqualBytes(this.B, that.B) {
-		return false
+	{
+		a, aok := m.Sub.(*UnsafeTest_Sub1_)
+		b, bok := that.Sub.(*UnsafeTest_Sub1_)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Sub1, b.Sub1, (*UnsafeTest_Sub1).CompareVT); c != 0 {
+				return c
+			}
+		}
 	}
-	return true
+	{
+		a, aok := m.Sub.(*UnsafeTest_Sub2_)
+		b, bok := that.Sub.(*UnsafeTest_Sub2_)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Sub2, b.Sub2, (*UnsafeTest_Sub2).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Sub.(*UnsafeTest_Sub3_)
+		b, bok := that.Sub.(*UnsafeTest_Sub3_)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Sub3, b.Sub3, (*UnsafeTest_Sub3).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Sub.(*UnsafeTest_Sub4_)
+		b, bok := that.Sub.(*UnsafeTest_Sub4_)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Sub4, b.Sub4, (*UnsafeTest_Sub4).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Sub.(*UnsafeTest_Sub5_)
+		b, bok := that.Sub.(*UnsafeTest_Sub5_)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.Sub5, b.Sub5, (*UnsafeTest_Sub5).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub5) EqualVT(that *UnsafeTest_Sub5) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest_Sub1) CopyVT(dst *UnsafeTest_Sub1) {
+	if m == dst || dst == nil {
+		return
 	}
-	if !protobuf_go_lite.EqualMap(this.Foo, that.Foo) {
-		return false
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	dst.S = m.S
+	dst.B = protobuf_go_lite.CopyBytes(dst.B, m.B)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (this *UnsafeTest_Sub5) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest_Sub5)
-	if !ok {
-		return false
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest_Sub2) CopyVT(dst *UnsafeTest_Sub2) {
+	if m == dst || dst == nil {
+		return
 	}
-	return this.EqualVT(that)
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.S = protobuf_go_lite.CopySlice(dst.S, m.S)
+	dst.B = protobuf_go_lite.CopyBytesSlice(dst.B, m.B)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
-func (this *UnsafeTest) EqualVT(that *UnsafeTest) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
-		return false
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest_Sub3) CopyVT(dst *UnsafeTest_Sub3) {
+	if m == dst || dst == nil {
+		return
 	}
-	if this.Sub == nil && that.Sub != nil {
-		return false
-	} else if this.Sub != nil {
-		if that.Sub == nil {
-			return false
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Foo = protobuf_go_lite.CopyBytesMap(dst.Foo, m.Foo)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest_Sub4) CopyVT(dst *UnsafeTest_Sub4) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch v := m.Foo.(type) {
+	case nil:
+		dst.Foo = nil
+	case *UnsafeTest_Sub4_S:
+		d, ok := dst.Foo.(*UnsafeTest_Sub4_S)
+		if !ok {
+			d = &UnsafeTest_Sub4_S{}
+			dst.Foo = d
 		}
-		if !this.Sub.(interface{ EqualVT(isUnsafeTest_Sub) bool }).EqualVT(that.Sub) {
-			return false
+		d.S = v.S
+	case *UnsafeTest_Sub4_B:
+		d, ok := dst.Foo.(*UnsafeTest_Sub4_B)
+		if !ok {
+			d = &UnsafeTest_Sub4_B{}
+			dst.Foo = d
 		}
+		d.B = protobuf_go_lite.CopyBytes(d.B, v.B)
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
 }
 
-func (this *UnsafeTest) EqualMessageVT(thatMsg any) bool {
-	that, ok := thatMsg.(*UnsafeTest)
-	if !ok {
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest_Sub5) CopyVT(dst *UnsafeTest_Sub5) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Foo = protobuf_go_lite.CopyMap(dst.Foo, m.Foo)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *UnsafeTest) CopyVT(dst *UnsafeTest) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch v := m.Sub.(type) {
+	case nil:
+		dst.Sub = nil
+	case *UnsafeTest_Sub1_:
+		d, ok := dst.Sub.(*UnsafeTest_Sub1_)
+		if !ok {
+			d = &UnsafeTest_Sub1_{}
+			dst.Sub = d
+		}
+		d.Sub1 = protobuf_go_lite.CopyVTValue(d.Sub1, v.Sub1, (*UnsafeTest_Sub1).CopyVT)
+	case *UnsafeTest_Sub2_:
+		d, ok := dst.Sub.(*UnsafeTest_Sub2_)
+		if !ok {
+			d = &UnsafeTest_Sub2_{}
+			dst.Sub = d
+		}
+		d.Sub2 = protobuf_go_lite.CopyVTValue(d.Sub2, v.Sub2, (*UnsafeTest_Sub2).CopyVT)
+	case *UnsafeTest_Sub3_:
+		d, ok := dst.Sub.(*UnsafeTest_Sub3_)
+		if !ok {
+			d = &UnsafeTest_Sub3_{}
+			dst.Sub = d
+		}
+		d.Sub3 = protobuf_go_lite.CopyVTValue(d.Sub3, v.Sub3, (*UnsafeTest_Sub3).CopyVT)
+	case *UnsafeTest_Sub4_:
+		d, ok := dst.Sub.(*UnsafeTest_Sub4_)
+		if !ok {
+			d = &UnsafeTest_Sub4_{}
+			dst.Sub = d
+		}
+		d.Sub4 = protobuf_go_lite.CopyVTValue(d.Sub4, v.Sub4, (*UnsafeTest_Sub4).CopyVT)
+	case *UnsafeTest_Sub5_:
+		d, ok := dst.Sub.(*UnsafeTest_Sub5_)
+		if !ok {
+			d = &UnsafeTest_Sub5_{}
+			dst.Sub = d
+		}
+		d.Sub5 = protobuf_go_lite.CopyVTValue(d.Sub5, v.Sub5, (*UnsafeTest_Sub5).CopyVT)
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest_Sub1) DiffVT(that *UnsafeTest_Sub1) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest_Sub1) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest_Sub1) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest_Sub1{}
+	}
+	if that == nil {
+		that = &UnsafeTest_Sub1{}
+	}
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "s", m.S, that.S)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "b", m.B, that.B)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest_Sub2) DiffVT(that *UnsafeTest_Sub2) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest_Sub2) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest_Sub2) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest_Sub2{}
+	}
+	if that == nil {
+		that = &UnsafeTest_Sub2{}
+	}
+	diffs = protobuf_go_lite.AppendDiffSlice(diffs, prefix, "s", m.S, that.S)
+	diffs = protobuf_go_lite.AppendDiffBytesSlice(diffs, prefix, "b", m.B, that.B)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest_Sub3) DiffVT(that *UnsafeTest_Sub3) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest_Sub3) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest_Sub3) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest_Sub3{}
+	}
+	if that == nil {
+		that = &UnsafeTest_Sub3{}
+	}
+	diffs = protobuf_go_lite.AppendDiffBytesMap(diffs, prefix, "foo", m.Foo, that.Foo)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest_Sub4) DiffVT(that *UnsafeTest_Sub4) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest_Sub4) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest_Sub4) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest_Sub4{}
+	}
+	if that == nil {
+		that = &UnsafeTest_Sub4{}
+	}
+	{
+		var a, b *string
+		if v, ok := m.Foo.(*UnsafeTest_Sub4_S); ok {
+			a = &v.S
+		}
+		if v, ok := that.Foo.(*UnsafeTest_Sub4_S); ok {
+			b = &v.S
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "s", a, b)
+	}
+	{
+		var a, b []byte
+		if v, ok := m.Foo.(*UnsafeTest_Sub4_B); ok {
+			a = v.B
+			if a == nil {
+				a = []byte{}
+			}
+		}
+		if v, ok := that.Foo.(*UnsafeTest_Sub4_B); ok {
+			b = v.B
+			if b == nil {
+				b = []byte{}
+			}
+		}
+		diffs = protobuf_go_lite.AppendDiffBytesPresent(diffs, prefix, "b", a, b)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest_Sub5) DiffVT(that *UnsafeTest_Sub5) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest_Sub5) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest_Sub5) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest_Sub5{}
+	}
+	if that == nil {
+		that = &UnsafeTest_Sub5{}
+	}
+	diffs = protobuf_go_lite.AppendDiffMap(diffs, prefix, "foo", m.Foo, that.Foo)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *UnsafeTest) DiffVT(that *UnsafeTest) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *UnsafeTest) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *UnsafeTest) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &UnsafeTest{}
+	}
+	if that == nil {
+		that = &UnsafeTest{}
+	}
+	{
+		var a, b *UnsafeTest_Sub1
+		if v, ok := m.Sub.(*UnsafeTest_Sub1_); ok {
+			a = v.Sub1
+		}
+		if v, ok := that.Sub.(*UnsafeTest_Sub1_); ok {
+			b = v.Sub1
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "sub1", a, b, (*UnsafeTest_Sub1).AppendDiffVT)
+	}
+	{
+		var a, b *UnsafeTest_Sub2
+		if v, ok := m.Sub.(*UnsafeTest_Sub2_); ok {
+			a = v.Sub2
+		}
+		if v, ok := that.Sub.(*UnsafeTest_Sub2_); ok {
+			b = v.Sub2
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "sub2", a, b, (*UnsafeTest_Sub2).AppendDiffVT)
+	}
+	{
+		var a, b *UnsafeTest_Sub3
+		if v, ok := m.Sub.(*UnsafeTest_Sub3_); ok {
+			a = v.Sub3
+		}
+		if v, ok := that.Sub.(*UnsafeTest_Sub3_); ok {
+			b = v.Sub3
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "sub3", a, b, (*UnsafeTest_Sub3).AppendDiffVT)
+	}
+	{
+		var a, b *UnsafeTest_Sub4
+		if v, ok := m.Sub.(*UnsafeTest_Sub4_); ok {
+			a = v.Sub4
+		}
+		if v, ok := that.Sub.(*UnsafeTest_Sub4_); ok {
+			b = v.Sub4
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "sub4", a, b, (*UnsafeTest_Sub4).AppendDiffVT)
+	}
+	{
+		var a, b *UnsafeTest_Sub5
+		if v, ok := m.Sub.(*UnsafeTest_Sub5_); ok {
+			a = v.Sub5
+		}
+		if v, ok := that.Sub.(*UnsafeTest_Sub5_); ok {
+			b = v.Sub5
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "sub5", a, b, (*UnsafeTest_Sub5).AppendDiffVT)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+func (this *UnsafeTest_Sub1) EqualVT(that *UnsafeTest_Sub1) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	return this.EqualVT(that)
+	if this.S != that.S {
+		return false
+	}
+	if !protobuf_go_lite.EqualBytes(this.B, that.B) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
-func (this *UnsafeTest_Sub1_) EqualVT(thatIface isUnsafeTest_Sub) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub1_)
+
+func (this *UnsafeTest_Sub1) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub1)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *UnsafeTest_Sub2) EqualVT(that *UnsafeTest_Sub2) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if !protobuf_go_lite.EqualSlice(this.S, that.S) {
 		return false
 	}
-	if !protobuf_go_lite.EqualVTImplicit(this.Sub1, that.Sub1, func() *UnsafeTest_Sub1 { return &UnsafeTest_Sub1{} }) {
+	if !protobuf_go_lite.EqualBytesSlice(this.B, that.B) {
 		return false
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub2_) EqualVT(thatIface isUnsafeTest_Sub) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub2_)
+func (this *UnsafeTest_Sub2) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub2)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *UnsafeTest_Sub3) EqualVT(that *UnsafeTest_Sub3) bool {
 	if this == that {
 		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
+	} else if this == nil || that == nil {
 		return false
 	}
-	if !protobuf_go_lite.EqualVTImplicit(this.Sub2, that.Sub2, func() *UnsafeTest_Sub2 { return &UnsafeTest_Sub2{} }) {
+	if !protobuf_go_lite.EqualBytesMap(this.Foo, that.Foo) {
 		return false
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub3_) EqualVT(thatIface isUnsafeTest_Sub) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub3_)
+func (this *UnsafeTest_Sub3) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub3)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *UnsafeTest_Sub4) EqualVT(that *UnsafeTest_Sub4) bool {
 	if this == that {
 		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
+	} else if this == nil || that == nil {
 		return false
 	}
-	if !protobuf_go_lite.EqualVTImplicit(this.Sub3, that.Sub3, func() *UnsafeTest_Sub3 { return &UnsafeTest_Sub3{} }) {
+	if this.Foo == nil && that.Foo != nil {
 		return false
+	} else if this.Foo != nil {
+		if that.Foo == nil {
+			return false
+		}
+		if !this.Foo.(interface {
+			EqualVT(isUnsafeTest_Sub4_Foo) bool
+		}).EqualVT(that.Foo) {
+			return false
+		}
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *UnsafeTest_Sub4_) EqualVT(thatIface isUnsafeTest_Sub) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub4_)
+func (this *UnsafeTest_Sub4) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub4)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *UnsafeTest_Sub4_S) EqualVT(thatIface isUnsafeTest_Sub4_Foo) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub4_S)
 	if !ok {
 		return false
 	}
@@ -724,14 +1363,14 @@ func (this *UnsafeTest_Sub4_) EqualVT(thatIface isUnsafeTest_Sub) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if !protobuf_go_lite.EqualVTImplicit(this.Sub4, that.Sub4, func() *UnsafeTest_Sub4 { return &UnsafeTest_Sub4{} }) {
+	if this.S != that.S {
 		return false
 	}
 	return true
 }
 
-func (this *UnsafeTest_Sub5_) EqualVT(thatIface isUnsafeTest_Sub) bool {
-	that, ok := thatIface.(*UnsafeTest_Sub5_)
+func (this *UnsafeTest_Sub4_B) EqualVT(thatIface isUnsafeTest_Sub4_Foo) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub4_B)
 	if !ok {
 		return false
 	}
@@ -741,256 +1380,648 @@ func (this *UnsafeTest_Sub5_) EqualVT(thatIface isUnsafeTest_Sub) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if !protobuf_go_lite.EqualVTImplicit(this.Sub5, that.Sub5, func() *UnsafeTest_Sub5 { return &UnsafeTest_Sub5{} }) {
+	if !protobuf_go_lite.EqualBytes(this.B, that.B) {
 		return false
 	}
 	return true
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub1 message to JSON.
-func (x *UnsafeTest_Sub1) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
-	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.S != "" || s.HasField("s") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("s")
-		s.WriteString(x.S)
+func (this *UnsafeTest_Sub5) EqualVT(that *UnsafeTest_Sub5) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if len(x.B) > 0 || s.HasField("b") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("b")
-		s.WriteBytes(x.B)
+	if !protobuf_go_lite.EqualMap(this.Foo, that.Foo) {
+		return false
 	}
-	s.WriteObjectEnd()
-}
-
-// MarshalJSON marshals the UnsafeTest_Sub1 to JSON.
-func (x *UnsafeTest_Sub1) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub1 message from JSON.
-func (x *UnsafeTest_Sub1) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+func (this *UnsafeTest_Sub5) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub5)
+	if !ok {
+		return false
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "s":
-			s.AddField("s")
-			x.S = s.ReadString()
-		case "b":
-			s.AddField("b")
-			x.B = s.ReadBytes()
-		}
-	})
+	return this.EqualVT(that)
 }
-
-// UnmarshalJSON unmarshals the UnsafeTest_Sub1 from JSON.
-func (x *UnsafeTest_Sub1) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+func (this *UnsafeTest) EqualVT(that *UnsafeTest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Sub == nil && that.Sub != nil {
+		return false
+	} else if this.Sub != nil {
+		if that.Sub == nil {
+			return false
+		}
+		if !this.Sub.(interface{ EqualVT(isUnsafeTest_Sub) bool }).EqualVT(that.Sub) {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub2 message to JSON.
-func (x *UnsafeTest_Sub2) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+func (this *UnsafeTest) EqualMessageVT(thatMsg any) bool {
+	that, ok := thatMsg.(*UnsafeTest)
+	if !ok {
+		return false
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if len(x.S) > 0 || s.HasField("s") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("s")
-		s.WriteStringArray(x.S)
+	return this.EqualVT(that)
+}
+func (this *UnsafeTest_Sub1_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub1_)
+	if !ok {
+		return false
 	}
-	if len(x.B) > 0 || s.HasField("b") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("b")
-		s.WriteBytesArray(x.B)
+	if this == that {
+		return true
 	}
-	s.WriteObjectEnd()
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Sub1, that.Sub1, func() *UnsafeTest_Sub1 { return &UnsafeTest_Sub1{} }) {
+		return false
+	}
+	return true
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub2 to JSON.
-func (x *UnsafeTest_Sub2) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+func (this *UnsafeTest_Sub2_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub2_)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Sub2, that.Sub2, func() *UnsafeTest_Sub2 { return &UnsafeTest_Sub2{} }) {
+		return false
+	}
+	return true
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub2 message from JSON.
-func (x *UnsafeTest_Sub2) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+func (this *UnsafeTest_Sub3_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub3_)
+	if !ok {
+		return false
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "s":
-			s.AddField("s")
-			if s.ReadNil() {
-				x.S = nil
-				return
-			}
-			x.S = s.ReadStringArray()
-		case "b":
-			s.AddField("b")
-			if s.ReadNil() {
-				x.B = nil
-				return
-			}
-			x.B = s.ReadBytesArray()
-		}
-	})
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Sub3, that.Sub3, func() *UnsafeTest_Sub3 { return &UnsafeTest_Sub3{} }) {
+		return false
+	}
+	return true
 }
 
-// UnmarshalJSON unmarshals the UnsafeTest_Sub2 from JSON.
-func (x *UnsafeTest_Sub2) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+func (this *UnsafeTest_Sub4_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub4_)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !protobuf_go_lite.EqualVTImplicit(this.Sub4, that.Sub4, func() *UnsafeTest_Sub4 { return &UnsafeTest_Sub4{} }) {
+		return false
+	}
+	return true
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub3_FooEntry message to JSON.
-func (x *UnsafeTest_Sub3_FooEntry) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+func (this *UnsafeTest_Sub5_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub5_)
+	if !ok {
+		return false
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.Key != "" || s.HasField("key") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("key")
-		s.WriteString(x.Key)
+	if this == that {
+		return true
 	}
-	if len(x.Value) > 0 || s.HasField("value") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("value")
-		s.WriteBytes(x.Value)
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	s.WriteObjectEnd()
+	if !protobuf_go_lite.EqualVTImplicit(this.Sub5, that.Sub5, func() *UnsafeTest_Sub5 { return &UnsafeTest_Sub5{} }) {
+		return false
+	}
+	return true
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub3_FooEntry to JSON.
-func (x *UnsafeTest_Sub3_FooEntry) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest_Sub1) EqualVTOpts(that *UnsafeTest_Sub1, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub3_FooEntry message from JSON.
-func (x *UnsafeTest_Sub3_FooEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest_Sub1) EqualVTOptsPrefix(that *UnsafeTest_Sub1, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "key":
-			s.AddField("key")
-			x.Key = s.ReadString()
-		case "value":
-			s.AddField("value")
-			x.Value = s.ReadBytes()
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-	})
+		if this == nil {
+			this = &UnsafeTest_Sub1{}
+		}
+		if that == nil {
+			that = &UnsafeTest_Sub1{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "s"); ok && this.S != that.S {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "b"); ok && (string(this.B) != string(that.B)) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// UnmarshalJSON unmarshals the UnsafeTest_Sub3_FooEntry from JSON.
-func (x *UnsafeTest_Sub3_FooEntry) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest_Sub2) EqualVTOpts(that *UnsafeTest_Sub2, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub3 message to JSON.
-func (x *UnsafeTest_Sub3) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest_Sub2) EqualVTOptsPrefix(that *UnsafeTest_Sub2, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.Foo != nil || s.HasField("foo") {
-		s.WriteMoreIf(&wroteField)
-		s.WriteObjectField("foo")
-		s.WriteObjectStart()
-		var wroteElement bool
-		for k, v := range x.Foo {
-			s.WriteMoreIf(&wroteElement)
-			s.WriteObjectStringField(k)
-			s.WriteBytes(v)
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &UnsafeTest_Sub2{}
+		}
+		if that == nil {
+			that = &UnsafeTest_Sub2{}
 		}
-		s.WriteObjectEnd()
 	}
-	s.WriteObjectEnd()
+	if _, ok := opts.FieldPath(prefix, "s"); ok && !slices.Equal(this.S, that.S) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "b"); ok && !protobuf_go_lite.EqualBytesSlice(this.B, that.B) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub3 to JSON.
-func (x *UnsafeTest_Sub3) MarshalJSON() ([]byte, error) {
-	return json.DefaultMarshalerConfig.Marshal(x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest_Sub3) EqualVTOpts(that *UnsafeTest_Sub3, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub3 message from JSON.
-func (x *UnsafeTest_Sub3) UnmarshalProtoJSON(s *json.UnmarshalState) {
-	if s.ReadNil() {
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest_Sub3) EqualVTOptsPrefix(that *UnsafeTest_Sub3, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.ReadObject(func(key string) {
-		switch key {
-		default:
-			s.Skip() // ignore unknown field
-		case "foo":
-			s.AddField("foo")
-			if s.ReadNil() {
-				x.Foo = nil
-				return
-			}
-			x.Foo = make(map[string][]byte)
-			s.ReadStringMap(func(key string) {
-				x.Foo[key] = s.ReadBytes()
-			})
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
 		}
-	})
+		if this == nil {
+			this = &UnsafeTest_Sub3{}
+		}
+		if that == nil {
+			that = &UnsafeTest_Sub3{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "foo"); ok && !protobuf_go_lite.EqualBytesMap(this.Foo, that.Foo) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-// UnmarshalJSON unmarshals the UnsafeTest_Sub3 from JSON.
-func (x *UnsafeTest_Sub3) UnmarshalJSON(b []byte) error {
-	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest_Sub4) EqualVTOpts(that *UnsafeTest_Sub4, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub4 message to JSON.
-func (x *UnsafeTest_Sub4) MarshalProtoJSON(s *json.MarshalState) {
-	if x == nil {
-		s.WriteNil()
-		return
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest_Sub4) EqualVTOptsPrefix(that *UnsafeTest_Sub4, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
 	}
-	s.WriteObjectStart()
-	var wroteField bool
-	if x.Foo != nil {
-		switch ov := x.Foo.(type) {
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &UnsafeTest_Sub4{}
+		}
+		if that == nil {
+			that = &UnsafeTest_Sub4{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "s"); ok {
+		a, aok := this.Foo.(*UnsafeTest_Sub4_S)
+		b, bok := that.Foo.(*UnsafeTest_Sub4_S)
+		if aok != bok || aok && a.S != b.S {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "b"); ok {
+		a, aok := this.Foo.(*UnsafeTest_Sub4_B)
+		b, bok := that.Foo.(*UnsafeTest_Sub4_B)
+		if aok != bok || aok && string(a.B) != string(b.B) {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest_Sub5) EqualVTOpts(that *UnsafeTest_Sub5, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest_Sub5) EqualVTOptsPrefix(that *UnsafeTest_Sub5, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &UnsafeTest_Sub5{}
+		}
+		if that == nil {
+			that = &UnsafeTest_Sub5{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "foo"); ok && !maps.Equal(this.Foo, that.Foo) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *UnsafeTest) EqualVTOpts(that *UnsafeTest, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *UnsafeTest) EqualVTOptsPrefix(that *UnsafeTest, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &UnsafeTest{}
+		}
+		if that == nil {
+			that = &UnsafeTest{}
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "sub1"); ok {
+		a, aok := this.Sub.(*UnsafeTest_Sub1_)
+		b, bok := that.Sub.(*UnsafeTest_Sub1_)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Sub1, b.Sub1, opts, path, (*UnsafeTest_Sub1).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "sub2"); ok {
+		a, aok := this.Sub.(*UnsafeTest_Sub2_)
+		b, bok := that.Sub.(*UnsafeTest_Sub2_)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Sub2, b.Sub2, opts, path, (*UnsafeTest_Sub2).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "sub3"); ok {
+		a, aok := this.Sub.(*UnsafeTest_Sub3_)
+		b, bok := that.Sub.(*UnsafeTest_Sub3_)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Sub3, b.Sub3, opts, path, (*UnsafeTest_Sub3).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "sub4"); ok {
+		a, aok := this.Sub.(*UnsafeTest_Sub4_)
+		b, bok := that.Sub.(*UnsafeTest_Sub4_)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Sub4, b.Sub4, opts, path, (*UnsafeTest_Sub4).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "sub5"); ok {
+		a, aok := this.Sub.(*UnsafeTest_Sub5_)
+		b, bok := that.Sub.(*UnsafeTest_Sub5_)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.Sub5, b.Sub5, opts, path, (*UnsafeTest_Sub5).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest_Sub1) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest_Sub1) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest_Sub1) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if m.S != "" {
+			w.Field(1)
+			w.String(m.S)
+		}
+		if len(m.B) != 0 {
+			w.Field(2)
+			w.Bytes(m.B)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest_Sub2) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest_Sub2) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest_Sub2) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.S) != 0 {
+			w.Field(1)
+			w.Uint64(uint64(len(m.S)))
+			for _, v := range m.S {
+				w.String(v)
+			}
+		}
+		if len(m.B) != 0 {
+			w.Field(2)
+			w.Uint64(uint64(len(m.B)))
+			for _, v := range m.B {
+				w.Bytes(v)
+			}
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest_Sub3) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest_Sub3) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest_Sub3) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.Foo) != 0 {
+			w.Field(1)
+			protobuf_go_lite.HashMap(w, m.Foo, func(w *protobuf_go_lite.Hasher, k string, v []byte) {
+				w.String(k)
+				w.Bytes(v)
+			})
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest_Sub4) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest_Sub4) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest_Sub4) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		switch v := m.Foo.(type) {
 		case *UnsafeTest_Sub4_S:
-			s.WriteMoreIf(&wroteField)
-			s.WriteObjectField("s")
-			s.WriteString(ov.S)
+			w.Field(1)
+			w.String(v.S)
 		case *UnsafeTest_Sub4_B:
-			s.WriteMoreIf(&wroteField)
-			s.WriteObjectField("b")
-			s.WriteBytes(ov.B)
+			w.Field(2)
+			w.Bytes(v.B)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest_Sub5) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest_Sub5) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest_Sub5) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.Foo) != 0 {
+			w.Field(1)
+			protobuf_go_lite.HashMap(w, m.Foo, func(w *protobuf_go_lite.Hasher, k string, v string) {
+				w.String(k)
+				w.String(v)
+			})
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *UnsafeTest) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *UnsafeTest) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *UnsafeTest) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		switch v := m.Sub.(type) {
+		case *UnsafeTest_Sub1_:
+			w.Field(1)
+			v.Sub1.WriteHashVT(w)
+		case *UnsafeTest_Sub2_:
+			w.Field(2)
+			v.Sub2.WriteHashVT(w)
+		case *UnsafeTest_Sub3_:
+			w.Field(3)
+			v.Sub3.WriteHashVT(w)
+		case *UnsafeTest_Sub4_:
+			w.Field(4)
+			v.Sub4.WriteHashVT(w)
+		case *UnsafeTest_Sub5_:
+			w.Field(5)
+			v.Sub5.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// MarshalProtoJSON marshals the UnsafeTest_Sub1 message to JSON.
+func (x *UnsafeTest_Sub1) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.S != "" || s.HasField("s") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("s")
+		s.WriteString(x.S)
+	}
+	if len(x.B) > 0 || s.HasField("b") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("b")
+		s.WriteBytes(x.B)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UnsafeTest_Sub1 to JSON.
+func (x *UnsafeTest_Sub1) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub1 message from JSON.
+func (x *UnsafeTest_Sub1) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "s":
+			s.AddField("s")
+			x.S = s.ReadString()
+		case "b":
+			s.AddField("b")
+			x.B = s.ReadBytes()
 		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UnsafeTest_Sub1 from JSON.
+func (x *UnsafeTest_Sub1) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UnsafeTest_Sub2 message to JSON.
+func (x *UnsafeTest_Sub2) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.S) > 0 || s.HasField("s") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("s")
+		s.WriteStringArray(x.S)
+	}
+	if len(x.B) > 0 || s.HasField("b") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("b")
+		s.WriteBytesArray(x.B)
 	}
 	s.WriteObjectEnd()
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub4 to JSON.
-func (x *UnsafeTest_Sub4) MarshalJSON() ([]byte, error) {
+// MarshalJSON marshals the UnsafeTest_Sub2 to JSON.
+func (x *UnsafeTest_Sub2) MarshalJSON() ([]byte, error) {
 	return json.DefaultMarshalerConfig.Marshal(x)
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub4 message from JSON.
-func (x *UnsafeTest_Sub4) UnmarshalProtoJSON(s *json.UnmarshalState) {
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub2 message from JSON.
+func (x *UnsafeTest_Sub2) UnmarshalProtoJSON(s *json.UnmarshalState) {
 	if s.ReadNil() {
 		return
 	}
@@ -1000,25 +2031,29 @@ func (x *UnsafeTest_Sub4) UnmarshalProtoJSON(s *json.UnmarshalState) {
 			s.Skip() // ignore unknown field
 		case "s":
 			s.AddField("s")
-			ov := &UnsafeTest_Sub4_S{}
-			x.Foo = ov
-			ov.S = s.ReadString()
+			if s.ReadNil() {
+				x.S = nil
+				return
+			}
+			x.S = s.ReadStringArray()
 		case "b":
 			s.AddField("b")
-			ov := &UnsafeTest_Sub4_B{}
-			x.Foo = ov
-			ov.B = s.ReadBytes()
+			if s.ReadNil() {
+				x.B = nil
+				return
+			}
+			x.B = s.ReadBytesArray()
 		}
 	})
 }
 
-// UnmarshalJSON unmarshals the UnsafeTest_Sub4 from JSON.
-func (x *UnsafeTest_Sub4) UnmarshalJSON(b []byte) error {
+// UnmarshalJSON unmarshals the UnsafeTest_Sub2 from JSON.
+func (x *UnsafeTest_Sub2) UnmarshalJSON(b []byte) error {
 	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub5_FooEntry message to JSON.
-func (x *UnsafeTest_Sub5_FooEntry) MarshalProtoJSON(s *json.MarshalState) {
+// MarshalProtoJSON marshals the UnsafeTest_Sub3_FooEntry message to JSON.
+func (x *UnsafeTest_Sub3_FooEntry) MarshalProtoJSON(s *json.MarshalState) {
 	if x == nil {
 		s.WriteNil()
 		return
@@ -1030,21 +2065,21 @@ func (x *UnsafeTest_Sub5_FooEntry) MarshalProtoJSON(s *json.MarshalState) {
 		s.WriteObjectField("key")
 		s.WriteString(x.Key)
 	}
-	if x.Value != "" || s.HasField("value") {
+	if len(x.Value) > 0 || s.HasField("value") {
 		s.WriteMoreIf(&wroteField)
 		s.WriteObjectField("value")
-		s.WriteString(x.Value)
+		s.WriteBytes(x.Value)
 	}
 	s.WriteObjectEnd()
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub5_FooEntry to JSON.
-func (x *UnsafeTest_Sub5_FooEntry) MarshalJSON() ([]byte, error) {
+// MarshalJSON marshals the UnsafeTest_Sub3_FooEntry to JSON.
+func (x *UnsafeTest_Sub3_FooEntry) MarshalJSON() ([]byte, error) {
 	return json.DefaultMarshalerConfig.Marshal(x)
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub5_FooEntry message from JSON.
-func (x *UnsafeTest_Sub5_FooEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub3_FooEntry message from JSON.
+func (x *UnsafeTest_Sub3_FooEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
 	if s.ReadNil() {
 		return
 	}
@@ -1057,18 +2092,18 @@ func (x *UnsafeTest_Sub5_FooEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
 			x.Key = s.ReadString()
 		case "value":
 			s.AddField("value")
-			x.Value = s.ReadString()
+			x.Value = s.ReadBytes()
 		}
 	})
 }
 
-// UnmarshalJSON unmarshals the UnsafeTest_Sub5_FooEntry from JSON.
-func (x *UnsafeTest_Sub5_FooEntry) UnmarshalJSON(b []byte) error {
+// UnmarshalJSON unmarshals the UnsafeTest_Sub3_FooEntry from JSON.
+func (x *UnsafeTest_Sub3_FooEntry) UnmarshalJSON(b []byte) error {
 	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
-// MarshalProtoJSON marshals the UnsafeTest_Sub5 message to JSON.
-func (x *UnsafeTest_Sub5) MarshalProtoJSON(s *json.MarshalState) {
+// MarshalProtoJSON marshals the UnsafeTest_Sub3 message to JSON.
+func (x *UnsafeTest_Sub3) MarshalProtoJSON(s *json.MarshalState) {
 	if x == nil {
 		s.WriteNil()
 		return
@@ -1083,19 +2118,181 @@ func (x *UnsafeTest_Sub5) MarshalProtoJSON(s *json.MarshalState) {
 		for k, v := range x.Foo {
 			s.WriteMoreIf(&wroteElement)
 			s.WriteObjectStringField(k)
-			s.WriteString(v)
+			s.WriteBytes(v)
 		}
 		s.WriteObjectEnd()
 	}
 	s.WriteObjectEnd()
 }
 
-// MarshalJSON marshals the UnsafeTest_Sub5 to JSON.
-func (x *UnsafeTest_Sub5) MarshalJSON() ([]byte, error) {
+// MarshalJSON marshals the UnsafeTest_Sub3 to JSON.
+func (x *UnsafeTest_Sub3) MarshalJSON() ([]byte, error) {
 	return json.DefaultMarshalerConfig.Marshal(x)
 }
 
-// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub5 message from JSON.
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub3 message from JSON.
+func (x *UnsafeTest_Sub3) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "foo":
+			s.AddField("foo")
+			if s.ReadNil() {
+				x.Foo = nil
+				return
+			}
+			x.Foo = make(map[string][]byte)
+			s.ReadStringMap(func(key string) {
+				x.Foo[key] = s.ReadBytes()
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UnsafeTest_Sub3 from JSON.
+func (x *UnsafeTest_Sub3) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UnsafeTest_Sub4 message to JSON.
+func (x *UnsafeTest_Sub4) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Foo != nil {
+		switch ov := x.Foo.(type) {
+		case *UnsafeTest_Sub4_S:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("s")
+			s.WriteString(ov.S)
+		case *UnsafeTest_Sub4_B:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("b")
+			s.WriteBytes(ov.B)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UnsafeTest_Sub4 to JSON.
+func (x *UnsafeTest_Sub4) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub4 message from JSON.
+func (x *UnsafeTest_Sub4) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "s":
+			s.AddField("s")
+			ov := &UnsafeTest_Sub4_S{}
+			x.Foo = ov
+			ov.S = s.ReadString()
+		case "b":
+			s.AddField("b")
+			ov := &UnsafeTest_Sub4_B{}
+			x.Foo = ov
+			ov.B = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UnsafeTest_Sub4 from JSON.
+func (x *UnsafeTest_Sub4) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UnsafeTest_Sub5_FooEntry message to JSON.
+func (x *UnsafeTest_Sub5_FooEntry) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Key != "" || s.HasField("key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("key")
+		s.WriteString(x.Key)
+	}
+	if x.Value != "" || s.HasField("value") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("value")
+		s.WriteString(x.Value)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UnsafeTest_Sub5_FooEntry to JSON.
+func (x *UnsafeTest_Sub5_FooEntry) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub5_FooEntry message from JSON.
+func (x *UnsafeTest_Sub5_FooEntry) UnmarshalProtoJSON(s *json.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.Skip() // ignore unknown field
+		case "key":
+			s.AddField("key")
+			x.Key = s.ReadString()
+		case "value":
+			s.AddField("value")
+			x.Value = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the UnsafeTest_Sub5_FooEntry from JSON.
+func (x *UnsafeTest_Sub5_FooEntry) UnmarshalJSON(b []byte) error {
+	return json.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the UnsafeTest_Sub5 message to JSON.
+func (x *UnsafeTest_Sub5) MarshalProtoJSON(s *json.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Foo != nil || s.HasField("foo") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("foo")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Foo {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the UnsafeTest_Sub5 to JSON.
+func (x *UnsafeTest_Sub5) MarshalJSON() ([]byte, error) {
+	return json.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the UnsafeTest_Sub5 message from JSON.
 func (x *UnsafeTest_Sub5) UnmarshalProtoJSON(s *json.UnmarshalState) {
 	if s.ReadNil() {
 		return
@@ -2063,97 +3260,346 @@ func (m *UnsafeTest_Sub5_) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
-func (m *UnsafeTest_Sub1) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest_Sub1) MergeVT(src *UnsafeTest_Sub1) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeStringNonEmpty(1, m.S)
-	n += protobuf_go_lite.SizeBytesNonEmpty(1, m.B)
-	n += len(m.unknownFields)
-	return n
+	if src.S != "" {
+		m.S = src.S
+	}
+	if len(src.B) > 0 {
+		m.B = slices.Clone(src.B)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
 
-func (m *UnsafeTest_Sub2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *UnsafeTest_Sub1) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest_Sub1)
+	if !ok {
+		return false
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeStringSlice(1, m.S)
-	n += protobuf_go_lite.SizeBytesSlice(1, m.B)
-	n += len(m.unknownFields)
-	return n
+	m.MergeVT(s)
+	return true
 }
 
-func (m *UnsafeTest_Sub3) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest_Sub2) MergeVT(src *UnsafeTest_Sub2) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	for k, v := range m.Foo {
-		_ = k
-		_ = v
-		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeBytesValue(1, len(v))
-		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	m.S = append(m.S, src.S...)
+	for _, v := range src.B {
+		m.B = append(m.B, slices.Clone(v))
 	}
-	n += len(m.unknownFields)
-	return n
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
 
-func (m *UnsafeTest_Sub4) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Foo.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+func (m *UnsafeTest_Sub2) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest_Sub2)
+	if !ok {
+		return false
 	}
-	n += len(m.unknownFields)
-	return n
+	m.MergeVT(s)
+	return true
 }
 
-func (m *UnsafeTest_Sub4_S) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest_Sub3) MergeVT(src *UnsafeTest_Sub3) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeStringValue(1, m.S)
-	return n
+	if len(src.Foo) > 0 {
+		if m.Foo == nil {
+			m.Foo = make(map[string][]byte, len(src.Foo))
+		}
+		for k, v := range src.Foo {
+			m.Foo[k] = slices.Clone(v)
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
-func (m *UnsafeTest_Sub4_B) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnsafeTest_Sub3) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest_Sub3)
+	if !ok {
+		return false
 	}
-	var l int
-	_ = l
-	n += protobuf_go_lite.SizeBytesValue(1, len(m.B))
-	return n
+	m.MergeVT(s)
+	return true
 }
-func (m *UnsafeTest_Sub5) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest_Sub4) MergeVT(src *UnsafeTest_Sub4) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	for k, v := range m.Foo {
-		_ = k
-		_ = v
-		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStringValue(1, v)
-		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	switch v := src.Foo.(type) {
+	case *UnsafeTest_Sub4_S:
+		m.Foo = &UnsafeTest_Sub4_S{S: v.S}
+	case *UnsafeTest_Sub4_B:
+		m.Foo = &UnsafeTest_Sub4_B{B: slices.Clone(v.B)}
 	}
-	n += len(m.unknownFields)
-	return n
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
 }
 
-func (m *UnsafeTest) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnsafeTest_Sub4) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest_Sub4)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest_Sub5) MergeVT(src *UnsafeTest_Sub5) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.Foo) > 0 {
+		if m.Foo == nil {
+			m.Foo = make(map[string]string, len(src.Foo))
+		}
+		for k, v := range src.Foo {
+			m.Foo[k] = v
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *UnsafeTest_Sub5) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest_Sub5)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *UnsafeTest) MergeVT(src *UnsafeTest) {
+	if m == nil || src == nil {
+		return
+	}
+	switch v := src.Sub.(type) {
+	case *UnsafeTest_Sub1_:
+		if cur, ok := m.Sub.(*UnsafeTest_Sub1_); ok && cur.Sub1 != nil {
+			cur.Sub1.MergeVT(v.Sub1)
+		} else {
+			e := new(UnsafeTest_Sub1)
+			e.MergeVT(v.Sub1)
+			m.Sub = &UnsafeTest_Sub1_{Sub1: e}
+		}
+	case *UnsafeTest_Sub2_:
+		if cur, ok := m.Sub.(*UnsafeTest_Sub2_); ok && cur.Sub2 != nil {
+			cur.Sub2.MergeVT(v.Sub2)
+		} else {
+			e := new(UnsafeTest_Sub2)
+			e.MergeVT(v.Sub2)
+			m.Sub = &UnsafeTest_Sub2_{Sub2: e}
+		}
+	case *UnsafeTest_Sub3_:
+		if cur, ok := m.Sub.(*UnsafeTest_Sub3_); ok && cur.Sub3 != nil {
+			cur.Sub3.MergeVT(v.Sub3)
+		} else {
+			e := new(UnsafeTest_Sub3)
+			e.MergeVT(v.Sub3)
+			m.Sub = &UnsafeTest_Sub3_{Sub3: e}
+		}
+	case *UnsafeTest_Sub4_:
+		if cur, ok := m.Sub.(*UnsafeTest_Sub4_); ok && cur.Sub4 != nil {
+			cur.Sub4.MergeVT(v.Sub4)
+		} else {
+			e := new(UnsafeTest_Sub4)
+			e.MergeVT(v.Sub4)
+			m.Sub = &UnsafeTest_Sub4_{Sub4: e}
+		}
+	case *UnsafeTest_Sub5_:
+		if cur, ok := m.Sub.(*UnsafeTest_Sub5_); ok && cur.Sub5 != nil {
+			cur.Sub5.MergeVT(v.Sub5)
+		} else {
+			e := new(UnsafeTest_Sub5)
+			e.MergeVT(v.Sub5)
+			m.Sub = &UnsafeTest_Sub5_{Sub5: e}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *UnsafeTest) MergeMessageVT(src any) bool {
+	s, ok := src.(*UnsafeTest)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest_Sub1) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest_Sub2) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest_Sub3) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest_Sub4) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest_Sub5) RedactVT() {
+	if m == nil {
+		return
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *UnsafeTest) RedactVT() {
+	if m == nil {
+		return
+	}
+	switch v := m.Sub.(type) {
+	case *UnsafeTest_Sub1_:
+		v.Sub1.RedactVT()
+	case *UnsafeTest_Sub2_:
+		v.Sub2.RedactVT()
+	case *UnsafeTest_Sub3_:
+		v.Sub3.RedactVT()
+	case *UnsafeTest_Sub4_:
+		v.Sub4.RedactVT()
+	case *UnsafeTest_Sub5_:
+		v.Sub5.RedactVT()
+	}
+}
+
+func (m *UnsafeTest_Sub1) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringNonEmpty(1, m.S)
+	n += protobuf_go_lite.SizeBytesNonEmpty(1, m.B)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnsafeTest_Sub2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringSlice(1, m.S)
+	n += protobuf_go_lite.SizeBytesSlice(1, m.B)
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnsafeTest_Sub3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	for k, v := range m.Foo {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeBytesValue(1, len(v))
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnsafeTest_Sub4) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Foo.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnsafeTest_Sub4_S) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeStringValue(1, m.S)
+	return n
+}
+func (m *UnsafeTest_Sub4_B) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += protobuf_go_lite.SizeBytesValue(1, len(m.B))
+	return n
+}
+func (m *UnsafeTest_Sub5) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	for k, v := range m.Foo {
+		_ = k
+		_ = v
+		mapEntrySize := protobuf_go_lite.SizeStringValue(1, k) + protobuf_go_lite.SizeStringValue(1, v)
+		n += protobuf_go_lite.SizeMessage(1, mapEntrySize)
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnsafeTest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if vtmsg, ok := m.Sub.(interface{ SizeVT() int }); ok {
@@ -2363,56 +3809,1114 @@ func (x *UnsafeTest_Sub5) MarshalProtoText() string {
 	return protobuf_go_lite.TextFinishMessage(&sb)
 }
 
-func (x *UnsafeTest_Sub5) String() string {
-	return x.MarshalProtoText()
-}
-func (x *UnsafeTest) MarshalProtoText() string {
-	var sb protobuf_go_lite.TextBuilder
-	initialLen := protobuf_go_lite.TextStartMessage(&sb, "UnsafeTest")
-	switch body := x.Sub.(type) {
-	case *UnsafeTest_Sub1_:
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub1")
-		if body.Sub1 == nil {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub1{})
-		} else {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub1)
+func (x *UnsafeTest_Sub5) String() string {
+	return x.MarshalProtoText()
+}
+func (x *UnsafeTest) MarshalProtoText() string {
+	var sb protobuf_go_lite.TextBuilder
+	initialLen := protobuf_go_lite.TextStartMessage(&sb, "UnsafeTest")
+	switch body := x.Sub.(type) {
+	case *UnsafeTest_Sub1_:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub1")
+		if body.Sub1 == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub1{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub1)
+		}
+	case *UnsafeTest_Sub2_:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub2")
+		if body.Sub2 == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub2{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub2)
+		}
+	case *UnsafeTest_Sub3_:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub3")
+		if body.Sub3 == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub3{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub3)
+		}
+	case *UnsafeTest_Sub4_:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub4")
+		if body.Sub4 == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub4{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub4)
+		}
+	case *UnsafeTest_Sub5_:
+		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub5")
+		if body.Sub5 == nil {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub5{})
+		} else {
+			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub5)
+		}
+	}
+	return protobuf_go_lite.TextFinishMessage(&sb)
+}
+
+func (x *UnsafeTest) String() string {
+	return x.MarshalProtoText()
+}
+func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.S = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			m.B, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.B, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeTest_Sub2) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.S = append(m.S, v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.B = append(m.B, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Foo == nil {
+				m.Foo = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Foo[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeTest_Sub4) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub4: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub4: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Foo = &UnsafeTest_Sub4_S{S: v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Foo = &UnsafeTest_Sub4_B{B: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub5: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub5: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Foo == nil {
+				m.Foo = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Foo[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub1", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub1_); ok {
+				if err := oneof.Sub1.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub1{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub1_{Sub1: v}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub2", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub2_); ok {
+				if err := oneof.Sub2.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub2{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub2_{Sub2: v}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub3", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub3_); ok {
+				if err := oneof.Sub3.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub3{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub3_{Sub3: v}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub4", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub4_); ok {
+				if err := oneof.Sub4.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub4{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub4_{Sub4: v}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub5", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub5_); ok {
+				if err := oneof.Sub5.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub5{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub5_{Sub5: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub1) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.S = v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			m.B, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.B, dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub2) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.S = append(m.S, v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.B = append(m.B, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub3) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Foo == nil {
+				m.Foo = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Foo[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub4) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub4: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub4: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Foo = &UnsafeTest_Sub4_S{S: v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v []byte
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			if err != nil {
+				return err
+			}
+			m.Foo = &UnsafeTest_Sub4_B{B: v}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub5) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub5: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub5: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Foo == nil {
+				m.Foo = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Foo[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
 		}
-	case *UnsafeTest_Sub2_:
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub2")
-		if body.Sub2 == nil {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub2{})
-		} else {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub2)
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest: wiretype end group for non-group")
 		}
-	case *UnsafeTest_Sub3_:
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub3")
-		if body.Sub3 == nil {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub3{})
-		} else {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub3)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	case *UnsafeTest_Sub4_:
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub4")
-		if body.Sub4 == nil {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub4{})
-		} else {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub4)
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
 		}
-	case *UnsafeTest_Sub5_:
-		protobuf_go_lite.TextWriteFieldPrefix(&sb, initialLen, "sub5")
-		if body.Sub5 == nil {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, &UnsafeTest_Sub5{})
-		} else {
-			protobuf_go_lite.TextWriteTextMarshaler(&sb, body.Sub5)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub1", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub1_); ok {
+				if err := oneof.Sub1.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub1{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub1_{Sub1: v}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub2", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub2_); ok {
+				if err := oneof.Sub2.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub2{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub2_{Sub2: v}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub3", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub3_); ok {
+				if err := oneof.Sub3.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub3{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub3_{Sub3: v}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub4", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub4_); ok {
+				if err := oneof.Sub4.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub4{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub4_{Sub4: v}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub5", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub5_); ok {
+				if err := oneof.Sub5.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub5{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub5_{Sub5: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	return protobuf_go_lite.TextFinishMessage(&sb)
-}
 
-func (x *UnsafeTest) String() string {
-	return x.MarshalProtoText()
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub1) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2431,13 +4935,20 @@ func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest_Sub1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -2446,7 +4957,7 @@ func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
 			}
-			m.B, iNdEx, err = protobuf_go_lite.DecodeBytesAppend(m.B, dAtA, iNdEx)
+			m.B, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -2472,7 +4983,11 @@ func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsafeTest_Sub2) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub2) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2491,13 +5006,20 @@ func (m *UnsafeTest_Sub2) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest_Sub2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -2507,7 +5029,7 @@ func (m *UnsafeTest_Sub2) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
 			}
 			var v []byte
-			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -2534,7 +5056,11 @@ func (m *UnsafeTest_Sub2) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub3) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2553,6 +5079,13 @@ func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest_Sub3: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
@@ -2577,12 +5110,12 @@ func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 					if err != nil {
 						return err
 					}
 				} else if fieldNum == 2 {
-					mapvalue, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 					if err != nil {
 						return err
 					}
@@ -2618,7 +5151,11 @@ func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsafeTest_Sub4) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub4) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2637,13 +5174,20 @@ func (m *UnsafeTest_Sub4) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest_Sub4: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
 			var v string
-			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 			if err != nil {
 				return err
 			}
@@ -2653,7 +5197,7 @@ func (m *UnsafeTest_Sub4) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
 			}
 			var v []byte
-			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, true)
+			v, iNdEx, err = protobuf_go_lite.DecodeBytes(dAtA, iNdEx, false)
 			if err != nil {
 				return err
 			}
@@ -2680,7 +5224,11 @@ func (m *UnsafeTest_Sub4) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest_Sub5) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2699,6 +5247,13 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest_Sub5: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
@@ -2723,12 +5278,12 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 					if err != nil {
 						return err
 					}
 				} else if fieldNum == 2 {
-					mapvalue, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					mapvalue, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
 					if err != nil {
 						return err
 					}
@@ -2764,7 +5319,11 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *UnsafeTest) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
 	l := len(dAtA)
 	iNdEx := 0
 	var err error
@@ -2783,6 +5342,13 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: UnsafeTest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
@@ -2793,12 +5359,12 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			if oneof, ok := m.Sub.(*UnsafeTest_Sub1_); ok {
-				if err := oneof.Sub1.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := oneof.Sub1.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 			} else {
 				v := &UnsafeTest_Sub1{}
-				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 				m.Sub = &UnsafeTest_Sub1_{Sub1: v}
@@ -2813,12 +5379,12 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			if oneof, ok := m.Sub.(*UnsafeTest_Sub2_); ok {
-				if err := oneof.Sub2.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := oneof.Sub2.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 			} else {
 				v := &UnsafeTest_Sub2{}
-				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 				m.Sub = &UnsafeTest_Sub2_{Sub2: v}
@@ -2833,12 +5399,12 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			if oneof, ok := m.Sub.(*UnsafeTest_Sub3_); ok {
-				if err := oneof.Sub3.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := oneof.Sub3.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 			} else {
 				v := &UnsafeTest_Sub3{}
-				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 				m.Sub = &UnsafeTest_Sub3_{Sub3: v}
@@ -2853,12 +5419,12 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			if oneof, ok := m.Sub.(*UnsafeTest_Sub4_); ok {
-				if err := oneof.Sub4.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := oneof.Sub4.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 			} else {
 				v := &UnsafeTest_Sub4{}
-				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 				m.Sub = &UnsafeTest_Sub4_{Sub4: v}
@@ -2873,12 +5439,12 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			if oneof, ok := m.Sub.(*UnsafeTest_Sub5_); ok {
-				if err := oneof.Sub5.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := oneof.Sub5.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 			} else {
 				v := &UnsafeTest_Sub5{}
-				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
 					return err
 				}
 				m.Sub = &UnsafeTest_Sub5_{Sub5: v}
@@ -2906,6 +5472,7 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
 func (m *UnsafeTest_Sub1) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,11 @@
 package wkt
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	slices "slices"
 
@@ -46,6 +50,45 @@ func (x *MessageWithWKT) Reset() {
 
 func (*MessageWithWKT) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *MessageWithWKT) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *MessageWithWKT) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *MessageWithWKT) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Any.DiscardUnknownVT()
+	x.Duration.DiscardUnknownVT()
+	x.Empty.DiscardUnknownVT()
+	x.Timestamp.DiscardUnknownVT()
+	x.DoubleValue.DiscardUnknownVT()
+	x.FloatValue.DiscardUnknownVT()
+	x.Int64Value.DiscardUnknownVT()
+	x.Uint64Value.DiscardUnknownVT()
+	x.Int32Value.DiscardUnknownVT()
+	x.Uint32Value.DiscardUnknownVT()
+	x.BoolValue.DiscardUnknownVT()
+	x.StringValue.DiscardUnknownVT()
+	x.BytesValue.DiscardUnknownVT()
+	x.StructValue.DiscardUnknownVT()
+	x.ValueValue.DiscardUnknownVT()
+	x.ListvalueValue.DiscardUnknownVT()
+}
+
 func (x *MessageWithWKT) GetAny() *anypb.Any {
 	if x != nil {
 		return x.Any
@@ -197,6 +240,144 @@ func (m *MessageWithWKT) CloneMessageVT() protobuf_go_lite.CloneMessage {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *MessageWithWKT) CompareVT(that *MessageWithWKT) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := m.Any.CompareVT(that.Any); c != 0 {
+		return c
+	}
+	if c := m.Duration.CompareVT(that.Duration); c != 0 {
+		return c
+	}
+	if c := m.Empty.CompareVT(that.Empty); c != 0 {
+		return c
+	}
+	if c := m.Timestamp.CompareVT(that.Timestamp); c != 0 {
+		return c
+	}
+	if c := m.DoubleValue.CompareVT(that.DoubleValue); c != 0 {
+		return c
+	}
+	if c := m.FloatValue.CompareVT(that.FloatValue); c != 0 {
+		return c
+	}
+	if c := m.Int64Value.CompareVT(that.Int64Value); c != 0 {
+		return c
+	}
+	if c := m.Uint64Value.CompareVT(that.Uint64Value); c != 0 {
+		return c
+	}
+	if c := m.Int32Value.CompareVT(that.Int32Value); c != 0 {
+		return c
+	}
+	if c := m.Uint32Value.CompareVT(that.Uint32Value); c != 0 {
+		return c
+	}
+	if c := m.BoolValue.CompareVT(that.BoolValue); c != 0 {
+		return c
+	}
+	if c := m.StringValue.CompareVT(that.StringValue); c != 0 {
+		return c
+	}
+	if c := m.BytesValue.CompareVT(that.BytesValue); c != 0 {
+		return c
+	}
+	if c := m.StructValue.CompareVT(that.StructValue); c != 0 {
+		return c
+	}
+	if c := m.ValueValue.CompareVT(that.ValueValue); c != 0 {
+		return c
+	}
+	if c := m.ListvalueValue.CompareVT(that.ListvalueValue); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(m.NullValue, that.NullValue); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *MessageWithWKT) CopyVT(dst *MessageWithWKT) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Any = protobuf_go_lite.CopyVTValue(dst.Any, m.Any, (*anypb.Any).CopyVT)
+	dst.Duration = protobuf_go_lite.CopyVTValue(dst.Duration, m.Duration, (*durationpb.Duration).CopyVT)
+	dst.Empty = protobuf_go_lite.CopyVTValue(dst.Empty, m.Empty, (*emptypb.Empty).CopyVT)
+	dst.Timestamp = protobuf_go_lite.CopyVTValue(dst.Timestamp, m.Timestamp, (*timestamppb.Timestamp).CopyVT)
+	dst.DoubleValue = protobuf_go_lite.CopyVTValue(dst.DoubleValue, m.DoubleValue, (*wrapperspb.DoubleValue).CopyVT)
+	dst.FloatValue = protobuf_go_lite.CopyVTValue(dst.FloatValue, m.FloatValue, (*wrapperspb.FloatValue).CopyVT)
+	dst.Int64Value = protobuf_go_lite.CopyVTValue(dst.Int64Value, m.Int64Value, (*wrapperspb.Int64Value).CopyVT)
+	dst.Uint64Value = protobuf_go_lite.CopyVTValue(dst.Uint64Value, m.Uint64Value, (*wrapperspb.UInt64Value).CopyVT)
+	dst.Int32Value = protobuf_go_lite.CopyVTValue(dst.Int32Value, m.Int32Value, (*wrapperspb.Int32Value).CopyVT)
+	dst.Uint32Value = protobuf_go_lite.CopyVTValue(dst.Uint32Value, m.Uint32Value, (*wrapperspb.UInt32Value).CopyVT)
+	dst.BoolValue = protobuf_go_lite.CopyVTValue(dst.BoolValue, m.BoolValue, (*wrapperspb.BoolValue).CopyVT)
+	dst.StringValue = protobuf_go_lite.CopyVTValue(dst.StringValue, m.StringValue, (*wrapperspb.StringValue).CopyVT)
+	dst.BytesValue = protobuf_go_lite.CopyVTValue(dst.BytesValue, m.BytesValue, (*wrapperspb.BytesValue).CopyVT)
+	dst.StructValue = protobuf_go_lite.CopyVTValue(dst.StructValue, m.StructValue, (*structpb.Struct).CopyVT)
+	dst.ValueValue = protobuf_go_lite.CopyVTValue(dst.ValueValue, m.ValueValue, (*structpb.Value).CopyVT)
+	dst.ListvalueValue = protobuf_go_lite.CopyVTValue(dst.ListvalueValue, m.ListvalueValue, (*structpb.ListValue).CopyVT)
+	dst.NullValue = m.NullValue
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *MessageWithWKT) DiffVT(that *MessageWithWKT) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *MessageWithWKT) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *MessageWithWKT) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &MessageWithWKT{}
+	}
+	if that == nil {
+		that = &MessageWithWKT{}
+	}
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "any", m.Any, that.Any, (*anypb.Any).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "duration", m.Duration, that.Duration, (*durationpb.Duration).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "empty", m.Empty, that.Empty, (*emptypb.Empty).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "timestamp", m.Timestamp, that.Timestamp, (*timestamppb.Timestamp).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "double_value", m.DoubleValue, that.DoubleValue, (*wrapperspb.DoubleValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "float_value", m.FloatValue, that.FloatValue, (*wrapperspb.FloatValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "int64_value", m.Int64Value, that.Int64Value, (*wrapperspb.Int64Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "uint64_value", m.Uint64Value, that.Uint64Value, (*wrapperspb.UInt64Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "int32_value", m.Int32Value, that.Int32Value, (*wrapperspb.Int32Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "uint32_value", m.Uint32Value, that.Uint32Value, (*wrapperspb.UInt32Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "bool_value", m.BoolValue, that.BoolValue, (*wrapperspb.BoolValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "string_value", m.StringValue, that.StringValue, (*wrapperspb.StringValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "bytes_value", m.BytesValue, that.BytesValue, (*wrapperspb.BytesValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "struct_value", m.StructValue, that.StructValue, (*structpb.Struct).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "value_value", m.ValueValue, that.ValueValue, (*structpb.Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "listvalue_value", m.ListvalueValue, that.ListvalueValue, (*structpb.ListValue).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiff(diffs, prefix, "null_value", m.NullValue, that.NullValue)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *MessageWithWKT) EqualVT(that *MessageWithWKT) bool {
 	if this == that {
 		return true
@@ -265,6 +446,175 @@ func (this *MessageWithWKT) EqualMessageVT(thatMsg any) bool {
 	return this.EqualVT(that)
 }
 
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *MessageWithWKT) EqualVTOpts(that *MessageWithWKT, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *MessageWithWKT) EqualVTOptsPrefix(that *MessageWithWKT, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &MessageWithWKT{}
+		}
+		if that == nil {
+			that = &MessageWithWKT{}
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "any"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Any, that.Any, opts, path, (*anypb.Any).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "duration"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Duration, that.Duration, opts, path, (*durationpb.Duration).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "empty"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Empty, that.Empty, opts, path, (*emptypb.Empty).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "timestamp"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Timestamp, that.Timestamp, opts, path, (*timestamppb.Timestamp).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "double_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.DoubleValue, that.DoubleValue, opts, path, (*wrapperspb.DoubleValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "float_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.FloatValue, that.FloatValue, opts, path, (*wrapperspb.FloatValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "int64_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Int64Value, that.Int64Value, opts, path, (*wrapperspb.Int64Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "uint64_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Uint64Value, that.Uint64Value, opts, path, (*wrapperspb.UInt64Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "int32_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Int32Value, that.Int32Value, opts, path, (*wrapperspb.Int32Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "uint32_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.Uint32Value, that.Uint32Value, opts, path, (*wrapperspb.UInt32Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bool_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BoolValue, that.BoolValue, opts, path, (*wrapperspb.BoolValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "string_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.StringValue, that.StringValue, opts, path, (*wrapperspb.StringValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "bytes_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.BytesValue, that.BytesValue, opts, path, (*wrapperspb.BytesValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "struct_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.StructValue, that.StructValue, opts, path, (*structpb.Struct).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "value_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.ValueValue, that.ValueValue, opts, path, (*structpb.Value).EqualVTOptsPrefix) {
+		return false
+	}
+	if path, ok := opts.FieldPath(prefix, "listvalue_value"); ok && !protobuf_go_lite.EqualVTOptsValue(this.ListvalueValue, that.ListvalueValue, opts, path, (*structpb.ListValue).EqualVTOptsPrefix) {
+		return false
+	}
+	if _, ok := opts.FieldPath(prefix, "null_value"); ok && this.NullValue != that.NullValue {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *MessageWithWKT) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *MessageWithWKT) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *MessageWithWKT) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if v := m.Any; v != nil {
+			w.Field(1)
+			v.WriteHashVT(w)
+		}
+		if v := m.Duration; v != nil {
+			w.Field(2)
+			v.WriteHashVT(w)
+		}
+		if v := m.Empty; v != nil {
+			w.Field(3)
+			v.WriteHashVT(w)
+		}
+		if v := m.Timestamp; v != nil {
+			w.Field(5)
+			v.WriteHashVT(w)
+		}
+		if v := m.DoubleValue; v != nil {
+			w.Field(6)
+			v.WriteHashVT(w)
+		}
+		if v := m.FloatValue; v != nil {
+			w.Field(7)
+			v.WriteHashVT(w)
+		}
+		if v := m.Int64Value; v != nil {
+			w.Field(8)
+			v.WriteHashVT(w)
+		}
+		if v := m.Uint64Value; v != nil {
+			w.Field(9)
+			v.WriteHashVT(w)
+		}
+		if v := m.Int32Value; v != nil {
+			w.Field(10)
+			v.WriteHashVT(w)
+		}
+		if v := m.Uint32Value; v != nil {
+			w.Field(11)
+			v.WriteHashVT(w)
+		}
+		if v := m.BoolValue; v != nil {
+			w.Field(12)
+			v.WriteHashVT(w)
+		}
+		if v := m.StringValue; v != nil {
+			w.Field(13)
+			v.WriteHashVT(w)
+		}
+		if v := m.BytesValue; v != nil {
+			w.Field(14)
+			v.WriteHashVT(w)
+		}
+		if v := m.StructValue; v != nil {
+			w.Field(15)
+			v.WriteHashVT(w)
+		}
+		if v := m.ValueValue; v != nil {
+			w.Field(16)
+			v.WriteHashVT(w)
+		}
+		if v := m.ListvalueValue; v != nil {
+			w.Field(17)
+			v.WriteHashVT(w)
+		}
+		if m.NullValue != 0 {
+			w.Field(18)
+			w.Uint64(uint64(m.NullValue))
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 // MarshalProtoJSON marshals the MessageWithWKT message to JSON.
 func (x *MessageWithWKT) MarshalProtoJSON(s *json.MarshalState) {
 	if x == nil {
@@ -905,6 +1255,148 @@ func (m *MessageWithWKT) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *MessageWithWKT) MergeVT(src *MessageWithWKT) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Any != nil {
+		if m.Any == nil {
+			m.Any = new(anypb.Any)
+		}
+		m.Any.MergeVT(src.Any)
+	}
+	if src.Duration != nil {
+		if m.Duration == nil {
+			m.Duration = new(durationpb.Duration)
+		}
+		m.Duration.MergeVT(src.Duration)
+	}
+	if src.Empty != nil {
+		if m.Empty == nil {
+			m.Empty = new(emptypb.Empty)
+		}
+		m.Empty.MergeVT(src.Empty)
+	}
+	if src.Timestamp != nil {
+		if m.Timestamp == nil {
+			m.Timestamp = new(timestamppb.Timestamp)
+		}
+		m.Timestamp.MergeVT(src.Timestamp)
+	}
+	if src.DoubleValue != nil {
+		if m.DoubleValue == nil {
+			m.DoubleValue = new(wrapperspb.DoubleValue)
+		}
+		m.DoubleValue.MergeVT(src.DoubleValue)
+	}
+	if src.FloatValue != nil {
+		if m.FloatValue == nil {
+			m.FloatValue = new(wrapperspb.FloatValue)
+		}
+		m.FloatValue.MergeVT(src.FloatValue)
+	}
+	if src.Int64Value != nil {
+		if m.Int64Value == nil {
+			m.Int64Value = new(wrapperspb.Int64Value)
+		}
+		m.Int64Value.MergeVT(src.Int64Value)
+	}
+	if src.Uint64Value != nil {
+		if m.Uint64Value == nil {
+			m.Uint64Value = new(wrapperspb.UInt64Value)
+		}
+		m.Uint64Value.MergeVT(src.Uint64Value)
+	}
+	if src.Int32Value != nil {
+		if m.Int32Value == nil {
+			m.Int32Value = new(wrapperspb.Int32Value)
+		}
+		m.Int32Value.MergeVT(src.Int32Value)
+	}
+	if src.Uint32Value != nil {
+		if m.Uint32Value == nil {
+			m.Uint32Value = new(wrapperspb.UInt32Value)
+		}
+		m.Uint32Value.MergeVT(src.Uint32Value)
+	}
+	if src.BoolValue != nil {
+		if m.BoolValue == nil {
+			m.BoolValue = new(wrapperspb.BoolValue)
+		}
+		m.BoolValue.MergeVT(src.BoolValue)
+	}
+	if src.StringValue != nil {
+		if m.StringValue == nil {
+			m.StringValue = new(wrapperspb.StringValue)
+		}
+		m.StringValue.MergeVT(src.StringValue)
+	}
+	if src.BytesValue != nil {
+		if m.BytesValue == nil {
+			m.BytesValue = new(wrapperspb.BytesValue)
+		}
+		m.BytesValue.MergeVT(src.BytesValue)
+	}
+	if src.StructValue != nil {
+		if m.StructValue == nil {
+			m.StructValue = new(structpb.Struct)
+		}
+		m.StructValue.MergeVT(src.StructValue)
+	}
+	if src.ValueValue != nil {
+		if m.ValueValue == nil {
+			m.ValueValue = new(structpb.Value)
+		}
+		m.ValueValue.MergeVT(src.ValueValue)
+	}
+	if src.ListvalueValue != nil {
+		if m.ListvalueValue == nil {
+			m.ListvalueValue = new(structpb.ListValue)
+		}
+		m.ListvalueValue.MergeVT(src.ListvalueValue)
+	}
+	if src.NullValue != 0 {
+		m.NullValue = src.NullValue
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MessageWithWKT) MergeMessageVT(src any) bool {
+	s, ok := src.(*MessageWithWKT)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *MessageWithWKT) RedactVT() {
+	if m == nil {
+		return
+	}
+	m.Any.RedactVT()
+	m.Duration.RedactVT()
+	m.Empty.RedactVT()
+	m.Timestamp.RedactVT()
+	m.DoubleValue.RedactVT()
+	m.FloatValue.RedactVT()
+	m.Int64Value.RedactVT()
+	m.Uint64Value.RedactVT()
+	m.Int32Value.RedactVT()
+	m.Uint32Value.RedactVT()
+	m.BoolValue.RedactVT()
+	m.StringValue.RedactVT()
+	m.BytesValue.RedactVT()
+	m.StructValue.RedactVT()
+	m.ValueValue.RedactVT()
+	m.ListvalueValue.RedactVT()
+}
+
 func (m *MessageWithWKT) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1350,6 +1842,615 @@ func (m *MessageWithWKT) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *MessageWithWKT) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageWithWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageWithWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Any == nil {
+				m.Any = &anypb.Any{}
+			}
+			if err := m.Any.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Empty == nil {
+				m.Empty = &emptypb.Empty{}
+			}
+			if err := m.Empty.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StructValue == nil {
+				m.StructValue = &structpb.Struct{}
+			}
+			if err := m.StructValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ValueValue == nil {
+				m.ValueValue = &structpb.Value{}
+			}
+			if err := m.ValueValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListvalueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ListvalueValue == nil {
+				m.ListvalueValue = &structpb.ListValue{}
+			}
+			if err := m.ListvalueValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullValue", wireType)
+			}
+			m.NullValue = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.NullValue = structpb.NullValue(_v)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *MessageWithWKT) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageWithWKT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageWithWKT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Any == nil {
+				m.Any = &anypb.Any{}
+			}
+			if err := m.Any.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Duration == nil {
+				m.Duration = &durationpb.Duration{}
+			}
+			if err := m.Duration.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Empty == nil {
+				m.Empty = &emptypb.Empty{}
+			}
+			if err := m.Empty.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &timestamppb.Timestamp{}
+			}
+			if err := m.Timestamp.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.DoubleValue == nil {
+				m.DoubleValue = &wrapperspb.DoubleValue{}
+			}
+			if err := m.DoubleValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.FloatValue == nil {
+				m.FloatValue = &wrapperspb.FloatValue{}
+			}
+			if err := m.FloatValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int64Value == nil {
+				m.Int64Value = &wrapperspb.Int64Value{}
+			}
+			if err := m.Int64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint64Value == nil {
+				m.Uint64Value = &wrapperspb.UInt64Value{}
+			}
+			if err := m.Uint64Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Int32Value == nil {
+				m.Int32Value = &wrapperspb.Int32Value{}
+			}
+			if err := m.Int32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Value", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.Uint32Value == nil {
+				m.Uint32Value = &wrapperspb.UInt32Value{}
+			}
+			if err := m.Uint32Value.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BoolValue == nil {
+				m.BoolValue = &wrapperspb.BoolValue{}
+			}
+			if err := m.BoolValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StringValue == nil {
+				m.StringValue = &wrapperspb.StringValue{}
+			}
+			if err := m.StringValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.BytesValue == nil {
+				m.BytesValue = &wrapperspb.BytesValue{}
+			}
+			if err := m.BytesValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.StructValue == nil {
+				m.StructValue = &structpb.Struct{}
+			}
+			if err := m.StructValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ValueValue == nil {
+				m.ValueValue = &structpb.Value{}
+			}
+			if err := m.ValueValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListvalueValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if m.ListvalueValue == nil {
+				m.ListvalueValue = &structpb.ListValue{}
+			}
+			if err := m.ListvalueValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullValue", wireType)
+			}
+			m.NullValue = 0
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			m.NullValue = structpb.NullValue(_v)
+			if err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *MessageWithWKT) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
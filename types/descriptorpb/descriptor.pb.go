@@ -7,6 +7,7 @@ package descriptorpb
 import (
 	fmt "fmt"
 	io "io"
+	iter "iter"
 	math "math"
 	slices "slices"
 	strconv "strconv"
@@ -826,6 +827,32 @@ func (x *FileDescriptorSet) Reset() {
 
 func (*FileDescriptorSet) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FileDescriptorSet) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FileDescriptorSet) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FileDescriptorSet) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.File {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *FileDescriptorSet) GetFile() []*FileDescriptorProto {
 	if x != nil {
 		return x.File
@@ -871,6 +898,43 @@ func (x *FileDescriptorProto) Reset() {
 
 func (*FileDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FileDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FileDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FileDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.MessageType {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.EnumType {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Service {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Extension {
+		v.DiscardUnknownVT()
+	}
+	x.Options.DiscardUnknownVT()
+	x.SourceCodeInfo.DiscardUnknownVT()
+}
+
 func (x *FileDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -985,6 +1049,51 @@ func (x *DescriptorProto) Reset() {
 
 func (*DescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *DescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *DescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *DescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Field {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Extension {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.NestedType {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.EnumType {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.ExtensionRange {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.OneofDecl {
+		v.DiscardUnknownVT()
+	}
+	x.Options.DiscardUnknownVT()
+	for _, v := range x.ReservedRange {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *DescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1082,6 +1191,36 @@ func (x *ExtensionRangeOptions) Reset() {
 
 func (*ExtensionRangeOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *ExtensionRangeOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *ExtensionRangeOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *ExtensionRangeOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.Declaration {
+		v.DiscardUnknownVT()
+	}
+	x.Features.DiscardUnknownVT()
+}
+
 func (x *ExtensionRangeOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if x != nil {
 		return x.UninterpretedOption
@@ -1172,6 +1311,30 @@ func (x *FieldDescriptorProto) Reset() {
 
 func (*FieldDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FieldDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FieldDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FieldDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *FieldDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1262,6 +1425,30 @@ func (x *OneofDescriptorProto) Reset() {
 
 func (*OneofDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *OneofDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *OneofDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *OneofDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *OneofDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1297,6 +1484,36 @@ func (x *EnumDescriptorProto) Reset() {
 
 func (*EnumDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Value {
+		v.DiscardUnknownVT()
+	}
+	x.Options.DiscardUnknownVT()
+	for _, v := range x.ReservedRange {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *EnumDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1346,6 +1563,30 @@ func (x *EnumValueDescriptorProto) Reset() {
 
 func (*EnumValueDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumValueDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumValueDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumValueDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *EnumValueDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1381,6 +1622,33 @@ func (x *ServiceDescriptorProto) Reset() {
 
 func (*ServiceDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *ServiceDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *ServiceDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *ServiceDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Method {
+		v.DiscardUnknownVT()
+	}
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *ServiceDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1429,6 +1697,30 @@ func (x *MethodDescriptorProto) Reset() {
 
 func (*MethodDescriptorProto) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *MethodDescriptorProto) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *MethodDescriptorProto) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *MethodDescriptorProto) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *MethodDescriptorProto) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1580,6 +1872,33 @@ func (x *FileOptions) Reset() {
 
 func (*FileOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FileOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FileOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FileOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *FileOptions) GetJavaPackage() string {
 	if x != nil && x.JavaPackage != nil {
 		return *x.JavaPackage
@@ -1817,6 +2136,33 @@ func (x *MessageOptions) Reset() {
 
 func (*MessageOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *MessageOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *MessageOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *MessageOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *MessageOptions) GetMessageSetWireFormat() bool {
 	if x != nil && x.MessageSetWireFormat != nil {
 		return *x.MessageSetWireFormat
@@ -1959,6 +2305,36 @@ func (x *FieldOptions) Reset() {
 
 func (*FieldOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FieldOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FieldOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FieldOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.EditionDefaults {
+		v.DiscardUnknownVT()
+	}
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *FieldOptions) GetCtype() FieldOptions_CType {
 	if x != nil && x.Ctype != nil {
 		return *x.Ctype
@@ -2064,6 +2440,33 @@ func (x *OneofOptions) Reset() {
 
 func (*OneofOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *OneofOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *OneofOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *OneofOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *OneofOptions) GetFeatures() *FeatureSet {
 	if x != nil {
 		return x.Features
@@ -2114,6 +2517,33 @@ func (x *EnumOptions) Reset() {
 
 func (*EnumOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *EnumOptions) GetAllowAlias() bool {
 	if x != nil && x.AllowAlias != nil {
 		return *x.AllowAlias
@@ -2179,6 +2609,33 @@ func (x *EnumValueOptions) Reset() {
 
 func (*EnumValueOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumValueOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumValueOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumValueOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *EnumValueOptions) GetDeprecated() bool {
 	if x != nil && x.Deprecated != nil {
 		return *x.Deprecated
@@ -2231,6 +2688,33 @@ func (x *ServiceOptions) Reset() {
 
 func (*ServiceOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *ServiceOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *ServiceOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *ServiceOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *ServiceOptions) GetFeatures() *FeatureSet {
 	if x != nil {
 		return x.Features
@@ -2278,6 +2762,33 @@ func (x *MethodOptions) Reset() {
 
 func (*MethodOptions) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *MethodOptions) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *MethodOptions) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *MethodOptions) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+	for _, v := range x.UninterpretedOption {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *MethodOptions) GetDeprecated() bool {
 	if x != nil && x.Deprecated != nil {
 		return *x.Deprecated
@@ -2331,6 +2842,32 @@ func (x *UninterpretedOption) Reset() {
 
 func (*UninterpretedOption) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UninterpretedOption) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UninterpretedOption) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UninterpretedOption) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Name {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *UninterpretedOption) GetName() []*UninterpretedOption_NamePart {
 	if x != nil {
 		return x.Name
@@ -2402,6 +2939,29 @@ func (x *FeatureSet) Reset() {
 
 func (*FeatureSet) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FeatureSet) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FeatureSet) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FeatureSet) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *FeatureSet) GetFieldPresence() FeatureSet_FieldPresence {
 	if x != nil && x.FieldPresence != nil {
 		return *x.FieldPresence
@@ -2465,6 +3025,32 @@ func (x *FeatureSetDefaults) Reset() {
 
 func (*FeatureSetDefaults) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FeatureSetDefaults) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FeatureSetDefaults) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FeatureSetDefaults) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Defaults {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *FeatureSetDefaults) GetDefaults() []*FeatureSetDefaults_FeatureSetEditionDefault {
 	if x != nil {
 		return x.Defaults
@@ -2547,6 +3133,32 @@ func (x *SourceCodeInfo) Reset() {
 
 func (*SourceCodeInfo) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SourceCodeInfo) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SourceCodeInfo) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SourceCodeInfo) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Location {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *SourceCodeInfo) GetLocation() []*SourceCodeInfo_Location {
 	if x != nil {
 		return x.Location
@@ -2570,6 +3182,32 @@ func (x *GeneratedCodeInfo) Reset() {
 
 func (*GeneratedCodeInfo) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *GeneratedCodeInfo) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *GeneratedCodeInfo) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *GeneratedCodeInfo) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Annotation {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *GeneratedCodeInfo) GetAnnotation() []*GeneratedCodeInfo_Annotation {
 	if x != nil {
 		return x.Annotation
@@ -2590,6 +3228,30 @@ func (x *DescriptorProto_ExtensionRange) Reset() {
 
 func (*DescriptorProto_ExtensionRange) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *DescriptorProto_ExtensionRange) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *DescriptorProto_ExtensionRange) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *DescriptorProto_ExtensionRange) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Options.DiscardUnknownVT()
+}
+
 func (x *DescriptorProto_ExtensionRange) GetStart() int32 {
 	if x != nil && x.Start != nil {
 		return *x.Start
@@ -2626,6 +3288,29 @@ func (x *DescriptorProto_ReservedRange) Reset() {
 
 func (*DescriptorProto_ReservedRange) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *DescriptorProto_ReservedRange) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *DescriptorProto_ReservedRange) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *DescriptorProto_ReservedRange) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *DescriptorProto_ReservedRange) GetStart() int32 {
 	if x != nil && x.Start != nil {
 		return *x.Start
@@ -2666,6 +3351,29 @@ func (x *ExtensionRangeOptions_Declaration) Reset() {
 
 func (*ExtensionRangeOptions_Declaration) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *ExtensionRangeOptions_Declaration) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *ExtensionRangeOptions_Declaration) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *ExtensionRangeOptions_Declaration) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *ExtensionRangeOptions_Declaration) GetNumber() int32 {
 	if x != nil && x.Number != nil {
 		return *x.Number
@@ -2719,6 +3427,29 @@ func (x *EnumDescriptorProto_EnumReservedRange) Reset() {
 
 func (*EnumDescriptorProto_EnumReservedRange) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *EnumDescriptorProto_EnumReservedRange) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *EnumDescriptorProto_EnumReservedRange) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *EnumDescriptorProto_EnumReservedRange) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *EnumDescriptorProto_EnumReservedRange) GetStart() int32 {
 	if x != nil && x.Start != nil {
 		return *x.Start
@@ -2745,6 +3476,29 @@ func (x *FieldOptions_EditionDefault) Reset() {
 
 func (*FieldOptions_EditionDefault) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FieldOptions_EditionDefault) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FieldOptions_EditionDefault) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FieldOptions_EditionDefault) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *FieldOptions_EditionDefault) GetEdition() Edition {
 	if x != nil && x.Edition != nil {
 		return *x.Edition
@@ -2776,6 +3530,29 @@ func (x *UninterpretedOption_NamePart) Reset() {
 
 func (*UninterpretedOption_NamePart) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *UninterpretedOption_NamePart) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *UninterpretedOption_NamePart) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *UninterpretedOption_NamePart) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *UninterpretedOption_NamePart) GetNamePart() string {
 	if x != nil && x.NamePart != nil {
 		return *x.NamePart
@@ -2806,6 +3583,30 @@ func (x *FeatureSetDefaults_FeatureSetEditionDefault) Reset() {
 
 func (*FeatureSetDefaults_FeatureSetEditionDefault) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *FeatureSetDefaults_FeatureSetEditionDefault) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *FeatureSetDefaults_FeatureSetEditionDefault) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *FeatureSetDefaults_FeatureSetEditionDefault) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Features.DiscardUnknownVT()
+}
+
 func (x *FeatureSetDefaults_FeatureSetEditionDefault) GetEdition() Edition {
 	if x != nil && x.Edition != nil {
 		return *x.Edition
@@ -2921,6 +3722,29 @@ func (x *SourceCodeInfo_Location) Reset() {
 
 func (*SourceCodeInfo_Location) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *SourceCodeInfo_Location) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *SourceCodeInfo_Location) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *SourceCodeInfo_Location) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *SourceCodeInfo_Location) GetPath() []int32 {
 	if x != nil {
 		return x.Path
@@ -2979,6 +3803,29 @@ func (x *GeneratedCodeInfo_Annotation) Reset() {
 
 func (*GeneratedCodeInfo_Annotation) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *GeneratedCodeInfo_Annotation) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *GeneratedCodeInfo_Annotation) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *GeneratedCodeInfo_Annotation) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *GeneratedCodeInfo_Annotation) GetPath() []int32 {
 	if x != nil {
 		return x.Path
@@ -11100,6 +11947,14 @@ func (m *FileDescriptorSet) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeFileDescriptorSetFileVT iterates over the File elements encoded in dAtA, a serialized FileDescriptorSet,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileDescriptorSetFileVT(dAtA []byte) iter.Seq2[*FileDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 1, (*FileDescriptorProto).UnmarshalVT)
+}
 func (m *FileDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11336,6 +12191,38 @@ func (m *FileDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeFileDescriptorProtoMessageTypeVT iterates over the MessageType elements encoded in dAtA, a serialized FileDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileDescriptorProtoMessageTypeVT(dAtA []byte) iter.Seq2[*DescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 4, (*DescriptorProto).UnmarshalVT)
+}
+
+// RangeFileDescriptorProtoEnumTypeVT iterates over the EnumType elements encoded in dAtA, a serialized FileDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileDescriptorProtoEnumTypeVT(dAtA []byte) iter.Seq2[*EnumDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 5, (*EnumDescriptorProto).UnmarshalVT)
+}
+
+// RangeFileDescriptorProtoServiceVT iterates over the Service elements encoded in dAtA, a serialized FileDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileDescriptorProtoServiceVT(dAtA []byte) iter.Seq2[*ServiceDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 6, (*ServiceDescriptorProto).UnmarshalVT)
+}
+
+// RangeFileDescriptorProtoExtensionVT iterates over the Extension elements encoded in dAtA, a serialized FileDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileDescriptorProtoExtensionVT(dAtA []byte) iter.Seq2[*FieldDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 7, (*FieldDescriptorProto).UnmarshalVT)
+}
 func (m *DescriptorProto_ExtensionRange) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11643,6 +12530,62 @@ func (m *DescriptorProto) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeDescriptorProtoFieldVT iterates over the Field elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoFieldVT(dAtA []byte) iter.Seq2[*FieldDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 2, (*FieldDescriptorProto).UnmarshalVT)
+}
+
+// RangeDescriptorProtoNestedTypeVT iterates over the NestedType elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoNestedTypeVT(dAtA []byte) iter.Seq2[*DescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 3, (*DescriptorProto).UnmarshalVT)
+}
+
+// RangeDescriptorProtoEnumTypeVT iterates over the EnumType elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoEnumTypeVT(dAtA []byte) iter.Seq2[*EnumDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 4, (*EnumDescriptorProto).UnmarshalVT)
+}
+
+// RangeDescriptorProtoExtensionRangeVT iterates over the ExtensionRange elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoExtensionRangeVT(dAtA []byte) iter.Seq2[*DescriptorProto_ExtensionRange, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 5, (*DescriptorProto_ExtensionRange).UnmarshalVT)
+}
+
+// RangeDescriptorProtoExtensionVT iterates over the Extension elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoExtensionVT(dAtA []byte) iter.Seq2[*FieldDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 6, (*FieldDescriptorProto).UnmarshalVT)
+}
+
+// RangeDescriptorProtoOneofDeclVT iterates over the OneofDecl elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoOneofDeclVT(dAtA []byte) iter.Seq2[*OneofDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 8, (*OneofDescriptorProto).UnmarshalVT)
+}
+
+// RangeDescriptorProtoReservedRangeVT iterates over the ReservedRange elements encoded in dAtA, a serialized DescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeDescriptorProtoReservedRangeVT(dAtA []byte) iter.Seq2[*DescriptorProto_ReservedRange, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 9, (*DescriptorProto_ReservedRange).UnmarshalVT)
+}
 func (m *ExtensionRangeOptions_Declaration) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11832,6 +12775,22 @@ func (m *ExtensionRangeOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeExtensionRangeOptionsDeclarationVT iterates over the Declaration elements encoded in dAtA, a serialized ExtensionRangeOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeExtensionRangeOptionsDeclarationVT(dAtA []byte) iter.Seq2[*ExtensionRangeOptions_Declaration, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 2, (*ExtensionRangeOptions_Declaration).UnmarshalVT)
+}
+
+// RangeExtensionRangeOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized ExtensionRangeOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeExtensionRangeOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *FieldDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12226,6 +13185,22 @@ func (m *EnumDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeEnumDescriptorProtoValueVT iterates over the Value elements encoded in dAtA, a serialized EnumDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEnumDescriptorProtoValueVT(dAtA []byte) iter.Seq2[*EnumValueDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 2, (*EnumValueDescriptorProto).UnmarshalVT)
+}
+
+// RangeEnumDescriptorProtoReservedRangeVT iterates over the ReservedRange elements encoded in dAtA, a serialized EnumDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEnumDescriptorProtoReservedRangeVT(dAtA []byte) iter.Seq2[*EnumDescriptorProto_EnumReservedRange, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 4, (*EnumDescriptorProto_EnumReservedRange).UnmarshalVT)
+}
 func (m *EnumValueDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12383,6 +13358,14 @@ func (m *ServiceDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeServiceDescriptorProtoMethodVT iterates over the Method elements encoded in dAtA, a serialized ServiceDescriptorProto,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeServiceDescriptorProtoMethodVT(dAtA []byte) iter.Seq2[*MethodDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 2, (*MethodDescriptorProto).UnmarshalVT)
+}
 func (m *MethodDescriptorProto) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12762,6 +13745,14 @@ func (m *FileOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeFileOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized FileOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFileOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *MessageOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12887,6 +13878,14 @@ func (m *MessageOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeMessageOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized MessageOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeMessageOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *FieldOptions_EditionDefault) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13170,6 +14169,22 @@ func (m *FieldOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeFieldOptionsEditionDefaultsVT iterates over the EditionDefaults elements encoded in dAtA, a serialized FieldOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFieldOptionsEditionDefaultsVT(dAtA []byte) iter.Seq2[*FieldOptions_EditionDefault, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 20, (*FieldOptions_EditionDefault).UnmarshalVT)
+}
+
+// RangeFieldOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized FieldOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFieldOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *OneofOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13240,6 +14255,14 @@ func (m *OneofOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeOneofOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized OneofOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeOneofOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *EnumOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13343,6 +14366,14 @@ func (m *EnumOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeEnumOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized EnumOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEnumOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *EnumValueOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13435,6 +14466,14 @@ func (m *EnumValueOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeEnumValueOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized EnumValueOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeEnumValueOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *ServiceOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13516,6 +14555,14 @@ func (m *ServiceOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeServiceOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized ServiceOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeServiceOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *MethodOptions) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13609,6 +14656,14 @@ func (m *MethodOptions) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeMethodOptionsUninterpretedOptionVT iterates over the UninterpretedOption elements encoded in dAtA, a serialized MethodOptions,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeMethodOptionsUninterpretedOptionVT(dAtA []byte) iter.Seq2[*UninterpretedOption, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 999, (*UninterpretedOption).UnmarshalVT)
+}
 func (m *UninterpretedOption_NamePart) UnmarshalVT(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -13797,6 +14852,14 @@ func (m *UninterpretedOption) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeUninterpretedOptionNameVT iterates over the Name elements encoded in dAtA, a serialized UninterpretedOption,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeUninterpretedOptionNameVT(dAtA []byte) iter.Seq2[*UninterpretedOption_NamePart, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 2, (*UninterpretedOption_NamePart).UnmarshalVT)
+}
 func (m *FeatureSet) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14059,6 +15122,14 @@ func (m *FeatureSetDefaults) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeFeatureSetDefaultsDefaultsVT iterates over the Defaults elements encoded in dAtA, a serialized FeatureSetDefaults,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeFeatureSetDefaultsDefaultsVT(dAtA []byte) iter.Seq2[*FeatureSetDefaults_FeatureSetEditionDefault, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 1, (*FeatureSetDefaults_FeatureSetEditionDefault).UnmarshalVT)
+}
 func (m *SourceCodeInfo_Location) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14246,6 +15317,14 @@ func (m *SourceCodeInfo) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeSourceCodeInfoLocationVT iterates over the Location elements encoded in dAtA, a serialized SourceCodeInfo,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeSourceCodeInfoLocationVT(dAtA []byte) iter.Seq2[*SourceCodeInfo_Location, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 1, (*SourceCodeInfo_Location).UnmarshalVT)
+}
 func (m *GeneratedCodeInfo_Annotation) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14415,6 +15494,14 @@ func (m *GeneratedCodeInfo) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeGeneratedCodeInfoAnnotationVT iterates over the Annotation elements encoded in dAtA, a serialized GeneratedCodeInfo,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeGeneratedCodeInfoAnnotationVT(dAtA []byte) iter.Seq2[*GeneratedCodeInfo_Annotation, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 1, (*GeneratedCodeInfo_Annotation).UnmarshalVT)
+}
 func (m *FileDescriptorSet) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,10 +5,15 @@
 package structpb
 
 import (
+	bytes "bytes"
+	cmp "cmp"
 	base64 "encoding/base64"
 	errors "errors"
 	fmt "fmt"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	iter "iter"
 	math "math"
 	slices "slices"
 	strconv "strconv"
@@ -131,6 +136,32 @@ func (x *Struct) Reset() {
 
 func (*Struct) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Struct) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Struct) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Struct) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Fields {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *Struct) GetFields() map[string]*Value {
 	if x != nil {
 		return x.Fields
@@ -302,6 +333,35 @@ func (x *Value) Reset() {
 
 func (*Value) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Value) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Value) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Value) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	if v, ok := x.Kind.(*Value_StructValue); ok {
+		v.StructValue.DiscardUnknownVT()
+	}
+	if v, ok := x.Kind.(*Value_ListValue); ok {
+		v.ListValue.DiscardUnknownVT()
+	}
+}
+
 func (m *Value) GetKind() isValue_Kind {
 	if m != nil {
 		return m.Kind
@@ -437,6 +497,32 @@ func (x *ListValue) Reset() {
 
 func (*ListValue) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *ListValue) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *ListValue) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *ListValue) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.Values {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *ListValue) GetValues() []*Value {
 	if x != nil {
 		return x.Values
@@ -456,6 +542,30 @@ func (x *Struct_FieldsEntry) Reset() {
 
 func (*Struct_FieldsEntry) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Struct_FieldsEntry) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Struct_FieldsEntry) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Struct_FieldsEntry) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.Value.DiscardUnknownVT()
+}
+
 func (x *Struct_FieldsEntry) GetKey() string {
 	if x != nil {
 		return x.Key
@@ -598,6 +708,353 @@ func (m *ListValue) CloneMessageVT() protobuf_go_lite.CloneMessage {
 	return m.CloneVT()
 }
 
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Struct) CompareVT(that *Struct) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.CompareVTMap(m.Fields, that.Fields, cmp.Compare[string], (*Value).CompareVT); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *Value) CompareVT(that *Value) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	{
+		a, aok := m.Kind.(*Value_NullValue)
+		b, bok := that.Kind.(*Value_NullValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.NullValue, b.NullValue); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Kind.(*Value_NumberValue)
+		b, bok := that.Kind.(*Value_NumberValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.NumberValue, b.NumberValue); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Kind.(*Value_StringValue)
+		b, bok := that.Kind.(*Value_StringValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := cmp.Compare(a.StringValue, b.StringValue); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Kind.(*Value_BoolValue)
+		b, bok := that.Kind.(*Value_BoolValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareBool(a.BoolValue, b.BoolValue); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Kind.(*Value_StructValue)
+		b, bok := that.Kind.(*Value_StructValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.StructValue, b.StructValue, (*Struct).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	{
+		a, aok := m.Kind.(*Value_ListValue)
+		b, bok := that.Kind.(*Value_ListValue)
+		if c := protobuf_go_lite.CompareBool(aok, bok); c != 0 {
+			return c
+		}
+		if aok {
+			if c := protobuf_go_lite.CompareVTImplicit(a.ListValue, b.ListValue, (*ListValue).CompareVT); c != 0 {
+				return c
+			}
+		}
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CompareVT returns -1, 0 or +1 as m orders before, equal to or after that.
+// Fields are compared in field number order: unset fields order before set
+// ones, repeated fields compare element by element and maps entry by entry in
+// key order. A nil message orders before any other.
+func (m *ListValue) CompareVT(that *ListValue) int {
+	if m == that {
+		return 0
+	}
+	if m == nil {
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := protobuf_go_lite.CompareVTSlice(m.Values, that.Values, (*Value).CompareVT); c != 0 {
+		return c
+	}
+	return bytes.Compare(m.unknownFields, that.unknownFields)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Struct) CopyVT(dst *Struct) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Fields = protobuf_go_lite.CopyVTMap(dst.Fields, m.Fields, (*Value).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *Value) CopyVT(dst *Value) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch v := m.Kind.(type) {
+	case nil:
+		dst.Kind = nil
+	case *Value_NullValue:
+		d, ok := dst.Kind.(*Value_NullValue)
+		if !ok {
+			d = &Value_NullValue{}
+			dst.Kind = d
+		}
+		d.NullValue = v.NullValue
+	case *Value_NumberValue:
+		d, ok := dst.Kind.(*Value_NumberValue)
+		if !ok {
+			d = &Value_NumberValue{}
+			dst.Kind = d
+		}
+		d.NumberValue = v.NumberValue
+	case *Value_StringValue:
+		d, ok := dst.Kind.(*Value_StringValue)
+		if !ok {
+			d = &Value_StringValue{}
+			dst.Kind = d
+		}
+		d.StringValue = v.StringValue
+	case *Value_BoolValue:
+		d, ok := dst.Kind.(*Value_BoolValue)
+		if !ok {
+			d = &Value_BoolValue{}
+			dst.Kind = d
+		}
+		d.BoolValue = v.BoolValue
+	case *Value_StructValue:
+		d, ok := dst.Kind.(*Value_StructValue)
+		if !ok {
+			d = &Value_StructValue{}
+			dst.Kind = d
+		}
+		d.StructValue = protobuf_go_lite.CopyVTValue(d.StructValue, v.StructValue, (*Struct).CopyVT)
+	case *Value_ListValue:
+		d, ok := dst.Kind.(*Value_ListValue)
+		if !ok {
+			d = &Value_ListValue{}
+			dst.Kind = d
+		}
+		d.ListValue = protobuf_go_lite.CopyVTValue(d.ListValue, v.ListValue, (*ListValue).CopyVT)
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// CopyVT overwrites dst with a deep copy of m, reusing the slices, maps and
+// sub-messages already allocated in dst. A nil m resets dst, and a nil dst
+// is left unchanged.
+func (m *ListValue) CopyVT(dst *ListValue) {
+	if m == dst || dst == nil {
+		return
+	}
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Values = protobuf_go_lite.CopyVTSlice(dst.Values, m.Values, (*Value).CopyVT)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Struct) DiffVT(that *Struct) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Struct) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Struct) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Struct{}
+	}
+	if that == nil {
+		that = &Struct{}
+	}
+	diffs = protobuf_go_lite.AppendDiffVTMap(diffs, prefix, "fields", m.Fields, that.Fields, (*Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *Value) DiffVT(that *Value) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *Value) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *Value) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &Value{}
+	}
+	if that == nil {
+		that = &Value{}
+	}
+	{
+		var a, b *NullValue
+		if v, ok := m.Kind.(*Value_NullValue); ok {
+			a = &v.NullValue
+		}
+		if v, ok := that.Kind.(*Value_NullValue); ok {
+			b = &v.NullValue
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "null_value", a, b)
+	}
+	{
+		var a, b *float64
+		if v, ok := m.Kind.(*Value_NumberValue); ok {
+			a = &v.NumberValue
+		}
+		if v, ok := that.Kind.(*Value_NumberValue); ok {
+			b = &v.NumberValue
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "number_value", a, b)
+	}
+	{
+		var a, b *string
+		if v, ok := m.Kind.(*Value_StringValue); ok {
+			a = &v.StringValue
+		}
+		if v, ok := that.Kind.(*Value_StringValue); ok {
+			b = &v.StringValue
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "string_value", a, b)
+	}
+	{
+		var a, b *bool
+		if v, ok := m.Kind.(*Value_BoolValue); ok {
+			a = &v.BoolValue
+		}
+		if v, ok := that.Kind.(*Value_BoolValue); ok {
+			b = &v.BoolValue
+		}
+		diffs = protobuf_go_lite.AppendDiffPtr(diffs, prefix, "bool_value", a, b)
+	}
+	{
+		var a, b *Struct
+		if v, ok := m.Kind.(*Value_StructValue); ok {
+			a = v.StructValue
+		}
+		if v, ok := that.Kind.(*Value_StructValue); ok {
+			b = v.StructValue
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "struct_value", a, b, (*Struct).AppendDiffVT)
+	}
+	{
+		var a, b *ListValue
+		if v, ok := m.Kind.(*Value_ListValue); ok {
+			a = v.ListValue
+		}
+		if v, ok := that.Kind.(*Value_ListValue); ok {
+			b = v.ListValue
+		}
+		diffs = protobuf_go_lite.AppendDiffVTValue(diffs, prefix, "list_value", a, b, (*ListValue).AppendDiffVT)
+	}
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
+// DiffVT returns the differences between m and that, in field order. A nil
+// message is treated as empty.
+func (m *ListValue) DiffVT(that *ListValue) []protobuf_go_lite.FieldDiff {
+	return m.AppendDiffVT(nil, "", that)
+}
+
+// AppendDiffVT appends the differences between m and that to diffs, prefixing
+// their paths with prefix.
+func (m *ListValue) AppendDiffVT(diffs []protobuf_go_lite.FieldDiff, prefix string, that *ListValue) []protobuf_go_lite.FieldDiff {
+	if m == that {
+		return diffs
+	}
+	if m == nil {
+		m = &ListValue{}
+	}
+	if that == nil {
+		that = &ListValue{}
+	}
+	diffs = protobuf_go_lite.AppendDiffVTSlice(diffs, prefix, "values", m.Values, that.Values, (*Value).AppendDiffVT)
+	diffs = protobuf_go_lite.AppendDiffBytes(diffs, prefix, "<unknown>", m.unknownFields, that.unknownFields)
+	return diffs
+}
+
 func (this *Struct) EqualVT(that *Struct) bool {
 	if this == that {
 		return true
@@ -764,6 +1221,239 @@ func (this *ListValue) EqualMessageVT(thatMsg any) bool {
 	}
 	return this.EqualVT(that)
 }
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Struct) EqualVTOpts(that *Struct, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Struct) EqualVTOptsPrefix(that *Struct, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Struct{}
+		}
+		if that == nil {
+			that = &Struct{}
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "fields"); ok && !protobuf_go_lite.EqualVTOptsMap(this.Fields, that.Fields, opts, path, (*Value).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *Value) EqualVTOpts(that *Value, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *Value) EqualVTOptsPrefix(that *Value, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &Value{}
+		}
+		if that == nil {
+			that = &Value{}
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "null_value"); ok {
+		a, aok := this.Kind.(*Value_NullValue)
+		b, bok := that.Kind.(*Value_NullValue)
+		if aok != bok || aok && a.NullValue != b.NullValue {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "number_value"); ok {
+		a, aok := this.Kind.(*Value_NumberValue)
+		b, bok := that.Kind.(*Value_NumberValue)
+		if aok != bok || aok && !protobuf_go_lite.EqualOptsFloat(opts, a.NumberValue, b.NumberValue) {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "string_value"); ok {
+		a, aok := this.Kind.(*Value_StringValue)
+		b, bok := that.Kind.(*Value_StringValue)
+		if aok != bok || aok && a.StringValue != b.StringValue {
+			return false
+		}
+	}
+	if _, ok := opts.FieldPath(prefix, "bool_value"); ok {
+		a, aok := this.Kind.(*Value_BoolValue)
+		b, bok := that.Kind.(*Value_BoolValue)
+		if aok != bok || aok && a.BoolValue != b.BoolValue {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "struct_value"); ok {
+		a, aok := this.Kind.(*Value_StructValue)
+		b, bok := that.Kind.(*Value_StructValue)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.StructValue, b.StructValue, opts, path, (*Struct).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "list_value"); ok {
+		a, aok := this.Kind.(*Value_ListValue)
+		b, bok := that.Kind.(*Value_ListValue)
+		if aok != bok || aok && !protobuf_go_lite.EqualVTOptsImplicit(a.ListValue, b.ListValue, opts, path, (*ListValue).EqualVTOptsPrefix) {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// EqualVTOpts reports whether this and that are equal under opts.
+func (this *ListValue) EqualVTOpts(that *ListValue, opts protobuf_go_lite.EqualOptions) bool {
+	return this.EqualVTOptsPrefix(that, &opts, "")
+}
+
+// EqualVTOptsPrefix reports whether this and that are equal under opts, where
+// prefix is the path of the messages.
+func (this *ListValue) EqualVTOptsPrefix(that *ListValue, opts *protobuf_go_lite.EqualOptions, prefix string) bool {
+	if this == that {
+		return true
+	}
+	if this == nil || that == nil {
+		if opts.EmptyMessages != protobuf_go_lite.NilEqualsEmpty {
+			return false
+		}
+		if this == nil {
+			this = &ListValue{}
+		}
+		if that == nil {
+			that = &ListValue{}
+		}
+	}
+	if path, ok := opts.FieldPath(prefix, "values"); ok && !protobuf_go_lite.EqualVTOptsSlice(this.Values, that.Values, opts, path, (*Value).EqualVTOptsPrefix) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Struct) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Struct) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Struct) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.Fields) != 0 {
+			w.Field(1)
+			protobuf_go_lite.HashMap(w, m.Fields, func(w *protobuf_go_lite.Hasher, k string, v *Value) {
+				w.String(k)
+				v.WriteHashVT(w)
+			})
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *Value) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *Value) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *Value) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		switch v := m.Kind.(type) {
+		case *Value_NullValue:
+			w.Field(1)
+			w.Uint64(uint64(v.NullValue))
+		case *Value_NumberValue:
+			w.Field(2)
+			w.Float64(float64(v.NumberValue))
+		case *Value_StringValue:
+			w.Field(3)
+			w.String(v.StringValue)
+		case *Value_BoolValue:
+			w.Field(4)
+			w.Bool(v.BoolValue)
+		case *Value_StructValue:
+			w.Field(5)
+			v.StructValue.WriteHashVT(w)
+		case *Value_ListValue:
+			w.Field(6)
+			v.ListValue.WriteHashVT(w)
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
+// HashVT writes the semantic contents of m to h, such that messages equal
+// under EqualVT hash the same. The order of map entries and unknown fields
+// does not matter.
+func (m *ListValue) HashVT(h hash.Hash64) {
+	w := protobuf_go_lite.NewHasher(h)
+	m.WriteHashVT(w)
+	w.Flush()
+}
+
+// Hash64VT returns the 64-bit FNV-1a hash of the semantic contents of m.
+func (m *ListValue) Hash64VT() uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+// WriteHashVT writes the semantic contents of m to w. A nil message is
+// written like an empty one.
+func (m *ListValue) WriteHashVT(w *protobuf_go_lite.Hasher) {
+	if m != nil {
+		if len(m.Values) != 0 {
+			w.Field(1)
+			w.Uint64(uint64(len(m.Values)))
+			for _, v := range m.Values {
+				v.WriteHashVT(w)
+			}
+		}
+		w.Unknown(m.unknownFields)
+	}
+	w.End()
+}
+
 func (m *Struct) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -1259,14 +1949,154 @@ func (m *ListValue) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Struct) SizeVT() (n int) {
-	if m == nil {
-		return 0
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Struct) MergeVT(src *Struct) {
+	if m == nil || src == nil {
+		return
 	}
-	var l int
-	_ = l
-	for k, v := range m.Fields {
-		_ = k
+	if len(src.Fields) > 0 {
+		if m.Fields == nil {
+			m.Fields = make(map[string]*Value, len(src.Fields))
+		}
+		for k, v := range src.Fields {
+			var e *Value
+			if v != nil {
+				e = new(Value)
+				e.MergeVT(v)
+			}
+			m.Fields[k] = e
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Struct) MergeMessageVT(src any) bool {
+	s, ok := src.(*Struct)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *Value) MergeVT(src *Value) {
+	if m == nil || src == nil {
+		return
+	}
+	switch v := src.Kind.(type) {
+	case *Value_NullValue:
+		m.Kind = &Value_NullValue{NullValue: v.NullValue}
+	case *Value_NumberValue:
+		m.Kind = &Value_NumberValue{NumberValue: v.NumberValue}
+	case *Value_StringValue:
+		m.Kind = &Value_StringValue{StringValue: v.StringValue}
+	case *Value_BoolValue:
+		m.Kind = &Value_BoolValue{BoolValue: v.BoolValue}
+	case *Value_StructValue:
+		if cur, ok := m.Kind.(*Value_StructValue); ok && cur.StructValue != nil {
+			cur.StructValue.MergeVT(v.StructValue)
+		} else {
+			e := new(Struct)
+			e.MergeVT(v.StructValue)
+			m.Kind = &Value_StructValue{StructValue: e}
+		}
+	case *Value_ListValue:
+		if cur, ok := m.Kind.(*Value_ListValue); ok && cur.ListValue != nil {
+			cur.ListValue.MergeVT(v.ListValue)
+		} else {
+			e := new(ListValue)
+			e.MergeVT(v.ListValue)
+			m.Kind = &Value_ListValue{ListValue: e}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Value) MergeMessageVT(src any) bool {
+	s, ok := src.(*Value)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// MergeVT merges src into m: set scalars overwrite, repeated fields append,
+// map entries overwrite, sub-messages merge recursively and a set oneof
+// replaces the current one. Values are deep copied from src.
+func (m *ListValue) MergeVT(src *ListValue) {
+	if m == nil || src == nil {
+		return
+	}
+	for _, v := range src.Values {
+		var e *Value
+		if v != nil {
+			e = new(Value)
+			e.MergeVT(v)
+		}
+		m.Values = append(m.Values, e)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ListValue) MergeMessageVT(src any) bool {
+	s, ok := src.(*ListValue)
+	if !ok {
+		return false
+	}
+	m.MergeVT(s)
+	return true
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Struct) RedactVT() {
+	if m == nil {
+		return
+	}
+	for _, v := range m.Fields {
+		v.RedactVT()
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *Value) RedactVT() {
+	if m == nil {
+		return
+	}
+	switch v := m.Kind.(type) {
+	case *Value_StructValue:
+		v.StructValue.RedactVT()
+	case *Value_ListValue:
+		v.ListValue.RedactVT()
+	}
+}
+
+// RedactVT clears the fields of m marked debug_redact, recursing into
+// sub-messages, including those held by repeated fields, maps and oneofs.
+func (m *ListValue) RedactVT() {
+	if m == nil {
+		return
+	}
+	for _, v := range m.Values {
+		v.RedactVT()
+	}
+}
+
+func (m *Struct) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	for k, v := range m.Fields {
+		_ = k
 		_ = v
 		l = 0
 		if v != nil {
@@ -1743,6 +2573,623 @@ func (m *ListValue) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeListValueValuesVT iterates over the Values elements encoded in dAtA, a serialized ListValue,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeListValueValuesVT(dAtA []byte) iter.Seq2[*Value, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 1, (*Value).UnmarshalVT)
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Struct) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Struct: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Struct: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Fields == nil {
+				m.Fields = make(map[string]*Value)
+			}
+			var mapkey string
+			var mapvalue *Value
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Value{}
+					if err := mapvalue.UnmarshalVT(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Fields[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Value) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullValue", wireType)
+			}
+			var v NullValue
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = NullValue(_v)
+			if err != nil {
+				return err
+			}
+			m.Kind = &Value_NullValue{NullValue: v}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberValue", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Kind = &Value_NumberValue{NumberValue: float64(math.Float64frombits(v))}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeString(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Kind = &Value_StringValue{StringValue: v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.Kind = &Value_BoolValue{BoolValue: b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Kind.(*Value_StructValue); ok {
+				if err := oneof.StructValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Struct{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Kind = &Value_StructValue{StructValue: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Kind.(*Value_ListValue); ok {
+				if err := oneof.ListValue.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &ListValue{}
+				if err := v.UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Kind = &Value_ListValue{ListValue: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFields is like UnmarshalVT but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *ListValue) UnmarshalVTFields(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Values = append(m.Values, &Value{})
+			if err := m.Values[len(m.Values)-1].UnmarshalVT(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Struct) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Struct: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Struct: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			iNdEx = msgStart
+			if m.Fields == nil {
+				m.Fields = make(map[string]*Value)
+			}
+			var mapkey string
+			var mapvalue *Value
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+				if err != nil {
+					return err
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					mapkey, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+				} else if fieldNum == 2 {
+					msgStartmapvalue, postmsgIndexmapvalue, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+					if err != nil {
+						return err
+					}
+					mapvalue = &Value{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[msgStartmapvalue:postmsgIndexmapvalue]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, iNdEx, postIndex)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			m.Fields[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *Value) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullValue", wireType)
+			}
+			var v NullValue
+			var _v uint64
+			_v, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+			v = NullValue(_v)
+			if err != nil {
+				return err
+			}
+			m.Kind = &Value_NullValue{NullValue: v}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberValue", wireType)
+			}
+			var v uint64
+			var _v64 uint64
+			_v64, iNdEx, err = protobuf_go_lite.DecodeFixed64(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			v = uint64(_v64)
+			m.Kind = &Value_NumberValue{NumberValue: float64(math.Float64frombits(v))}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var v string
+			v, iNdEx, err = protobuf_go_lite.DecodeStringUnsafe(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Kind = &Value_StringValue{StringValue: v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v bool
+			v, iNdEx, err = protobuf_go_lite.DecodeVarintBool(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			b := bool(v)
+			m.Kind = &Value_BoolValue{BoolValue: b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Kind.(*Value_StructValue); ok {
+				if err := oneof.StructValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Struct{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Kind = &Value_StructValue{StructValue: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListValue", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			if oneof, ok := m.Kind.(*Value_ListValue); ok {
+				if err := oneof.ListValue.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &ListValue{}
+				if err := v.UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+					return err
+				}
+				m.Kind = &Value_ListValue{ListValue: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// UnmarshalVTFieldsUnsafe is like UnmarshalVTUnsafe but only decodes the listed field numbers.
+// Other fields are skipped without being decoded or kept as unknown fields.
+// Required fields are only checked if they are listed.
+func (m *ListValue) UnmarshalVTFieldsUnsafe(dAtA []byte, fields ...int32) error {
+	l := len(dAtA)
+	iNdEx := 0
+	var err error
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		wire, iNdEx, err = protobuf_go_lite.DecodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		if !slices.Contains(fields, fieldNum) {
+			iNdEx, err = protobuf_go_lite.SkipWithin(dAtA, preIndex, l)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			msgStart, postIndex, err := protobuf_go_lite.DecodeLengthDelimited(dAtA, iNdEx)
+			if err != nil {
+				return err
+			}
+			m.Values = append(m.Values, &Value{})
+			if err := m.Values[len(m.Values)-1].UnmarshalVTUnsafe(dAtA[msgStart:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protobuf_go_lite.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protobuf_go_lite.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *Struct) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
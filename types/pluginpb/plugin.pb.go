@@ -7,6 +7,7 @@ package google_protobuf_compiler
 import (
 	fmt "fmt"
 	io "io"
+	iter "iter"
 	slices "slices"
 	strconv "strconv"
 
@@ -88,6 +89,29 @@ func (x *Version) Reset() {
 
 func (*Version) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *Version) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *Version) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *Version) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+}
+
 func (x *Version) GetMajor() int32 {
 	if x != nil && x.Major != nil {
 		return *x.Major
@@ -159,6 +183,36 @@ func (x *CodeGeneratorRequest) Reset() {
 
 func (*CodeGeneratorRequest) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CodeGeneratorRequest) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CodeGeneratorRequest) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CodeGeneratorRequest) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.ProtoFile {
+		v.DiscardUnknownVT()
+	}
+	for _, v := range x.SourceFileDescriptors {
+		v.DiscardUnknownVT()
+	}
+	x.CompilerVersion.DiscardUnknownVT()
+}
+
 func (x *CodeGeneratorRequest) GetFileToGenerate() []string {
 	if x != nil {
 		return x.FileToGenerate
@@ -228,6 +282,32 @@ func (x *CodeGeneratorResponse) Reset() {
 
 func (*CodeGeneratorResponse) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CodeGeneratorResponse) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CodeGeneratorResponse) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CodeGeneratorResponse) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	for _, v := range x.File {
+		v.DiscardUnknownVT()
+	}
+}
+
 func (x *CodeGeneratorResponse) GetError() string {
 	if x != nil && x.Error != nil {
 		return *x.Error
@@ -334,6 +414,30 @@ func (x *CodeGeneratorResponse_File) Reset() {
 
 func (*CodeGeneratorResponse_File) ProtoMessage() {}
 
+// GetUnknownFieldsVT returns the encoded fields of x that were not recognized
+// when decoding. The returned slice is not a copy.
+func (x *CodeGeneratorResponse_File) GetUnknownFieldsVT() []byte {
+	if x != nil {
+		return x.unknownFields
+	}
+	return nil
+}
+
+// SetUnknownFieldsVT replaces the unknown fields of x with b, which must hold
+// encoded fields. b is retained, not copied.
+func (x *CodeGeneratorResponse_File) SetUnknownFieldsVT(b []byte) {
+	x.unknownFields = b
+}
+
+// DiscardUnknownVT clears the unknown fields of x and of all sub-messages.
+func (x *CodeGeneratorResponse_File) DiscardUnknownVT() {
+	if x == nil {
+		return
+	}
+	x.unknownFields = nil
+	x.GeneratedCodeInfo.DiscardUnknownVT()
+}
+
 func (x *CodeGeneratorResponse_File) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
@@ -1468,6 +1572,22 @@ func (m *CodeGeneratorRequest) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeCodeGeneratorRequestProtoFileVT iterates over the ProtoFile elements encoded in dAtA, a serialized CodeGeneratorRequest,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeCodeGeneratorRequestProtoFileVT(dAtA []byte) iter.Seq2[*descriptorpb.FileDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 15, (*descriptorpb.FileDescriptorProto).UnmarshalVT)
+}
+
+// RangeCodeGeneratorRequestSourceFileDescriptorsVT iterates over the SourceFileDescriptors elements encoded in dAtA, a serialized CodeGeneratorRequest,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeCodeGeneratorRequestSourceFileDescriptorsVT(dAtA []byte) iter.Seq2[*descriptorpb.FileDescriptorProto, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 17, (*descriptorpb.FileDescriptorProto).UnmarshalVT)
+}
 func (m *CodeGeneratorResponse_File) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1650,6 +1770,14 @@ func (m *CodeGeneratorResponse) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+
+// RangeCodeGeneratorResponseFileVT iterates over the File elements encoded in dAtA, a serialized CodeGeneratorResponse,
+// without decoding the other fields.
+// The yielded message is reused between iterations and must be copied to be kept.
+// Only the top-level struct is reused: its nested fields are allocated for each element.
+func RangeCodeGeneratorResponseFileVT(dAtA []byte) iter.Seq2[*CodeGeneratorResponse_File, error] {
+	return protobuf_go_lite.RangeMessages(dAtA, 15, (*CodeGeneratorResponse_File).UnmarshalVT)
+}
 func (m *Version) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
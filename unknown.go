@@ -0,0 +1,48 @@
+package protobuf_go_lite
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/aperturerobotics/protobuf-go-lite/encoding/protowire"
+)
+
+// UnknownField is one encoded field from the unknown fields of a message, as
+// returned by GetUnknownFieldsVT.
+type UnknownField struct {
+	// Number is the field number.
+	Number protowire.Number
+	// Type is the wire type.
+	Type protowire.Type
+	// Raw is the complete encoded field, including the tag.
+	Raw []byte
+}
+
+// RangeUnknownFields iterates over the encoded fields in b. Raw aliases b.
+// Iteration stops after the first error is yielded.
+func RangeUnknownFields(b []byte) iter.Seq2[UnknownField, error] {
+	return func(yield func(UnknownField, error) bool) {
+		for idx := 0; idx < len(b); {
+			wire, _, err := DecodeVarint(b, idx)
+			if err != nil {
+				yield(UnknownField{}, err)
+				return
+			}
+			num := protowire.Number(wire >> 3) //nolint:gosec
+			if num <= 0 {
+				yield(UnknownField{}, fmt.Errorf("proto: illegal tag %d (wire type %d)", num, wire&0x7))
+				return
+			}
+			next, err := SkipWithin(b, idx, len(b))
+			if err != nil {
+				yield(UnknownField{}, err)
+				return
+			}
+			field := UnknownField{Number: num, Type: protowire.Type(wire & 0x7), Raw: b[idx:next]}
+			if !yield(field, nil) {
+				return
+			}
+			idx = next
+		}
+	}
+}